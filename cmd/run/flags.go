@@ -27,6 +27,10 @@ type RunFlags struct {
 	useRandomID bool
 	configPaths []string
 	environment string
+
+	adminUsername string
+	adminEmail    string
+	adminPassword string
 }
 
 var runFlags RunFlags
@@ -46,6 +50,9 @@ func (f *RunFlags) addFlags(c *cobra.Command, bc *conf.Bootstrap) {
 	}
 	c.PersistentFlags().StringSliceVar(&f.metadata, "server-metadata", metadataStr, `Example: --server-metadata="tag=sovereign" --server-metadata="email=aidecloud@163.com"`)
 	c.PersistentFlags().StringVar(&f.environment, "environment", f.Environment.String(), `Example: --environment="DEV", --environment="TEST", --environment="PREVIEW", --environment="PROD"`)
+	c.PersistentFlags().StringVar(&f.adminUsername, "admin-username", "", `Example: --admin-username="admin", creates the local admin on first start`)
+	c.PersistentFlags().StringVar(&f.adminEmail, "admin-email", "", `Example: --admin-email="admin@example.com"`)
+	c.PersistentFlags().StringVar(&f.adminPassword, "admin-password", "", `Example: --admin-password="change-me-please"`)
 }
 
 func (f *RunFlags) ApplyToBootstrap() error {
@@ -95,9 +102,29 @@ func (f *RunFlags) ApplyToBootstrap() error {
 		}
 	}
 
+	f.applyBootstrapAdmin()
 	return nil
 }
 
+// applyBootstrapAdmin lets the admin flags override the bootstrap admin of the config files.
+func (f *RunFlags) applyBootstrapAdmin() {
+	if strutil.IsEmpty(f.adminUsername) && strutil.IsEmpty(f.adminEmail) && strutil.IsEmpty(f.adminPassword) {
+		return
+	}
+	if pointer.IsNil(f.BootstrapAdmin) {
+		f.BootstrapAdmin = &conf.BootstrapAdmin{}
+	}
+	if strutil.IsNotEmpty(f.adminUsername) {
+		f.BootstrapAdmin.Username = f.adminUsername
+	}
+	if strutil.IsNotEmpty(f.adminEmail) {
+		f.BootstrapAdmin.Email = f.adminEmail
+	}
+	if strutil.IsNotEmpty(f.adminPassword) {
+		f.BootstrapAdmin.Password = f.adminPassword
+	}
+}
+
 func GetRunFlags() *RunFlags {
 	return &runFlags
 }
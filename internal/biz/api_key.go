@@ -0,0 +1,105 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewAPIKey(apiKeyRepo repository.APIKey, pageTokens *bo.PageTokenCodec, helper *klog.Helper) *APIKey {
+	return &APIKey{
+		apiKeyRepo: apiKeyRepo,
+		pageTokens: pageTokens,
+		helper:     klog.NewHelper(klog.With(helper.Logger(), "biz", "apiKey")),
+	}
+}
+
+type APIKey struct {
+	helper     *klog.Helper
+	apiKeyRepo repository.APIKey
+	pageTokens *bo.PageTokenCodec
+}
+
+// CreateAPIKey creates an API key of the signed-in user, a request signed in with an API key can not create another one.
+func (a *APIKey) CreateAPIKey(ctx context.Context, req *bo.CreateAPIKeyBo) (*bo.CreatedAPIKeyBo, error) {
+	userUID, err := a.interactiveUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserUID = userUID
+	created, err := a.apiKeyRepo.CreateAPIKey(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		a.helper.Errorw("msg", "create api key failed", "error", err, "userUID", userUID)
+		return nil, merr.ErrorInternal("create api key failed").WithCause(err)
+	}
+	return created, nil
+}
+
+func (a *APIKey) ListAPIKeys(ctx context.Context, req *bo.ListAPIKeysBo) (*bo.PageResponseBo[*bo.APIKeyBo], error) {
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	req.UserUID = claims.UID
+	filters := req.PageFilters()
+	if err := a.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := a.apiKeyRepo.ListAPIKeys(ctx, req)
+	if err != nil {
+		a.helper.Errorw("msg", "list api keys failed", "error", err, "userUID", claims.UID)
+		return nil, merr.ErrorInternal("list api keys failed").WithCause(err)
+	}
+	a.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+// RevokeAPIKey revokes an API key of the signed-in user, a key may revoke itself.
+func (a *APIKey) RevokeAPIKey(ctx context.Context, apiKeyUID snowflake.ID) error {
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if err := a.apiKeyRepo.RevokeAPIKey(ctx, claims.UID, apiKeyUID); err != nil {
+		if merr.IsNotFound(err) {
+			return err
+		}
+		a.helper.Errorw("msg", "revoke api key failed", "error", err, "userUID", claims.UID, "apiKeyUID", apiKeyUID)
+		return merr.ErrorInternal("revoke api key failed").WithCause(err)
+	}
+	return nil
+}
+
+// VerifyAPIKey is consulted by the login middleware for every request sent with an API key.
+func (a *APIKey) VerifyAPIKey(ctx context.Context, key string) (*bo.APIKeyBo, error) {
+	apiKey, err := a.apiKeyRepo.VerifyAPIKey(ctx, key)
+	if err != nil {
+		if merr.IsUnauthorized(err) {
+			return nil, err
+		}
+		a.helper.Errorw("msg", "verify api key failed", "error", err)
+		return nil, merr.ErrorInternal("verify api key failed").WithCause(err)
+	}
+	return apiKey, nil
+}
+
+// interactiveUser returns the signed-in user of a request signed in with a JWT, so a leaked key can not mint keys outliving it.
+func (a *APIKey) interactiveUser(ctx context.Context) (snowflake.ID, error) {
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if _, ok := authv1.GetAPIKeyInfo(ctx); ok {
+		return 0, merr.ErrorForbidden("api keys can only be created by a signed-in user")
+	}
+	return claims.UID, nil
+}
@@ -5,6 +5,17 @@ import "github.com/google/wire"
 
 var ProviderSetBiz = wire.NewSet(
 	NewHealth,
+	NewPageTokenCodec,
 	NewNamespace,
+	NewQuota,
 	NewLoginBiz,
+	NewToken,
+	NewAPIKey,
+	NewRBAC,
+	NewPolicy,
+	NewUser,
+	NewOAuth2Provider,
+	NewInvitation,
+	NewLoginLimit,
+	NewMFA,
 )
@@ -0,0 +1,110 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// APIKeyBo 用户的个人访问令牌，ExpiresAt 为零值时永不过期
+type APIKeyBo struct {
+	UID        snowflake.ID
+	UserUID    snowflake.ID
+	Username   string
+	Name       string
+	Prefix     string
+	Scopes     []string
+	Namespaces []string
+	ExpiresAt  time.Time
+	LastUsedAt time.Time
+	CreatedAt  time.Time
+}
+
+func (b *APIKeyBo) ToAPIV1APIKeyItem() *apiv1.APIKeyItem {
+	item := &apiv1.APIKeyItem{
+		Uid:        b.UID.Int64(),
+		Name:       b.Name,
+		Prefix:     b.Prefix,
+		Scopes:     b.Scopes,
+		Namespaces: b.Namespaces,
+		CreatedAt:  b.CreatedAt.Format(time.DateTime),
+	}
+	if !b.ExpiresAt.IsZero() {
+		item.ExpiresAt = b.ExpiresAt.Format(time.DateTime)
+	}
+	if !b.LastUsedAt.IsZero() {
+		item.LastUsedAt = b.LastUsedAt.Format(time.DateTime)
+	}
+	return item
+}
+
+// CreateAPIKeyBo 为用户 UserUID 创建 API key，ExpiresAt 为零值时永不过期
+type CreateAPIKeyBo struct {
+	UserUID    snowflake.ID
+	Name       string
+	Scopes     []string
+	Namespaces []string
+	ExpiresAt  time.Time
+}
+
+func NewCreateAPIKeyBo(req *apiv1.CreateAPIKeyRequest) *CreateAPIKeyBo {
+	b := &CreateAPIKeyBo{
+		Name:       req.GetName(),
+		Scopes:     req.GetScopes(),
+		Namespaces: req.GetNamespaces(),
+	}
+	if expiresIn := req.GetExpiresIn(); expiresIn > 0 {
+		b.ExpiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return b
+}
+
+// CreatedAPIKeyBo 新创建的 API key，Key 只返回这一次
+type CreatedAPIKeyBo struct {
+	APIKey *APIKeyBo
+	Key    string
+}
+
+func (b *CreatedAPIKeyBo) ToAPIV1CreateAPIKeyReply() *apiv1.CreateAPIKeyReply {
+	return &apiv1.CreateAPIKeyReply{
+		ApiKey: b.APIKey.ToAPIV1APIKeyItem(),
+		Key:    b.Key,
+	}
+}
+
+// ListAPIKeysBo 按创建时间倒序分页列出用户的 API key
+type ListAPIKeysBo struct {
+	*PageRequestBo
+	UserUID snowflake.ID
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *ListAPIKeysBo) PageFilters() map[string]string {
+	return map[string]string{
+		"userUID": b.UserUID.String(),
+	}
+}
+
+// NewListAPIKeysBo 创建列出 API key 的请求，UserUID 为登录用户，由 biz 设置
+func NewListAPIKeysBo(req *apiv1.ListAPIKeysRequest) *ListAPIKeysBo {
+	return &ListAPIKeysBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+	}
+}
+
+func ToAPIV1ListAPIKeysReply(pageResponseBo *PageResponseBo[*APIKeyBo]) *apiv1.ListAPIKeysReply {
+	items := make([]*apiv1.APIKeyItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1APIKeyItem())
+	}
+	return &apiv1.ListAPIKeysReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
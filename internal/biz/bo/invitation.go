@@ -0,0 +1,110 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// InvitationBo 邀请码，用于在 inviteOnly 的提供方注册新用户，Provider 为空时适用于所有提供方，MaxUses 为 0 时不限次数，ExpiresAt 为零值时永不过期
+type InvitationBo struct {
+	UID        snowflake.ID
+	Provider   string
+	Prefix     string
+	MaxUses    int32
+	UsedCount  int32
+	ExpiresAt  time.Time
+	CreatorUID snowflake.ID
+	Remark     string
+	CreatedAt  time.Time
+}
+
+func (b *InvitationBo) ToAPIV1InvitationItem() *apiv1.InvitationItem {
+	item := &apiv1.InvitationItem{
+		Uid:        b.UID.Int64(),
+		Provider:   b.Provider,
+		Prefix:     b.Prefix,
+		MaxUses:    b.MaxUses,
+		UsedCount:  b.UsedCount,
+		CreatorUID: b.CreatorUID.Int64(),
+		Remark:     b.Remark,
+		CreatedAt:  b.CreatedAt.Format(time.DateTime),
+	}
+	if !b.ExpiresAt.IsZero() {
+		item.ExpiresAt = b.ExpiresAt.Format(time.DateTime)
+	}
+	return item
+}
+
+// CreateInvitationBo 创建邀请码，CreatorUID 为当前登录用户
+type CreateInvitationBo struct {
+	Provider   string
+	MaxUses    int32
+	ExpiresAt  time.Time
+	CreatorUID snowflake.ID
+	Remark     string
+}
+
+func NewCreateInvitationBo(req *apiv1.CreateInvitationRequest) *CreateInvitationBo {
+	b := &CreateInvitationBo{
+		Provider: req.GetProvider(),
+		MaxUses:  req.GetMaxUses(),
+		Remark:   req.GetRemark(),
+	}
+	if expiresIn := req.GetExpiresIn(); expiresIn > 0 {
+		b.ExpiresAt = time.Now().Add(time.Duration(expiresIn) * time.Second)
+	}
+	return b
+}
+
+// CreatedInvitationBo 新创建的邀请码，Code 只返回这一次
+type CreatedInvitationBo struct {
+	Invitation *InvitationBo
+	Code       string
+}
+
+func (b *CreatedInvitationBo) ToAPIV1CreateInvitationReply() *apiv1.CreateInvitationReply {
+	return &apiv1.CreateInvitationReply{
+		Invitation: b.Invitation.ToAPIV1InvitationItem(),
+		Code:       b.Code,
+	}
+}
+
+// SignupBo 登录注册新用户时的限制，InviteOnly 时注册新用户需要有效的邀请码 InvitationCode
+type SignupBo struct {
+	InviteOnly     bool
+	InvitationCode string
+}
+
+// ListInvitationsBo 按创建时间倒序分页列出邀请码
+type ListInvitationsBo struct {
+	*PageRequestBo
+}
+
+// PageFilters 翻页令牌绑定的过滤条件，没有过滤条件
+func (b *ListInvitationsBo) PageFilters() map[string]string {
+	return nil
+}
+
+func NewListInvitationsBo(req *apiv1.ListInvitationsRequest) *ListInvitationsBo {
+	return &ListInvitationsBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+	}
+}
+
+func ToAPIV1ListInvitationsReply(pageResponseBo *PageResponseBo[*InvitationBo]) *apiv1.ListInvitationsReply {
+	items := make([]*apiv1.InvitationItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1InvitationItem())
+	}
+	return &apiv1.ListInvitationsReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
@@ -0,0 +1,68 @@
+package bo
+
+import (
+	"time"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// LoginAuditEventBo IP 或账号因连续登录失败被锁定的记录，Kind 为 ip 或 account，Subject 为被锁定的 IP 或账号
+type LoginAuditEventBo struct {
+	ID          uint32
+	Action      string
+	Kind        string
+	Subject     string
+	Provider    string
+	IP          string
+	Failures    int64
+	LockedUntil time.Time
+	CreatedAt   time.Time
+}
+
+func (b *LoginAuditEventBo) ToAPIV1LoginAuditEventItem() *apiv1.LoginAuditEventItem {
+	return &apiv1.LoginAuditEventItem{
+		Id:          b.ID,
+		Action:      b.Action,
+		Kind:        b.Kind,
+		Subject:     b.Subject,
+		Provider:    b.Provider,
+		Ip:          b.IP,
+		Failures:    b.Failures,
+		LockedUntil: b.LockedUntil.Format(time.DateTime),
+		CreatedAt:   b.CreatedAt.Format(time.DateTime),
+	}
+}
+
+type ListLoginAuditEventsBo struct {
+	*PageRequestBo
+	Subject string
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *ListLoginAuditEventsBo) PageFilters() map[string]string {
+	return map[string]string{
+		"subject": b.Subject,
+	}
+}
+
+func NewListLoginAuditEventsBo(req *apiv1.ListLoginAuditEventsRequest) *ListLoginAuditEventsBo {
+	return &ListLoginAuditEventsBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+		Subject:       req.GetSubject(),
+	}
+}
+
+func ToAPIV1ListLoginAuditEventsReply(pageResponseBo *PageResponseBo[*LoginAuditEventBo]) *apiv1.ListLoginAuditEventsReply {
+	items := make([]*apiv1.LoginAuditEventItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1LoginAuditEventItem())
+	}
+	return &apiv1.ListLoginAuditEventsReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
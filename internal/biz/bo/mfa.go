@@ -0,0 +1,50 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// UserMFABo 用户的 TOTP 第二因素，Secret 为加密后的密钥，未注册时为空
+type UserMFABo struct {
+	UserUID       snowflake.ID
+	Secret        string
+	Enabled       bool
+	RecoveryCodes int32
+	EnabledAt     time.Time
+}
+
+func (b *UserMFABo) ToAPIV1MFAItem() *apiv1.MFAItem {
+	item := &apiv1.MFAItem{
+		Enabled:       b.Enabled,
+		RecoveryCodes: b.RecoveryCodes,
+	}
+	if b.Enabled {
+		item.EnabledAt = b.EnabledAt.Format(time.DateTime)
+	}
+	return item
+}
+
+// EnrollTOTPBo 新生成的 TOTP 密钥，ProvisioningURI 由前端显示为二维码
+type EnrollTOTPBo struct {
+	Secret          string
+	ProvisioningURI string
+}
+
+func (b *EnrollTOTPBo) ToAPIV1EnrollTOTPReply() *apiv1.EnrollTOTPReply {
+	return &apiv1.EnrollTOTPReply{
+		Secret:          b.Secret,
+		ProvisioningURI: b.ProvisioningURI,
+	}
+}
+
+// MFAChallengeBo 等待第二因素的登录，AMR 为第一因素的认证方式
+type MFAChallengeBo struct {
+	UserUID   snowflake.ID
+	AMR       []string
+	Attempts  int32
+	ExpiresAt time.Time
+}
@@ -1,6 +1,7 @@
 package bo
 
 import (
+	"strconv"
 	"time"
 
 	"github.com/bwmarrin/snowflake"
@@ -8,79 +9,165 @@ import (
 	"github.com/aide-family/sovereign/internal/biz/vobj"
 	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
 	"github.com/aide-family/sovereign/pkg/enum"
+	"github.com/aide-family/sovereign/pkg/merr"
 )
 
 type CreateNamespaceBo struct {
-	Name     string
-	Metadata map[string]string
-	Status   vobj.GlobalStatus
+	Name        string
+	Metadata    map[string]string
+	Status      vobj.GlobalStatus
+	DisplayName string
+	Description string
+	Owners      []snowflake.ID
+	Icon        string
+	Operator    snowflake.ID
 }
 
-func NewCreateNamespaceBo(req *apiv1.CreateNamespaceRequest) *CreateNamespaceBo {
+// NewCreateNamespaceBo 从 API 请求创建 BO，未指定所有者时由创建人担任
+func NewCreateNamespaceBo(operator snowflake.ID, req *apiv1.CreateNamespaceRequest) *CreateNamespaceBo {
+	owners := ParseOwners(req.Owners)
+	if len(owners) == 0 && operator > 0 {
+		owners = []snowflake.ID{operator}
+	}
 	return &CreateNamespaceBo{
-		Name:     req.Name,
-		Metadata: req.Metadata,
-		Status:   vobj.GlobalStatusEnabled,
+		Name:        req.Name,
+		Metadata:    req.Metadata,
+		Status:      vobj.GlobalStatusEnabled,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Owners:      owners,
+		Icon:        req.Icon,
+		Operator:    operator,
 	}
 }
 
 type UpdateNamespaceBo struct {
-	UID      snowflake.ID
-	Name     string
-	Metadata map[string]string
+	UID         snowflake.ID
+	Name        string
+	Metadata    map[string]string
+	DisplayName string
+	Description string
+	Owners      []snowflake.ID
+	Icon        string
+	Operator    snowflake.ID
 }
 
-func NewUpdateNamespaceBo(req *apiv1.UpdateNamespaceRequest) *UpdateNamespaceBo {
+func NewUpdateNamespaceBo(operator snowflake.ID, req *apiv1.UpdateNamespaceRequest) *UpdateNamespaceBo {
 	return &UpdateNamespaceBo{
-		UID:      snowflake.ParseInt64(req.Uid),
-		Name:     req.Name,
-		Metadata: req.Metadata,
+		UID:         snowflake.ParseInt64(req.Uid),
+		Name:        req.Name,
+		Metadata:    req.Metadata,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Owners:      ParseOwners(req.Owners),
+		Icon:        req.Icon,
+		Operator:    operator,
+	}
+}
+
+// TransferNamespaceOwnershipBo 转移Namespace所有权，PreviousOwner 为空时 NewOwner 成为唯一所有者
+type TransferNamespaceOwnershipBo struct {
+	NamespaceUID  snowflake.ID
+	NewOwner      snowflake.ID
+	PreviousOwner snowflake.ID
+	Operator      snowflake.ID
+}
+
+func NewTransferNamespaceOwnershipBo(operator snowflake.ID, req *apiv1.TransferNamespaceOwnershipRequest) *TransferNamespaceOwnershipBo {
+	return &TransferNamespaceOwnershipBo{
+		NamespaceUID:  snowflake.ParseInt64(req.Uid),
+		NewOwner:      snowflake.ParseInt64(req.NewOwner),
+		PreviousOwner: snowflake.ParseInt64(req.PreviousOwner),
+		Operator:      operator,
+	}
+}
+
+// ParseOwners 将 int64 列表转换为所有者
+func ParseOwners(owners []int64) []snowflake.ID {
+	ownerIDs := make([]snowflake.ID, 0, len(owners))
+	for _, owner := range owners {
+		ownerIDs = append(ownerIDs, snowflake.ParseInt64(owner))
+	}
+	return ownerIDs
+}
+
+// OwnersToInt64s 将所有者转换为 int64 列表
+func OwnersToInt64s(owners []snowflake.ID) []int64 {
+	ownerIDs := make([]int64, 0, len(owners))
+	for _, owner := range owners {
+		ownerIDs = append(ownerIDs, owner.Int64())
 	}
+	return ownerIDs
 }
 
 type UpdateNamespaceStatusBo struct {
-	UID    snowflake.ID
-	Status vobj.GlobalStatus
+	UID      snowflake.ID
+	Status   vobj.GlobalStatus
+	Operator snowflake.ID
 }
 
 type ListNamespaceBo struct {
 	*PageRequestBo
 	Keyword string
 	Status  vobj.GlobalStatus
+	OrderBy vobj.NamespaceOrderField
+	Desc    bool
+}
+
+// PageFilters 翻页令牌绑定的过滤和排序条件
+func (b *ListNamespaceBo) PageFilters() map[string]string {
+	return map[string]string{
+		"keyword": b.Keyword,
+		"status":  strconv.Itoa(int(b.Status)),
+		"orderBy": strconv.Itoa(int(b.OrderBy)),
+		"desc":    strconv.FormatBool(b.Desc),
+	}
 }
 
 type NamespaceItemBo struct {
-	UID       snowflake.ID
-	Name      string
-	Metadata  map[string]string
-	Status    vobj.GlobalStatus
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	UID         snowflake.ID
+	Name        string
+	Metadata    map[string]string
+	Status      vobj.GlobalStatus
+	DisplayName string
+	Description string
+	Owners      []snowflake.ID
+	Icon        string
+	Creator     snowflake.ID
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
 }
 
 func (b *NamespaceItemBo) ToAPIV1NamespaceItem() *apiv1.NamespaceItem {
 	return &apiv1.NamespaceItem{
-		Uid:       b.UID.Int64(),
-		Name:      b.Name,
-		Metadata:  b.Metadata,
-		Status:    enum.GlobalStatus(b.Status),
-		CreatedAt: b.CreatedAt.Format(time.DateTime),
-		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
+		Uid:         b.UID.Int64(),
+		Name:        b.Name,
+		Metadata:    b.Metadata,
+		Status:      enum.GlobalStatus(b.Status),
+		CreatedAt:   b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:   b.UpdatedAt.Format(time.DateTime),
+		DisplayName: b.DisplayName,
+		Description: b.Description,
+		Owners:      OwnersToInt64s(b.Owners),
+		Icon:        b.Icon,
 	}
 }
 
-func NewUpdateNamespaceStatusBo(req *apiv1.UpdateNamespaceStatusRequest) *UpdateNamespaceStatusBo {
+func NewUpdateNamespaceStatusBo(operator snowflake.ID, req *apiv1.UpdateNamespaceStatusRequest) *UpdateNamespaceStatusBo {
 	return &UpdateNamespaceStatusBo{
-		UID:    snowflake.ParseInt64(req.Uid),
-		Status: vobj.GlobalStatus(req.Status),
+		UID:      snowflake.ParseInt64(req.Uid),
+		Status:   vobj.GlobalStatus(req.Status),
+		Operator: operator,
 	}
 }
 
 func NewListNamespaceBo(req *apiv1.ListNamespaceRequest) *ListNamespaceBo {
 	return &ListNamespaceBo{
-		PageRequestBo: NewPageRequestBo(req.Page, req.PageSize),
+		PageRequestBo: NewTokenPageRequestBo(req.Page, req.PageSize, req.PageToken),
 		Keyword:       req.Keyword,
 		Status:        vobj.GlobalStatus(req.Status),
+		OrderBy:       vobj.NamespaceOrderField(req.OrderBy),
+		Desc:          req.Desc,
 	}
 }
 
@@ -90,17 +177,20 @@ func ToAPIV1ListNamespaceReply(pageResponseBo *PageResponseBo[*NamespaceItemBo])
 		items = append(items, item.ToAPIV1NamespaceItem())
 	}
 	return &apiv1.ListNamespaceReply{
-		Items:    items,
-		Total:    pageResponseBo.GetTotal(),
-		Page:     pageResponseBo.GetPage(),
-		PageSize: pageResponseBo.GetPageSize(),
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
 	}
 }
 
-// SelectNamespaceBo 选择Namespace的 BO
+// SelectNamespaceBo 选择Namespace的 BO，PageSize 即每次选择的数量
 type SelectNamespaceBo struct {
+	*PageRequestBo
 	Keyword string
-	Limit   int32
+	// Deprecated: 使用 PageToken 翻页
 	LastUID snowflake.ID
 	Status  vobj.GlobalStatus
 }
@@ -112,10 +202,18 @@ func NewSelectNamespaceBo(req *apiv1.SelectNamespaceRequest) *SelectNamespaceBo
 		lastUID = snowflake.ParseInt64(req.LastUID)
 	}
 	return &SelectNamespaceBo{
-		Keyword: req.Keyword,
-		Limit:   req.Limit,
-		LastUID: lastUID,
-		Status:  vobj.GlobalStatus(req.Status),
+		PageRequestBo: NewTokenPageRequestBo(1, req.Limit, req.PageToken),
+		Keyword:       req.Keyword,
+		LastUID:       lastUID,
+		Status:        vobj.GlobalStatus(req.Status),
+	}
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *SelectNamespaceBo) PageFilters() map[string]string {
+	return map[string]string{
+		"keyword": b.Keyword,
+		"status":  strconv.Itoa(int(b.Status)),
 	}
 }
 
@@ -140,10 +238,12 @@ func (b *NamespaceItemSelectBo) ToAPIV1NamespaceItemSelect() *apiv1.NamespaceIte
 
 // SelectNamespaceBoResult Biz层返回结果
 type SelectNamespaceBoResult struct {
-	Items   []*NamespaceItemSelectBo
-	Total   int64
-	LastUID snowflake.ID
-	HasMore bool
+	Items         []*NamespaceItemSelectBo
+	Total         int64
+	LastUID       snowflake.ID
+	HasMore       bool
+	PrevPageToken string
+	NextPageToken string
 }
 
 // ToAPIV1SelectNamespaceReply 转换为 API 响应
@@ -154,9 +254,142 @@ func ToAPIV1SelectNamespaceReply(result *SelectNamespaceBoResult) *apiv1.SelectN
 	}
 
 	return &apiv1.SelectNamespaceReply{
-		Items:   selectItems,
-		Total:   result.Total,
-		LastUID: result.LastUID.Int64(),
-		HasMore: result.HasMore,
+		Items:         selectItems,
+		Total:         result.Total,
+		LastUID:       result.LastUID.Int64(),
+		HasMore:       result.HasMore,
+		NextPageToken: result.NextPageToken,
+		PrevPageToken: result.PrevPageToken,
+	}
+}
+
+const (
+	defaultNamespaceStatsDays = 30
+	maxNamespaceStatsDays     = 366
+	defaultNamespaceStatsTopN = 10
+	maxNamespaceStatsTopN     = 100
+)
+
+// GetNamespaceStatsBo Namespace统计的查询条件，日期均为 UTC 自然日，区间为 [StartDate, EndDate]
+type GetNamespaceStatsBo struct {
+	StartDate time.Time
+	EndDate   time.Time
+	TopN      int32
+}
+
+// NewGetNamespaceStatsBo 从 API 请求创建 BO，未指定时默认统计最近 30 天、前 10 名
+func NewGetNamespaceStatsBo(req *apiv1.GetNamespaceStatsRequest) (*GetNamespaceStatsBo, error) {
+	endDate := time.Now().UTC().Truncate(24 * time.Hour)
+	if req.GetEndDate() != "" {
+		date, err := time.ParseInLocation(time.DateOnly, req.GetEndDate(), time.UTC)
+		if err != nil {
+			return nil, merr.ErrorParams("invalid endDate %s", req.GetEndDate())
+		}
+		endDate = date
+	}
+	startDate := endDate.AddDate(0, 0, -(defaultNamespaceStatsDays - 1))
+	if req.GetStartDate() != "" {
+		date, err := time.ParseInLocation(time.DateOnly, req.GetStartDate(), time.UTC)
+		if err != nil {
+			return nil, merr.ErrorParams("invalid startDate %s", req.GetStartDate())
+		}
+		startDate = date
+	}
+	if startDate.After(endDate) {
+		return nil, merr.ErrorParams("startDate must be before or equal to endDate")
+	}
+	if endDate.Sub(startDate) >= maxNamespaceStatsDays*24*time.Hour {
+		return nil, merr.ErrorParams("date range must be less than or equal to %d days", maxNamespaceStatsDays)
+	}
+	topN := req.GetTopN()
+	if topN <= 0 {
+		topN = defaultNamespaceStatsTopN
+	}
+	topN = min(topN, maxNamespaceStatsTopN)
+	return &GetNamespaceStatsBo{
+		StartDate: startDate,
+		EndDate:   endDate,
+		TopN:      topN,
+	}, nil
+}
+
+// StartAt 统计区间的起始时间（包含）
+func (b *GetNamespaceStatsBo) StartAt() time.Time {
+	return b.StartDate
+}
+
+// EndAt 统计区间的结束时间（不包含）
+func (b *GetNamespaceStatsBo) EndAt() time.Time {
+	return b.EndDate.AddDate(0, 0, 1)
+}
+
+type NamespaceStatusCountBo struct {
+	Status vobj.GlobalStatus
+	Count  int64
+}
+
+type NamespaceDailyCountBo struct {
+	Date  string
+	Count int64
+}
+
+type NamespaceCreatorCountBo struct {
+	Creator snowflake.ID
+	Count   int64
+}
+
+type NamespaceMetadataSizeBo struct {
+	UID  snowflake.ID
+	Name string
+	Size int64
+}
+
+// NamespaceStatsBo Namespace统计结果
+type NamespaceStatsBo struct {
+	StartDate       time.Time
+	EndDate         time.Time
+	Total           int64
+	StatusCounts    []*NamespaceStatusCountBo
+	DailyCreations  []*NamespaceDailyCountBo
+	TopCreators     []*NamespaceCreatorCountBo
+	LargestMetadata []*NamespaceMetadataSizeBo
+}
+
+// FillDailyCreations 补齐区间内没有新建记录的日期
+func (b *NamespaceStatsBo) FillDailyCreations() {
+	counts := make(map[string]int64, len(b.DailyCreations))
+	for _, item := range b.DailyCreations {
+		counts[item.Date] = item.Count
+	}
+	dailyCreations := make([]*NamespaceDailyCountBo, 0, len(b.DailyCreations))
+	for date := b.StartDate; !date.After(b.EndDate); date = date.AddDate(0, 0, 1) {
+		day := date.Format(time.DateOnly)
+		dailyCreations = append(dailyCreations, &NamespaceDailyCountBo{Date: day, Count: counts[day]})
+	}
+	b.DailyCreations = dailyCreations
+}
+
+func (b *NamespaceStatsBo) ToAPIV1GetNamespaceStatsReply() *apiv1.GetNamespaceStatsReply {
+	reply := &apiv1.GetNamespaceStatsReply{
+		Total:           b.Total,
+		StatusCounts:    make([]*apiv1.NamespaceStatusCount, 0, len(b.StatusCounts)),
+		DailyCreations:  make([]*apiv1.NamespaceDailyCount, 0, len(b.DailyCreations)),
+		TopCreators:     make([]*apiv1.NamespaceCreatorCount, 0, len(b.TopCreators)),
+		LargestMetadata: make([]*apiv1.NamespaceMetadataSize, 0, len(b.LargestMetadata)),
+		StartDate:       b.StartDate.Format(time.DateOnly),
+		EndDate:         b.EndDate.Format(time.DateOnly),
+	}
+	for _, item := range b.StatusCounts {
+		reply.StatusCounts = append(reply.StatusCounts, &apiv1.NamespaceStatusCount{Status: enum.GlobalStatus(item.Status), Count: item.Count})
+	}
+	for _, item := range b.DailyCreations {
+		reply.DailyCreations = append(reply.DailyCreations, &apiv1.NamespaceDailyCount{Date: item.Date, Count: item.Count})
+	}
+	for _, item := range b.TopCreators {
+		reply.TopCreators = append(reply.TopCreators, &apiv1.NamespaceCreatorCount{Creator: item.Creator.Int64(), Count: item.Count})
+	}
+	for _, item := range b.LargestMetadata {
+		reply.LargestMetadata = append(reply.LargestMetadata, &apiv1.NamespaceMetadataSize{Uid: item.UID.Int64(), Name: item.Name, Size: item.Size})
 	}
+	return reply
 }
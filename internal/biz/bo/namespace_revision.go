@@ -0,0 +1,192 @@
+package bo
+
+import (
+	"maps"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/enum"
+)
+
+type ListNamespaceRevisionsBo struct {
+	*PageRequestBo
+	NamespaceUID snowflake.ID
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *ListNamespaceRevisionsBo) PageFilters() map[string]string {
+	return map[string]string{
+		"uid": b.NamespaceUID.String(),
+	}
+}
+
+func NewListNamespaceRevisionsBo(req *apiv1.ListNamespaceRevisionsRequest) *ListNamespaceRevisionsBo {
+	return &ListNamespaceRevisionsBo{
+		PageRequestBo: NewTokenPageRequestBo(req.Page, req.PageSize, req.PageToken),
+		NamespaceUID:  snowflake.ParseInt64(req.Uid),
+	}
+}
+
+type RollbackNamespaceBo struct {
+	NamespaceUID snowflake.ID
+	Revision     int32
+	Operator     snowflake.ID
+}
+
+func NewRollbackNamespaceBo(operator snowflake.ID, req *apiv1.RollbackNamespaceRequest) *RollbackNamespaceBo {
+	return &RollbackNamespaceBo{
+		NamespaceUID: snowflake.ParseInt64(req.Uid),
+		Revision:     req.Revision,
+		Operator:     operator,
+	}
+}
+
+// NamespaceRevisionItemBo Namespace某一版本的快照
+type NamespaceRevisionItemBo struct {
+	UID            snowflake.ID
+	NamespaceUID   snowflake.ID
+	Revision       int32
+	Name           string
+	Metadata       map[string]string
+	Status         vobj.GlobalStatus
+	Action         vobj.NamespaceRevisionAction
+	SourceRevision int32
+	DisplayName    string
+	Description    string
+	Owners         []snowflake.ID
+	Icon           string
+	Creator        snowflake.ID
+	CreatedAt      time.Time
+}
+
+func (b *NamespaceRevisionItemBo) ToAPIV1NamespaceRevisionItem() *apiv1.NamespaceRevisionItem {
+	return &apiv1.NamespaceRevisionItem{
+		Uid:            b.UID.Int64(),
+		NamespaceUid:   b.NamespaceUID.Int64(),
+		Revision:       b.Revision,
+		Name:           b.Name,
+		Metadata:       b.Metadata,
+		Status:         enum.GlobalStatus(b.Status),
+		Action:         apiv1.NamespaceRevisionAction(b.Action),
+		SourceRevision: b.SourceRevision,
+		Creator:        b.Creator.Int64(),
+		CreatedAt:      b.CreatedAt.Format(time.DateTime),
+		DisplayName:    b.DisplayName,
+		Description:    b.Description,
+		Owners:         OwnersToInt64s(b.Owners),
+		Icon:           b.Icon,
+	}
+}
+
+func ToAPIV1ListNamespaceRevisionsReply(pageResponseBo *PageResponseBo[*NamespaceRevisionItemBo]) *apiv1.ListNamespaceRevisionsReply {
+	items := make([]*apiv1.NamespaceRevisionItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1NamespaceRevisionItem())
+	}
+	return &apiv1.ListNamespaceRevisionsReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
+
+// NamespaceFieldChangeBo 两个版本间单个字段的变化，元数据字段以 metadata.<key> 表示
+type NamespaceFieldChangeBo struct {
+	Field string
+	Type  vobj.NamespaceChangeType
+	From  string
+	To    string
+}
+
+// NamespaceRevisionDiffBo 两个版本间的差异
+type NamespaceRevisionDiffBo struct {
+	FromRevision int32
+	ToRevision   int32
+	Changes      []*NamespaceFieldChangeBo
+}
+
+// DiffNamespaceRevisions 比较两个版本的名称、展示信息、所有者、状态和元数据
+func DiffNamespaceRevisions(from, to *NamespaceRevisionItemBo) *NamespaceRevisionDiffBo {
+	diff := &NamespaceRevisionDiffBo{
+		FromRevision: from.Revision,
+		ToRevision:   to.Revision,
+		Changes:      make([]*NamespaceFieldChangeBo, 0),
+	}
+	diff.appendModified("name", from.Name, to.Name)
+	diff.appendModified("displayName", from.DisplayName, to.DisplayName)
+	diff.appendModified("description", from.Description, to.Description)
+	diff.appendModified("icon", from.Icon, to.Icon)
+	diff.appendModified("owners", joinOwners(from.Owners), joinOwners(to.Owners))
+	if from.Status != to.Status {
+		diff.Changes = append(diff.Changes, &NamespaceFieldChangeBo{
+			Field: "status",
+			Type:  vobj.NamespaceChangeTypeModified,
+			From:  enum.GlobalStatus(from.Status).String(),
+			To:    enum.GlobalStatus(to.Status).String(),
+		})
+	}
+	keys := slices.Sorted(maps.Keys(from.Metadata))
+	for key := range to.Metadata {
+		if _, ok := from.Metadata[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		fromValue, inFrom := from.Metadata[key]
+		toValue, inTo := to.Metadata[key]
+		change := &NamespaceFieldChangeBo{Field: "metadata." + key, From: fromValue, To: toValue}
+		switch {
+		case !inFrom:
+			change.Type = vobj.NamespaceChangeTypeAdded
+		case !inTo:
+			change.Type = vobj.NamespaceChangeTypeRemoved
+		case fromValue != toValue:
+			change.Type = vobj.NamespaceChangeTypeModified
+		default:
+			continue
+		}
+		diff.Changes = append(diff.Changes, change)
+	}
+	return diff
+}
+
+func (b *NamespaceRevisionDiffBo) appendModified(field, from, to string) {
+	if from == to {
+		return
+	}
+	b.Changes = append(b.Changes, &NamespaceFieldChangeBo{Field: field, Type: vobj.NamespaceChangeTypeModified, From: from, To: to})
+}
+
+func joinOwners(owners []snowflake.ID) string {
+	ownerIDs := make([]string, 0, len(owners))
+	for _, owner := range owners {
+		ownerIDs = append(ownerIDs, owner.String())
+	}
+	return strings.Join(ownerIDs, ",")
+}
+
+func (b *NamespaceRevisionDiffBo) ToAPIV1DiffNamespaceRevisionsReply() *apiv1.DiffNamespaceRevisionsReply {
+	changes := make([]*apiv1.NamespaceFieldChange, 0, len(b.Changes))
+	for _, change := range b.Changes {
+		changes = append(changes, &apiv1.NamespaceFieldChange{
+			Field: change.Field,
+			Type:  apiv1.NamespaceChangeType(change.Type),
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+	return &apiv1.DiffNamespaceRevisionsReply{
+		FromRevision: b.FromRevision,
+		ToRevision:   b.ToRevision,
+		Changes:      changes,
+	}
+}
@@ -0,0 +1,32 @@
+package bo_test
+
+import (
+	"testing"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+func TestNewGetNamespaceStatsBoTopN(t *testing.T) {
+	tests := []struct {
+		name string
+		topN int32
+		want int32
+	}{
+		{name: "default", topN: 0, want: 10},
+		{name: "negative", topN: -1, want: 10},
+		{name: "requested", topN: 25, want: 25},
+		{name: "clamped", topN: 1 << 30, want: 100},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			statsBo, err := bo.NewGetNamespaceStatsBo(&apiv1.GetNamespaceStatsRequest{TopN: tt.topN})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if statsBo.TopN != tt.want {
+				t.Fatalf("want topN %d, got %d", tt.want, statsBo.TopN)
+			}
+		})
+	}
+}
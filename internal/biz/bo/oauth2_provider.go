@@ -0,0 +1,117 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/enum"
+)
+
+const (
+	// OAuth2ProviderSourceConfig 配置文件中的 OAuth2 提供方，不能通过 API 修改
+	OAuth2ProviderSourceConfig = "config"
+	// OAuth2ProviderSourceAPI 通过 API 管理的 OAuth2 提供方
+	OAuth2ProviderSourceAPI = "api"
+)
+
+// OAuth2ProviderBo OAuth2 提供方，Config 不含 client secret，ClientSecret 为加密后的 client secret
+type OAuth2ProviderBo struct {
+	UID          snowflake.ID
+	Name         string
+	Config       *config.OAuth2_Config
+	ClientSecret string
+	Status       vobj.GlobalStatus
+	Source       string
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (b *OAuth2ProviderBo) ToAPIV1OAuth2ProviderItem() *apiv1.OAuth2ProviderItem {
+	providerConfig := proto.Clone(b.Config).(*config.OAuth2_Config)
+	hasClientSecret := b.ClientSecret != "" || providerConfig.GetClientSecret() != ""
+	providerConfig.ClientSecret = ""
+	item := &apiv1.OAuth2ProviderItem{
+		Uid:             b.UID.Int64(),
+		Name:            b.Name,
+		Config:          providerConfig,
+		Status:          enum.GlobalStatus(b.Status),
+		Source:          b.Source,
+		HasClientSecret: hasClientSecret,
+		LoginPath:       auth.LoginPath(b.Name),
+	}
+	if !b.CreatedAt.IsZero() {
+		item.CreatedAt = b.CreatedAt.Format(time.DateTime)
+	}
+	if !b.UpdatedAt.IsZero() {
+		item.UpdatedAt = b.UpdatedAt.Format(time.DateTime)
+	}
+	return item
+}
+
+// SaveOAuth2ProviderBo 创建或更新 OAuth2 提供方，UID 为零值时创建，Config 中的 client secret 为明文，为空时保留已保存的 client secret
+type SaveOAuth2ProviderBo struct {
+	UID    snowflake.ID
+	Config *config.OAuth2_Config
+}
+
+func NewCreateOAuth2ProviderBo(req *apiv1.CreateOAuth2ProviderRequest) *SaveOAuth2ProviderBo {
+	return &SaveOAuth2ProviderBo{
+		Config: req.GetConfig(),
+	}
+}
+
+func NewUpdateOAuth2ProviderBo(req *apiv1.UpdateOAuth2ProviderRequest) *SaveOAuth2ProviderBo {
+	return &SaveOAuth2ProviderBo{
+		UID:    snowflake.ParseInt64(req.GetUid()),
+		Config: req.GetConfig(),
+	}
+}
+
+type UpdateOAuth2ProviderStatusBo struct {
+	UID    snowflake.ID
+	Status vobj.GlobalStatus
+}
+
+func NewUpdateOAuth2ProviderStatusBo(req *apiv1.UpdateOAuth2ProviderStatusRequest) *UpdateOAuth2ProviderStatusBo {
+	return &UpdateOAuth2ProviderStatusBo{
+		UID:    snowflake.ParseInt64(req.GetUid()),
+		Status: vobj.GlobalStatus(req.GetStatus()),
+	}
+}
+
+// ListOAuth2ProvidersBo 按 id 升序分页列出通过 API 管理的 OAuth2 提供方，配置文件中的提供方只在第一页列出
+type ListOAuth2ProvidersBo struct {
+	*PageRequestBo
+}
+
+// PageFilters 翻页令牌绑定的过滤条件，没有过滤条件
+func (b *ListOAuth2ProvidersBo) PageFilters() map[string]string {
+	return nil
+}
+
+func NewListOAuth2ProvidersBo(req *apiv1.ListOAuth2ProvidersRequest) *ListOAuth2ProvidersBo {
+	return &ListOAuth2ProvidersBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+	}
+}
+
+func ToAPIV1ListOAuth2ProvidersReply(pageResponseBo *PageResponseBo[*OAuth2ProviderBo]) *apiv1.ListOAuth2ProvidersReply {
+	items := make([]*apiv1.OAuth2ProviderItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1OAuth2ProviderItem())
+	}
+	return &apiv1.ListOAuth2ProvidersReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
@@ -11,7 +11,31 @@ func NewPageRequestBo(page int32, pageSize int32) *PageRequestBo {
 type PageRequestBo struct {
 	Page     int32
 	PageSize int32
-	total    int64
+	// PageToken 客户端传入的翻页令牌，解析后得到 Cursor
+	PageToken string
+	Cursor    *PageCursorBo
+	total     int64
+
+	prevCursor    *PageCursorBo
+	nextCursor    *PageCursorBo
+	PrevPageToken string
+	NextPageToken string
+}
+
+// NewTokenPageRequestBo 创建支持翻页令牌的分页请求，设置了令牌时忽略页码
+func NewTokenPageRequestBo(page int32, pageSize int32, pageToken string) *PageRequestBo {
+	return &PageRequestBo{
+		Page:      page,
+		PageSize:  pageSize,
+		PageToken: pageToken,
+	}
+}
+
+// PageCursorBo 翻页游标，记录当前页边界行的排序键，UID 用于排序键相同时的定位
+type PageCursorBo struct {
+	Value    string `json:"v,omitempty"`
+	UID      int64  `json:"u,omitempty"`
+	Backward bool   `json:"b,omitempty"`
 }
 
 func (p *PageRequestBo) Offset() int {
@@ -27,6 +51,17 @@ func (p *PageRequestBo) WithTotal(total int64) *PageRequestBo {
 	return p
 }
 
+// WithCursors 记录当前页前后两个方向的游标，没有上一页或下一页时为 nil
+func (p *PageRequestBo) WithCursors(prev, next *PageCursorBo) *PageRequestBo {
+	p.prevCursor, p.nextCursor = prev, next
+	return p
+}
+
+// HasMore 是否还有下一页
+func (p *PageRequestBo) HasMore() bool {
+	return p.nextCursor != nil
+}
+
 func NewPageResponseBo[T any](pageRequestBo *PageRequestBo, data []T) *PageResponseBo[T] {
 	return &PageResponseBo[T]{
 		items:         data,
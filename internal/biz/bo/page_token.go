@@ -0,0 +1,111 @@
+package bo
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"maps"
+	"strings"
+	"time"
+
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// PageTokenTTL 翻页令牌的有效期
+const PageTokenTTL = 24 * time.Hour
+
+// pageTokenPayload 翻页令牌的内容，Filters 记录生成令牌时的过滤和排序条件
+type pageTokenPayload struct {
+	Filters   map[string]string `json:"f,omitempty"`
+	Cursor    *PageCursorBo     `json:"c"`
+	ExpiresAt int64             `json:"e"`
+}
+
+// PageTokenCodec 签发和校验翻页令牌，令牌对客户端不透明且不可篡改
+type PageTokenCodec struct {
+	secret []byte
+	now    func() time.Time
+}
+
+type PageTokenCodecOption func(*PageTokenCodec)
+
+// BindPageTokenClock 设置签发和校验令牌时使用的时钟
+func BindPageTokenClock(now func() time.Time) PageTokenCodecOption {
+	return func(c *PageTokenCodec) {
+		c.now = now
+	}
+}
+
+func NewPageTokenCodec(secret string, opts ...PageTokenCodecOption) *PageTokenCodec {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("page-token"))
+	c := &PageTokenCodec{secret: mac.Sum(nil), now: time.Now}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// Encode 将游标和过滤条件编码为签名后的令牌
+func (c *PageTokenCodec) Encode(cursor *PageCursorBo, filters map[string]string) string {
+	payload, _ := json.Marshal(&pageTokenPayload{Filters: filters, Cursor: cursor, ExpiresAt: c.now().Add(PageTokenTTL).Unix()})
+	encoded := base64.RawURLEncoding.EncodeToString(payload)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(c.sign(encoded))
+}
+
+// Decode 校验令牌签名，并要求令牌中的过滤条件与本次请求一致
+func (c *PageTokenCodec) Decode(token string, filters map[string]string) (*PageCursorBo, error) {
+	encoded, signature, ok := strings.Cut(token, ".")
+	if !ok {
+		return nil, merr.ErrorParams("invalid page token")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, c.sign(encoded)) {
+		return nil, merr.ErrorParams("invalid page token")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, merr.ErrorParams("invalid page token")
+	}
+	var payload pageTokenPayload
+	if err := json.Unmarshal(raw, &payload); err != nil || payload.Cursor == nil {
+		return nil, merr.ErrorParams("invalid page token")
+	}
+	if c.now().Unix() >= payload.ExpiresAt {
+		return nil, merr.ErrorParams("page token expired, list again from the first page")
+	}
+	if !maps.Equal(payload.Filters, filters) {
+		return nil, merr.ErrorParams("page token does not match the request, keep the filters and order of the first page")
+	}
+	return payload.Cursor, nil
+}
+
+// Resolve 解析请求中的翻页令牌
+func (c *PageTokenCodec) Resolve(req *PageRequestBo, filters map[string]string) error {
+	if req.PageToken == "" {
+		return nil
+	}
+	cursor, err := c.Decode(req.PageToken, filters)
+	if err != nil {
+		return err
+	}
+	req.Cursor = cursor
+	return nil
+}
+
+// Issue 为当前页签发上一页和下一页的令牌
+func (c *PageTokenCodec) Issue(req *PageRequestBo, filters map[string]string) {
+	if req.prevCursor != nil {
+		req.PrevPageToken = c.Encode(req.prevCursor, filters)
+	}
+	if req.nextCursor != nil {
+		req.NextPageToken = c.Encode(req.nextCursor, filters)
+	}
+}
+
+func (c *PageTokenCodec) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, c.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
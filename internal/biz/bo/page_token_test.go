@@ -0,0 +1,89 @@
+package bo_test
+
+import (
+	"encoding/base64"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func TestPageTokenRoundTrip(t *testing.T) {
+	codec := bo.NewPageTokenCodec("secret")
+	filters := map[string]string{"keyword": "dev", "orderBy": "1", "desc": "true"}
+	cursor := &bo.PageCursorBo{Value: "dev-a", UID: 42, Backward: true}
+	got, err := codec.Decode(codec.Encode(cursor, filters), filters)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if *got != *cursor {
+		t.Fatalf("want cursor %+v, got %+v", cursor, got)
+	}
+}
+
+func TestPageTokenRejected(t *testing.T) {
+	now := time.Unix(1_700_000_000, 0)
+	codec := bo.NewPageTokenCodec("secret", bo.BindPageTokenClock(func() time.Time { return now }))
+	filters := map[string]string{"keyword": "dev", "orderBy": "1", "desc": "false"}
+	token := codec.Encode(&bo.PageCursorBo{Value: "dev-a", UID: 42}, filters)
+	encoded, signature, _ := strings.Cut(token, ".")
+	forged := base64.RawURLEncoding.EncodeToString([]byte(`{"c":{"v":"zzz","u":1},"e":9999999999}`))
+
+	tests := []struct {
+		name    string
+		codec   *bo.PageTokenCodec
+		token   string
+		filters map[string]string
+	}{
+		{name: "empty", codec: codec, token: "", filters: filters},
+		{name: "garbage", codec: codec, token: "not-a-token", filters: filters},
+		{name: "garbage signature", codec: codec, token: encoded + ".!!", filters: filters},
+		{name: "garbage payload", codec: codec, token: "!!." + signature, filters: filters},
+		{name: "tampered payload", codec: codec, token: forged + "." + signature, filters: filters},
+		{name: "tampered signature", codec: codec, token: encoded + "." + base64.RawURLEncoding.EncodeToString([]byte("forged")), filters: filters},
+		{name: "another secret", codec: bo.NewPageTokenCodec("other", bo.BindPageTokenClock(func() time.Time { return now })), token: token, filters: filters},
+		{name: "other keyword", codec: codec, token: token, filters: map[string]string{"keyword": "prod", "orderBy": "1", "desc": "false"}},
+		{name: "other order", codec: codec, token: token, filters: map[string]string{"keyword": "dev", "orderBy": "1", "desc": "true"}},
+		{name: "other order field", codec: codec, token: token, filters: map[string]string{"keyword": "dev", "orderBy": "2", "desc": "false"}},
+		{name: "missing filter", codec: codec, token: token, filters: map[string]string{"keyword": "dev", "orderBy": "1"}},
+		{name: "expired", codec: bo.NewPageTokenCodec("secret", bo.BindPageTokenClock(func() time.Time { return now.Add(bo.PageTokenTTL) })), token: token, filters: filters},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := tt.codec.Decode(tt.token, tt.filters); !merr.IsParams(err) {
+				t.Fatalf("want the token rejected, got %v", err)
+			}
+		})
+	}
+
+	if _, err := bo.NewPageTokenCodec("secret", bo.BindPageTokenClock(func() time.Time { return now.Add(bo.PageTokenTTL - time.Second) })).Decode(token, filters); err != nil {
+		t.Fatalf("want the token accepted until it expires, got %v", err)
+	}
+}
+
+func TestPageTokenResolveIssue(t *testing.T) {
+	codec := bo.NewPageTokenCodec("secret")
+	filters := map[string]string{"orderBy": "0"}
+	first := bo.NewTokenPageRequestBo(1, 10, "")
+	if err := codec.Resolve(first, filters); err != nil {
+		t.Fatal(err)
+	}
+	if first.Cursor != nil {
+		t.Fatalf("want no cursor without a token, got %+v", first.Cursor)
+	}
+	first.WithCursors(nil, &bo.PageCursorBo{UID: 10})
+	codec.Issue(first, filters)
+	if first.PrevPageToken != "" || first.NextPageToken == "" {
+		t.Fatalf("want only a next page token on the first page, got prev %q next %q", first.PrevPageToken, first.NextPageToken)
+	}
+
+	second := bo.NewTokenPageRequestBo(1, 10, first.NextPageToken)
+	if err := codec.Resolve(second, filters); err != nil {
+		t.Fatal(err)
+	}
+	if second.Cursor == nil || second.Cursor.UID != 10 || second.Cursor.Backward {
+		t.Fatalf("want the forward cursor of the first page, got %+v", second.Cursor)
+	}
+}
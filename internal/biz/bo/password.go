@@ -0,0 +1,17 @@
+package bo
+
+import "github.com/bwmarrin/snowflake"
+
+// ChangePasswordBo 修改用户 UID 的密码，未设置过密码的用户无需提供旧密码
+type ChangePasswordBo struct {
+	UID         snowflake.ID
+	OldPassword string
+	NewPassword string
+}
+
+// BootstrapAdminBo 首次启动时创建的本地管理员账号
+type BootstrapAdminBo struct {
+	Username string
+	Email    string
+	Password string
+}
@@ -0,0 +1,166 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+const (
+	// PolicySourceConfig 配置文件中的策略，不能通过 API 修改
+	PolicySourceConfig = "config"
+	// PolicySourceAPI 通过 API 管理的策略
+	PolicySourceAPI = "api"
+)
+
+// PolicyBo CEL 策略，匹配 Operations 的请求必须使 Expression 为 true，否则拒绝
+type PolicyBo struct {
+	UID         snowflake.ID
+	Name        string
+	Description string
+	Operations  []string
+	Expression  string
+	Message     string
+	Source      string
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (b *PolicyBo) ToAPIV1PolicyItem() *apiv1.PolicyItem {
+	item := &apiv1.PolicyItem{
+		Uid:         b.UID.Int64(),
+		Name:        b.Name,
+		Description: b.Description,
+		Operations:  b.Operations,
+		Expression:  b.Expression,
+		Message:     b.Message,
+		Source:      b.Source,
+	}
+	if !b.CreatedAt.IsZero() {
+		item.CreatedAt = b.CreatedAt.Format(time.DateTime)
+	}
+	if !b.UpdatedAt.IsZero() {
+		item.UpdatedAt = b.UpdatedAt.Format(time.DateTime)
+	}
+	return item
+}
+
+// SavePolicyBo 创建或更新策略，UID 为零值时创建
+type SavePolicyBo struct {
+	UID         snowflake.ID
+	Name        string
+	Description string
+	Operations  []string
+	Expression  string
+	Message     string
+}
+
+func NewCreatePolicyBo(req *apiv1.CreatePolicyRequest) *SavePolicyBo {
+	return &SavePolicyBo{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Operations:  req.GetOperations(),
+		Expression:  req.GetExpression(),
+		Message:     req.GetMessage(),
+	}
+}
+
+func NewUpdatePolicyBo(req *apiv1.UpdatePolicyRequest) *SavePolicyBo {
+	return &SavePolicyBo{
+		UID:         snowflake.ParseInt64(req.GetUid()),
+		Description: req.GetDescription(),
+		Operations:  req.GetOperations(),
+		Expression:  req.GetExpression(),
+		Message:     req.GetMessage(),
+	}
+}
+
+// ExplainPolicyBo 解释用户 UserUID 在 Namespace 中调用 Operation 的决策，Request 为请求消息的 JSON
+type ExplainPolicyBo struct {
+	Operation string
+	Namespace string
+	Request   string
+	UserUID   snowflake.ID
+}
+
+func NewExplainPolicyBo(req *apiv1.ExplainPolicyRequest) *ExplainPolicyBo {
+	return &ExplainPolicyBo{
+		Operation: req.GetOperation(),
+		Namespace: req.GetNamespace(),
+		Request:   req.GetRequest(),
+		UserUID:   snowflake.ParseInt64(req.GetUserUID()),
+	}
+}
+
+// PolicyResultBo 单个策略的求值结果，未匹配的策略不会求值
+type PolicyResultBo struct {
+	Name    string
+	Source  string
+	Matched bool
+	Allowed bool
+	Error   string
+	Message string
+}
+
+// PolicyDecisionBo 请求的授权决策，Reason 为拒绝时返回的错误信息
+type PolicyDecisionBo struct {
+	Allowed           bool
+	PermissionGranted bool
+	Roles             []string
+	Policies          []*PolicyResultBo
+	Reason            string
+}
+
+func (b *PolicyDecisionBo) ToAPIV1ExplainPolicyReply() *apiv1.ExplainPolicyReply {
+	reply := &apiv1.ExplainPolicyReply{
+		Allowed:           b.Allowed,
+		PermissionGranted: b.PermissionGranted,
+		Roles:             b.Roles,
+		Policies:          make([]*apiv1.PolicyResult, 0, len(b.Policies)),
+		Reason:            b.Reason,
+	}
+	for _, result := range b.Policies {
+		reply.Policies = append(reply.Policies, &apiv1.PolicyResult{
+			Name:    result.Name,
+			Source:  result.Source,
+			Matched: result.Matched,
+			Allowed: result.Allowed,
+			Error:   result.Error,
+			Message: result.Message,
+		})
+	}
+	return reply
+}
+
+// ListPoliciesBo 按 id 升序分页列出通过 API 管理的策略，配置文件中的策略只在第一页列出
+type ListPoliciesBo struct {
+	*PageRequestBo
+}
+
+// PageFilters 翻页令牌绑定的过滤条件，没有过滤条件
+func (b *ListPoliciesBo) PageFilters() map[string]string {
+	return nil
+}
+
+func NewListPoliciesBo(req *apiv1.ListPoliciesRequest) *ListPoliciesBo {
+	return &ListPoliciesBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+	}
+}
+
+func ToAPIV1ListPoliciesReply(pageResponseBo *PageResponseBo[*PolicyBo]) *apiv1.ListPoliciesReply {
+	items := make([]*apiv1.PolicyItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1PolicyItem())
+	}
+	return &apiv1.ListPoliciesReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
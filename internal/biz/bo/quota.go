@@ -0,0 +1,86 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// SetQuotaBo sets the limit of a resource of the namespace, NamespaceUID is resolved from the name by the biz.
+type SetQuotaBo struct {
+	Namespace    string
+	NamespaceUID snowflake.ID
+	Resource     string
+	Limit        int64
+}
+
+func NewSetQuotaBo(namespace string, req *apiv1.SetQuotaRequest) *SetQuotaBo {
+	return &SetQuotaBo{
+		Namespace: namespace,
+		Resource:  req.Resource,
+		Limit:     req.Limit,
+	}
+}
+
+// QuotaUsageBo 配额占用/释放的 BO，NamespaceUID 由 biz 按名称解析
+type QuotaUsageBo struct {
+	Namespace    string
+	NamespaceUID snowflake.ID
+	Resource     string
+	Amount       int64
+}
+
+func NewReserveQuotaBo(namespace string, req *apiv1.ReserveRequest) *QuotaUsageBo {
+	return &QuotaUsageBo{
+		Namespace: namespace,
+		Resource:  req.Resource,
+		Amount:    req.Amount,
+	}
+}
+
+func NewReleaseQuotaBo(namespace string, req *apiv1.ReleaseRequest) *QuotaUsageBo {
+	return &QuotaUsageBo{
+		Namespace: namespace,
+		Resource:  req.Resource,
+		Amount:    req.Amount,
+	}
+}
+
+type QuotaItemBo struct {
+	UID          snowflake.ID
+	NamespaceUID snowflake.ID
+	Resource     string
+	Limit        int64
+	Used         int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+// Remaining 剩余可用配额
+func (b *QuotaItemBo) Remaining() int64 {
+	return max(b.Limit-b.Used, 0)
+}
+
+func (b *QuotaItemBo) ToAPIV1QuotaItem() *apiv1.QuotaItem {
+	return &apiv1.QuotaItem{
+		Resource:  b.Resource,
+		Limit:     b.Limit,
+		Used:      b.Used,
+		Remaining: b.Remaining(),
+		CreatedAt: b.CreatedAt.Format(time.DateTime),
+		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+func ToAPIV1ListQuotaReply(items []*QuotaItemBo) *apiv1.ListQuotaReply {
+	quotaItems := make([]*apiv1.QuotaItem, 0, len(items))
+	for _, item := range items {
+		quotaItems = append(quotaItems, item.ToAPIV1QuotaItem())
+	}
+	return &apiv1.ListQuotaReply{
+		Items: quotaItems,
+		Total: int64(len(quotaItems)),
+	}
+}
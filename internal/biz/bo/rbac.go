@@ -0,0 +1,175 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// RoleBo 角色，权限为 operation 名称，以 * 结尾时匹配该前缀的所有 operation，RequireMFA 时只授予 MFA 登录的令牌
+type RoleBo struct {
+	UID         snowflake.ID
+	Name        string
+	Description string
+	Permissions []string
+	Builtin     bool
+	RequireMFA  bool
+	CreatedAt   time.Time
+	UpdatedAt   time.Time
+}
+
+func (b *RoleBo) ToAPIV1RoleItem() *apiv1.RoleItem {
+	return &apiv1.RoleItem{
+		Uid:         b.UID.Int64(),
+		Name:        b.Name,
+		Description: b.Description,
+		Permissions: b.Permissions,
+		Builtin:     b.Builtin,
+		RequireMfa:  b.RequireMFA,
+		CreatedAt:   b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:   b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+// SaveRoleBo 创建或更新角色，UID 为零值时创建
+type SaveRoleBo struct {
+	UID         snowflake.ID
+	Name        string
+	Description string
+	Permissions []string
+}
+
+func NewCreateRoleBo(req *apiv1.CreateRoleRequest) *SaveRoleBo {
+	return &SaveRoleBo{
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Permissions: req.GetPermissions(),
+	}
+}
+
+func NewUpdateRoleBo(req *apiv1.UpdateRoleRequest) *SaveRoleBo {
+	return &SaveRoleBo{
+		UID:         snowflake.ParseInt64(req.GetUid()),
+		Description: req.GetDescription(),
+		Permissions: req.GetPermissions(),
+	}
+}
+
+// RoleBindingBo 用户在 Namespace 中拥有的角色，Namespace 为空时在所有 namespace 中生效
+type RoleBindingBo struct {
+	UID       snowflake.ID
+	UserUID   snowflake.ID
+	Username  string
+	Role      *RoleBo
+	Namespace string
+	CreatedAt time.Time
+}
+
+func (b *RoleBindingBo) ToAPIV1RoleBindingItem() *apiv1.RoleBindingItem {
+	item := &apiv1.RoleBindingItem{
+		Uid:       b.UID.Int64(),
+		UserUID:   b.UserUID.Int64(),
+		Username:  b.Username,
+		Namespace: b.Namespace,
+		CreatedAt: b.CreatedAt.Format(time.DateTime),
+	}
+	if b.Role != nil {
+		item.Role = b.Role.ToAPIV1RoleItem()
+	}
+	return item
+}
+
+// CreateRoleBindingBo 为用户绑定角色
+type CreateRoleBindingBo struct {
+	UserUID   snowflake.ID
+	RoleUID   snowflake.ID
+	Namespace string
+}
+
+func NewCreateRoleBindingBo(req *apiv1.CreateRoleBindingRequest) *CreateRoleBindingBo {
+	return &CreateRoleBindingBo{
+		UserUID:   snowflake.ParseInt64(req.GetUserUID()),
+		RoleUID:   snowflake.ParseInt64(req.GetRoleUID()),
+		Namespace: req.GetNamespace(),
+	}
+}
+
+// ListRoleBindingsBo 按设置的字段过滤角色绑定
+type ListRoleBindingsBo struct {
+	*PageRequestBo
+	UserUID   snowflake.ID
+	RoleUID   snowflake.ID
+	Namespace string
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *ListRoleBindingsBo) PageFilters() map[string]string {
+	return map[string]string{
+		"userUID":   b.UserUID.String(),
+		"roleUID":   b.RoleUID.String(),
+		"namespace": b.Namespace,
+	}
+}
+
+func NewListRoleBindingsBo(req *apiv1.ListRoleBindingsRequest) *ListRoleBindingsBo {
+	return &ListRoleBindingsBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+		UserUID:       snowflake.ParseInt64(req.GetUserUID()),
+		RoleUID:       snowflake.ParseInt64(req.GetRoleUID()),
+		Namespace:     req.GetNamespace(),
+	}
+}
+
+func ToAPIV1ListRoleBindingsReply(pageResponseBo *PageResponseBo[*RoleBindingBo]) *apiv1.ListRoleBindingsReply {
+	items := make([]*apiv1.RoleBindingItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1RoleBindingItem())
+	}
+	return &apiv1.ListRoleBindingsReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
+
+// UserPermissionsBo 用户在 namespace 中绑定的角色及其权限，包含全局绑定的角色
+type UserPermissionsBo struct {
+	Roles       []string
+	Permissions []string
+}
+
+// ListRolesBo 按 id 升序分页列出角色
+type ListRolesBo struct {
+	*PageRequestBo
+}
+
+// PageFilters 翻页令牌绑定的过滤条件，没有过滤条件
+func (b *ListRolesBo) PageFilters() map[string]string {
+	return nil
+}
+
+func NewListRolesBo(req *apiv1.ListRolesRequest) *ListRolesBo {
+	return &ListRolesBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+	}
+}
+
+func ToAPIV1ListRolesReply(pageResponseBo *PageResponseBo[*RoleBo]) *apiv1.ListRolesReply {
+	items := make([]*apiv1.RoleItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1RoleItem())
+	}
+	return &apiv1.ListRolesReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
@@ -0,0 +1,56 @@
+package bo
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// SecretCipher 使用 AES-GCM 加密保存到数据库的密钥，密文包含随机 nonce，密钥更换后旧密文无法解密
+type SecretCipher struct {
+	aead cipher.AEAD
+}
+
+// NewSecretCipher 由 secret 和用途 label 派生加密密钥，不同用途的密文不能互相解密
+func NewSecretCipher(secret, label string) (*SecretCipher, error) {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(label))
+	block, err := aes.NewCipher(mac.Sum(nil))
+	if err != nil {
+		return nil, merr.ErrorInternal("create secret cipher failed").WithCause(err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, merr.ErrorInternal("create secret cipher failed").WithCause(err)
+	}
+	return &SecretCipher{aead: aead}, nil
+}
+
+// Encrypt 加密明文，返回 base64 编码的 nonce 和密文
+func (c *SecretCipher) Encrypt(plaintext string) (string, error) {
+	nonce := make([]byte, c.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", merr.ErrorInternal("generate nonce failed").WithCause(err)
+	}
+	sealed := c.aead.Seal(nonce, nonce, []byte(plaintext), nil)
+	return base64.RawURLEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt 解密 Encrypt 返回的密文
+func (c *SecretCipher) Decrypt(ciphertext string) (string, error) {
+	sealed, err := base64.RawURLEncoding.DecodeString(ciphertext)
+	if err != nil || len(sealed) < c.aead.NonceSize() {
+		return "", merr.ErrorInternal("invalid secret ciphertext")
+	}
+	nonce, sealed := sealed[:c.aead.NonceSize()], sealed[c.aead.NonceSize():]
+	plaintext, err := c.aead.Open(nil, nonce, sealed, nil)
+	if err != nil {
+		return "", merr.ErrorInternal("decrypt secret failed, the secret key may have changed").WithCause(err)
+	}
+	return string(plaintext), nil
+}
@@ -0,0 +1,72 @@
+package bo_test
+
+import (
+	"testing"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+func TestSecretCipherRoundTrip(t *testing.T) {
+	secretCipher, err := bo.NewSecretCipher("secret", "oauth2-client-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, plaintext := range []string{"", "client-secret", "秘密"} {
+		ciphertext, err := secretCipher.Encrypt(plaintext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if plaintext != "" && ciphertext == plaintext {
+			t.Fatalf("want %q encrypted", plaintext)
+		}
+		got, err := secretCipher.Decrypt(ciphertext)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got != plaintext {
+			t.Fatalf("want %q, got %q", plaintext, got)
+		}
+	}
+
+	// the nonce is random, so the same plaintext is never stored twice the same
+	first, _ := secretCipher.Encrypt("client-secret")
+	second, _ := secretCipher.Encrypt("client-secret")
+	if first == second {
+		t.Fatal("want a random nonce in each ciphertext")
+	}
+}
+
+func TestSecretCipherRejected(t *testing.T) {
+	secretCipher, err := bo.NewSecretCipher("secret", "oauth2-client-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext, err := secretCipher.Encrypt("client-secret")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		secret     string
+		label      string
+		ciphertext string
+	}{
+		{name: "secret changed", secret: "rotated", label: "oauth2-client-secret", ciphertext: ciphertext},
+		{name: "other label", secret: "secret", label: "mfa-totp-secret", ciphertext: ciphertext},
+		{name: "tampered", secret: "secret", label: "oauth2-client-secret", ciphertext: ciphertext[:len(ciphertext)-2] + "AA"},
+		{name: "truncated", secret: "secret", label: "oauth2-client-secret", ciphertext: ciphertext[:8]},
+		{name: "not base64", secret: "secret", label: "oauth2-client-secret", ciphertext: "not base64!"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			other, err := bo.NewSecretCipher(tt.secret, tt.label)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if plaintext, err := other.Decrypt(tt.ciphertext); err == nil {
+				t.Fatalf("want the ciphertext rejected, got %q", plaintext)
+			}
+		})
+	}
+}
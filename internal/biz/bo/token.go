@@ -0,0 +1,42 @@
+package bo
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+// TokenBo 访问令牌和轮换后的刷新令牌，启用了 MFA 的用户登录时只有 MFAChallenge
+type TokenBo struct {
+	Token                 string
+	ExpiresAt             time.Time
+	RefreshToken          string
+	RefreshExpiresAt      time.Time
+	MFAChallenge          string
+	MFAChallengeExpiresAt time.Time
+}
+
+func (b *TokenBo) ToAPIV1TokenReply() *apiv1.TokenReply {
+	if b.MFAChallenge != "" {
+		return &apiv1.TokenReply{
+			MfaChallenge:          b.MFAChallenge,
+			MfaChallengeExpiresAt: b.MFAChallengeExpiresAt.Format(time.DateTime),
+		}
+	}
+	return &apiv1.TokenReply{
+		Token:            b.Token,
+		ExpiresAt:        b.ExpiresAt.Format(time.DateTime),
+		RefreshToken:     b.RefreshToken,
+		RefreshExpiresAt: b.RefreshExpiresAt.Format(time.DateTime),
+	}
+}
+
+// RevokeTokenBo 吊销刷新令牌所属的登录，以及在过期前吊销访问令牌 TokenID
+type RevokeTokenBo struct {
+	RefreshToken string
+	TokenID      string
+	UID          snowflake.ID
+	ExpiresAt    time.Time
+}
@@ -0,0 +1,258 @@
+package bo
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/enum"
+)
+
+// UserItemBo 系统用户，Status 为未知的用户是状态字段启用前创建的，视为启用
+type UserItemBo struct {
+	UID       snowflake.ID
+	Name      string
+	Nickname  string
+	Email     string
+	Avatar    string
+	Remark    string
+	Status    vobj.GlobalStatus
+	CreatedAt time.Time
+	UpdatedAt time.Time
+	// EmailVerified 邮箱已被登录提供方验证，只有已验证的邮箱会自动关联新的 OAuth2 账号
+	EmailVerified bool
+}
+
+func (b *UserItemBo) ToAPIV1UserItem() *apiv1.UserItem {
+	status := b.Status
+	if status.IsUnknown() {
+		status = vobj.GlobalStatusEnabled
+	}
+	return &apiv1.UserItem{
+		Uid:           b.UID.Int64(),
+		Name:          b.Name,
+		Nickname:      b.Nickname,
+		Email:         b.Email,
+		Avatar:        b.Avatar,
+		Remark:        b.Remark,
+		Status:        enum.GlobalStatus(status),
+		CreatedAt:     b.CreatedAt.Format(time.DateTime),
+		UpdatedAt:     b.UpdatedAt.Format(time.DateTime),
+		EmailVerified: b.EmailVerified,
+	}
+}
+
+type ListUsersBo struct {
+	*PageRequestBo
+	Keyword string
+	Status  vobj.GlobalStatus
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *ListUsersBo) PageFilters() map[string]string {
+	return map[string]string{
+		"keyword": b.Keyword,
+		"status":  strconv.Itoa(int(b.Status)),
+	}
+}
+
+func NewListUsersBo(req *apiv1.ListUsersRequest) *ListUsersBo {
+	return &ListUsersBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+		Keyword:       req.GetKeyword(),
+		Status:        vobj.GlobalStatus(req.GetStatus()),
+	}
+}
+
+func ToAPIV1ListUsersReply(pageResponseBo *PageResponseBo[*UserItemBo]) *apiv1.ListUsersReply {
+	items := make([]*apiv1.UserItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1UserItem())
+	}
+	return &apiv1.ListUsersReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
+
+// UpdateUserBo 更新用户资料，用户名和邮箱不可修改
+type UpdateUserBo struct {
+	UID      snowflake.ID
+	Nickname string
+	Avatar   string
+	Remark   string
+}
+
+func NewUpdateUserBo(req *apiv1.UpdateUserRequest) *UpdateUserBo {
+	return &UpdateUserBo{
+		UID:      snowflake.ParseInt64(req.GetUid()),
+		Nickname: req.GetNickname(),
+		Avatar:   req.GetAvatar(),
+		Remark:   req.GetRemark(),
+	}
+}
+
+type UpdateUserStatusBo struct {
+	UID      snowflake.ID
+	Status   vobj.GlobalStatus
+	Operator snowflake.ID
+}
+
+func NewUpdateUserStatusBo(operator snowflake.ID, req *apiv1.UpdateUserStatusRequest) *UpdateUserStatusBo {
+	return &UpdateUserStatusBo{
+		UID:      snowflake.ParseInt64(req.GetUid()),
+		Status:   vobj.GlobalStatus(req.GetStatus()),
+		Operator: operator,
+	}
+}
+
+// IdentityBo 用户关联的 OAuth2 账号，App 为登录所用的提供方
+type IdentityBo struct {
+	ID        uint32
+	UserUID   snowflake.ID
+	App       string
+	OpenID    string
+	Name      string
+	Email     string
+	Avatar    string
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (b *IdentityBo) ToAPIV1IdentityItem() *apiv1.IdentityItem {
+	return &apiv1.IdentityItem{
+		Id:        b.ID,
+		UserUID:   b.UserUID.Int64(),
+		App:       b.App,
+		OpenID:    b.OpenID,
+		Name:      b.Name,
+		Email:     b.Email,
+		Avatar:    b.Avatar,
+		CreatedAt: b.CreatedAt.Format(time.DateTime),
+		UpdatedAt: b.UpdatedAt.Format(time.DateTime),
+	}
+}
+
+// IdentityAuditEventBo OAuth2 账号的关联或解除关联记录，ActorUID 为操作人
+type IdentityAuditEventBo struct {
+	ID            uint32
+	UserUID       snowflake.ID
+	ActorUID      snowflake.ID
+	Action        string
+	Reason        string
+	App           string
+	OpenID        string
+	Email         string
+	EmailVerified bool
+	CreatedAt     time.Time
+}
+
+func (b *IdentityAuditEventBo) ToAPIV1IdentityAuditEventItem() *apiv1.IdentityAuditEventItem {
+	return &apiv1.IdentityAuditEventItem{
+		Id:            b.ID,
+		UserUID:       b.UserUID.Int64(),
+		ActorUID:      b.ActorUID.Int64(),
+		Action:        b.Action,
+		Reason:        b.Reason,
+		App:           b.App,
+		OpenID:        b.OpenID,
+		Email:         b.Email,
+		EmailVerified: b.EmailVerified,
+		CreatedAt:     b.CreatedAt.Format(time.DateTime),
+	}
+}
+
+type ListIdentityAuditEventsBo struct {
+	*PageRequestBo
+	UserUID snowflake.ID
+}
+
+// PageFilters 翻页令牌绑定的过滤条件
+func (b *ListIdentityAuditEventsBo) PageFilters() map[string]string {
+	return map[string]string{
+		"uid": b.UserUID.String(),
+	}
+}
+
+func NewListIdentityAuditEventsBo(req *apiv1.ListIdentityAuditEventsRequest) *ListIdentityAuditEventsBo {
+	return &ListIdentityAuditEventsBo{
+		PageRequestBo: NewTokenPageRequestBo(req.GetPage(), req.GetPageSize(), req.GetPageToken()),
+		UserUID:       snowflake.ParseInt64(req.GetUid()),
+	}
+}
+
+func ToAPIV1ListIdentityAuditEventsReply(pageResponseBo *PageResponseBo[*IdentityAuditEventBo]) *apiv1.ListIdentityAuditEventsReply {
+	items := make([]*apiv1.IdentityAuditEventItem, 0, len(pageResponseBo.GetItems()))
+	for _, item := range pageResponseBo.GetItems() {
+		items = append(items, item.ToAPIV1IdentityAuditEventItem())
+	}
+	return &apiv1.ListIdentityAuditEventsReply{
+		Items:         items,
+		Total:         pageResponseBo.GetTotal(),
+		Page:          pageResponseBo.GetPage(),
+		PageSize:      pageResponseBo.GetPageSize(),
+		NextPageToken: pageResponseBo.NextPageToken,
+		PrevPageToken: pageResponseBo.PrevPageToken,
+	}
+}
+
+// UserPreferencesBo 用户偏好，DefaultNamespace 用于未设置 namespace 请求头的请求
+type UserPreferencesBo struct {
+	UserUID          snowflake.ID
+	DefaultNamespace string
+	Locale           string
+	Timezone         string
+	UpdatedAt        time.Time
+}
+
+func NewUserPreferencesBo(userUID snowflake.ID, req *apiv1.UpdatePreferencesRequest) *UserPreferencesBo {
+	return &UserPreferencesBo{
+		UserUID:          userUID,
+		DefaultNamespace: req.GetDefaultNamespace(),
+		Locale:           req.GetLocale(),
+		Timezone:         req.GetTimezone(),
+	}
+}
+
+func (b *UserPreferencesBo) ToAPIV1PreferencesItem() *apiv1.PreferencesItem {
+	item := &apiv1.PreferencesItem{
+		DefaultNamespace: b.DefaultNamespace,
+		Locale:           b.Locale,
+		Timezone:         b.Timezone,
+	}
+	if !b.UpdatedAt.IsZero() {
+		item.UpdatedAt = b.UpdatedAt.Format(time.DateTime)
+	}
+	return item
+}
+
+// UserProfileBo 当前登录用户的资料，Memberships 为用户的角色绑定
+type UserProfileBo struct {
+	User        *UserItemBo
+	Identities  []*IdentityBo
+	Memberships []*RoleBindingBo
+	Preferences *UserPreferencesBo
+}
+
+func (b *UserProfileBo) ToAPIV1GetMeReply() *apiv1.GetMeReply {
+	reply := &apiv1.GetMeReply{
+		User:        b.User.ToAPIV1UserItem(),
+		Identities:  make([]*apiv1.IdentityItem, 0, len(b.Identities)),
+		Memberships: make([]*apiv1.RoleBindingItem, 0, len(b.Memberships)),
+		Preferences: b.Preferences.ToAPIV1PreferencesItem(),
+	}
+	for _, identity := range b.Identities {
+		reply.Identities = append(reply.Identities, identity.ToAPIV1IdentityItem())
+	}
+	for _, membership := range b.Memberships {
+		reply.Memberships = append(reply.Memberships, membership.ToAPIV1RoleBindingItem())
+	}
+	return reply
+}
@@ -0,0 +1,79 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewInvitation(invitationRepo repository.Invitation, oauth2Provider *OAuth2Provider, pageTokens *bo.PageTokenCodec, helper *klog.Helper) *Invitation {
+	return &Invitation{
+		invitationRepo: invitationRepo,
+		oauth2Provider: oauth2Provider,
+		pageTokens:     pageTokens,
+		helper:         klog.NewHelper(klog.With(helper.Logger(), "biz", "invitation")),
+	}
+}
+
+type Invitation struct {
+	helper         *klog.Helper
+	invitationRepo repository.Invitation
+	oauth2Provider *OAuth2Provider
+	pageTokens     *bo.PageTokenCodec
+}
+
+// CreateInvitation creates an invitation of the signed-in user, the provider of the invitation must be a known provider.
+func (i *Invitation) CreateInvitation(ctx context.Context, req *bo.CreateInvitationBo) (*bo.CreatedInvitationBo, error) {
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if req.Provider != "" {
+		providerConfig, err := i.oauth2Provider.ProviderConfig(ctx, req.Provider)
+		if err != nil {
+			return nil, err
+		}
+		if providerConfig == nil {
+			return nil, merr.ErrorParams("oauth2 provider %s is not configured", req.Provider)
+		}
+	}
+	req.CreatorUID = claims.UID
+	created, err := i.invitationRepo.CreateInvitation(ctx, req)
+	if err != nil {
+		i.helper.Errorw("msg", "create invitation failed", "error", err, "provider", req.Provider)
+		return nil, merr.ErrorInternal("create invitation failed").WithCause(err)
+	}
+	return created, nil
+}
+
+func (i *Invitation) ListInvitations(ctx context.Context, req *bo.ListInvitationsBo) (*bo.PageResponseBo[*bo.InvitationBo], error) {
+	filters := req.PageFilters()
+	if err := i.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := i.invitationRepo.ListInvitations(ctx, req)
+	if err != nil {
+		i.helper.Errorw("msg", "list invitations failed", "error", err)
+		return nil, merr.ErrorInternal("list invitations failed").WithCause(err)
+	}
+	i.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+// DeleteInvitation revokes the invitation, the users who signed up with it are kept.
+func (i *Invitation) DeleteInvitation(ctx context.Context, uid snowflake.ID) error {
+	if err := i.invitationRepo.DeleteInvitation(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return err
+		}
+		i.helper.Errorw("msg", "delete invitation failed", "error", err, "invitationUID", uid)
+		return merr.ErrorInternal("delete invitation failed").WithCause(err)
+	}
+	return nil
+}
@@ -2,25 +2,236 @@ package biz
 
 import (
 	"context"
+	"net/url"
+	"strings"
 
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
 	"golang.org/x/oauth2"
 
+	"github.com/aide-family/sovereign/internal/biz/bo"
 	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/conf"
 	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
 )
 
-func NewLoginBiz(authRepo repository.LoginRepository) *LoginBiz {
-	return &LoginBiz{authRepo: authRepo}
+// NewLoginBiz creates the login biz, and creates the configured bootstrap admin when no user has its username yet.
+// The bootstrap admin is bound to the builtin admin role on every start.
+func NewLoginBiz(authRepo repository.LoginRepository, ldapRepo repository.LDAP, rbac *RBAC, oauth2Provider *OAuth2Provider, loginLimit *LoginLimit, bc *conf.Bootstrap, helper *klog.Helper) (*LoginBiz, error) {
+	b := &LoginBiz{
+		authRepo:       authRepo,
+		ldapRepo:       ldapRepo,
+		rbac:           rbac,
+		oauth2Provider: oauth2Provider,
+		limiter:        loginLimit.Limiter(),
+		oauth2Conf:     bc.GetOauth2(),
+		helper:         klog.NewHelper(klog.With(helper.Logger(), "biz", "login")),
+	}
+	// the link tickets are verified by the login state of the oauth2 handler, which signs with the same secret
+	if strings.EqualFold(b.oauth2Conf.GetEnable(), "true") {
+		linkState, err := auth.NewOAuth2State(b.oauth2Conf, bc.GetJwt().GetSecret())
+		if err != nil {
+			return nil, err
+		}
+		b.linkState = linkState
+	}
+	if err := b.bootstrapAdmin(context.Background(), bc.GetBootstrapAdmin()); err != nil {
+		return nil, err
+	}
+	return b, nil
 }
 
 type LoginBiz struct {
-	authRepo repository.LoginRepository
+	helper         *klog.Helper
+	authRepo       repository.LoginRepository
+	ldapRepo       repository.LDAP
+	rbac           *RBAC
+	oauth2Provider *OAuth2Provider
+	oauth2Conf     *config.OAuth2
+	linkState      *auth.OAuth2State
+	// limiter limits the password and LDAP logins, nil when the login rate limit is not enabled
+	limiter *auth.LoginLimiter
+}
+
+// Limiter returns the login limiter, which also limits the OAuth2 login routes, nil when the login rate limit is not enabled.
+func (b *LoginBiz) Limiter() *auth.LoginLimiter {
+	return b.limiter
 }
 
-func (b *LoginBiz) Login(ctx context.Context, oauthConfig *oauth2.Config, user auth.User) (string, error) {
-	redirectURL, err := b.authRepo.Login(ctx, oauthConfig, user)
+// Login signs the user of the identity in, or links the identity to the user of the link ticket the login started with.
+func (b *LoginBiz) Login(ctx context.Context, oauthConfig *oauth2.Config, user auth.User, loginState *auth.LoginState) (string, error) {
+	if linkUID := loginState.GetLinkUID(); linkUID != 0 {
+		return b.linkIdentity(ctx, oauthConfig, snowflake.ParseInt64(linkUID), user)
+	}
+	providerConfig, err := b.oauth2Provider.ProviderConfig(ctx, auth.UserProviderName(user))
 	if err != nil {
 		return "", err
 	}
-	return redirectURL, nil
+	signup := &bo.SignupBo{
+		InviteOnly:     providerConfig.GetAdmission().GetInviteOnly(),
+		InvitationCode: loginState.GetInvitation(),
+	}
+	redirectURL, signedIn, err := b.authRepo.Login(ctx, oauthConfig, user, b.oauth2Conf.GetLinkPolicy(), signup)
+	if err != nil {
+		return "", err
+	}
+	// the user still signed in with the noreply email of the app is asked to set its email
+	if providerConfig.GetEmailFallback() != config.OAuth2_COMPLETE_PROFILE || !auth.IsNoreplyEmail(signedIn.Email) {
+		return redirectURL, nil
+	}
+	redirectURLObj, err := url.Parse(redirectURL)
+	if err != nil {
+		return "", merr.ErrorInternal("invalid redirect URL").WithCause(err)
+	}
+	query := redirectURLObj.Query()
+	query.Set(auth.CompleteProfileQueryName, "true")
+	redirectURLObj.RawQuery = query.Encode()
+	return redirectURLObj.String(), nil
+}
+
+// LinkLoginURL returns the login URL of the provider carrying a link ticket of the signed-in user.
+func (b *LoginBiz) LinkLoginURL(ctx context.Context, app, returnTo string) (string, error) {
+	if b.linkState == nil {
+		return "", merr.ErrorParams("oauth2 is not enabled")
+	}
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	providerConfig, err := b.oauth2Provider.ProviderConfig(ctx, app)
+	if err != nil {
+		return "", err
+	}
+	if providerConfig == nil {
+		return "", merr.ErrorParams("oauth2 provider %s is not configured", app)
+	}
+	if returnTo != "" && !b.linkState.AllowReturnTo(returnTo) {
+		return "", merr.ErrorParams("return_to %s is not allowed", returnTo)
+	}
+	query := url.Values{}
+	query.Set(auth.LinkTicketQueryName, b.linkState.IssueLinkTicket(claims.UID.Int64()))
+	if returnTo != "" {
+		query.Set(auth.ReturnToQueryName, returnTo)
+	}
+	return auth.LoginPath(auth.ProviderName(providerConfig)) + "?" + query.Encode(), nil
+}
+
+// linkIdentity links the identity to the user and sends the browser to the redirect URL of the login with the linked app.
+func (b *LoginBiz) linkIdentity(ctx context.Context, oauthConfig *oauth2.Config, userUID snowflake.ID, user auth.User) (string, error) {
+	identity, err := b.authRepo.LinkIdentity(ctx, userUID, user)
+	if err != nil {
+		if merr.IsForbidden(err) || merr.IsNotFound(err) {
+			return "", err
+		}
+		b.helper.Errorw("msg", "link identity failed", "error", err, "uid", userUID, "app", user.GetAPP())
+		return "", merr.ErrorInternal("link identity failed").WithCause(err)
+	}
+	redirectURL, err := url.Parse(oauthConfig.RedirectURL)
+	if err != nil {
+		return "", merr.ErrorInternal("invalid redirect URL").WithCause(err)
+	}
+	query := redirectURL.Query()
+	query.Set("linked", identity.App)
+	redirectURL.RawQuery = query.Encode()
+	return redirectURL.String(), nil
+}
+
+// PasswordLogin signs a local account in, the logins are limited by the login rate limit and a wrong password counts as a failure.
+func (b *LoginBiz) PasswordLogin(ctx context.Context, username, password string) (*bo.TokenBo, error) {
+	attempt := &auth.LoginAttempt{IP: b.limiter.ContextClientIP(ctx), Account: username, Provider: auth.PasswordProviderName}
+	if err := b.limiter.Allow(ctx, attempt); err != nil {
+		return nil, err
+	}
+	tokenBo, err := b.authRepo.PasswordLogin(ctx, username, password)
+	if err != nil {
+		if merr.IsUnauthorized(err) {
+			b.limiter.Fail(ctx, attempt)
+			return nil, err
+		}
+		b.helper.Errorw("msg", "password login failed", "error", err, "username", username)
+		return nil, merr.ErrorInternal("password login failed").WithCause(err)
+	}
+	b.limiter.Succeed(ctx, attempt)
+	return tokenBo, nil
+}
+
+// LDAPLogin checks the directory password and links the directory user like an OAuth2 user, it is limited like a password login.
+func (b *LoginBiz) LDAPLogin(ctx context.Context, username, password string) (*bo.TokenBo, error) {
+	attempt := &auth.LoginAttempt{IP: b.limiter.ContextClientIP(ctx), Account: username, Provider: strings.ToLower(config.OAuth2_LDAP.String())}
+	if err := b.limiter.Allow(ctx, attempt); err != nil {
+		return nil, err
+	}
+	user, err := b.ldapRepo.Login(ctx, username, password)
+	if err != nil {
+		if merr.IsUnauthorized(err) || merr.IsForbidden(err) {
+			b.limiter.Fail(ctx, attempt)
+			return nil, err
+		}
+		b.helper.Errorw("msg", "ldap login failed", "error", err, "username", username)
+		return nil, merr.ErrorInternal("ldap login failed").WithCause(err)
+	}
+	tokenBo, err := b.authRepo.UserLogin(ctx, user, b.oauth2Conf.GetLinkPolicy())
+	if err != nil {
+		if merr.IsForbidden(err) {
+			return nil, err
+		}
+		b.helper.Errorw("msg", "ldap user login failed", "error", err, "username", username, "openID", user.GetOpenID())
+		return nil, merr.ErrorInternal("ldap login failed").WithCause(err)
+	}
+	b.limiter.Succeed(ctx, attempt)
+	return tokenBo, nil
+}
+
+// ChangePassword changes the password of the signed-in user.
+func (b *LoginBiz) ChangePassword(ctx context.Context, oldPassword, newPassword string) error {
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req := &bo.ChangePasswordBo{
+		UID:         claims.UID,
+		OldPassword: oldPassword,
+		NewPassword: newPassword,
+	}
+	if err := b.authRepo.ChangePassword(ctx, req); err != nil {
+		if merr.IsParams(err) || merr.IsNotFound(err) {
+			return err
+		}
+		b.helper.Errorw("msg", "change password failed", "error", err, "uid", req.UID)
+		return merr.ErrorInternal("change password failed").WithCause(err)
+	}
+	return nil
+}
+
+func (b *LoginBiz) bootstrapAdmin(ctx context.Context, admin *conf.BootstrapAdmin) error {
+	if admin.GetUsername() == "" || admin.GetPassword() == "" {
+		return nil
+	}
+	req := &bo.BootstrapAdminBo{
+		Username: admin.GetUsername(),
+		Email:    admin.GetEmail(),
+		Password: admin.GetPassword(),
+	}
+	// the email is unique, the admin without one gets a local address which no OAuth2 account can claim
+	if req.Email == "" {
+		req.Email = req.Username + "@localhost"
+	}
+	adminUID, err := b.authRepo.BootstrapAdmin(ctx, req)
+	if err != nil {
+		// the admin role is never bound to a federated user who took the name, the server starts without the bootstrap admin
+		if merr.IsForbidden(err) {
+			b.helper.Errorw("msg", "bootstrap admin refused, rename the bootstrap admin", "error", err, "username", req.Username)
+			return nil
+		}
+		b.helper.Errorw("msg", "bootstrap admin failed", "error", err, "username", req.Username)
+		return err
+	}
+	if err := b.rbac.bindAdmin(ctx, adminUID); err != nil {
+		b.helper.Errorw("msg", "bind bootstrap admin role failed", "error", err, "username", req.Username)
+		return err
+	}
+	return nil
 }
@@ -0,0 +1,77 @@
+package biz
+
+import (
+	"context"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// NewLoginLimit creates the login limiter of the loginRateLimit config, counting in the memory of the replica
+// or in the database of the login repository, the limiter is nil when the config does not enable it.
+func NewLoginLimit(loginLimitRepo repository.LoginLimit, bc *conf.Bootstrap, pageTokens *bo.PageTokenCodec, helper *klog.Helper) (*LoginLimit, error) {
+	l := &LoginLimit{
+		loginLimitRepo: loginLimitRepo,
+		pageTokens:     pageTokens,
+		helper:         klog.NewHelper(klog.With(helper.Logger(), "biz", "loginLimit")),
+	}
+	limitConf := bc.GetLoginRateLimit()
+	store := auth.NewMemoryLoginLimitStore()
+	if limitConf.GetStore() == config.LoginRateLimit_DATABASE {
+		store = loginLimitRepo
+	}
+	limiter, err := auth.NewLoginLimiter(limitConf, store, auth.BindLockoutFunc(l.recordLockout))
+	if err != nil {
+		return nil, err
+	}
+	l.limiter = limiter
+	return l, nil
+}
+
+type LoginLimit struct {
+	helper         *klog.Helper
+	loginLimitRepo repository.LoginLimit
+	limiter        *auth.LoginLimiter
+	pageTokens     *bo.PageTokenCodec
+}
+
+// Limiter returns the login limiter, nil when the login rate limit is not enabled.
+func (l *LoginLimit) Limiter() *auth.LoginLimiter {
+	return l.limiter
+}
+
+func (l *LoginLimit) ListLoginAuditEvents(ctx context.Context, req *bo.ListLoginAuditEventsBo) (*bo.PageResponseBo[*bo.LoginAuditEventBo], error) {
+	filters := req.PageFilters()
+	if err := l.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := l.loginLimitRepo.ListLoginAuditEvents(ctx, req)
+	if err != nil {
+		l.helper.Errorw("msg", "list login audit events failed", "error", err, "subject", req.Subject)
+		return nil, merr.ErrorInternal("list login audit events failed").WithCause(err)
+	}
+	l.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+// recordLockout keeps the audit event of a lockout, a failure is logged and does not change the refused login.
+func (l *LoginLimit) recordLockout(ctx context.Context, event *auth.LockoutEvent) {
+	eventBo := &bo.LoginAuditEventBo{
+		Action:      auth.LoginAuditActionLockout,
+		Kind:        event.Kind,
+		Subject:     event.Subject,
+		Provider:    event.Provider,
+		IP:          event.IP,
+		Failures:    event.Failures,
+		LockedUntil: event.LockedUntil,
+	}
+	if err := l.loginLimitRepo.CreateLoginAuditEvent(ctx, eventBo); err != nil {
+		l.helper.Errorw("msg", "create login audit event failed", "error", err, "kind", event.Kind, "subject", event.Subject)
+	}
+}
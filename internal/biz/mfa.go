@@ -0,0 +1,316 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/strutil"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	// mfaTOTPSecretLabel derives the key of the TOTP secrets from the secret key, so that the key is not
+	// the one signing the tokens when the jwt secret is used
+	mfaTOTPSecretLabel = "sovereign.mfa.totp-secret"
+	// mfaProviderName is the provider of the MFA codes counted by the login limiter
+	mfaProviderName = "mfa"
+)
+
+// NewMFA creates the MFA biz, the TOTP secrets are encrypted with the secret key of the mfa config,
+// the jwt secret is used when no secret key is configured.
+func NewMFA(mfaRepo repository.MFA, loginLimit *LoginLimit, bc *conf.Bootstrap, helper *klog.Helper) (*MFA, error) {
+	secretKey := bc.GetMfa().GetSecretKey()
+	if strutil.IsEmpty(secretKey) {
+		secretKey = bc.GetJwt().GetSecret()
+	}
+	issuer := bc.GetMfa().GetIssuer()
+	if strutil.IsEmpty(issuer) {
+		issuer = authv1.Issuer(bc.GetJwt())
+	}
+	m := &MFA{
+		helper:  klog.NewHelper(klog.With(helper.Logger(), "biz", "mfa")),
+		mfaRepo: mfaRepo,
+		limiter: loginLimit.Limiter(),
+		issuer:  issuer,
+	}
+	if strutil.IsNotEmpty(secretKey) {
+		secretCipher, err := bo.NewSecretCipher(secretKey, mfaTOTPSecretLabel)
+		if err != nil {
+			return nil, err
+		}
+		m.secretCipher = secretCipher
+	}
+	return m, nil
+}
+
+type MFA struct {
+	helper  *klog.Helper
+	mfaRepo repository.MFA
+	// limiter limits the codes answering the MFA challenges like the logins, nil when the login rate limit is not enabled
+	limiter *auth.LoginLimiter
+	issuer  string
+	// secretCipher is nil when neither the secret key nor the jwt secret is configured, no user can enroll then
+	secretCipher *bo.SecretCipher
+}
+
+// GetMFA returns the MFA of the signed-in user.
+func (m *MFA) GetMFA(ctx context.Context) (*bo.UserMFABo, error) {
+	baseInfo, err := mfaUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return m.getUserMFA(ctx, baseInfo.UID)
+}
+
+// EnrollTOTP creates a new TOTP secret for the signed-in user, the secret is not used before ConfirmTOTP.
+func (m *MFA) EnrollTOTP(ctx context.Context) (*bo.EnrollTOTPBo, error) {
+	baseInfo, err := mfaUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if m.secretCipher == nil {
+		return nil, merr.ErrorParams("TOTP secrets can not be stored, set mfa.secretKey or jwt.secret")
+	}
+	secret := authv1.NewTOTPSecret()
+	encrypted, err := m.secretCipher.Encrypt(secret)
+	if err != nil {
+		m.helper.Errorw("msg", "encrypt TOTP secret failed", "error", err)
+		return nil, merr.ErrorInternal("encrypt TOTP secret failed").WithCause(err)
+	}
+	if _, err := m.mfaRepo.SaveUserMFA(ctx, baseInfo.UID, encrypted); err != nil {
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		m.helper.Errorw("msg", "save user MFA failed", "error", err, "userUID", baseInfo.UID)
+		return nil, merr.ErrorInternal("enroll TOTP failed").WithCause(err)
+	}
+	return &bo.EnrollTOTPBo{
+		Secret:          secret,
+		ProvisioningURI: authv1.TOTPProvisioningURI(m.issuer, baseInfo.Username, secret),
+	}, nil
+}
+
+// ConfirmTOTP enables the enrolled secret with a code of the authenticator app, and returns the new recovery codes.
+func (m *MFA) ConfirmTOTP(ctx context.Context, code string) ([]string, error) {
+	baseInfo, err := mfaUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	mfa, err := m.getUserMFA(ctx, baseInfo.UID)
+	if err != nil {
+		return nil, err
+	}
+	if mfa.Enabled {
+		return nil, merr.ErrorParams("MFA is already enabled")
+	}
+	if strutil.IsEmpty(mfa.Secret) {
+		return nil, merr.ErrorParams("there is no TOTP enrollment to confirm, enroll first")
+	}
+	secret, err := m.decryptSecret(mfa)
+	if err != nil {
+		return nil, err
+	}
+	step, ok := authv1.VerifyTOTP(secret, code, time.Now())
+	if !ok {
+		return nil, merr.ErrorParams("code is incorrect")
+	}
+	codes, hashes := authv1.NewRecoveryCodes()
+	if _, err := m.mfaRepo.EnableUserMFA(ctx, baseInfo.UID, step, hashes); err != nil {
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		m.helper.Errorw("msg", "enable user MFA failed", "error", err, "userUID", baseInfo.UID)
+		return nil, merr.ErrorInternal("confirm TOTP failed").WithCause(err)
+	}
+	return codes, nil
+}
+
+// RegenerateRecoveryCodes replaces the recovery codes of the signed-in user, the code of the second factor is required.
+func (m *MFA) RegenerateRecoveryCodes(ctx context.Context, code string) ([]string, error) {
+	baseInfo, err := mfaUser(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.checkCode(ctx, baseInfo.UID, code); err != nil {
+		return nil, err
+	}
+	codes, hashes := authv1.NewRecoveryCodes()
+	if _, err := m.mfaRepo.SaveRecoveryCodes(ctx, baseInfo.UID, hashes); err != nil {
+		m.helper.Errorw("msg", "save recovery codes failed", "error", err, "userUID", baseInfo.UID)
+		return nil, merr.ErrorInternal("regenerate recovery codes failed").WithCause(err)
+	}
+	return codes, nil
+}
+
+// DisableMFA removes the MFA of the signed-in user, the code of the second factor is required.
+func (m *MFA) DisableMFA(ctx context.Context, code string) error {
+	baseInfo, err := mfaUser(ctx)
+	if err != nil {
+		return err
+	}
+	if err := m.checkCode(ctx, baseInfo.UID, code); err != nil {
+		return err
+	}
+	return m.deleteUserMFA(ctx, baseInfo.UID)
+}
+
+// ResetUserMFA removes the MFA of a user who lost the authenticator app and the recovery codes, the user may enroll again.
+func (m *MFA) ResetUserMFA(ctx context.Context, userUID snowflake.ID) error {
+	return m.deleteUserMFA(ctx, userUID)
+}
+
+// VerifyChallenge answers the MFA challenge of a login and returns the tokens of the login,
+// the wrong codes are limited by the login rate limit like the failed logins.
+func (m *MFA) VerifyChallenge(ctx context.Context, challenge, code string) (*bo.TokenBo, error) {
+	challengeBo, err := m.mfaRepo.GetChallenge(ctx, challenge)
+	if err != nil {
+		if merr.IsUnauthorized(err) {
+			return nil, err
+		}
+		m.helper.Errorw("msg", "get MFA challenge failed", "error", err)
+		return nil, merr.ErrorInternal("verify MFA failed").WithCause(err)
+	}
+	attempt := &auth.LoginAttempt{IP: m.limiter.ContextClientIP(ctx), Account: challengeBo.UserUID.String(), Provider: mfaProviderName}
+	if err := m.limiter.Allow(ctx, attempt); err != nil {
+		return nil, err
+	}
+	mfa, err := m.getUserMFA(ctx, challengeBo.UserUID)
+	if err != nil {
+		return nil, err
+	}
+	method, ok, err := m.verifyCode(ctx, mfa, code)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		m.limiter.Fail(ctx, attempt)
+		failed, err := m.mfaRepo.FailChallenge(ctx, challenge)
+		if err != nil {
+			if merr.IsUnauthorized(err) {
+				return nil, err
+			}
+			m.helper.Errorw("msg", "fail MFA challenge failed", "error", err, "userUID", challengeBo.UserUID)
+			return nil, merr.ErrorInternal("verify MFA failed").WithCause(err)
+		}
+		if failed.Attempts >= authv1.MFAChallengeMaxAttempts {
+			return nil, merr.ErrorUnauthorized("code is incorrect too many times, please login again")
+		}
+		return nil, merr.ErrorUnauthorized("code is incorrect")
+	}
+	token, err := m.mfaRepo.CompleteChallenge(ctx, challenge, method)
+	if err != nil {
+		if merr.IsUnauthorized(err) || merr.IsForbidden(err) {
+			return nil, err
+		}
+		m.helper.Errorw("msg", "complete MFA challenge failed", "error", err, "userUID", challengeBo.UserUID)
+		return nil, merr.ErrorInternal("verify MFA failed").WithCause(err)
+	}
+	m.limiter.Succeed(ctx, attempt)
+	return token, nil
+}
+
+// checkCode requires a TOTP code or a recovery code of the enabled MFA of the user, for the changes of the MFA,
+// the wrong codes are limited by the login rate limit like the wrong codes of the challenges.
+func (m *MFA) checkCode(ctx context.Context, userUID snowflake.ID, code string) error {
+	mfa, err := m.getUserMFA(ctx, userUID)
+	if err != nil {
+		return err
+	}
+	if !mfa.Enabled {
+		return merr.ErrorParams("MFA is not enabled")
+	}
+	attempt := &auth.LoginAttempt{IP: m.limiter.ContextClientIP(ctx), Account: userUID.String(), Provider: mfaProviderName}
+	if err := m.limiter.Allow(ctx, attempt); err != nil {
+		return err
+	}
+	_, ok, err := m.verifyCode(ctx, mfa, code)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		m.limiter.Fail(ctx, attempt)
+		return merr.ErrorParams("code is incorrect")
+	}
+	m.limiter.Succeed(ctx, attempt)
+	return nil
+}
+
+// verifyCode checks a TOTP code or a recovery code of the enabled MFA and uses it up, it returns the amr of the code.
+func (m *MFA) verifyCode(ctx context.Context, mfa *bo.UserMFABo, code string) (string, bool, error) {
+	if !mfa.Enabled {
+		return "", false, nil
+	}
+	code = strings.TrimSpace(code)
+	if authv1.IsTOTPCode(code) {
+		secret, err := m.decryptSecret(mfa)
+		if err != nil {
+			return "", false, err
+		}
+		step, ok := authv1.VerifyTOTP(secret, code, time.Now())
+		if !ok {
+			return "", false, nil
+		}
+		accepted, err := m.mfaRepo.UseTOTPStep(ctx, mfa.UserUID, step)
+		if err != nil {
+			m.helper.Errorw("msg", "use TOTP code failed", "error", err, "userUID", mfa.UserUID)
+			return "", false, merr.ErrorInternal("verify code failed").WithCause(err)
+		}
+		return authv1.AMROTP, accepted, nil
+	}
+	accepted, err := m.mfaRepo.UseRecoveryCode(ctx, mfa.UserUID, authv1.HashRecoveryCode(code))
+	if err != nil {
+		m.helper.Errorw("msg", "use recovery code failed", "error", err, "userUID", mfa.UserUID)
+		return "", false, merr.ErrorInternal("verify code failed").WithCause(err)
+	}
+	return authv1.AMRRecoveryCode, accepted, nil
+}
+
+func (m *MFA) decryptSecret(mfa *bo.UserMFABo) (string, error) {
+	if m.secretCipher == nil {
+		return "", merr.ErrorInternal("TOTP secret can not be decrypted, set mfa.secretKey or jwt.secret")
+	}
+	secret, err := m.secretCipher.Decrypt(mfa.Secret)
+	if err != nil {
+		m.helper.Errorw("msg", "decrypt TOTP secret failed", "error", err, "userUID", mfa.UserUID)
+		return "", err
+	}
+	return secret, nil
+}
+
+func (m *MFA) getUserMFA(ctx context.Context, userUID snowflake.ID) (*bo.UserMFABo, error) {
+	mfa, err := m.mfaRepo.GetUserMFA(ctx, userUID)
+	if err != nil {
+		m.helper.Errorw("msg", "get user MFA failed", "error", err, "userUID", userUID)
+		return nil, merr.ErrorInternal("get user MFA failed").WithCause(err)
+	}
+	return mfa, nil
+}
+
+func (m *MFA) deleteUserMFA(ctx context.Context, userUID snowflake.ID) error {
+	if err := m.mfaRepo.DeleteUserMFA(ctx, userUID); err != nil {
+		m.helper.Errorw("msg", "delete user MFA failed", "error", err, "userUID", userUID)
+		return merr.ErrorInternal("disable MFA failed").WithCause(err)
+	}
+	return nil
+}
+
+// mfaUser returns the signed-in user, the MFA can not be changed with an API key.
+func mfaUser(ctx context.Context) (authv1.BaseInfo, error) {
+	baseInfo, ok := authv1.GetBaseInfo(ctx)
+	if !ok {
+		return authv1.BaseInfo{}, merr.ErrorUnauthorized("token is required")
+	}
+	if _, apiKey := authv1.GetAPIKeyInfo(ctx); apiKey {
+		return authv1.BaseInfo{}, merr.ErrorForbidden("MFA can not be changed with an API key")
+	}
+	return baseInfo, nil
+}
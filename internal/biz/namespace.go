@@ -13,10 +13,16 @@ import (
 
 func NewNamespace(
 	namespaceRepo repository.Namespace,
+	userRepo repository.User,
+	quotaRepo repository.Quota,
+	pageTokens *bo.PageTokenCodec,
 	helper *klog.Helper,
 ) *Namespace {
 	return &Namespace{
 		namespaceRepo: namespaceRepo,
+		userRepo:      userRepo,
+		quotaRepo:     quotaRepo,
+		pageTokens:    pageTokens,
 		helper:        klog.NewHelper(klog.With(helper.Logger(), "biz", "namespace")),
 	}
 }
@@ -24,6 +30,23 @@ func NewNamespace(
 type Namespace struct {
 	helper        *klog.Helper
 	namespaceRepo repository.Namespace
+	userRepo      repository.User
+	quotaRepo     repository.Quota
+	pageTokens    *bo.PageTokenCodec
+}
+
+// checkUsersExist ensures every user is present in the users table.
+func (n *Namespace) checkUsersExist(ctx context.Context, uids ...snowflake.ID) error {
+	for _, uid := range uids {
+		if _, err := n.userRepo.GetUser(ctx, uid); err != nil {
+			if merr.IsNotFound(err) {
+				return merr.ErrorParams("user %s not found", uid)
+			}
+			n.helper.Errorw("msg", "check user exists failed", "error", err, "uid", uid)
+			return merr.ErrorInternal("check user %s exists failed", uid).WithCause(err)
+		}
+	}
+	return nil
 }
 
 func (n *Namespace) CreateNamespace(ctx context.Context, req *bo.CreateNamespaceBo) error {
@@ -33,6 +56,9 @@ func (n *Namespace) CreateNamespace(ctx context.Context, req *bo.CreateNamespace
 		n.helper.Errorw("msg", "check namespace exists failed", "error", err, "name", req.Name)
 		return merr.ErrorInternal("create namespace %s failed", req.Name).WithCause(err)
 	}
+	if err := n.checkUsersExist(ctx, req.Owners...); err != nil {
+		return err
+	}
 	if err := n.namespaceRepo.CreateNamespace(ctx, req); err != nil {
 		n.helper.Errorw("msg", "create namespace failed", "error", err, "name", req.Name)
 		return merr.ErrorInternal("create namespace %s failed", req.Name).WithCause(err)
@@ -48,6 +74,16 @@ func (n *Namespace) UpdateNamespace(ctx context.Context, req *bo.UpdateNamespace
 	} else if existNamespace != nil && existNamespace.UID != req.UID {
 		return merr.ErrorParams("namespace %s already exists", req.Name)
 	}
+	if len(req.Owners) == 0 {
+		// owners are kept as they are unless the request replaces them
+		namespaceItemBo, err := n.GetNamespace(ctx, req.UID)
+		if err != nil {
+			return err
+		}
+		req.Owners = namespaceItemBo.Owners
+	} else if err := n.checkUsersExist(ctx, req.Owners...); err != nil {
+		return err
+	}
 	if err := n.namespaceRepo.UpdateNamespace(ctx, req); err != nil {
 		n.helper.Errorw("msg", "update namespace failed", "error", err, "uid", req.UID)
 		return merr.ErrorInternal("update namespace %s failed", req.UID).WithCause(err)
@@ -63,7 +99,16 @@ func (n *Namespace) UpdateNamespaceStatus(ctx context.Context, req *bo.UpdateNam
 	return nil
 }
 
+// DeleteNamespace deletes the namespace together with its quotas. The quotas may live in another store, so they go
+// first: a failed deletion of the namespace then leaves it without quotas rather than leaves quotas without a namespace.
 func (n *Namespace) DeleteNamespace(ctx context.Context, uid snowflake.ID) error {
+	if _, err := n.GetNamespace(ctx, uid); err != nil {
+		return err
+	}
+	if err := n.quotaRepo.DeleteNamespaceQuotas(ctx, uid); err != nil {
+		n.helper.Errorw("msg", "delete namespace quotas failed", "error", err, "uid", uid)
+		return merr.ErrorInternal("delete namespace %s quotas failed", uid).WithCause(err)
+	}
 	if err := n.namespaceRepo.DeleteNamespace(ctx, uid); err != nil {
 		n.helper.Errorw("msg", "delete namespace failed", "error", err, "uid", uid)
 		return merr.ErrorInternal("delete namespace %s failed", uid).WithCause(err)
@@ -97,23 +142,125 @@ func (n *Namespace) GetNamespaceByName(ctx context.Context, name string) (*bo.Na
 }
 
 func (n *Namespace) ListNamespace(ctx context.Context, req *bo.ListNamespaceBo) (*bo.PageResponseBo[*bo.NamespaceItemBo], error) {
+	filters := req.PageFilters()
+	if err := n.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
 	pageResponseBo, err := n.namespaceRepo.ListNamespace(ctx, req)
 	if err != nil {
 		n.helper.Errorw("msg", "list namespace failed", "error", err, "req", req)
 		return nil, merr.ErrorInternal("list namespace failed").WithCause(err)
 	}
+	n.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
 	return bo.NewPageResponseBo(pageResponseBo.PageRequestBo, pageResponseBo.GetItems()), nil
 }
 
 func (n *Namespace) SelectNamespace(ctx context.Context, req *bo.SelectNamespaceBo) (*bo.SelectNamespaceBoResult, error) {
+	filters := req.PageFilters()
+	if err := n.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
 	result, err := n.namespaceRepo.SelectNamespace(ctx, req)
 	if err != nil {
 		n.helper.Errorw("msg", "select namespace failed", "error", err, "req", req)
 		return nil, merr.ErrorInternal("select namespace failed").WithCause(err)
 	}
+	n.pageTokens.Issue(req.PageRequestBo, filters)
 	return &bo.SelectNamespaceBoResult{
-		Items:   result.Items,
-		Total:   result.Total,
-		LastUID: result.LastUID,
+		Items:         result.Items,
+		Total:         result.Total,
+		LastUID:       result.LastUID,
+		HasMore:       req.HasMore(),
+		PrevPageToken: req.PrevPageToken,
+		NextPageToken: req.NextPageToken,
 	}, nil
 }
+
+func (n *Namespace) GetNamespaceStats(ctx context.Context, req *bo.GetNamespaceStatsBo) (*bo.NamespaceStatsBo, error) {
+	stats, err := n.namespaceRepo.GetNamespaceStats(ctx, req)
+	if err != nil {
+		n.helper.Errorw("msg", "get namespace stats failed", "error", err, "req", req)
+		return nil, merr.ErrorInternal("get namespace stats failed").WithCause(err)
+	}
+	stats.FillDailyCreations()
+	return stats, nil
+}
+
+func (n *Namespace) ListNamespaceRevisions(ctx context.Context, req *bo.ListNamespaceRevisionsBo) (*bo.PageResponseBo[*bo.NamespaceRevisionItemBo], error) {
+	filters := req.PageFilters()
+	if err := n.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := n.namespaceRepo.ListNamespaceRevisions(ctx, req)
+	if err != nil {
+		n.helper.Errorw("msg", "list namespace revisions failed", "error", err, "uid", req.NamespaceUID)
+		return nil, merr.ErrorInternal("list namespace %s revisions failed", req.NamespaceUID).WithCause(err)
+	}
+	n.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+func (n *Namespace) GetNamespaceRevision(ctx context.Context, namespaceUID snowflake.ID, revision int32) (*bo.NamespaceRevisionItemBo, error) {
+	revisionBo, err := n.namespaceRepo.GetNamespaceRevision(ctx, namespaceUID, revision)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("namespace %s revision %d not found", namespaceUID, revision)
+		}
+		n.helper.Errorw("msg", "get namespace revision failed", "error", err, "uid", namespaceUID, "revision", revision)
+		return nil, merr.ErrorInternal("get namespace %s revision %d failed", namespaceUID, revision).WithCause(err)
+	}
+	return revisionBo, nil
+}
+
+func (n *Namespace) DiffNamespaceRevisions(ctx context.Context, namespaceUID snowflake.ID, fromRevision, toRevision int32) (*bo.NamespaceRevisionDiffBo, error) {
+	from, err := n.GetNamespaceRevision(ctx, namespaceUID, fromRevision)
+	if err != nil {
+		return nil, err
+	}
+	to, err := n.GetNamespaceRevision(ctx, namespaceUID, toRevision)
+	if err != nil {
+		return nil, err
+	}
+	return bo.DiffNamespaceRevisions(from, to), nil
+}
+
+func (n *Namespace) RollbackNamespace(ctx context.Context, req *bo.RollbackNamespaceBo) (*bo.NamespaceRevisionItemBo, error) {
+	source, err := n.GetNamespaceRevision(ctx, req.NamespaceUID, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	existNamespace, err := n.namespaceRepo.GetNamespaceByName(ctx, source.Name)
+	if err != nil && !merr.IsNotFound(err) {
+		n.helper.Errorw("msg", "check namespace exists failed", "error", err, "name", source.Name)
+		return nil, merr.ErrorInternal("rollback namespace %s failed", req.NamespaceUID).WithCause(err)
+	} else if existNamespace != nil && existNamespace.UID != req.NamespaceUID {
+		return nil, merr.ErrorParams("namespace %s already exists", source.Name)
+	}
+	revisionBo, err := n.namespaceRepo.RollbackNamespace(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("namespace %s not found", req.NamespaceUID)
+		}
+		n.helper.Errorw("msg", "rollback namespace failed", "error", err, "uid", req.NamespaceUID, "revision", req.Revision)
+		return nil, merr.ErrorInternal("rollback namespace %s failed", req.NamespaceUID).WithCause(err)
+	}
+	return revisionBo, nil
+}
+
+func (n *Namespace) TransferNamespaceOwnership(ctx context.Context, req *bo.TransferNamespaceOwnershipBo) (*bo.NamespaceRevisionItemBo, error) {
+	if err := n.checkUsersExist(ctx, req.NewOwner); err != nil {
+		return nil, err
+	}
+	revisionBo, err := n.namespaceRepo.TransferNamespaceOwnership(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("namespace %s not found", req.NamespaceUID)
+		}
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		n.helper.Errorw("msg", "transfer namespace ownership failed", "error", err, "uid", req.NamespaceUID, "newOwner", req.NewOwner)
+		return nil, merr.ErrorInternal("transfer namespace %s ownership failed", req.NamespaceUID).WithCause(err)
+	}
+	return revisionBo, nil
+}
@@ -0,0 +1,163 @@
+package biz_test
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// newFileNamespace returns the namespace biz over a namespaces file and a sqlite auth repository.
+func newFileNamespace(t *testing.T) (*biz.Namespace, authv1.Repository) {
+	t.Helper()
+	helper := klog.NewHelper(klog.DefaultLogger)
+	c := &conf.Bootstrap{NamespaceConfig: newFileDomainConfig(t, "namespaces.yaml")}
+	d, cleanup, err := data.New(c, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	namespaceRepo, err := impl.NewNamespaceRepository(c, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := newAuthRepository(t)
+	return biz.NewNamespace(namespaceRepo, impl.NewUserRepository(repo), nil, bo.NewPageTokenCodec("secret"), helper), repo
+}
+
+func TestNamespaceRevisions(t *testing.T) {
+	namespaceBiz, repo := newFileNamespace(t)
+	alice := newUser(t, repo, "alice")
+	ctx := context.Background()
+
+	createNamespace := func(name string) snowflake.ID {
+		t.Helper()
+		err := namespaceBiz.CreateNamespace(ctx, &bo.CreateNamespaceBo{
+			Name:        name,
+			Metadata:    map[string]string{"tier": "dev", "team": "core"},
+			Status:      vobj.GlobalStatusEnabled,
+			DisplayName: "Team A",
+			Owners:      []snowflake.ID{alice},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		namespace, err := namespaceBiz.GetNamespaceByName(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return namespace.UID
+	}
+	teamA := createNamespace("team-a")
+	err := namespaceBiz.UpdateNamespace(ctx, &bo.UpdateNamespaceBo{
+		UID:         teamA,
+		Name:        "team-b",
+		Metadata:    map[string]string{"tier": "prod", "region": "eu"},
+		DisplayName: "Team A",
+		Owners:      []snowflake.ID{alice},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := namespaceBiz.DiffNamespaceRevisions(ctx, teamA, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantChanges := []bo.NamespaceFieldChangeBo{
+		{Field: "name", Type: vobj.NamespaceChangeTypeModified, From: "team-a", To: "team-b"},
+		{Field: "metadata.region", Type: vobj.NamespaceChangeTypeAdded, To: "eu"},
+		{Field: "metadata.team", Type: vobj.NamespaceChangeTypeRemoved, From: "core"},
+		{Field: "metadata.tier", Type: vobj.NamespaceChangeTypeModified, From: "dev", To: "prod"},
+	}
+	changes := make([]bo.NamespaceFieldChangeBo, 0, len(diff.Changes))
+	for _, change := range diff.Changes {
+		changes = append(changes, *change)
+	}
+	if diff.FromRevision != 1 || diff.ToRevision != 2 || !slices.Equal(changes, wantChanges) {
+		t.Fatalf("want changes %+v, got %+v", wantChanges, changes)
+	}
+	if _, err := namespaceBiz.DiffNamespaceRevisions(ctx, teamA, 1, 9); !merr.IsNotFound(err) {
+		t.Fatalf("want a missing revision not found, got %v", err)
+	}
+
+	// the old name is taken again, so rolling back to it would duplicate it
+	createNamespace("team-a")
+	rollbackTests := []struct {
+		name     string
+		revision int32
+		check    func(error) bool
+	}{
+		{name: "name taken by another namespace", revision: 1, check: merr.IsParams},
+		{name: "own name", revision: 2, check: func(err error) bool { return err == nil }},
+		{name: "missing revision", revision: 9, check: merr.IsNotFound},
+	}
+	for _, tt := range rollbackTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := namespaceBiz.RollbackNamespace(ctx, &bo.RollbackNamespaceBo{NamespaceUID: teamA, Revision: tt.revision, Operator: alice}); !tt.check(err) {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+	namespace, err := namespaceBiz.GetNamespace(ctx, teamA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if namespace.Name != "team-b" {
+		t.Fatalf("want the name kept after the refused rollback, got %s", namespace.Name)
+	}
+}
+
+func TestNamespaceTransferOwnership(t *testing.T) {
+	namespaceBiz, repo := newFileNamespace(t)
+	alice := newUser(t, repo, "alice")
+	bob := newUser(t, repo, "bob")
+	ctx := context.Background()
+	if err := namespaceBiz.CreateNamespace(ctx, &bo.CreateNamespaceBo{Name: "team-a", Status: vobj.GlobalStatusEnabled, Owners: []snowflake.ID{alice}}); err != nil {
+		t.Fatal(err)
+	}
+	namespace, err := namespaceBiz.GetNamespaceByName(ctx, "team-a")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		newOwner snowflake.ID
+		owners   []snowflake.ID
+		check    func(error) bool
+	}{
+		{name: "unknown user", newOwner: alice + bob, owners: []snowflake.ID{alice}, check: merr.IsParams},
+		{name: "existing user", newOwner: bob, owners: []snowflake.ID{bob}, check: func(err error) bool { return err == nil }},
+		{name: "previous owner no longer an owner", newOwner: bob, owners: []snowflake.ID{bob}, check: merr.IsParams},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision, err := namespaceBiz.TransferNamespaceOwnership(ctx, &bo.TransferNamespaceOwnershipBo{NamespaceUID: namespace.UID, NewOwner: tt.newOwner, PreviousOwner: alice, Operator: alice})
+			if !tt.check(err) {
+				t.Fatalf("unexpected error %v", err)
+			}
+			if err == nil && (revision.Action != vobj.NamespaceRevisionActionTransferOwnership || revision.Creator != alice || !slices.Equal(revision.Owners, tt.owners)) {
+				t.Fatalf("want the transfer recorded by alice with owners %v, got %+v", tt.owners, revision)
+			}
+			got, err := namespaceBiz.GetNamespace(ctx, namespace.UID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got.Owners, tt.owners) {
+				t.Fatalf("want owners %v, got %v", tt.owners, got.Owners)
+			}
+		})
+	}
+}
@@ -0,0 +1,314 @@
+package biz
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aide-family/magicbox/strutil"
+	"github.com/bwmarrin/snowflake"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	// oauth2ProviderReloadInterval is how long the providers of the API are kept before they are read again,
+	// the providers written through this instance are read again at once
+	oauth2ProviderReloadInterval = 30 * time.Second
+	// oauth2ClientSecretLabel derives the key of the client secrets from the secret key, so that the key is not
+	// the one signing the tokens when the jwt secret is used
+	oauth2ClientSecretLabel = "sovereign.oauth2.client-secret"
+)
+
+// NewOAuth2Provider creates the OAuth2 provider biz, the client secrets of the API are encrypted with the secret key
+// of the oauth2 config, the jwt secret is used when no secret key is configured.
+func NewOAuth2Provider(providerRepo repository.OAuth2Provider, bc *conf.Bootstrap, pageTokens *bo.PageTokenCodec, helper *klog.Helper) (*OAuth2Provider, error) {
+	secretKey := bc.GetOauth2().GetSecretKey()
+	if strutil.IsEmpty(secretKey) {
+		secretKey = bc.GetJwt().GetSecret()
+	}
+	p := &OAuth2Provider{
+		helper:          klog.NewHelper(klog.With(helper.Logger(), "biz", "oauth2_provider")),
+		providerRepo:    providerRepo,
+		pageTokens:      pageTokens,
+		configProviders: bc.GetOauth2().GetConfigs(),
+	}
+	if strutil.IsNotEmpty(secretKey) {
+		secretCipher, err := bo.NewSecretCipher(secretKey, oauth2ClientSecretLabel)
+		if err != nil {
+			return nil, err
+		}
+		p.secretCipher = secretCipher
+	}
+	return p, nil
+}
+
+type OAuth2Provider struct {
+	helper       *klog.Helper
+	providerRepo repository.OAuth2Provider
+	pageTokens   *bo.PageTokenCodec
+	// secretCipher is nil when neither the secret key nor the jwt secret is configured, no client secret can be stored then
+	secretCipher *bo.SecretCipher
+
+	configProviders []*config.OAuth2_Config
+
+	mu               sync.RWMutex
+	enabledProviders []*config.OAuth2_Config
+	apiLoadedAt      time.Time
+}
+
+func (p *OAuth2Provider) configProvider(name string) *config.OAuth2_Config {
+	for _, item := range p.configProviders {
+		if strings.EqualFold(auth.ProviderName(item), name) {
+			return item
+		}
+	}
+	return nil
+}
+
+// validate checks the provider config as the oauth2 handler does on startup, and reports the errors as params errors.
+func (p *OAuth2Provider) validate(providerConfig *config.OAuth2_Config) error {
+	if err := auth.ValidateProviderConfig(providerConfig); err != nil {
+		return merr.ErrorParams("%s", kerrors.FromError(err).GetMessage())
+	}
+	app := providerConfig.GetApp()
+	if _, ok := auth.GetOAuth2ProviderFun(app); ok {
+		return nil
+	}
+	if _, ok := auth.GetOAuth2LoginFun(app); ok {
+		return nil
+	}
+	return merr.ErrorParams("oauth2 app %s is not supported", app)
+}
+
+// encryptClientSecret moves the plaintext client secret of the config out of it, encrypted.
+func (p *OAuth2Provider) encryptClientSecret(providerConfig *config.OAuth2_Config) (string, error) {
+	clientSecret := providerConfig.GetClientSecret()
+	providerConfig.ClientSecret = ""
+	if strutil.IsEmpty(clientSecret) {
+		return "", nil
+	}
+	if p.secretCipher == nil {
+		return "", merr.ErrorParams("client secret can not be stored, set oauth2.secretKey or jwt.secret")
+	}
+	encrypted, err := p.secretCipher.Encrypt(clientSecret)
+	if err != nil {
+		p.helper.Errorw("msg", "encrypt oauth2 client secret failed", "error", err)
+		return "", merr.ErrorInternal("encrypt client secret failed").WithCause(err)
+	}
+	return encrypted, nil
+}
+
+// CreateOAuth2Provider stores a provider, its name defaults to the app and is unique ignoring case
+// among the providers of the config and of the API.
+func (p *OAuth2Provider) CreateOAuth2Provider(ctx context.Context, req *bo.SaveOAuth2ProviderBo) (*bo.OAuth2ProviderBo, error) {
+	providerConfig := proto.Clone(req.Config).(*config.OAuth2_Config)
+	name := auth.ProviderName(providerConfig)
+	providerConfig.Name = name
+	if err := p.validate(providerConfig); err != nil {
+		return nil, err
+	}
+	if p.configProvider(name) != nil {
+		return nil, merr.ErrorParams("oauth2 provider %s is defined in the config", name)
+	}
+	providers, err := p.providerRepo.ListOAuth2Providers(ctx, &bo.ListOAuth2ProvidersBo{PageRequestBo: bo.NewPageRequestBo(0, 0)})
+	if err != nil {
+		p.helper.Errorw("msg", "list oauth2 providers failed", "error", err)
+		return nil, merr.ErrorInternal("create oauth2 provider failed").WithCause(err)
+	}
+	for _, provider := range providers.GetItems() {
+		if strings.EqualFold(provider.Name, name) {
+			return nil, merr.ErrorParams("oauth2 provider %s already exists", name)
+		}
+	}
+	clientSecret, err := p.encryptClientSecret(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := p.providerRepo.CreateOAuth2Provider(ctx, &bo.OAuth2ProviderBo{
+		Name:         name,
+		Config:       providerConfig,
+		ClientSecret: clientSecret,
+	})
+	if err != nil {
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "create oauth2 provider failed", "error", err, "name", name)
+		return nil, merr.ErrorInternal("create oauth2 provider failed").WithCause(err)
+	}
+	p.reload()
+	return provider, nil
+}
+
+// UpdateOAuth2Provider replaces the config of a provider, the name can not be changed and an empty client secret
+// keeps the stored one.
+func (p *OAuth2Provider) UpdateOAuth2Provider(ctx context.Context, req *bo.SaveOAuth2ProviderBo) (*bo.OAuth2ProviderBo, error) {
+	provider, err := p.providerRepo.GetOAuth2Provider(ctx, req.UID)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "get oauth2 provider failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update oauth2 provider failed").WithCause(err)
+	}
+	providerConfig := proto.Clone(req.Config).(*config.OAuth2_Config)
+	if name := providerConfig.GetName(); strutil.IsNotEmpty(name) && !strings.EqualFold(name, provider.Name) {
+		return nil, merr.ErrorParams("oauth2 provider name %s can not be changed", provider.Name)
+	}
+	providerConfig.Name = provider.Name
+	if err := p.validate(providerConfig); err != nil {
+		return nil, err
+	}
+	clientSecret, err := p.encryptClientSecret(providerConfig)
+	if err != nil {
+		return nil, err
+	}
+	provider, err = p.providerRepo.UpdateOAuth2Provider(ctx, &bo.OAuth2ProviderBo{
+		UID:          req.UID,
+		Name:         provider.Name,
+		Config:       providerConfig,
+		ClientSecret: clientSecret,
+	})
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "update oauth2 provider failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update oauth2 provider failed").WithCause(err)
+	}
+	p.reload()
+	return provider, nil
+}
+
+func (p *OAuth2Provider) UpdateOAuth2ProviderStatus(ctx context.Context, req *bo.UpdateOAuth2ProviderStatusBo) (*bo.OAuth2ProviderBo, error) {
+	provider, err := p.providerRepo.UpdateOAuth2ProviderStatus(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "update oauth2 provider status failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update oauth2 provider status failed").WithCause(err)
+	}
+	p.reload()
+	return provider, nil
+}
+
+func (p *OAuth2Provider) DeleteOAuth2Provider(ctx context.Context, uid snowflake.ID) error {
+	if err := p.providerRepo.DeleteOAuth2Provider(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return err
+		}
+		p.helper.Errorw("msg", "delete oauth2 provider failed", "error", err, "uid", uid)
+		return merr.ErrorInternal("delete oauth2 provider failed").WithCause(err)
+	}
+	p.reload()
+	return nil
+}
+
+// ListOAuth2Providers lists the providers of the config before the providers of the API.
+// ListOAuth2Providers pages the providers of the API, the providers of the config are listed ahead of them on the first page
+// and counted in the total.
+func (p *OAuth2Provider) ListOAuth2Providers(ctx context.Context, req *bo.ListOAuth2ProvidersBo) (*bo.PageResponseBo[*bo.OAuth2ProviderBo], error) {
+	filters := req.PageFilters()
+	if err := p.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	apiProviders, err := p.providerRepo.ListOAuth2Providers(ctx, req)
+	if err != nil {
+		p.helper.Errorw("msg", "list oauth2 providers failed", "error", err)
+		return nil, merr.ErrorInternal("list oauth2 providers failed").WithCause(err)
+	}
+	p.pageTokens.Issue(apiProviders.PageRequestBo, filters)
+	providers := make([]*bo.OAuth2ProviderBo, 0, len(p.configProviders)+len(apiProviders.GetItems()))
+	if req.Cursor == nil && req.Page <= 1 {
+		for _, item := range p.configProviders {
+			providers = append(providers, &bo.OAuth2ProviderBo{
+				Name:   auth.ProviderName(item),
+				Config: item,
+				Status: vobj.GlobalStatusEnabled,
+				Source: bo.OAuth2ProviderSourceConfig,
+			})
+		}
+	}
+	req.WithTotal(apiProviders.GetTotal() + int64(len(p.configProviders)))
+	return bo.NewPageResponseBo(req.PageRequestBo, append(providers, apiProviders.GetItems()...)), nil
+}
+
+// reload makes the next login read the providers of the API again.
+func (p *OAuth2Provider) reload() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.apiLoadedAt = time.Time{}
+}
+
+// EnabledProviders returns the enabled providers of the API with their client secrets decrypted,
+// a provider whose client secret can not be decrypted is left out until it is updated.
+func (p *OAuth2Provider) EnabledProviders(ctx context.Context) ([]*config.OAuth2_Config, error) {
+	p.mu.RLock()
+	if !p.apiLoadedAt.IsZero() && time.Since(p.apiLoadedAt) < oauth2ProviderReloadInterval {
+		defer p.mu.RUnlock()
+		return p.enabledProviders, nil
+	}
+	p.mu.RUnlock()
+
+	providers, err := p.providerRepo.ListOAuth2Providers(ctx, &bo.ListOAuth2ProvidersBo{PageRequestBo: bo.NewPageRequestBo(0, 0)})
+	if err != nil {
+		p.helper.Errorw("msg", "load oauth2 providers failed", "error", err)
+		return nil, merr.ErrorInternal("load oauth2 providers failed").WithCause(err)
+	}
+	enabledProviders := make([]*config.OAuth2_Config, 0, len(providers.GetItems()))
+	for _, provider := range providers.GetItems() {
+		if provider.Status != vobj.GlobalStatusEnabled {
+			continue
+		}
+		providerConfig := provider.Config
+		providerConfig.Name = provider.Name
+		if strutil.IsNotEmpty(provider.ClientSecret) {
+			if p.secretCipher == nil {
+				p.helper.Warnw("msg", "oauth2 provider client secret can not be decrypted without a secret key", "name", provider.Name)
+				continue
+			}
+			clientSecret, err := p.secretCipher.Decrypt(provider.ClientSecret)
+			if err != nil {
+				p.helper.Warnw("msg", "oauth2 provider client secret can not be decrypted", "error", err, "name", provider.Name)
+				continue
+			}
+			providerConfig.ClientSecret = clientSecret
+		}
+		enabledProviders = append(enabledProviders, providerConfig)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.enabledProviders, p.apiLoadedAt = enabledProviders, time.Now()
+	return enabledProviders, nil
+}
+
+// ProviderConfig returns the provider of the name, the providers of the config before the enabled providers of the API,
+// nil when no provider has the name.
+func (p *OAuth2Provider) ProviderConfig(ctx context.Context, name string) (*config.OAuth2_Config, error) {
+	if providerConfig := p.configProvider(name); providerConfig != nil {
+		return providerConfig, nil
+	}
+	providers, err := p.EnabledProviders(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, item := range providers {
+		if strings.EqualFold(auth.ProviderName(item), name) {
+			return item, nil
+		}
+	}
+	return nil, nil
+}
@@ -0,0 +1,177 @@
+package biz_test
+
+import (
+	"context"
+	"encoding/json"
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	_ "github.com/aide-family/sovereign/pkg/api/auth/github"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func newGitHubProviderConfig(clientID, clientSecret string) *config.OAuth2_Config {
+	return &config.OAuth2_Config{
+		App:          config.OAuth2_GITHUB,
+		Name:         "corp-github",
+		ClientId:     clientID,
+		ClientSecret: clientSecret,
+		AuthUrl:      "https://github.example.com/login/oauth/authorize",
+		TokenUrl:     "https://github.example.com/login/oauth/access_token",
+		ApiUrl:       "https://github.example.com/api/v3",
+		CallbackUri:  "https://sovereign.example.com/oauth2/login/corp-github/callback",
+	}
+}
+
+func TestOAuth2ProviderDispatch(t *testing.T) {
+	helper := klog.NewHelper(klog.DefaultLogger)
+	repo := newAuthRepository(t)
+	bc := &conf.Bootstrap{Jwt: &config.JWT{Secret: "secret"}}
+	providerBiz, err := biz.NewOAuth2Provider(impl.NewOAuth2ProviderRepository(repo), bc, bo.NewPageTokenCodec("secret"), helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := http.NewServer()
+	handler := auth.NewOAuth2Handler(&config.OAuth2{Enable: "true"}, nil,
+		auth.BindStateSecret("state-secret"),
+		auth.BindProviderSource(providerBiz.EnabledProviders),
+	)
+	if err := handler.Handler(srv); err != nil {
+		t.Fatal(err)
+	}
+	// login returns the client id the browser is sent to the provider with, empty when the provider is not served
+	login := func(t *testing.T) string {
+		t.Helper()
+		recorder := httptest.NewRecorder()
+		srv.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, "/oauth2/login/corp-github", nil))
+		if recorder.Code != nethttp.StatusTemporaryRedirect {
+			var reply struct{ Reason string }
+			if err := json.Unmarshal(recorder.Body.Bytes(), &reply); err == nil && reply.Reason == merr.ClientError_NOT_FOUND.String() {
+				return ""
+			}
+			t.Fatalf("want a redirect to the provider, got %d: %s", recorder.Code, recorder.Body)
+		}
+		location, err := url.Parse(recorder.Header().Get("Location"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return location.Query().Get("client_id")
+	}
+	ctx := context.Background()
+
+	if clientID := login(t); clientID != "" {
+		t.Fatalf("want an unknown provider not served, got client id %q", clientID)
+	}
+	provider, err := providerBiz.CreateOAuth2Provider(ctx, &bo.SaveOAuth2ProviderBo{Config: newGitHubProviderConfig("client-1", "client-secret")})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name     string
+		change   func() error
+		clientID string
+	}{
+		{name: "created", change: func() error { return nil }, clientID: "client-1"},
+		{
+			name: "updated",
+			change: func() error {
+				_, err := providerBiz.UpdateOAuth2Provider(ctx, &bo.SaveOAuth2ProviderBo{UID: provider.UID, Config: newGitHubProviderConfig("client-2", "")})
+				return err
+			},
+			clientID: "client-2",
+		},
+		{
+			name: "disabled",
+			change: func() error {
+				_, err := providerBiz.UpdateOAuth2ProviderStatus(ctx, &bo.UpdateOAuth2ProviderStatusBo{UID: provider.UID, Status: vobj.GlobalStatusDisabled})
+				return err
+			},
+		},
+		{
+			name: "enabled",
+			change: func() error {
+				_, err := providerBiz.UpdateOAuth2ProviderStatus(ctx, &bo.UpdateOAuth2ProviderStatusBo{UID: provider.UID, Status: vobj.GlobalStatusEnabled})
+				return err
+			},
+			clientID: "client-2",
+		},
+		{name: "deleted", change: func() error { return providerBiz.DeleteOAuth2Provider(ctx, provider.UID) }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := tt.change(); err != nil {
+				t.Fatal(err)
+			}
+			if clientID := login(t); clientID != tt.clientID {
+				t.Fatalf("want client id %q, got %q", tt.clientID, clientID)
+			}
+		})
+	}
+}
+
+func TestOAuth2ProviderClientSecret(t *testing.T) {
+	helper := klog.NewHelper(klog.DefaultLogger)
+	repo := newAuthRepository(t)
+	providerRepo := impl.NewOAuth2ProviderRepository(repo)
+	newProviderBiz := func(secretKey string) *biz.OAuth2Provider {
+		t.Helper()
+		bc := &conf.Bootstrap{Jwt: &config.JWT{Secret: "secret"}, Oauth2: &config.OAuth2{SecretKey: secretKey}}
+		providerBiz, err := biz.NewOAuth2Provider(providerRepo, bc, bo.NewPageTokenCodec("secret"), helper)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return providerBiz
+	}
+	ctx := context.Background()
+	provider, err := newProviderBiz("").CreateOAuth2Provider(ctx, &bo.SaveOAuth2ProviderBo{Config: newGitHubProviderConfig("client-1", "client-secret")})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stored, err := providerRepo.GetOAuth2Provider(ctx, provider.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stored.ClientSecret == "" || stored.ClientSecret == "client-secret" || stored.Config.GetClientSecret() != "" {
+		t.Fatalf("want the client secret stored encrypted only, got %q and %q", stored.ClientSecret, stored.Config.GetClientSecret())
+	}
+
+	tests := []struct {
+		name         string
+		secretKey    string
+		clientSecret string
+	}{
+		{name: "jwt secret", clientSecret: "client-secret"},
+		// a provider whose client secret can not be decrypted is left out until it is updated
+		{name: "secret key changed", secretKey: "rotated"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			providers, err := newProviderBiz(tt.secretKey).EnabledProviders(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.clientSecret == "" {
+				if len(providers) != 0 {
+					t.Fatalf("want the provider left out, got %v", providers)
+				}
+				return
+			}
+			if len(providers) != 1 || providers[0].GetClientSecret() != tt.clientSecret {
+				t.Fatalf("want the client secret decrypted, got %v", providers)
+			}
+		})
+	}
+}
@@ -0,0 +1,31 @@
+package biz
+
+import (
+	"crypto/rand"
+	"encoding/base64"
+
+	"github.com/aide-family/magicbox/strutil"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/conf"
+)
+
+// NewPageTokenCodec creates the codec signing list page tokens, the jwt secret is used when no dedicated secret is configured.
+// Without either, as with asymmetric jwt keys, the tokens are signed with a random key of this process, so that they can
+// not be forged but do not survive a restart nor move between replicas.
+func NewPageTokenCodec(c *conf.Bootstrap, helper *klog.Helper) (*bo.PageTokenCodec, error) {
+	secret := c.GetPageTokenSecret()
+	if strutil.IsEmpty(secret) {
+		secret = c.GetJwt().GetSecret()
+	}
+	if strutil.IsEmpty(secret) {
+		key := make([]byte, 32)
+		if _, err := rand.Read(key); err != nil {
+			return nil, err
+		}
+		secret = base64.RawStdEncoding.EncodeToString(key)
+		helper.Warnw("msg", "no page token secret nor jwt secret is configured, page tokens are signed with a random key of this process")
+	}
+	return bo.NewPageTokenCodec(secret), nil
+}
@@ -0,0 +1,48 @@
+package biz_test
+
+import (
+	"testing"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/pkg/config"
+)
+
+func TestNewPageTokenCodec(t *testing.T) {
+	filters := map[string]string{"keyword": "dev"}
+	token := bo.NewPageTokenCodec("jwt-secret").Encode(&bo.PageCursorBo{Value: "dev-a", UID: 42}, filters)
+
+	tests := []struct {
+		name   string
+		c      *conf.Bootstrap
+		accept bool
+	}{
+		{name: "page token secret", c: &conf.Bootstrap{PageTokenSecret: "jwt-secret", Jwt: &config.JWT{Secret: "other"}}, accept: true},
+		{name: "jwt secret", c: &conf.Bootstrap{Jwt: &config.JWT{Secret: "jwt-secret"}}, accept: true},
+		{name: "no secret", c: &conf.Bootstrap{}, accept: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			codec, err := biz.NewPageTokenCodec(tt.c, klog.NewHelper(klog.DefaultLogger))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if _, err := codec.Decode(token, filters); (err == nil) != tt.accept {
+				t.Fatalf("want accepted %v, got %v", tt.accept, err)
+			}
+		})
+	}
+
+	// without a secret the tokens are signed with a random key, never with the empty one
+	codec, err := biz.NewPageTokenCodec(&conf.Bootstrap{}, klog.NewHelper(klog.DefaultLogger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	forged := bo.NewPageTokenCodec("").Encode(&bo.PageCursorBo{Value: "dev-a", UID: 42}, filters)
+	if _, err := codec.Decode(forged, filters); err == nil {
+		t.Fatal("want a token signed with the empty secret rejected")
+	}
+}
@@ -0,0 +1,532 @@
+package biz
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/google/cel-go/cel"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/conf"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/enum"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	// policyCostLimit bounds the work of one evaluation, so that a policy can not stall the requests it matches
+	policyCostLimit = 100000
+	// policyReloadInterval is how long the policies of the API are kept before they are read again,
+	// the policies written through this instance are read again at once
+	policyReloadInterval = 30 * time.Second
+	// namespaceOperationPrefix prefixes the operations whose request uid is the namespace the policies see
+	namespaceOperationPrefix = "/sovereign.api.v1.Namespace/"
+)
+
+// NewPolicy creates the policy biz, the policies of the config are compiled at once and fail the startup when invalid.
+func NewPolicy(policyRepo repository.Policy, rbac *RBAC, namespace *Namespace, userRepo repository.User, bc *conf.Bootstrap, pageTokens *bo.PageTokenCodec, helper *klog.Helper) (*Policy, error) {
+	env, err := newPolicyEnv()
+	if err != nil {
+		return nil, err
+	}
+	p := &Policy{
+		helper:     klog.NewHelper(klog.With(helper.Logger(), "biz", "policy")),
+		policyRepo: policyRepo,
+		rbac:       rbac,
+		namespace:  namespace,
+		userRepo:   userRepo,
+		pageTokens: pageTokens,
+		env:        env,
+	}
+	for _, item := range bc.GetPolicies() {
+		policy := &bo.PolicyBo{
+			Name:        item.GetName(),
+			Description: item.GetDescription(),
+			Operations:  item.GetOperations(),
+			Expression:  item.GetExpression(),
+			Message:     item.GetMessage(),
+			Source:      bo.PolicySourceConfig,
+		}
+		compiled, err := p.compile(policy)
+		if err != nil {
+			return nil, err
+		}
+		p.configPolicies = append(p.configPolicies, compiled)
+	}
+	return p, nil
+}
+
+// newPolicyEnv declares the variables a policy sees:
+//   - principal: uid, username, groups (the names of the roles bound to the user), apiKey and mfa (the token is of an MFA login)
+//   - operation: the operation name, e.g. /sovereign.api.v1.Namespace/DeleteNamespace
+//   - ns: uid, name, status (ENABLED or DISABLED), metadata, creator and owners, empty when the request has no namespace,
+//     it is not named namespace which is a reserved word of CEL
+//   - request: the request message, with the field names of the proto file
+func newPolicyEnv() (*cel.Env, error) {
+	return cel.NewEnv(
+		cel.TypeDescs(
+			apiv1.File_api_v1_auth_proto,
+			apiv1.File_api_v1_health_proto,
+			apiv1.File_api_v1_namespace_proto,
+			apiv1.File_api_v1_oauth2_provider_proto,
+			apiv1.File_api_v1_invitation_proto,
+			apiv1.File_api_v1_policy_proto,
+			apiv1.File_api_v1_quota_proto,
+			apiv1.File_api_v1_rbac_proto,
+			apiv1.File_api_v1_user_proto,
+		),
+		cel.OptionalTypes(),
+		cel.Variable("principal", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("operation", cel.StringType),
+		cel.Variable("ns", cel.MapType(cel.StringType, cel.DynType)),
+		cel.Variable("request", cel.DynType),
+	)
+}
+
+type Policy struct {
+	helper     *klog.Helper
+	policyRepo repository.Policy
+	rbac       *RBAC
+	namespace  *Namespace
+	userRepo   repository.User
+	pageTokens *bo.PageTokenCodec
+	env        *cel.Env
+
+	configPolicies []*compiledPolicy
+
+	mu          sync.RWMutex
+	apiPolicies []*compiledPolicy
+	apiLoadedAt time.Time
+}
+
+type compiledPolicy struct {
+	policy  *bo.PolicyBo
+	program cel.Program
+	// err is set for a stored policy which no longer compiles, it denies the operations it matches
+	err error
+}
+
+func (c *compiledPolicy) eval(ctx context.Context, activation map[string]any) *bo.PolicyResultBo {
+	result := &bo.PolicyResultBo{
+		Name:    c.policy.Name,
+		Source:  c.policy.Source,
+		Matched: true,
+		Message: c.policy.Message,
+	}
+	if c.err != nil {
+		result.Error = c.err.Error()
+		return result
+	}
+	out, _, err := c.program.ContextEval(ctx, activation)
+	if err != nil {
+		result.Error = err.Error()
+		return result
+	}
+	allowed, ok := out.Value().(bool)
+	if !ok {
+		result.Error = fmt.Sprintf("expression returned %s instead of bool", out.Type().TypeName())
+		return result
+	}
+	result.Allowed = allowed
+	return result
+}
+
+func (p *Policy) compile(policy *bo.PolicyBo) (*compiledPolicy, error) {
+	ast, iss := p.env.Compile(policy.Expression)
+	if iss.Err() != nil {
+		return nil, merr.ErrorParams("policy %s expression is invalid: %s", policy.Name, iss.Err())
+	}
+	if !ast.OutputType().IsExactType(cel.BoolType) {
+		return nil, merr.ErrorParams("policy %s expression must return bool, not %s", policy.Name, ast.OutputType())
+	}
+	program, err := p.env.Program(ast, cel.CostLimit(policyCostLimit), cel.InterruptCheckFrequency(100))
+	if err != nil {
+		return nil, merr.ErrorParams("policy %s expression is invalid: %s", policy.Name, err)
+	}
+	return &compiledPolicy{policy: policy, program: program}, nil
+}
+
+func (p *Policy) isConfigPolicy(name string) bool {
+	for _, compiled := range p.configPolicies {
+		if compiled.policy.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (p *Policy) CreatePolicy(ctx context.Context, req *bo.SavePolicyBo) (*bo.PolicyBo, error) {
+	if p.isConfigPolicy(req.Name) {
+		return nil, merr.ErrorParams("policy %s is defined in the config", req.Name)
+	}
+	if _, err := p.compile(&bo.PolicyBo{Name: req.Name, Expression: req.Expression}); err != nil {
+		return nil, err
+	}
+	policy, err := p.policyRepo.CreatePolicy(ctx, req)
+	if err != nil {
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "create policy failed", "error", err, "name", req.Name)
+		return nil, merr.ErrorInternal("create policy failed").WithCause(err)
+	}
+	p.reload()
+	return policy, nil
+}
+
+// UpdatePolicy updates a policy of the API, a stored policy named like a policy of the config, which the config
+// overrides, can not be updated as it could not be created.
+func (p *Policy) UpdatePolicy(ctx context.Context, req *bo.SavePolicyBo) (*bo.PolicyBo, error) {
+	stored, err := p.policyRepo.GetPolicy(ctx, req.UID)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "get policy failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update policy failed").WithCause(err)
+	}
+	if p.isConfigPolicy(stored.Name) {
+		return nil, merr.ErrorParams("policy %s is defined in the config", stored.Name)
+	}
+	if _, err := p.compile(&bo.PolicyBo{Name: stored.Name, Expression: req.Expression}); err != nil {
+		return nil, err
+	}
+	policy, err := p.policyRepo.UpdatePolicy(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		p.helper.Errorw("msg", "update policy failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update policy failed").WithCause(err)
+	}
+	p.reload()
+	return policy, nil
+}
+
+func (p *Policy) DeletePolicy(ctx context.Context, uid snowflake.ID) error {
+	if err := p.policyRepo.DeletePolicy(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return err
+		}
+		p.helper.Errorw("msg", "delete policy failed", "error", err, "uid", uid)
+		return merr.ErrorInternal("delete policy failed").WithCause(err)
+	}
+	p.reload()
+	return nil
+}
+
+// ListPolicies lists the policies of the config before the policies of the API.
+// ListPolicies pages the policies of the API, the policies of the config are listed ahead of them on the first page
+// and counted in the total.
+func (p *Policy) ListPolicies(ctx context.Context, req *bo.ListPoliciesBo) (*bo.PageResponseBo[*bo.PolicyBo], error) {
+	filters := req.PageFilters()
+	if err := p.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	apiPolicies, err := p.policyRepo.ListPolicies(ctx, req)
+	if err != nil {
+		p.helper.Errorw("msg", "list policies failed", "error", err)
+		return nil, merr.ErrorInternal("list policies failed").WithCause(err)
+	}
+	p.pageTokens.Issue(apiPolicies.PageRequestBo, filters)
+	policies := make([]*bo.PolicyBo, 0, len(p.configPolicies)+len(apiPolicies.GetItems()))
+	if req.Cursor == nil && req.Page <= 1 {
+		for _, compiled := range p.configPolicies {
+			policies = append(policies, compiled.policy)
+		}
+	}
+	req.WithTotal(apiPolicies.GetTotal() + int64(len(p.configPolicies)))
+	return bo.NewPageResponseBo(req.PageRequestBo, append(policies, apiPolicies.GetItems()...)), nil
+}
+
+// reload makes the next request read the policies of the API again.
+func (p *Policy) reload() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.apiLoadedAt = time.Time{}
+}
+
+func (p *Policy) loadAPIPolicies(ctx context.Context) ([]*compiledPolicy, error) {
+	p.mu.RLock()
+	if !p.apiLoadedAt.IsZero() && time.Since(p.apiLoadedAt) < policyReloadInterval {
+		defer p.mu.RUnlock()
+		return p.apiPolicies, nil
+	}
+	p.mu.RUnlock()
+
+	policies, err := p.policyRepo.ListPolicies(ctx, &bo.ListPoliciesBo{PageRequestBo: bo.NewPageRequestBo(0, 0)})
+	if err != nil {
+		p.helper.Errorw("msg", "load policies failed", "error", err)
+		return nil, merr.ErrorInternal("load policies failed").WithCause(err)
+	}
+	compiledPolicies := make([]*compiledPolicy, 0, len(policies.GetItems()))
+	for _, policy := range policies.GetItems() {
+		compiled, err := p.compile(policy)
+		if err != nil {
+			p.helper.Warnw("msg", "policy does not compile, it denies the operations it matches", "error", err, "name", policy.Name)
+			compiled = &compiledPolicy{policy: policy, err: errors.New(kerrors.FromError(err).GetMessage())}
+		}
+		compiledPolicies = append(compiledPolicies, compiled)
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.apiPolicies, p.apiLoadedAt = compiledPolicies, time.Now()
+	return compiledPolicies, nil
+}
+
+// matchPolicies returns the policies which apply to the operation.
+func (p *Policy) matchPolicies(ctx context.Context, operation string) ([]*compiledPolicy, error) {
+	apiPolicies, err := p.loadAPIPolicies(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matched []*compiledPolicy
+	for _, policies := range [][]*compiledPolicy{p.configPolicies, apiPolicies} {
+		for _, compiled := range policies {
+			if authv1.MatchPermission(compiled.policy.Operations, operation) {
+				matched = append(matched, compiled)
+			}
+		}
+	}
+	return matched, nil
+}
+
+// policyInput is what the policies of one request are evaluated over.
+type policyInput struct {
+	operation string
+	namespace string
+	request   any
+	principal map[string]any
+}
+
+func (p *Policy) decide(ctx context.Context, input *policyInput) (*bo.PolicyDecisionBo, error) {
+	policies, err := p.matchPolicies(ctx, input.operation)
+	if err != nil {
+		return nil, err
+	}
+	decision := &bo.PolicyDecisionBo{Allowed: true, Roles: input.principal["groups"].([]string)}
+	if len(policies) == 0 {
+		return decision, nil
+	}
+	namespace, err := p.namespaceAttributes(ctx, input.operation, input.namespace, input.request)
+	if err != nil {
+		return nil, err
+	}
+	// requests which are not messages of the API, such as the OAuth2 callbacks, are seen as an empty map
+	var request any = map[string]any{}
+	if message, ok := input.request.(proto.Message); ok {
+		request = message
+	}
+	activation := map[string]any{
+		"principal": input.principal,
+		"operation": input.operation,
+		"ns":        namespace,
+		"request":   request,
+	}
+	for _, compiled := range policies {
+		result := compiled.eval(ctx, activation)
+		decision.Policies = append(decision.Policies, result)
+		if decision.Allowed && !result.Allowed {
+			decision.Allowed = false
+			decision.Reason = denyReason(result)
+		}
+	}
+	return decision, nil
+}
+
+func denyReason(result *bo.PolicyResultBo) string {
+	switch {
+	case result.Error != "":
+		return fmt.Sprintf("policy %s failed to evaluate: %s", result.Name, result.Error)
+	case result.Message != "":
+		return fmt.Sprintf("policy %s denied the request: %s", result.Name, result.Message)
+	default:
+		return fmt.Sprintf("policy %s denied the request", result.Name)
+	}
+}
+
+// namespaceAttributes returns the namespace the policies see, the namespace of the request uid for the namespace
+// operations, the namespace of the request header otherwise.
+func (p *Policy) namespaceAttributes(ctx context.Context, operation, name string, request any) (map[string]any, error) {
+	var (
+		namespace *bo.NamespaceItemBo
+		err       error
+	)
+	uidRequest, ok := request.(interface{ GetUid() int64 })
+	switch {
+	case strings.HasPrefix(operation, namespaceOperationPrefix) && ok && uidRequest.GetUid() > 0:
+		namespace, err = p.namespace.GetNamespace(ctx, snowflake.ParseInt64(uidRequest.GetUid()))
+	case name != "":
+		namespace, err = p.namespace.GetNamespaceByName(ctx, name)
+	default:
+		return map[string]any{}, nil
+	}
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return map[string]any{}, nil
+		}
+		return nil, err
+	}
+	metadata := namespace.Metadata
+	if metadata == nil {
+		metadata = map[string]string{}
+	}
+	return map[string]any{
+		"uid":         namespace.UID.Int64(),
+		"name":        namespace.Name,
+		"displayName": namespace.DisplayName,
+		"status":      enum.GlobalStatus(namespace.Status).String(),
+		"metadata":    metadata,
+		"creator":     namespace.Creator.Int64(),
+		"owners":      bo.OwnersToInt64s(namespace.Owners),
+	}, nil
+}
+
+func (p *Policy) principal(ctx context.Context, baseInfo authv1.BaseInfo, apiKey bool, namespace string) (map[string]any, *bo.UserPermissionsBo, error) {
+	grants, err := p.rbac.GetUserGrants(ctx, baseInfo.UID, namespace, baseInfo.MFA())
+	if err != nil {
+		return nil, nil, err
+	}
+	roles := grants.Roles
+	if roles == nil {
+		roles = []string{}
+	}
+	return map[string]any{
+		"uid":      baseInfo.UID.Int64(),
+		"username": baseInfo.Username,
+		"groups":   roles,
+		"apiKey":   apiKey,
+		"mfa":      baseInfo.MFA(),
+	}, grants, nil
+}
+
+type policyDecisionsKey struct{}
+
+// policyDecisions caches the decisions of one request by operation and namespace.
+type policyDecisions struct {
+	mu    sync.Mutex
+	items map[string]*bo.PolicyDecisionBo
+}
+
+// Authorize evaluates the policies matching the operation for the signed-in user, the decision is cached
+// in the returned context, so that authorizing the same operation again in the request does not evaluate again.
+func (p *Policy) Authorize(ctx context.Context, operation, namespace string, request any) (context.Context, error) {
+	decisions, ok := ctx.Value(policyDecisionsKey{}).(*policyDecisions)
+	if !ok {
+		decisions = &policyDecisions{items: make(map[string]*bo.PolicyDecisionBo)}
+		ctx = context.WithValue(ctx, policyDecisionsKey{}, decisions)
+	}
+	decisions.mu.Lock()
+	defer decisions.mu.Unlock()
+	key := operation + "\x00" + namespace
+	decision, ok := decisions.items[key]
+	if !ok {
+		policies, err := p.matchPolicies(ctx, operation)
+		if err != nil {
+			return ctx, err
+		}
+		if len(policies) == 0 {
+			return ctx, nil
+		}
+		baseInfo, ok := authv1.GetBaseInfo(ctx)
+		if !ok {
+			return ctx, merr.ErrorUnauthorized("token is required")
+		}
+		_, apiKey := authv1.GetAPIKeyInfo(ctx)
+		principal, _, err := p.principal(ctx, baseInfo, apiKey, namespace)
+		if err != nil {
+			return ctx, err
+		}
+		decision, err = p.decide(ctx, &policyInput{operation: operation, namespace: namespace, request: request, principal: principal})
+		if err != nil {
+			return ctx, err
+		}
+		decisions.items[key] = decision
+	}
+	if !decision.Allowed {
+		return ctx, merr.ErrorForbidden("%s", decision.Reason)
+	}
+	return ctx, nil
+}
+
+// Explain evaluates the role permissions and the policies for a request without calling it.
+func (p *Policy) Explain(ctx context.Context, req *bo.ExplainPolicyBo) (*bo.PolicyDecisionBo, error) {
+	request, err := newOperationRequest(req.Operation, req.Request)
+	if err != nil {
+		return nil, err
+	}
+	baseInfo, ok := authv1.GetBaseInfo(ctx)
+	if !ok {
+		return nil, merr.ErrorUnauthorized("token is required")
+	}
+	if req.UserUID > 0 && req.UserUID != baseInfo.UID {
+		user, err := p.userRepo.GetUser(ctx, req.UserUID)
+		if err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorNotFound("user %s not found", req.UserUID)
+			}
+			p.helper.Errorw("msg", "get user failed", "error", err, "uid", req.UserUID)
+			return nil, merr.ErrorInternal("get user failed").WithCause(err)
+		}
+		baseInfo = authv1.BaseInfo{UID: user.UID, Username: user.Email}
+	}
+	principal, grants, err := p.principal(ctx, baseInfo, false, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	decision, err := p.decide(ctx, &policyInput{operation: req.Operation, namespace: req.Namespace, request: request, principal: principal})
+	if err != nil {
+		return nil, err
+	}
+	decision.PermissionGranted = authv1.MatchPermission(grants.Permissions, req.Operation)
+	if !decision.PermissionGranted {
+		decision.Allowed = false
+		decision.Reason = fmt.Sprintf("permission %s is required", req.Operation)
+	}
+	return decision, nil
+}
+
+// newOperationRequest returns the request message of the operation, filled with the JSON body.
+func newOperationRequest(operation, body string) (proto.Message, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(operation, "/"), "/")
+	if !ok {
+		return nil, merr.ErrorParams("operation %s is invalid", operation)
+	}
+	descriptor, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, merr.ErrorParams("operation %s is unknown", operation)
+	}
+	serviceDescriptor, ok := descriptor.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, merr.ErrorParams("operation %s is unknown", operation)
+	}
+	methodDescriptor := serviceDescriptor.Methods().ByName(protoreflect.Name(method))
+	if methodDescriptor == nil {
+		return nil, merr.ErrorParams("operation %s is unknown", operation)
+	}
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(methodDescriptor.Input().FullName())
+	if err != nil {
+		return nil, merr.ErrorParams("operation %s is unknown", operation)
+	}
+	request := messageType.New().Interface()
+	if body != "" {
+		if err := protojson.Unmarshal([]byte(body), request); err != nil {
+			return nil, merr.ErrorParams("request is not a valid %s: %s", methodDescriptor.Input().FullName(), err)
+		}
+	}
+	return request, nil
+}
@@ -0,0 +1,287 @@
+package biz_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// newPolicy returns the policy biz over a sqlite auth repository, with the policies of the config.
+func newPolicy(t *testing.T, repo authv1.Repository, policies ...*conf.Policy) *biz.Policy {
+	t.Helper()
+	helper := klog.NewHelper(klog.DefaultLogger)
+	pageTokens := bo.NewPageTokenCodec("secret")
+	rbac, err := biz.NewRBAC(impl.NewRBACRepository(repo), pageTokens, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy, err := biz.NewPolicy(impl.NewPolicyRepository(repo), rbac, nil, impl.NewUserRepository(repo), &conf.Bootstrap{Policies: policies}, pageTokens, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return policy
+}
+
+// signIn returns the context of a request of the user.
+func signIn(uid snowflake.ID, username string) context.Context {
+	return authv1.WithBaseInfo(context.Background(), authv1.BaseInfo{UID: uid, Username: username})
+}
+
+func TestPolicyCompile(t *testing.T) {
+	repo := newAuthRepository(t)
+	policy := newPolicy(t, repo, &conf.Policy{Name: "locked", Operations: []string{apiv1.OperationPolicyCreatePolicy}, Expression: "true"})
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		policyName string
+		expression string
+		valid      bool
+	}{
+		{name: "bool expression", policyName: "owner-only", expression: "principal.uid == 1", valid: true},
+		{name: "invalid expression", policyName: "invalid", expression: "principal.uid ==", valid: false},
+		{name: "unknown variable", policyName: "unknown", expression: "namespace.name == 'a'", valid: false},
+		{name: "non-bool output", policyName: "number", expression: "1 + 1", valid: false},
+		{name: "string output", policyName: "string", expression: "operation", valid: false},
+		{name: "name of a config policy", policyName: "locked", expression: "true", valid: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policy.CreatePolicy(ctx, &bo.SavePolicyBo{Name: tt.policyName, Operations: []string{"*"}, Expression: tt.expression})
+			if tt.valid {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !merr.IsParams(err) {
+				t.Fatalf("want a params error, got %v", err)
+			}
+		})
+	}
+
+	// the policies of the config are compiled at startup
+	_, err := biz.NewPolicy(impl.NewPolicyRepository(repo), nil, nil, nil, &conf.Bootstrap{Policies: []*conf.Policy{
+		{Name: "number", Operations: []string{"*"}, Expression: "1 + 1"},
+	}}, bo.NewPageTokenCodec("secret"), klog.NewHelper(klog.DefaultLogger))
+	if !merr.IsParams(err) {
+		t.Fatalf("want a config policy with a non-bool output rejected, got %v", err)
+	}
+}
+
+func TestPolicyUpdateConfigPolicy(t *testing.T) {
+	repo := newAuthRepository(t)
+	ctx := context.Background()
+	// stored before the config declared a policy of the same name
+	shadowed, err := repo.CreatePolicy(ctx, &authv1.CreatePolicyRequest{Name: "locked", Operations: []string{"*"}, Expression: "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	policy := newPolicy(t, repo, &conf.Policy{Name: "locked", Operations: []string{apiv1.OperationPolicyCreatePolicy}, Expression: "true"})
+	stored, err := policy.CreatePolicy(ctx, &bo.SavePolicyBo{Name: "stored", Operations: []string{"*"}, Expression: "true"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		uid        snowflake.ID
+		expression string
+		check      func(error) bool
+	}{
+		{name: "api policy", uid: stored.UID, expression: "false", check: func(err error) bool { return err == nil }},
+		{name: "invalid expression", uid: stored.UID, expression: "1 + 1", check: merr.IsParams},
+		{name: "named like a config policy", uid: snowflake.ParseInt64(shadowed.GetUid()), expression: "false", check: merr.IsParams},
+		{name: "missing policy", uid: stored.UID + 1, expression: "false", check: merr.IsNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policy.UpdatePolicy(ctx, &bo.SavePolicyBo{UID: tt.uid, Operations: []string{"*"}, Expression: tt.expression})
+			if !tt.check(err) {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
+
+func TestPolicyAuthorize(t *testing.T) {
+	repo := newAuthRepository(t)
+	alice := newUser(t, repo, "alice")
+	ctx := context.Background()
+	stored := []*authv1.CreatePolicyRequest{
+		{Name: "alice-only", Operations: []string{apiv1.OperationPolicyCreatePolicy}, Expression: "principal.username == 'alice@example.com'"},
+		{Name: "no-delete", Operations: []string{apiv1.OperationPolicyDeletePolicy}, Expression: "false", Message: "policies are kept"},
+		// written to the store directly, it no longer compiles
+		{Name: "broken", Operations: []string{apiv1.OperationPolicyUpdatePolicy}, Expression: "principal.uid =="},
+		{Name: "non-bool", Operations: []string{apiv1.OperationPolicyExplainPolicy}, Expression: "principal.uid"},
+	}
+	for _, req := range stored {
+		if _, err := repo.CreatePolicy(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	policy := newPolicy(t, repo)
+
+	tests := []struct {
+		name      string
+		user      snowflake.ID
+		username  string
+		operation string
+		allowed   bool
+		reason    string
+	}{
+		{name: "no policy matches", user: alice, username: "bob@example.com", operation: apiv1.OperationPolicyListPolicies, allowed: true},
+		{name: "policy allows", user: alice, username: "alice@example.com", operation: apiv1.OperationPolicyCreatePolicy, allowed: true},
+		{name: "policy denies", user: alice, username: "bob@example.com", operation: apiv1.OperationPolicyCreatePolicy, reason: "policy alice-only denied the request"},
+		{name: "policy message", user: alice, username: "alice@example.com", operation: apiv1.OperationPolicyDeletePolicy, reason: "policies are kept"},
+		{name: "broken policy denies", user: alice, username: "alice@example.com", operation: apiv1.OperationPolicyUpdatePolicy, reason: "policy broken failed to evaluate"},
+		{name: "non-bool policy denies", user: alice, username: "alice@example.com", operation: apiv1.OperationPolicyExplainPolicy, reason: "policy non-bool failed to evaluate"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policy.Authorize(signIn(tt.user, tt.username), tt.operation, "", nil)
+			if tt.allowed {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if !merr.IsForbidden(err) || !strings.Contains(err.Error(), tt.reason) {
+				t.Fatalf("want forbidden with %q, got %v", tt.reason, err)
+			}
+		})
+	}
+
+	if _, err := policy.Authorize(ctx, apiv1.OperationPolicyCreatePolicy, "", nil); !merr.IsUnauthorized(err) {
+		t.Fatalf("want unauthorized without a user, got %v", err)
+	}
+}
+
+func TestPolicyAuthorizeCachesDecisions(t *testing.T) {
+	repo := newAuthRepository(t)
+	alice := newUser(t, repo, "alice")
+	policy := newPolicy(t, repo)
+	noDelete, err := policy.CreatePolicy(context.Background(), &bo.SavePolicyBo{Name: "no-delete", Operations: []string{apiv1.OperationPolicyDeletePolicy}, Expression: "false"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ctx, err := policy.Authorize(signIn(alice, "alice@example.com"), apiv1.OperationPolicyDeletePolicy, "", nil)
+	if !merr.IsForbidden(err) {
+		t.Fatalf("want forbidden, got %v", err)
+	}
+	if err := policy.DeletePolicy(ctx, noDelete.UID); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		ctx       context.Context
+		namespace string
+		allowed   bool
+	}{
+		{name: "same request", ctx: ctx, allowed: false},
+		{name: "same request other namespace", ctx: ctx, namespace: "team-a", allowed: true},
+		{name: "new request", ctx: signIn(alice, "alice@example.com"), allowed: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := policy.Authorize(tt.ctx, apiv1.OperationPolicyDeletePolicy, tt.namespace, nil)
+			if tt.allowed != (err == nil) {
+				t.Fatalf("want allowed %v, got %v", tt.allowed, err)
+			}
+		})
+	}
+}
+
+func TestPolicyExplain(t *testing.T) {
+	repo := newAuthRepository(t)
+	alice := newUser(t, repo, "alice")
+	bob := newUser(t, repo, "bob")
+	policy := newPolicy(t, repo, &conf.Policy{
+		Name:       "reserved-names",
+		Operations: []string{apiv1.OperationPolicyCreatePolicy},
+		Expression: "!request.name.startsWith('system-')",
+		Message:    "system policies are reserved",
+	})
+	rbac, err := biz.NewRBAC(impl.NewRBACRepository(repo), bo.NewPageTokenCodec("secret"), klog.NewHelper(klog.DefaultLogger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := signIn(alice, "alice@example.com")
+	editor, err := rbac.CreateRole(ctx, &bo.SaveRoleBo{Name: "policy-editor", Permissions: []string{apiv1.OperationPolicyCreatePolicy}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rbac.CreateRoleBinding(ctx, &bo.CreateRoleBindingBo{UserUID: alice, RoleUID: editor.UID}); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name       string
+		req        *bo.ExplainPolicyBo
+		allowed    bool
+		permission bool
+		reason     string
+	}{
+		{
+			name:       "allowed",
+			req:        &bo.ExplainPolicyBo{Operation: apiv1.OperationPolicyCreatePolicy, Request: `{"name":"team-a"}`},
+			allowed:    true,
+			permission: true,
+		},
+		{
+			name:       "denied by the policy",
+			req:        &bo.ExplainPolicyBo{Operation: apiv1.OperationPolicyCreatePolicy, Request: `{"name":"system-a"}`},
+			permission: true,
+			reason:     "policy reserved-names denied the request: system policies are reserved",
+		},
+		{
+			name:   "other user without the permission",
+			req:    &bo.ExplainPolicyBo{Operation: apiv1.OperationPolicyCreatePolicy, Request: `{"name":"team-a"}`, UserUID: bob},
+			reason: "permission " + apiv1.OperationPolicyCreatePolicy + " is required",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			decision, err := policy.Explain(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if decision.Allowed != tt.allowed || decision.PermissionGranted != tt.permission || decision.Reason != tt.reason {
+				t.Fatalf("want allowed %v, permission %v and reason %q, got %+v", tt.allowed, tt.permission, tt.reason, decision)
+			}
+			if len(decision.Policies) != 1 || decision.Policies[0].Name != "reserved-names" || decision.Policies[0].Source != bo.PolicySourceConfig {
+				t.Fatalf("want the reserved-names policy evaluated, got %+v", decision.Policies)
+			}
+		})
+	}
+
+	errTests := []struct {
+		name  string
+		req   *bo.ExplainPolicyBo
+		check func(error) bool
+	}{
+		{name: "unknown operation", req: &bo.ExplainPolicyBo{Operation: "/sovereign.api.v1.Policy/Unknown"}, check: merr.IsParams},
+		{name: "invalid request", req: &bo.ExplainPolicyBo{Operation: apiv1.OperationPolicyCreatePolicy, Request: `{"name":1}`}, check: merr.IsParams},
+		{name: "unknown user", req: &bo.ExplainPolicyBo{Operation: apiv1.OperationPolicyCreatePolicy, UserUID: bob + alice}, check: merr.IsNotFound},
+	}
+	for _, tt := range errTests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := policy.Explain(ctx, tt.req); !tt.check(err) {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewQuota(
+	quotaRepo repository.Quota,
+	namespaceRepo repository.Namespace,
+	helper *klog.Helper,
+) *Quota {
+	return &Quota{
+		quotaRepo:     quotaRepo,
+		namespaceRepo: namespaceRepo,
+		helper:        klog.NewHelper(klog.With(helper.Logger(), "biz", "quota")),
+	}
+}
+
+type Quota struct {
+	helper        *klog.Helper
+	quotaRepo     repository.Quota
+	namespaceRepo repository.Namespace
+}
+
+// namespaceUID resolves the namespace of the request, the quotas are kept by its uid.
+func (q *Quota) namespaceUID(ctx context.Context, namespace string) (snowflake.ID, error) {
+	namespaceItemBo, err := q.namespaceRepo.GetNamespaceByName(ctx, namespace)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return 0, merr.ErrorNotFound("namespace %s not found", namespace)
+		}
+		q.helper.Errorw("msg", "get namespace failed", "error", err, "namespace", namespace)
+		return 0, merr.ErrorInternal("get namespace %s failed", namespace).WithCause(err)
+	}
+	return namespaceItemBo.UID, nil
+}
+
+func (q *Quota) SetQuota(ctx context.Context, req *bo.SetQuotaBo) (*bo.QuotaItemBo, error) {
+	namespaceUID, err := q.namespaceUID(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	req.NamespaceUID = namespaceUID
+	quotaItemBo, err := q.quotaRepo.SetQuota(ctx, req)
+	if err != nil {
+		q.helper.Errorw("msg", "set quota failed", "error", err, "namespace", req.Namespace, "resource", req.Resource)
+		return nil, merr.ErrorInternal("set quota %s failed", req.Resource).WithCause(err)
+	}
+	return quotaItemBo, nil
+}
+
+func (q *Quota) GetQuota(ctx context.Context, namespace, resource string) (*bo.QuotaItemBo, error) {
+	namespaceUID, err := q.namespaceUID(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	quotaItemBo, err := q.quotaRepo.GetQuota(ctx, namespaceUID, resource)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("quota %s not found", resource)
+		}
+		q.helper.Errorw("msg", "get quota failed", "error", err, "namespace", namespace, "resource", resource)
+		return nil, merr.ErrorInternal("get quota %s failed", resource).WithCause(err)
+	}
+	return quotaItemBo, nil
+}
+
+func (q *Quota) DeleteQuota(ctx context.Context, namespace, resource string) error {
+	namespaceUID, err := q.namespaceUID(ctx, namespace)
+	if err != nil {
+		return err
+	}
+	if err := q.quotaRepo.DeleteQuota(ctx, namespaceUID, resource); err != nil {
+		q.helper.Errorw("msg", "delete quota failed", "error", err, "namespace", namespace, "resource", resource)
+		return merr.ErrorInternal("delete quota %s failed", resource).WithCause(err)
+	}
+	return nil
+}
+
+func (q *Quota) ListQuota(ctx context.Context, namespace string) ([]*bo.QuotaItemBo, error) {
+	namespaceUID, err := q.namespaceUID(ctx, namespace)
+	if err != nil {
+		return nil, err
+	}
+	items, err := q.quotaRepo.ListQuota(ctx, namespaceUID)
+	if err != nil {
+		q.helper.Errorw("msg", "list quota failed", "error", err, "namespace", namespace)
+		return nil, merr.ErrorInternal("list quota failed").WithCause(err)
+	}
+	return items, nil
+}
+
+// Reserve 占用配额，超出上限时返回 merr.ErrorTooManyRequests
+func (q *Quota) Reserve(ctx context.Context, req *bo.QuotaUsageBo) (*bo.QuotaItemBo, error) {
+	namespaceUID, err := q.namespaceUID(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	req.NamespaceUID = namespaceUID
+	quotaItemBo, err := q.quotaRepo.Reserve(ctx, req)
+	if err != nil {
+		switch {
+		case merr.IsTooManyRequests(err):
+			return nil, err
+		case merr.IsNotFound(err):
+			return nil, merr.ErrorNotFound("quota %s not found", req.Resource)
+		}
+		q.helper.Errorw("msg", "reserve quota failed", "error", err, "namespace", req.Namespace, "resource", req.Resource, "amount", req.Amount)
+		return nil, merr.ErrorInternal("reserve quota %s failed", req.Resource).WithCause(err)
+	}
+	return quotaItemBo, nil
+}
+
+// Release 释放配额，已用量最低为 0
+func (q *Quota) Release(ctx context.Context, req *bo.QuotaUsageBo) (*bo.QuotaItemBo, error) {
+	namespaceUID, err := q.namespaceUID(ctx, req.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	req.NamespaceUID = namespaceUID
+	quotaItemBo, err := q.quotaRepo.Release(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("quota %s not found", req.Resource)
+		}
+		q.helper.Errorw("msg", "release quota failed", "error", err, "namespace", req.Namespace, "resource", req.Resource, "amount", req.Amount)
+		return nil, merr.ErrorInternal("release quota %s failed", req.Resource).WithCause(err)
+	}
+	return quotaItemBo, nil
+}
@@ -0,0 +1,106 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// newFileDomainConfig returns the file storage of a domain in a temporary directory.
+func newFileDomainConfig(t *testing.T, filename string) *config.DomainConfig {
+	t.Helper()
+	options, err := anypb.New(&config.FileConfig{Path: t.TempDir(), Filename: filename, StorageInterval: durationpb.New(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &config.DomainConfig{Driver: config.DomainConfig_FILE, Options: options}
+}
+
+func TestQuotaFollowsNamespace(t *testing.T) {
+	helper := klog.NewHelper(klog.DefaultLogger)
+	c := &conf.Bootstrap{
+		NamespaceConfig: newFileDomainConfig(t, "namespaces.yaml"),
+		QuotaConfig:     newFileDomainConfig(t, "quotas.yaml"),
+	}
+	d, cleanup, err := data.New(c, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	namespaceRepo, err := impl.NewNamespaceRepository(c, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	quotaRepo, err := impl.NewQuotaRepository(c, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	namespaceBiz := biz.NewNamespace(namespaceRepo, nil, quotaRepo, bo.NewPageTokenCodec("secret"), helper)
+	quotaBiz := biz.NewQuota(quotaRepo, namespaceRepo, helper)
+	ctx := context.Background()
+
+	createNamespace := func(name string) *bo.NamespaceItemBo {
+		t.Helper()
+		if err := namespaceBiz.CreateNamespace(ctx, &bo.CreateNamespaceBo{Name: name, Status: vobj.GlobalStatusEnabled}); err != nil {
+			t.Fatal(err)
+		}
+		namespace, err := namespaceBiz.GetNamespaceByName(ctx, name)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return namespace
+	}
+	teamA := createNamespace("team-a")
+	if _, err := quotaBiz.SetQuota(ctx, &bo.SetQuotaBo{Namespace: "team-a", Resource: "configs", Limit: 3}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := quotaBiz.Reserve(ctx, &bo.QuotaUsageBo{Namespace: "team-a", Resource: "configs", Amount: 2}); err != nil {
+		t.Fatal(err)
+	}
+
+	// the quota and its usage follow the renamed namespace
+	if err := namespaceBiz.UpdateNamespace(ctx, &bo.UpdateNamespaceBo{UID: teamA.UID, Name: "team-b"}); err != nil {
+		t.Fatal(err)
+	}
+	quota, err := quotaBiz.GetQuota(ctx, "team-b", "configs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.Limit != 3 || quota.Used != 2 {
+		t.Fatalf("want limit 3 and used 2 after the rename, got limit %d and used %d", quota.Limit, quota.Used)
+	}
+
+	// a new namespace under the old name does not inherit the quota
+	createNamespace("team-a")
+	if _, err := quotaBiz.GetQuota(ctx, "team-a", "configs"); !merr.IsNotFound(err) {
+		t.Fatalf("want no quota for a new namespace of the old name, got %v", err)
+	}
+	if _, err := quotaBiz.GetQuota(ctx, "team-c", "configs"); !merr.IsNotFound(err) {
+		t.Fatalf("want the quota of a missing namespace not found, got %v", err)
+	}
+
+	// the quotas go with the deleted namespace
+	if err := namespaceBiz.DeleteNamespace(ctx, teamA.UID); err != nil {
+		t.Fatal(err)
+	}
+	quotas, err := quotaRepo.ListQuota(ctx, teamA.UID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(quotas) != 0 {
+		t.Fatalf("want the quotas of the deleted namespace removed, got %d", len(quotas))
+	}
+}
@@ -0,0 +1,217 @@
+package biz
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	// RoleAdmin may call every operation
+	RoleAdmin = "admin"
+	// RoleViewer may call the operations which only read
+	RoleViewer = "viewer"
+)
+
+// builtinRoles are created at startup, and set back to these permissions on every start.
+var builtinRoles = []*bo.RoleBo{
+	{
+		Name:        RoleAdmin,
+		Description: "may call every operation",
+		Permissions: []string{"*"},
+	},
+	{
+		Name:        RoleViewer,
+		Description: "may read namespaces, quotas and roles",
+		Permissions: []string{
+			apiv1.OperationNamespaceGetNamespace,
+			apiv1.OperationNamespaceListNamespace,
+			apiv1.OperationNamespaceSelectNamespace,
+			apiv1.OperationNamespaceGetNamespaceStats,
+			apiv1.OperationNamespaceListNamespaceRevisions,
+			apiv1.OperationNamespaceGetNamespaceRevision,
+			apiv1.OperationNamespaceDiffNamespaceRevisions,
+			apiv1.OperationQuotaGetQuota,
+			apiv1.OperationQuotaListQuota,
+			apiv1.OperationRBACGetRole,
+			apiv1.OperationRBACListRoles,
+			apiv1.OperationRBACListRoleBindings,
+		},
+	},
+}
+
+// NewRBAC creates the rbac biz, and creates or updates the builtin roles.
+func NewRBAC(rbacRepo repository.RBAC, pageTokens *bo.PageTokenCodec, helper *klog.Helper) (*RBAC, error) {
+	r := &RBAC{
+		rbacRepo:   rbacRepo,
+		pageTokens: pageTokens,
+		helper:     klog.NewHelper(klog.With(helper.Logger(), "biz", "rbac")),
+	}
+	roles, err := rbacRepo.EnsureBuiltinRoles(context.Background(), builtinRoles)
+	if err != nil {
+		r.helper.Errorw("msg", "ensure builtin roles failed", "error", err)
+		return nil, err
+	}
+	r.builtinRoles = make(map[string]*bo.RoleBo, len(roles))
+	for _, role := range roles {
+		r.builtinRoles[role.Name] = role
+	}
+	return r, nil
+}
+
+type RBAC struct {
+	helper       *klog.Helper
+	rbacRepo     repository.RBAC
+	pageTokens   *bo.PageTokenCodec
+	builtinRoles map[string]*bo.RoleBo
+}
+
+func (r *RBAC) CreateRole(ctx context.Context, req *bo.SaveRoleBo) (*bo.RoleBo, error) {
+	role, err := r.rbacRepo.CreateRole(ctx, req)
+	if err != nil {
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		r.helper.Errorw("msg", "create role failed", "error", err, "name", req.Name)
+		return nil, merr.ErrorInternal("create role failed").WithCause(err)
+	}
+	return role, nil
+}
+
+func (r *RBAC) UpdateRole(ctx context.Context, req *bo.SaveRoleBo) (*bo.RoleBo, error) {
+	role, err := r.rbacRepo.UpdateRole(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) || merr.IsForbidden(err) {
+			return nil, err
+		}
+		r.helper.Errorw("msg", "update role failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update role failed").WithCause(err)
+	}
+	return role, nil
+}
+
+func (r *RBAC) DeleteRole(ctx context.Context, uid snowflake.ID) error {
+	if err := r.rbacRepo.DeleteRole(ctx, uid); err != nil {
+		if merr.IsNotFound(err) || merr.IsForbidden(err) {
+			return err
+		}
+		r.helper.Errorw("msg", "delete role failed", "error", err, "uid", uid)
+		return merr.ErrorInternal("delete role failed").WithCause(err)
+	}
+	return nil
+}
+
+func (r *RBAC) GetRole(ctx context.Context, uid snowflake.ID) (*bo.RoleBo, error) {
+	role, err := r.rbacRepo.GetRole(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		r.helper.Errorw("msg", "get role failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternal("get role failed").WithCause(err)
+	}
+	return role, nil
+}
+
+func (r *RBAC) ListRoles(ctx context.Context, req *bo.ListRolesBo) (*bo.PageResponseBo[*bo.RoleBo], error) {
+	filters := req.PageFilters()
+	if err := r.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := r.rbacRepo.ListRoles(ctx, req)
+	if err != nil {
+		r.helper.Errorw("msg", "list roles failed", "error", err)
+		return nil, merr.ErrorInternal("list roles failed").WithCause(err)
+	}
+	r.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+func (r *RBAC) CreateRoleBinding(ctx context.Context, req *bo.CreateRoleBindingBo) (*bo.RoleBindingBo, error) {
+	binding, err := r.rbacRepo.CreateRoleBinding(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		r.helper.Errorw("msg", "create role binding failed", "error", err, "userUID", req.UserUID, "roleUID", req.RoleUID)
+		return nil, merr.ErrorInternal("create role binding failed").WithCause(err)
+	}
+	return binding, nil
+}
+
+func (r *RBAC) DeleteRoleBinding(ctx context.Context, uid snowflake.ID) error {
+	if err := r.rbacRepo.DeleteRoleBinding(ctx, uid); err != nil {
+		if merr.IsNotFound(err) {
+			return err
+		}
+		r.helper.Errorw("msg", "delete role binding failed", "error", err, "uid", uid)
+		return merr.ErrorInternal("delete role binding failed").WithCause(err)
+	}
+	return nil
+}
+
+func (r *RBAC) ListRoleBindings(ctx context.Context, req *bo.ListRoleBindingsBo) (*bo.PageResponseBo[*bo.RoleBindingBo], error) {
+	filters := req.PageFilters()
+	if err := r.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := r.rbacRepo.ListRoleBindings(ctx, req)
+	if err != nil {
+		r.helper.Errorw("msg", "list role bindings failed", "error", err)
+		return nil, merr.ErrorInternal("list role bindings failed").WithCause(err)
+	}
+	r.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+// SetRoleMFA sets whether the permissions of the role are only granted to the tokens of an MFA login.
+func (r *RBAC) SetRoleMFA(ctx context.Context, uid snowflake.ID, requireMFA bool) (*bo.RoleBo, error) {
+	role, err := r.rbacRepo.SetRoleMFA(ctx, uid, requireMFA)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, err
+		}
+		r.helper.Errorw("msg", "set role MFA failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternal("set role MFA failed").WithCause(err)
+	}
+	return role, nil
+}
+
+// GetUserPermissions is consulted by the permission middleware for every request which needs a permission,
+// the roles requiring MFA only count for the token of an MFA login.
+func (r *RBAC) GetUserPermissions(ctx context.Context, userUID snowflake.ID, namespace string) ([]string, error) {
+	baseInfo, _ := authv1.GetBaseInfo(ctx)
+	grants, err := r.GetUserGrants(ctx, userUID, namespace, baseInfo.MFA())
+	if err != nil {
+		return nil, err
+	}
+	return grants.Permissions, nil
+}
+
+// GetUserGrants returns the roles bound to the user globally and in the namespace, with their permissions,
+// the roles requiring MFA are skipped unless mfa is true.
+func (r *RBAC) GetUserGrants(ctx context.Context, userUID snowflake.ID, namespace string, mfa bool) (*bo.UserPermissionsBo, error) {
+	grants, err := r.rbacRepo.GetUserPermissions(ctx, userUID, namespace, mfa)
+	if err != nil {
+		r.helper.Errorw("msg", "get user permissions failed", "error", err, "userUID", userUID, "namespace", namespace)
+		return nil, merr.ErrorInternal("get user permissions failed").WithCause(err)
+	}
+	return grants, nil
+}
+
+// bindAdmin binds the builtin admin role to the user in every namespace.
+func (r *RBAC) bindAdmin(ctx context.Context, userUID snowflake.ID) error {
+	admin, ok := r.builtinRoles[RoleAdmin]
+	if !ok {
+		return merr.ErrorInternal("builtin role %s is not found", RoleAdmin)
+	}
+	_, err := r.rbacRepo.CreateRoleBinding(ctx, &bo.CreateRoleBindingBo{UserUID: userUID, RoleUID: admin.UID})
+	return err
+}
@@ -0,0 +1,126 @@
+package biz_test
+
+import (
+	"context"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/config"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+)
+
+// newAuthRepository returns the auth repository of a migrated sqlite database in a temporary directory.
+func newAuthRepository(t *testing.T) authv1.Repository {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "sovereign.db")
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{DisableForeignKeyConstraintWhenMigrating: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(model.Models()...); err != nil {
+		t.Fatal(err)
+	}
+	sqliteOptions, err := anypb.New(&config.SQLiteOptions{Dsn: dsn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err := anypb.New(&config.ORMConfig{Dialector: config.ORMConfig_SQLITE, Options: sqliteOptions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtConfig := &config.JWT{Secret: "secret", Expire: durationpb.New(time.Hour), Issuer: "sovereign-test"}
+	repo, closer, err := gormimpl.NewGormRepository(&config.DomainConfig{Driver: config.DomainConfig_GORM, Options: options}, jwtConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+	})
+	return repo
+}
+
+// newUser signs a user in with an OAuth2 identity and returns its uid.
+func newUser(t *testing.T, repo authv1.Repository, name string) snowflake.ID {
+	t.Helper()
+	login, err := repo.Login(context.Background(), &authv1.LoginRequest{User: &authv1.User{App: "sso", OpenID: name, Name: name, Email: name + "@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return snowflake.ParseInt64(login.GetUser().GetUid())
+}
+
+func TestRBACGetUserGrants(t *testing.T) {
+	repo := newAuthRepository(t)
+	ctx := context.Background()
+	rbac, err := biz.NewRBAC(impl.NewRBACRepository(repo), bo.NewPageTokenCodec("secret"), klog.NewHelper(klog.DefaultLogger))
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := newUser(t, repo, "alice")
+	deployer, err := rbac.CreateRole(ctx, &bo.SaveRoleBo{Name: "deployer", Permissions: []string{apiv1.OperationNamespaceDeleteNamespace}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	auditor, err := rbac.CreateRole(ctx, &bo.SaveRoleBo{Name: "auditor", Permissions: []string{apiv1.OperationPolicyListPolicies}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rbac.SetRoleMFA(ctx, auditor.UID, true); err != nil {
+		t.Fatal(err)
+	}
+	bindings := []*bo.CreateRoleBindingBo{
+		{UserUID: alice, RoleUID: deployer.UID, Namespace: "team-a"},
+		{UserUID: alice, RoleUID: auditor.UID},
+	}
+	for _, binding := range bindings {
+		if _, err := rbac.CreateRoleBinding(ctx, binding); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	tests := []struct {
+		name      string
+		namespace string
+		mfa       bool
+		roles     []string
+	}{
+		{name: "global without mfa", roles: []string{}},
+		{name: "bound namespace", namespace: "team-a", roles: []string{"deployer"}},
+		{name: "other namespace", namespace: "team-b", roles: []string{}},
+		{name: "global with mfa", mfa: true, roles: []string{"auditor"}},
+		{name: "bound namespace with mfa", namespace: "team-a", mfa: true, roles: []string{"auditor", "deployer"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			grants, err := rbac.GetUserGrants(ctx, alice, tt.namespace, tt.mfa)
+			if err != nil {
+				t.Fatal(err)
+			}
+			slices.Sort(grants.Roles)
+			if !slices.Equal(grants.Roles, tt.roles) {
+				t.Fatalf("want roles %v, got %v", tt.roles, grants.Roles)
+			}
+			canDelete := authv1.MatchPermission(grants.Permissions, apiv1.OperationNamespaceDeleteNamespace)
+			if want := slices.Contains(tt.roles, "deployer"); canDelete != want {
+				t.Fatalf("want delete namespace granted %v, got permissions %v", want, grants.Permissions)
+			}
+		})
+	}
+}
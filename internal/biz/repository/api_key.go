@@ -0,0 +1,17 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+type APIKey interface {
+	CreateAPIKey(ctx context.Context, req *bo.CreateAPIKeyBo) (*bo.CreatedAPIKeyBo, error)
+	ListAPIKeys(ctx context.Context, req *bo.ListAPIKeysBo) (*bo.PageResponseBo[*bo.APIKeyBo], error)
+	RevokeAPIKey(ctx context.Context, userUID, apiKeyUID snowflake.ID) error
+	// VerifyAPIKey returns the API key of key, and records its use.
+	VerifyAPIKey(ctx context.Context, key string) (*bo.APIKeyBo, error)
+}
@@ -0,0 +1,16 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+// Invitation stores the invitation codes of the invite-only providers, only the hash of a code is stored.
+type Invitation interface {
+	CreateInvitation(ctx context.Context, req *bo.CreateInvitationBo) (*bo.CreatedInvitationBo, error)
+	ListInvitations(ctx context.Context, req *bo.ListInvitationsBo) (*bo.PageResponseBo[*bo.InvitationBo], error)
+	DeleteInvitation(ctx context.Context, uid snowflake.ID) error
+}
@@ -0,0 +1,12 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+)
+
+type LDAP interface {
+	// Login checks the directory password of username and returns the directory user.
+	Login(ctx context.Context, username, password string) (auth.User, error)
+}
@@ -3,11 +3,25 @@ package repository
 import (
 	"context"
 
+	"github.com/bwmarrin/snowflake"
 	"golang.org/x/oauth2"
 
+	"github.com/aide-family/sovereign/internal/biz/bo"
 	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
 )
 
 type LoginRepository interface {
-	Login(ctx context.Context, oauthConfig *oauth2.Config, user auth.User) (string, error)
+	// Login signs the user of the identity in and returns the redirect URL with the signed-in user,
+	// the first login of an identity finds the user of its email by the link policy, and signs up a new user by the signup restrictions.
+	Login(ctx context.Context, oauthConfig *oauth2.Config, user auth.User, linkPolicy config.OAuth2_LinkPolicy, signup *bo.SignupBo) (string, *bo.UserItemBo, error)
+	// UserLogin links the user signed in by a provider without a browser redirect, such as LDAP, and issues its token.
+	UserLogin(ctx context.Context, user auth.User, linkPolicy config.OAuth2_LinkPolicy) (*bo.TokenBo, error)
+	// LinkIdentity links the identity to the signed-in user, an identity linked to another user is refused.
+	LinkIdentity(ctx context.Context, userUID snowflake.ID, user auth.User) (*bo.IdentityBo, error)
+	PasswordLogin(ctx context.Context, username, password string) (*bo.TokenBo, error)
+	ChangePassword(ctx context.Context, req *bo.ChangePasswordBo) error
+	// BootstrapAdmin returns the uid of the admin, whether it is created or exists already as a local account.
+	// The name of a federated user is refused as forbidden.
+	BootstrapAdmin(ctx context.Context, req *bo.BootstrapAdminBo) (snowflake.ID, error)
 }
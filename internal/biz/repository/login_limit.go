@@ -0,0 +1,15 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+)
+
+// LoginLimit is the login rate limit store shared by the replicas, and keeps the audit events of the lockouts.
+type LoginLimit interface {
+	auth.LoginLimitStore
+	CreateLoginAuditEvent(ctx context.Context, event *bo.LoginAuditEventBo) error
+	ListLoginAuditEvents(ctx context.Context, req *bo.ListLoginAuditEventsBo) (*bo.PageResponseBo[*bo.LoginAuditEventBo], error)
+}
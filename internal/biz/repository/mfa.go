@@ -0,0 +1,30 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+// MFA keeps the TOTP secrets and the recovery codes of the users, and the logins waiting for the second factor.
+type MFA interface {
+	// GetUserMFA returns the MFA of the user, a user who never enrolled has an empty secret.
+	GetUserMFA(ctx context.Context, userUID snowflake.ID) (*bo.UserMFABo, error)
+	// SaveUserMFA starts the enrollment with the encrypted secret, it fails when the MFA of the user is enabled.
+	SaveUserMFA(ctx context.Context, userUID snowflake.ID, secret string) (*bo.UserMFABo, error)
+	// EnableUserMFA confirms the enrollment, step is the time step of the confirming code which can not be used again.
+	EnableUserMFA(ctx context.Context, userUID snowflake.ID, step int64, recoveryCodeHashes []string) (*bo.UserMFABo, error)
+	DeleteUserMFA(ctx context.Context, userUID snowflake.ID) error
+	SaveRecoveryCodes(ctx context.Context, userUID snowflake.ID, recoveryCodeHashes []string) (*bo.UserMFABo, error)
+	// UseTOTPStep reports whether the time step was not used yet, and marks it used.
+	UseTOTPStep(ctx context.Context, userUID snowflake.ID, step int64) (bool, error)
+	// UseRecoveryCode reports whether the recovery code of the hash was not used yet, and marks it used.
+	UseRecoveryCode(ctx context.Context, userUID snowflake.ID, codeHash string) (bool, error)
+	GetChallenge(ctx context.Context, challenge string) (*bo.MFAChallengeBo, error)
+	// FailChallenge counts a wrong code of the challenge, the challenge is removed after too many of them.
+	FailChallenge(ctx context.Context, challenge string) (*bo.MFAChallengeBo, error)
+	// CompleteChallenge uses the challenge up and issues the tokens of the login, method is the amr of the second factor.
+	CompleteChallenge(ctx context.Context, challenge, method string) (*bo.TokenBo, error)
+}
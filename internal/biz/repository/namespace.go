@@ -16,4 +16,9 @@ type Namespace interface {
 	GetNamespaceByName(ctx context.Context, name string) (*bo.NamespaceItemBo, error)
 	ListNamespace(ctx context.Context, req *bo.ListNamespaceBo) (*bo.PageResponseBo[*bo.NamespaceItemBo], error)
 	SelectNamespace(ctx context.Context, req *bo.SelectNamespaceBo) (*bo.SelectNamespaceBoResult, error)
+	GetNamespaceStats(ctx context.Context, req *bo.GetNamespaceStatsBo) (*bo.NamespaceStatsBo, error)
+	ListNamespaceRevisions(ctx context.Context, req *bo.ListNamespaceRevisionsBo) (*bo.PageResponseBo[*bo.NamespaceRevisionItemBo], error)
+	GetNamespaceRevision(ctx context.Context, namespaceUID snowflake.ID, revision int32) (*bo.NamespaceRevisionItemBo, error)
+	RollbackNamespace(ctx context.Context, req *bo.RollbackNamespaceBo) (*bo.NamespaceRevisionItemBo, error)
+	TransferNamespaceOwnership(ctx context.Context, req *bo.TransferNamespaceOwnershipBo) (*bo.NamespaceRevisionItemBo, error)
 }
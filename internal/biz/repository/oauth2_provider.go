@@ -0,0 +1,22 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+// OAuth2Provider stores the providers managed by the OAuth2 provider API, the client secrets are encrypted by the caller
+// and stored as they are.
+type OAuth2Provider interface {
+	CreateOAuth2Provider(ctx context.Context, req *bo.OAuth2ProviderBo) (*bo.OAuth2ProviderBo, error)
+	// UpdateOAuth2Provider replaces the config of the provider, an empty client secret keeps the stored one.
+	UpdateOAuth2Provider(ctx context.Context, req *bo.OAuth2ProviderBo) (*bo.OAuth2ProviderBo, error)
+	UpdateOAuth2ProviderStatus(ctx context.Context, req *bo.UpdateOAuth2ProviderStatusBo) (*bo.OAuth2ProviderBo, error)
+	DeleteOAuth2Provider(ctx context.Context, uid snowflake.ID) error
+	GetOAuth2Provider(ctx context.Context, uid snowflake.ID) (*bo.OAuth2ProviderBo, error)
+	// ListOAuth2Providers lists the providers managed by the API, a page size of 0 lists all of them.
+	ListOAuth2Providers(ctx context.Context, req *bo.ListOAuth2ProvidersBo) (*bo.PageResponseBo[*bo.OAuth2ProviderBo], error)
+}
@@ -0,0 +1,18 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+type Policy interface {
+	CreatePolicy(ctx context.Context, req *bo.SavePolicyBo) (*bo.PolicyBo, error)
+	UpdatePolicy(ctx context.Context, req *bo.SavePolicyBo) (*bo.PolicyBo, error)
+	GetPolicy(ctx context.Context, uid snowflake.ID) (*bo.PolicyBo, error)
+	DeletePolicy(ctx context.Context, uid snowflake.ID) error
+	// ListPolicies lists the policies managed by the policy API, a page size of 0 lists all of them.
+	ListPolicies(ctx context.Context, req *bo.ListPoliciesBo) (*bo.PageResponseBo[*bo.PolicyBo], error)
+}
@@ -0,0 +1,21 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+// Quota keeps the quotas by the namespace uid, so that they follow the namespace through renames and rollbacks.
+type Quota interface {
+	SetQuota(ctx context.Context, req *bo.SetQuotaBo) (*bo.QuotaItemBo, error)
+	GetQuota(ctx context.Context, namespaceUID snowflake.ID, resource string) (*bo.QuotaItemBo, error)
+	DeleteQuota(ctx context.Context, namespaceUID snowflake.ID, resource string) error
+	// DeleteNamespaceQuotas removes every quota of the namespace at once.
+	DeleteNamespaceQuotas(ctx context.Context, namespaceUID snowflake.ID) error
+	ListQuota(ctx context.Context, namespaceUID snowflake.ID) ([]*bo.QuotaItemBo, error)
+	Reserve(ctx context.Context, req *bo.QuotaUsageBo) (*bo.QuotaItemBo, error)
+	Release(ctx context.Context, req *bo.QuotaUsageBo) (*bo.QuotaItemBo, error)
+}
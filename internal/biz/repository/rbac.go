@@ -0,0 +1,28 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+type RBAC interface {
+	CreateRole(ctx context.Context, req *bo.SaveRoleBo) (*bo.RoleBo, error)
+	UpdateRole(ctx context.Context, req *bo.SaveRoleBo) (*bo.RoleBo, error)
+	DeleteRole(ctx context.Context, uid snowflake.ID) error
+	GetRole(ctx context.Context, uid snowflake.ID) (*bo.RoleBo, error)
+	ListRoles(ctx context.Context, req *bo.ListRolesBo) (*bo.PageResponseBo[*bo.RoleBo], error)
+	// EnsureBuiltinRoles creates or updates the builtin roles by name.
+	EnsureBuiltinRoles(ctx context.Context, roles []*bo.RoleBo) ([]*bo.RoleBo, error)
+	CreateRoleBinding(ctx context.Context, req *bo.CreateRoleBindingBo) (*bo.RoleBindingBo, error)
+	DeleteRoleBinding(ctx context.Context, uid snowflake.ID) error
+	// ListRoleBindings lists the bindings matching the set fields, a page size of 0 lists all of them.
+	ListRoleBindings(ctx context.Context, req *bo.ListRoleBindingsBo) (*bo.PageResponseBo[*bo.RoleBindingBo], error)
+	// SetRoleMFA sets whether the permissions of the role are only granted to the tokens of an MFA login.
+	SetRoleMFA(ctx context.Context, uid snowflake.ID, requireMFA bool) (*bo.RoleBo, error)
+	// GetUserPermissions returns the roles bound to the user globally and in the namespace, with their permissions,
+	// the roles requiring MFA are skipped unless mfa is true.
+	GetUserPermissions(ctx context.Context, userUID snowflake.ID, namespace string, mfa bool) (*bo.UserPermissionsBo, error)
+}
@@ -0,0 +1,13 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+type Token interface {
+	RefreshToken(ctx context.Context, refreshToken string) (*bo.TokenBo, error)
+	RevokeToken(ctx context.Context, req *bo.RevokeTokenBo) error
+	IsTokenRevoked(ctx context.Context, tokenID string) (bool, error)
+}
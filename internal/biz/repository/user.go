@@ -0,0 +1,27 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+)
+
+type User interface {
+	GetUser(ctx context.Context, uid snowflake.ID) (*bo.UserItemBo, error)
+	// GetIdentityUser returns the user linked to the identity openID of app.
+	GetIdentityUser(ctx context.Context, app, openID string) (*bo.UserItemBo, error)
+	ListUsers(ctx context.Context, req *bo.ListUsersBo) (*bo.PageResponseBo[*bo.UserItemBo], error)
+	UpdateUser(ctx context.Context, req *bo.UpdateUserBo) (*bo.UserItemBo, error)
+	UpdateUserStatus(ctx context.Context, req *bo.UpdateUserStatusBo) (*bo.UserItemBo, error)
+	// UpdateUserEmail refuses an email of another user, the email is saved as not verified.
+	UpdateUserEmail(ctx context.Context, uid snowflake.ID, email string) (*bo.UserItemBo, error)
+	ListUserIdentities(ctx context.Context, userUID snowflake.ID) ([]*bo.IdentityBo, error)
+	// UnlinkUserIdentity refuses to remove the last identity of a user without a password, the operator is recorded in the audit event.
+	UnlinkUserIdentity(ctx context.Context, operator, userUID snowflake.ID, identityID uint32) error
+	ListIdentityAuditEvents(ctx context.Context, req *bo.ListIdentityAuditEventsBo) (*bo.PageResponseBo[*bo.IdentityAuditEventBo], error)
+	// GetUserPreferences returns empty preferences for a user who has not set any.
+	GetUserPreferences(ctx context.Context, userUID snowflake.ID) (*bo.UserPreferencesBo, error)
+	SaveUserPreferences(ctx context.Context, req *bo.UserPreferencesBo) (*bo.UserPreferencesBo, error)
+}
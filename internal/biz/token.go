@@ -0,0 +1,78 @@
+package biz
+
+import (
+	"context"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewToken(tokenRepo repository.Token, helper *klog.Helper) *Token {
+	return &Token{
+		tokenRepo: tokenRepo,
+		helper:    klog.NewHelper(klog.With(helper.Logger(), "biz", "token")),
+	}
+}
+
+type Token struct {
+	helper    *klog.Helper
+	tokenRepo repository.Token
+}
+
+func (t *Token) RefreshToken(ctx context.Context, refreshToken string) (*bo.TokenBo, error) {
+	tokenBo, err := t.tokenRepo.RefreshToken(ctx, refreshToken)
+	if err != nil {
+		if merr.IsUnauthorized(err) {
+			return nil, err
+		}
+		t.helper.Errorw("msg", "refresh token failed", "error", err)
+		return nil, merr.ErrorInternal("refresh token failed").WithCause(err)
+	}
+	return tokenBo, nil
+}
+
+// Logout revokes the access token of the request, and the refresh token of the login when it is given.
+func (t *Token) Logout(ctx context.Context, refreshToken string) error {
+	claims, err := authv1.GetClaimsFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	req := &bo.RevokeTokenBo{
+		RefreshToken: refreshToken,
+		TokenID:      claims.ID,
+		UID:          claims.UID,
+	}
+	if claims.ExpiresAt != nil {
+		req.ExpiresAt = claims.ExpiresAt.Time
+	}
+	return t.revokeToken(ctx, req)
+}
+
+func (t *Token) RevokeToken(ctx context.Context, refreshToken string) error {
+	return t.revokeToken(ctx, &bo.RevokeTokenBo{RefreshToken: refreshToken})
+}
+
+// IsTokenRevoked reports whether the access token tokenID has been revoked before it expires.
+func (t *Token) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	revoked, err := t.tokenRepo.IsTokenRevoked(ctx, tokenID)
+	if err != nil {
+		t.helper.Errorw("msg", "check token revocation failed", "error", err, "tokenID", tokenID)
+		return false, merr.ErrorInternal("check token revocation failed").WithCause(err)
+	}
+	return revoked, nil
+}
+
+func (t *Token) revokeToken(ctx context.Context, req *bo.RevokeTokenBo) error {
+	if err := t.tokenRepo.RevokeToken(ctx, req); err != nil {
+		if merr.IsUnauthorized(err) || merr.IsForbidden(err) {
+			return err
+		}
+		t.helper.Errorw("msg", "revoke token failed", "error", err, "uid", req.UID)
+		return merr.ErrorInternal("revoke token failed").WithCause(err)
+	}
+	return nil
+}
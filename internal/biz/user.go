@@ -0,0 +1,245 @@
+package biz
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewUser(userRepo repository.User, rbacRepo repository.RBAC, namespaceRepo repository.Namespace, pageTokens *bo.PageTokenCodec, helper *klog.Helper) *User {
+	return &User{
+		userRepo:      userRepo,
+		rbacRepo:      rbacRepo,
+		namespaceRepo: namespaceRepo,
+		pageTokens:    pageTokens,
+		helper:        klog.NewHelper(klog.With(helper.Logger(), "biz", "user")),
+	}
+}
+
+type User struct {
+	helper        *klog.Helper
+	userRepo      repository.User
+	rbacRepo      repository.RBAC
+	namespaceRepo repository.Namespace
+	pageTokens    *bo.PageTokenCodec
+}
+
+func (u *User) GetUser(ctx context.Context, uid snowflake.ID) (*bo.UserItemBo, error) {
+	user, err := u.userRepo.GetUser(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("user %s not found", uid)
+		}
+		u.helper.Errorw("msg", "get user failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternal("get user %s failed", uid).WithCause(err)
+	}
+	return user, nil
+}
+
+// GetIdentityUser is consulted by the login middleware for the tokens of a trusted issuer,
+// their subject is an identity of the provider and must be linked to a user.
+func (u *User) GetIdentityUser(ctx context.Context, provider, subject string) (*bo.UserItemBo, error) {
+	user, err := u.userRepo.GetIdentityUser(ctx, provider, subject)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorUnauthorized("token subject is not linked to a user")
+		}
+		u.helper.Errorw("msg", "get identity user failed", "error", err, "provider", provider, "subject", subject)
+		return nil, merr.ErrorInternal("get identity user failed").WithCause(err)
+	}
+	return user, nil
+}
+
+func (u *User) ListUsers(ctx context.Context, req *bo.ListUsersBo) (*bo.PageResponseBo[*bo.UserItemBo], error) {
+	filters := req.PageFilters()
+	if err := u.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := u.userRepo.ListUsers(ctx, req)
+	if err != nil {
+		u.helper.Errorw("msg", "list users failed", "error", err, "req", req)
+		return nil, merr.ErrorInternal("list users failed").WithCause(err)
+	}
+	u.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+func (u *User) UpdateUser(ctx context.Context, req *bo.UpdateUserBo) (*bo.UserItemBo, error) {
+	user, err := u.userRepo.UpdateUser(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("user %s not found", req.UID)
+		}
+		u.helper.Errorw("msg", "update user failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update user %s failed", req.UID).WithCause(err)
+	}
+	return user, nil
+}
+
+// UpdateUserStatus enables or disables the user, an operator can not disable itself.
+func (u *User) UpdateUserStatus(ctx context.Context, req *bo.UpdateUserStatusBo) (*bo.UserItemBo, error) {
+	if req.Status.IsDisabled() && req.UID == req.Operator {
+		return nil, merr.ErrorParams("you can not disable yourself")
+	}
+	user, err := u.userRepo.UpdateUserStatus(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("user %s not found", req.UID)
+		}
+		u.helper.Errorw("msg", "update user status failed", "error", err, "uid", req.UID)
+		return nil, merr.ErrorInternal("update user %s status failed", req.UID).WithCause(err)
+	}
+	return user, nil
+}
+
+// UpdateEmail completes the profile of a user who signed up with a noreply email, a real email is kept.
+func (u *User) UpdateEmail(ctx context.Context, uid snowflake.ID, email string) (*bo.UserItemBo, error) {
+	current, err := u.GetUser(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	if current.Email != "" && !auth.IsNoreplyEmail(current.Email) {
+		return nil, merr.ErrorParams("the email of the user is already set")
+	}
+	user, err := u.userRepo.UpdateUserEmail(ctx, uid, email)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("user %s not found", uid)
+		}
+		if merr.IsParams(err) {
+			return nil, err
+		}
+		u.helper.Errorw("msg", "update user email failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternal("update user %s email failed", uid).WithCause(err)
+	}
+	return user, nil
+}
+
+func (u *User) ListUserIdentities(ctx context.Context, userUID snowflake.ID) ([]*bo.IdentityBo, error) {
+	identities, err := u.userRepo.ListUserIdentities(ctx, userUID)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("user %s not found", userUID)
+		}
+		u.helper.Errorw("msg", "list user identities failed", "error", err, "uid", userUID)
+		return nil, merr.ErrorInternal("list user %s identities failed", userUID).WithCause(err)
+	}
+	return identities, nil
+}
+
+func (u *User) UnlinkUserIdentity(ctx context.Context, operator, userUID snowflake.ID, identityID uint32) error {
+	if err := u.userRepo.UnlinkUserIdentity(ctx, operator, userUID, identityID); err != nil {
+		if merr.IsNotFound(err) || merr.IsParams(err) {
+			return err
+		}
+		u.helper.Errorw("msg", "unlink user identity failed", "error", err, "uid", userUID, "identityID", identityID)
+		return merr.ErrorInternal("unlink user %s identity failed", userUID).WithCause(err)
+	}
+	return nil
+}
+
+func (u *User) ListIdentityAuditEvents(ctx context.Context, req *bo.ListIdentityAuditEventsBo) (*bo.PageResponseBo[*bo.IdentityAuditEventBo], error) {
+	filters := req.PageFilters()
+	if err := u.pageTokens.Resolve(req.PageRequestBo, filters); err != nil {
+		return nil, err
+	}
+	pageResponseBo, err := u.userRepo.ListIdentityAuditEvents(ctx, req)
+	if err != nil {
+		u.helper.Errorw("msg", "list identity audit events failed", "error", err, "uid", req.UserUID)
+		return nil, merr.ErrorInternal("list identity audit events failed").WithCause(err)
+	}
+	u.pageTokens.Issue(pageResponseBo.PageRequestBo, filters)
+	return pageResponseBo, nil
+}
+
+// IsUserDisabled is consulted by the login middleware for every authenticated request, users unknown here are not disabled.
+func (u *User) IsUserDisabled(ctx context.Context, uid snowflake.ID) (bool, error) {
+	user, err := u.userRepo.GetUser(ctx, uid)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return false, nil
+		}
+		u.helper.Errorw("msg", "get user failed", "error", err, "uid", uid)
+		return false, merr.ErrorInternal("get user %s failed", uid).WithCause(err)
+	}
+	return user.Status.IsDisabled(), nil
+}
+
+// GetMe returns the signed-in user with its identities, role bindings and preferences.
+func (u *User) GetMe(ctx context.Context, uid snowflake.ID) (*bo.UserProfileBo, error) {
+	user, err := u.GetUser(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	identities, err := u.ListUserIdentities(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	memberships, err := u.rbacRepo.ListRoleBindings(ctx, &bo.ListRoleBindingsBo{PageRequestBo: bo.NewPageRequestBo(0, 0), UserUID: uid})
+	if err != nil {
+		u.helper.Errorw("msg", "list role bindings failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternal("list role bindings failed").WithCause(err)
+	}
+	preferences, err := u.getPreferences(ctx, uid)
+	if err != nil {
+		return nil, err
+	}
+	return &bo.UserProfileBo{
+		User:        user,
+		Identities:  identities,
+		Memberships: memberships.GetItems(),
+		Preferences: preferences,
+	}, nil
+}
+
+func (u *User) getPreferences(ctx context.Context, uid snowflake.ID) (*bo.UserPreferencesBo, error) {
+	preferences, err := u.userRepo.GetUserPreferences(ctx, uid)
+	if err != nil {
+		u.helper.Errorw("msg", "get user preferences failed", "error", err, "uid", uid)
+		return nil, merr.ErrorInternal("get user preferences failed").WithCause(err)
+	}
+	return preferences, nil
+}
+
+// UpdatePreferences checks the default namespace exists and the timezone is known before saving the preferences.
+func (u *User) UpdatePreferences(ctx context.Context, req *bo.UserPreferencesBo) (*bo.UserPreferencesBo, error) {
+	if req.DefaultNamespace != "" {
+		if _, err := u.namespaceRepo.GetNamespaceByName(ctx, req.DefaultNamespace); err != nil {
+			if merr.IsNotFound(err) {
+				return nil, merr.ErrorParams("namespace %s not found", req.DefaultNamespace)
+			}
+			u.helper.Errorw("msg", "get namespace failed", "error", err, "name", req.DefaultNamespace)
+			return nil, merr.ErrorInternal("get namespace %s failed", req.DefaultNamespace).WithCause(err)
+		}
+	}
+	if req.Timezone != "" {
+		if _, err := time.LoadLocation(req.Timezone); err != nil {
+			return nil, merr.ErrorParams("timezone %s is unknown", req.Timezone)
+		}
+	}
+	preferences, err := u.userRepo.SaveUserPreferences(ctx, req)
+	if err != nil {
+		if merr.IsNotFound(err) {
+			return nil, merr.ErrorNotFound("user %s not found", req.UserUID)
+		}
+		u.helper.Errorw("msg", "save user preferences failed", "error", err, "uid", req.UserUID)
+		return nil, merr.ErrorInternal("save user preferences failed").WithCause(err)
+	}
+	return preferences, nil
+}
+
+// GetDefaultNamespace is consulted by the namespace middleware for the requests without a namespace.
+func (u *User) GetDefaultNamespace(ctx context.Context, uid snowflake.ID) (string, error) {
+	preferences, err := u.getPreferences(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	return preferences.DefaultNamespace, nil
+}
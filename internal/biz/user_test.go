@@ -0,0 +1,93 @@
+package biz_test
+
+import (
+	"context"
+	"testing"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func TestUserPreferences(t *testing.T) {
+	helper := klog.NewHelper(klog.DefaultLogger)
+	c := &conf.Bootstrap{NamespaceConfig: newFileDomainConfig(t, "namespaces.yaml")}
+	d, cleanup, err := data.New(c, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	namespaceRepo, err := impl.NewNamespaceRepository(c, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo := newAuthRepository(t)
+	pageTokens := bo.NewPageTokenCodec("secret")
+	userBiz := biz.NewUser(impl.NewUserRepository(repo), impl.NewRBACRepository(repo), namespaceRepo, pageTokens, helper)
+	rbac, err := biz.NewRBAC(impl.NewRBACRepository(repo), pageTokens, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	if err := biz.NewNamespace(namespaceRepo, nil, nil, pageTokens, helper).CreateNamespace(ctx, &bo.CreateNamespaceBo{Name: "team-a", Status: vobj.GlobalStatusEnabled}); err != nil {
+		t.Fatal(err)
+	}
+	alice := newUser(t, repo, "alice")
+
+	tests := []struct {
+		name  string
+		req   *bo.UserPreferencesBo
+		check func(error) bool
+	}{
+		{name: "unknown namespace", req: &bo.UserPreferencesBo{UserUID: alice, DefaultNamespace: "team-b"}, check: merr.IsParams},
+		{name: "unknown timezone", req: &bo.UserPreferencesBo{UserUID: alice, Timezone: "Mars/Olympus"}, check: merr.IsParams},
+		{name: "unknown user", req: &bo.UserPreferencesBo{UserUID: alice + 1, DefaultNamespace: "team-a"}, check: merr.IsNotFound},
+		{name: "saved", req: &bo.UserPreferencesBo{UserUID: alice, DefaultNamespace: "team-a", Locale: "en", Timezone: "Europe/Paris"}, check: func(err error) bool { return err == nil }},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := userBiz.UpdatePreferences(ctx, tt.req); !tt.check(err) {
+				t.Fatalf("unexpected error %v", err)
+			}
+		})
+	}
+
+	defaultNamespace, err := userBiz.GetDefaultNamespace(ctx, alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if defaultNamespace != "team-a" {
+		t.Fatalf("want the default namespace team-a, got %q", defaultNamespace)
+	}
+
+	reader, err := rbac.CreateRole(ctx, &bo.SaveRoleBo{Name: "team-reader", Permissions: []string{apiv1.OperationNamespaceGetNamespace}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rbac.CreateRoleBinding(ctx, &bo.CreateRoleBindingBo{UserUID: alice, RoleUID: reader.UID, Namespace: "team-a"}); err != nil {
+		t.Fatal(err)
+	}
+	me, err := userBiz.GetMe(ctx, alice)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if me.User.UID != alice || me.User.Email != "alice@example.com" {
+		t.Fatalf("want alice, got %+v", me.User)
+	}
+	if len(me.Identities) != 1 || me.Identities[0].App != "sso" || me.Identities[0].OpenID != "alice" {
+		t.Fatalf("want the sso identity, got %+v", me.Identities)
+	}
+	if len(me.Memberships) != 1 || me.Memberships[0].Role.Name != "team-reader" || me.Memberships[0].Namespace != "team-a" {
+		t.Fatalf("want the team-reader binding in team-a, got %+v", me.Memberships)
+	}
+	if me.Preferences.DefaultNamespace != "team-a" || me.Preferences.Locale != "en" || me.Preferences.Timezone != "Europe/Paris" {
+		t.Fatalf("want the saved preferences, got %+v", me.Preferences)
+	}
+}
@@ -0,0 +1,11 @@
+package vobj
+
+//go:generate stringer -type=NamespaceOrderField -linecomment -output=namespace_order_field__string.go
+type NamespaceOrderField int8
+
+const (
+	NamespaceOrderFieldUID       NamespaceOrderField = iota // UID
+	NamespaceOrderFieldName                                 // 名称
+	NamespaceOrderFieldCreatedAt                            // 创建时间
+	NamespaceOrderFieldUpdatedAt                            // 更新时间
+)
@@ -0,0 +1,23 @@
+package vobj
+
+//go:generate stringer -type=NamespaceRevisionAction -linecomment -output=namespace_revision_action__string.go
+type NamespaceRevisionAction int8
+
+const (
+	NamespaceRevisionActionUnknown           NamespaceRevisionAction = iota // 未知
+	NamespaceRevisionActionCreate                                           // 创建
+	NamespaceRevisionActionUpdate                                           // 更新
+	NamespaceRevisionActionUpdateStatus                                     // 更新状态
+	NamespaceRevisionActionRollback                                         // 回滚
+	NamespaceRevisionActionTransferOwnership                                // 转移所有权
+)
+
+//go:generate stringer -type=NamespaceChangeType -linecomment -output=namespace_change_type__string.go
+type NamespaceChangeType int8
+
+const (
+	NamespaceChangeTypeUnknown  NamespaceChangeType = iota // 未知
+	NamespaceChangeTypeAdded                               // 新增
+	NamespaceChangeTypeRemoved                             // 删除
+	NamespaceChangeTypeModified                            // 修改
+)
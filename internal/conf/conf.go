@@ -95,9 +95,3 @@ type ServerConfig interface {
 	GetTimeout() *durationpb.Duration
 	GetProtocol() config.Protocol
 }
-
-type JWTConfig interface {
-	GetSecret() string
-	GetExpire() *durationpb.Duration
-	GetIssuer() string
-}
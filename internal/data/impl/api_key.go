@@ -0,0 +1,93 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewAPIKeyRepository(repo authv1.Repository) repository.APIKey {
+	return &apiKeyRepository{repo: repo}
+}
+
+type apiKeyRepository struct {
+	repo authv1.Repository
+}
+
+// CreateAPIKey implements [repository.APIKey].
+func (a *apiKeyRepository) CreateAPIKey(ctx context.Context, req *bo.CreateAPIKeyBo) (*bo.CreatedAPIKeyBo, error) {
+	createReq := &authv1.CreateAPIKeyRequest{
+		Uid:        req.UserUID.Int64(),
+		Name:       req.Name,
+		Scopes:     req.Scopes,
+		Namespaces: req.Namespaces,
+	}
+	if !req.ExpiresAt.IsZero() {
+		createReq.ExpiresAt = req.ExpiresAt.Unix()
+	}
+	reply, err := a.repo.CreateAPIKey(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+	return &bo.CreatedAPIKeyBo{APIKey: parseAPIKeyModel(reply.GetApiKey()), Key: reply.GetKey()}, nil
+}
+
+// ListAPIKeys implements [repository.APIKey].
+func (a *apiKeyRepository) ListAPIKeys(ctx context.Context, req *bo.ListAPIKeysBo) (*bo.PageResponseBo[*bo.APIKeyBo], error) {
+	reply, err := a.repo.ListAPIKeys(ctx, &authv1.ListAPIKeysRequest{
+		Uid:      req.UserUID.Int64(),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.APIKeyBo, 0, len(reply.GetItems()))
+	for _, item := range reply.GetItems() {
+		items = append(items, parseAPIKeyModel(item))
+	}
+	req.WithTotal(reply.GetTotal())
+	req.WithCursors(parseAuthPageCursor(reply.GetPrevCursor()), parseAuthPageCursor(reply.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// RevokeAPIKey implements [repository.APIKey].
+func (a *apiKeyRepository) RevokeAPIKey(ctx context.Context, userUID, apiKeyUID snowflake.ID) error {
+	_, err := a.repo.RevokeAPIKey(ctx, &authv1.RevokeAPIKeyRequest{Uid: userUID.Int64(), ApiKeyUID: apiKeyUID.Int64()})
+	return err
+}
+
+// VerifyAPIKey implements [repository.APIKey].
+func (a *apiKeyRepository) VerifyAPIKey(ctx context.Context, key string) (*bo.APIKeyBo, error) {
+	apiKeyModel, err := a.repo.VerifyAPIKey(ctx, &authv1.VerifyAPIKeyRequest{Key: key})
+	if err != nil {
+		return nil, err
+	}
+	return parseAPIKeyModel(apiKeyModel), nil
+}
+
+func parseAPIKeyModel(apiKeyModel *authv1.APIKeyModel) *bo.APIKeyBo {
+	apiKey := &bo.APIKeyBo{
+		UID:        snowflake.ParseInt64(apiKeyModel.GetUid()),
+		UserUID:    snowflake.ParseInt64(apiKeyModel.GetUserUID()),
+		Username:   apiKeyModel.GetUsername(),
+		Name:       apiKeyModel.GetName(),
+		Prefix:     apiKeyModel.GetPrefix(),
+		Scopes:     apiKeyModel.GetScopes(),
+		Namespaces: apiKeyModel.GetNamespaces(),
+		CreatedAt:  time.Unix(apiKeyModel.GetCreatedAt(), 0),
+	}
+	if expiresAt := apiKeyModel.GetExpiresAt(); expiresAt > 0 {
+		apiKey.ExpiresAt = time.Unix(expiresAt, 0)
+	}
+	if lastUsedAt := apiKeyModel.GetLastUsedAt(); lastUsedAt > 0 {
+		apiKey.LastUsedAt = time.Unix(lastUsedAt, 0)
+	}
+	return apiKey
+}
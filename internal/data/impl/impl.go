@@ -7,5 +7,17 @@ import "github.com/google/wire"
 var ProviderSetImpl = wire.NewSet(
 	NewHealthRepository,
 	NewNamespaceRepository,
+	NewQuotaRepository,
+	NewAuthV1Repository,
 	NewLoginRepository,
+	NewUserRepository,
+	NewTokenRepository,
+	NewLDAPRepository,
+	NewAPIKeyRepository,
+	NewRBACRepository,
+	NewPolicyRepository,
+	NewOAuth2ProviderRepository,
+	NewInvitationRepository,
+	NewLoginLimitRepository,
+	NewMFARepository,
 )
@@ -0,0 +1,80 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewInvitationRepository(repo authv1.Repository) repository.Invitation {
+	return &invitationRepository{repo: repo}
+}
+
+type invitationRepository struct {
+	repo authv1.Repository
+}
+
+// CreateInvitation implements [repository.Invitation].
+func (i *invitationRepository) CreateInvitation(ctx context.Context, req *bo.CreateInvitationBo) (*bo.CreatedInvitationBo, error) {
+	createReq := &authv1.CreateInvitationRequest{
+		Provider:   req.Provider,
+		MaxUses:    req.MaxUses,
+		CreatorUID: req.CreatorUID.Int64(),
+		Remark:     req.Remark,
+	}
+	if !req.ExpiresAt.IsZero() {
+		createReq.ExpiresAt = req.ExpiresAt.Unix()
+	}
+	reply, err := i.repo.CreateInvitation(ctx, createReq)
+	if err != nil {
+		return nil, err
+	}
+	return &bo.CreatedInvitationBo{Invitation: parseInvitationModel(reply.GetInvitation()), Code: reply.GetCode()}, nil
+}
+
+// ListInvitations implements [repository.Invitation].
+func (i *invitationRepository) ListInvitations(ctx context.Context, req *bo.ListInvitationsBo) (*bo.PageResponseBo[*bo.InvitationBo], error) {
+	reply, err := i.repo.ListInvitations(ctx, &authv1.ListInvitationsRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.InvitationBo, 0, len(reply.GetItems()))
+	for _, item := range reply.GetItems() {
+		items = append(items, parseInvitationModel(item))
+	}
+	req.WithTotal(reply.GetTotal())
+	req.WithCursors(parseAuthPageCursor(reply.GetPrevCursor()), parseAuthPageCursor(reply.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// DeleteInvitation implements [repository.Invitation].
+func (i *invitationRepository) DeleteInvitation(ctx context.Context, uid snowflake.ID) error {
+	_, err := i.repo.DeleteInvitation(ctx, &authv1.DeleteInvitationRequest{Uid: uid.Int64()})
+	return err
+}
+
+func parseInvitationModel(invitationModel *authv1.InvitationModel) *bo.InvitationBo {
+	invitation := &bo.InvitationBo{
+		UID:        snowflake.ParseInt64(invitationModel.GetUid()),
+		Provider:   invitationModel.GetProvider(),
+		Prefix:     invitationModel.GetPrefix(),
+		MaxUses:    invitationModel.GetMaxUses(),
+		UsedCount:  invitationModel.GetUsedCount(),
+		CreatorUID: snowflake.ParseInt64(invitationModel.GetCreatorUID()),
+		Remark:     invitationModel.GetRemark(),
+		CreatedAt:  time.Unix(invitationModel.GetCreatedAt(), 0),
+	}
+	if expiresAt := invitationModel.GetExpiresAt(); expiresAt > 0 {
+		invitation.ExpiresAt = time.Unix(expiresAt, 0)
+	}
+	return invitation
+}
@@ -0,0 +1,39 @@
+package impl
+
+import (
+	"context"
+	"strings"
+
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data"
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/api/auth/ldap"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// NewLDAPRepository creates the LDAP repository, logins are rejected when LDAP is not enabled.
+func NewLDAPRepository(c *conf.Bootstrap, d *data.Data) (repository.LDAP, error) {
+	ldapConfig := c.GetLdap()
+	if !strings.EqualFold(ldapConfig.GetEnable(), "true") {
+		return &ldapRepository{}, nil
+	}
+	provider, err := ldap.NewProvider(ldapConfig)
+	if err != nil {
+		return nil, err
+	}
+	d.AppendClose("ldap", provider.Close)
+	return &ldapRepository{provider: provider}, nil
+}
+
+type ldapRepository struct {
+	provider *ldap.Provider
+}
+
+// Login implements [repository.LDAP].
+func (l *ldapRepository) Login(ctx context.Context, username, password string) (auth.User, error) {
+	if l.provider == nil {
+		return nil, merr.ErrorForbidden("ldap login is not enabled")
+	}
+	return l.provider.Login(ctx, username, password)
+}
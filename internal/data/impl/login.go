@@ -5,13 +5,16 @@ import (
 
 	"context"
 
+	"github.com/bwmarrin/snowflake"
 	klog "github.com/go-kratos/kratos/v2/log"
 	"golang.org/x/oauth2"
 
+	"github.com/aide-family/sovereign/internal/biz/bo"
 	"github.com/aide-family/sovereign/internal/biz/repository"
 	"github.com/aide-family/sovereign/internal/conf"
 	"github.com/aide-family/sovereign/internal/data"
 	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
 	"github.com/aide-family/sovereign/pkg/domain"
 	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
 	"github.com/aide-family/sovereign/pkg/merr"
@@ -21,7 +24,8 @@ type loginRepository struct {
 	repo authv1.Repository
 }
 
-func NewLoginRepository(c *conf.Bootstrap, d *data.Data) (repository.LoginRepository, error) {
+// NewAuthV1Repository creates the auth domain repository shared by the login and user repositories.
+func NewAuthV1Repository(c *conf.Bootstrap, d *data.Data) (authv1.Repository, error) {
 	repoConfig := c.GetLoginConfig()
 	version := repoConfig.GetVersion()
 	driver := repoConfig.GetDriver()
@@ -35,13 +39,17 @@ func NewLoginRepository(c *conf.Bootstrap, d *data.Data) (repository.LoginReposi
 		if err != nil {
 			return nil, err
 		}
-		d.AppendClose("loginRepo", close)
+		d.AppendClose("authRepo", close)
 
-		return &loginRepository{repo: repoImpl}, nil
+		return repoImpl, nil
 	}
 }
 
-func (l *loginRepository) Login(ctx context.Context, oauthConfig *oauth2.Config, user auth.User) (string, error) {
+func NewLoginRepository(repo authv1.Repository) repository.LoginRepository {
+	return &loginRepository{repo: repo}
+}
+
+func (l *loginRepository) Login(ctx context.Context, oauthConfig *oauth2.Config, user auth.User, linkPolicy config.OAuth2_LinkPolicy, signup *bo.SignupBo) (string, *bo.UserItemBo, error) {
 	req := &authv1.LoginRequest{
 		OauthConfig: &authv1.OAuth2Config{
 			ClientID:     oauthConfig.ClientID,
@@ -55,21 +63,89 @@ func (l *loginRepository) Login(ctx context.Context, oauthConfig *oauth2.Config,
 				AuthStyle:     int32(oauthConfig.Endpoint.AuthStyle),
 			},
 		},
-		User: &authv1.User{
-			OpenID:   user.GetOpenID(),
-			Name:     user.GetName(),
-			Nickname: user.GetNickname(),
-			Email:    user.GetEmail(),
-			Avatar:   user.GetAvatar(),
-			App:      user.GetAPP().String(),
-			Raw:      user.GetRaw(),
-			Remark:   user.GetRemark(),
-		},
+		User:           toAuthV1User(user),
+		LinkPolicy:     toAuthV1LinkPolicy(linkPolicy),
+		InviteOnly:     signup.InviteOnly,
+		InvitationCode: signup.InvitationCode,
+		Amr:            []string{authv1.AMRFederated},
 	}
 	reply, err := l.repo.Login(ctx, req)
 	if err != nil {
 		klog.Context(ctx).Debugw("msg", "login failed", "error", err)
-		return "", err
+		return "", nil, err
+	}
+	return reply.GetRedirectURL(), parseUserModel(reply.GetUser()), nil
+}
+
+func (l *loginRepository) UserLogin(ctx context.Context, user auth.User, linkPolicy config.OAuth2_LinkPolicy) (*bo.TokenBo, error) {
+	reply, err := l.repo.Login(ctx, &authv1.LoginRequest{
+		User:       toAuthV1User(user),
+		LinkPolicy: toAuthV1LinkPolicy(linkPolicy),
+		Amr:        []string{authv1.AMRPassword},
+	})
+	if err != nil {
+		klog.Context(ctx).Debugw("msg", "login failed", "error", err, "app", user.GetAPP())
+		return nil, err
+	}
+	return parseTokenModel(reply.GetToken()), nil
+}
+
+func (l *loginRepository) LinkIdentity(ctx context.Context, userUID snowflake.ID, user auth.User) (*bo.IdentityBo, error) {
+	identity, err := l.repo.LinkIdentity(ctx, &authv1.LinkIdentityRequest{UserUID: userUID.Int64(), User: toAuthV1User(user)})
+	if err != nil {
+		return nil, err
+	}
+	return parseIdentityModel(identity), nil
+}
+
+func (l *loginRepository) PasswordLogin(ctx context.Context, username, password string) (*bo.TokenBo, error) {
+	tokenModel, err := l.repo.PasswordLogin(ctx, &authv1.PasswordLoginRequest{Username: username, Password: password})
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenModel(tokenModel), nil
+}
+
+func (l *loginRepository) ChangePassword(ctx context.Context, req *bo.ChangePasswordBo) error {
+	_, err := l.repo.ChangePassword(ctx, &authv1.ChangePasswordRequest{
+		Uid:         req.UID.Int64(),
+		OldPassword: req.OldPassword,
+		NewPassword: req.NewPassword,
+	})
+	return err
+}
+
+func (l *loginRepository) BootstrapAdmin(ctx context.Context, req *bo.BootstrapAdminBo) (snowflake.ID, error) {
+	admin, err := l.repo.BootstrapAdmin(ctx, &authv1.BootstrapAdminRequest{
+		Username: req.Username,
+		Email:    req.Email,
+		Password: req.Password,
+	})
+	if err != nil {
+		return 0, err
+	}
+	return snowflake.ParseInt64(admin.GetUid()), nil
+}
+
+func toAuthV1User(user auth.User) *authv1.User {
+	return &authv1.User{
+		OpenID:        user.GetOpenID(),
+		Name:          user.GetName(),
+		Nickname:      user.GetNickname(),
+		Email:         user.GetEmail(),
+		Avatar:        user.GetAvatar(),
+		App:           auth.UserProviderName(user),
+		Raw:           user.GetRaw(),
+		Remark:        user.GetRemark(),
+		EmailVerified: user.IsEmailVerified(),
+	}
+}
+
+func toAuthV1LinkPolicy(linkPolicy config.OAuth2_LinkPolicy) authv1.LinkPolicy {
+	switch linkPolicy {
+	case config.OAuth2_EXPLICIT:
+		return authv1.LinkPolicy_LINK_POLICY_EXPLICIT
+	default:
+		return authv1.LinkPolicy_LINK_POLICY_VERIFIED_EMAIL
 	}
-	return reply.GetRedirectURL(), nil
 }
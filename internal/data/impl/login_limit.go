@@ -0,0 +1,101 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewLoginLimitRepository(repo authv1.Repository) repository.LoginLimit {
+	return &loginLimitRepository{repo: repo}
+}
+
+type loginLimitRepository struct {
+	repo authv1.Repository
+}
+
+// Hit implements [repository.LoginLimit], the window is counted in whole seconds.
+func (l *loginLimitRepository) Hit(ctx context.Context, key string, window time.Duration) (int64, error) {
+	seconds := int64(window / time.Second)
+	if seconds < 1 {
+		seconds = 1
+	}
+	limit, err := l.repo.HitLoginLimit(ctx, &authv1.HitLoginLimitRequest{Key: key, Window: seconds})
+	if err != nil {
+		return 0, err
+	}
+	return limit.GetCount(), nil
+}
+
+// Lock implements [repository.LoginLimit].
+func (l *loginLimitRepository) Lock(ctx context.Context, key string, until time.Time) error {
+	_, err := l.repo.LockLoginLimit(ctx, &authv1.LockLoginLimitRequest{Key: key, LockedUntil: until.Unix()})
+	return err
+}
+
+// LockedUntil implements [repository.LoginLimit].
+func (l *loginLimitRepository) LockedUntil(ctx context.Context, key string) (time.Time, error) {
+	limit, err := l.repo.GetLoginLimit(ctx, &authv1.GetLoginLimitRequest{Key: key})
+	if err != nil {
+		return time.Time{}, err
+	}
+	if limit.GetLockedUntil() == 0 {
+		return time.Time{}, nil
+	}
+	return time.Unix(limit.GetLockedUntil(), 0), nil
+}
+
+// Reset implements [repository.LoginLimit].
+func (l *loginLimitRepository) Reset(ctx context.Context, key string) error {
+	_, err := l.repo.ResetLoginLimit(ctx, &authv1.ResetLoginLimitRequest{Key: key})
+	return err
+}
+
+// CreateLoginAuditEvent implements [repository.LoginLimit].
+func (l *loginLimitRepository) CreateLoginAuditEvent(ctx context.Context, event *bo.LoginAuditEventBo) error {
+	_, err := l.repo.CreateLoginAuditEvent(ctx, &authv1.CreateLoginAuditEventRequest{
+		Event: &authv1.LoginAuditEventModel{
+			Action:      event.Action,
+			Kind:        event.Kind,
+			Subject:     event.Subject,
+			Provider:    event.Provider,
+			Ip:          event.IP,
+			Failures:    event.Failures,
+			LockedUntil: event.LockedUntil.Unix(),
+		},
+	})
+	return err
+}
+
+// ListLoginAuditEvents implements [repository.LoginLimit].
+func (l *loginLimitRepository) ListLoginAuditEvents(ctx context.Context, req *bo.ListLoginAuditEventsBo) (*bo.PageResponseBo[*bo.LoginAuditEventBo], error) {
+	listResponse, err := l.repo.ListLoginAuditEvents(ctx, &authv1.ListLoginAuditEventsRequest{
+		Subject:  req.Subject,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.LoginAuditEventBo, 0, len(listResponse.GetItems()))
+	for _, event := range listResponse.GetItems() {
+		items = append(items, &bo.LoginAuditEventBo{
+			ID:          event.GetId(),
+			Action:      event.GetAction(),
+			Kind:        event.GetKind(),
+			Subject:     event.GetSubject(),
+			Provider:    event.GetProvider(),
+			IP:          event.GetIp(),
+			Failures:    event.GetFailures(),
+			LockedUntil: time.Unix(event.GetLockedUntil(), 0),
+			CreatedAt:   time.Unix(event.GetCreatedAt(), 0),
+		})
+	}
+	req.WithTotal(listResponse.GetTotal())
+	req.WithCursors(parseAuthPageCursor(listResponse.GetPrevCursor()), parseAuthPageCursor(listResponse.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
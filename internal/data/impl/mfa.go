@@ -0,0 +1,130 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewMFARepository(repo authv1.Repository) repository.MFA {
+	return &mfaRepository{repo: repo}
+}
+
+type mfaRepository struct {
+	repo authv1.Repository
+}
+
+// GetUserMFA implements [repository.MFA].
+func (m *mfaRepository) GetUserMFA(ctx context.Context, userUID snowflake.ID) (*bo.UserMFABo, error) {
+	mfa, err := m.repo.GetUserMFA(ctx, &authv1.GetUserMFARequest{UserUID: userUID.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserMFAModel(mfa), nil
+}
+
+// SaveUserMFA implements [repository.MFA].
+func (m *mfaRepository) SaveUserMFA(ctx context.Context, userUID snowflake.ID, secret string) (*bo.UserMFABo, error) {
+	mfa, err := m.repo.SaveUserMFA(ctx, &authv1.SaveUserMFARequest{UserUID: userUID.Int64(), Secret: secret})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserMFAModel(mfa), nil
+}
+
+// EnableUserMFA implements [repository.MFA].
+func (m *mfaRepository) EnableUserMFA(ctx context.Context, userUID snowflake.ID, step int64, recoveryCodeHashes []string) (*bo.UserMFABo, error) {
+	mfa, err := m.repo.EnableUserMFA(ctx, &authv1.EnableUserMFARequest{
+		UserUID:            userUID.Int64(),
+		Step:               step,
+		RecoveryCodeHashes: recoveryCodeHashes,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserMFAModel(mfa), nil
+}
+
+// DeleteUserMFA implements [repository.MFA].
+func (m *mfaRepository) DeleteUserMFA(ctx context.Context, userUID snowflake.ID) error {
+	_, err := m.repo.DeleteUserMFA(ctx, &authv1.DeleteUserMFARequest{UserUID: userUID.Int64()})
+	return err
+}
+
+// SaveRecoveryCodes implements [repository.MFA].
+func (m *mfaRepository) SaveRecoveryCodes(ctx context.Context, userUID snowflake.ID, recoveryCodeHashes []string) (*bo.UserMFABo, error) {
+	mfa, err := m.repo.SaveMFARecoveryCodes(ctx, &authv1.SaveMFARecoveryCodesRequest{UserUID: userUID.Int64(), RecoveryCodeHashes: recoveryCodeHashes})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserMFAModel(mfa), nil
+}
+
+// UseTOTPStep implements [repository.MFA].
+func (m *mfaRepository) UseTOTPStep(ctx context.Context, userUID snowflake.ID, step int64) (bool, error) {
+	reply, err := m.repo.UseMFACode(ctx, &authv1.UseMFACodeRequest{UserUID: userUID.Int64(), Step: step})
+	if err != nil {
+		return false, err
+	}
+	return reply.GetAccepted(), nil
+}
+
+// UseRecoveryCode implements [repository.MFA].
+func (m *mfaRepository) UseRecoveryCode(ctx context.Context, userUID snowflake.ID, codeHash string) (bool, error) {
+	reply, err := m.repo.UseMFACode(ctx, &authv1.UseMFACodeRequest{UserUID: userUID.Int64(), RecoveryCodeHash: codeHash})
+	if err != nil {
+		return false, err
+	}
+	return reply.GetAccepted(), nil
+}
+
+// GetChallenge implements [repository.MFA].
+func (m *mfaRepository) GetChallenge(ctx context.Context, challenge string) (*bo.MFAChallengeBo, error) {
+	challengeModel, err := m.repo.GetMFAChallenge(ctx, &authv1.GetMFAChallengeRequest{Challenge: challenge})
+	if err != nil {
+		return nil, err
+	}
+	return parseMFAChallengeModel(challengeModel), nil
+}
+
+// FailChallenge implements [repository.MFA].
+func (m *mfaRepository) FailChallenge(ctx context.Context, challenge string) (*bo.MFAChallengeBo, error) {
+	challengeModel, err := m.repo.FailMFAChallenge(ctx, &authv1.FailMFAChallengeRequest{Challenge: challenge})
+	if err != nil {
+		return nil, err
+	}
+	return parseMFAChallengeModel(challengeModel), nil
+}
+
+// CompleteChallenge implements [repository.MFA].
+func (m *mfaRepository) CompleteChallenge(ctx context.Context, challenge, method string) (*bo.TokenBo, error) {
+	tokenModel, err := m.repo.CompleteMFAChallenge(ctx, &authv1.CompleteMFAChallengeRequest{Challenge: challenge, Method: method})
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenModel(tokenModel), nil
+}
+
+func parseUserMFAModel(mfa *authv1.UserMFAModel) *bo.UserMFABo {
+	return &bo.UserMFABo{
+		UserUID:       snowflake.ParseInt64(mfa.GetUserUID()),
+		Secret:        mfa.GetSecret(),
+		Enabled:       mfa.GetEnabled(),
+		RecoveryCodes: mfa.GetRecoveryCodes(),
+		EnabledAt:     time.Unix(mfa.GetEnabledAt(), 0),
+	}
+}
+
+func parseMFAChallengeModel(challenge *authv1.MFAChallengeModel) *bo.MFAChallengeBo {
+	return &bo.MFAChallengeBo{
+		UserUID:   snowflake.ParseInt64(challenge.GetUserUID()),
+		AMR:       challenge.GetAmr(),
+		Attempts:  challenge.GetAttempts(),
+		ExpiresAt: time.Unix(challenge.GetExpiresAt(), 0),
+	}
+}
@@ -46,9 +46,14 @@ type namespaceRepository struct {
 // CreateNamespace implements [repository.Namespace].
 func (n *namespaceRepository) CreateNamespace(ctx context.Context, req *bo.CreateNamespaceBo) error {
 	_, err := n.repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{
-		Name:     req.Name,
-		Metadata: req.Metadata,
-		Status:   enum.GlobalStatus(req.Status),
+		Name:        req.Name,
+		Metadata:    req.Metadata,
+		Status:      enum.GlobalStatus(req.Status),
+		Operator:    req.Operator.Int64(),
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Owners:      bo.OwnersToInt64s(req.Owners),
+		Icon:        req.Icon,
 	})
 	if err != nil {
 		return err
@@ -91,11 +96,18 @@ func (n *namespaceRepository) GetNamespaceByName(ctx context.Context, name strin
 
 // ListNamespace implements [repository.Namespace].
 func (n *namespaceRepository) ListNamespace(ctx context.Context, req *bo.ListNamespaceBo) (*bo.PageResponseBo[*bo.NamespaceItemBo], error) {
+	order := namespacev1.Order_ASC
+	if req.Desc {
+		order = namespacev1.Order_DESC
+	}
 	listNamespaceResponse, err := n.repo.ListNamespace(ctx, &namespacev1.ListNamespaceRequest{
 		Page:     req.Page,
 		PageSize: req.PageSize,
 		Keyword:  req.Keyword,
 		Status:   enum.GlobalStatus(req.Status),
+		OrderBy:  namespaceOrderFields[req.OrderBy],
+		Order:    order,
+		Cursor:   toPageCursor(req.Cursor),
 	})
 	if err != nil {
 		return nil, err
@@ -105,6 +117,7 @@ func (n *namespaceRepository) ListNamespace(ctx context.Context, req *bo.ListNam
 		items = append(items, parseNamespaceModel(namespaceModel))
 	}
 	req.WithTotal(listNamespaceResponse.Total)
+	req.WithCursors(parsePageCursor(listNamespaceResponse.PrevCursor), parsePageCursor(listNamespaceResponse.NextCursor))
 	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
 }
 
@@ -112,9 +125,10 @@ func (n *namespaceRepository) ListNamespace(ctx context.Context, req *bo.ListNam
 func (n *namespaceRepository) SelectNamespace(ctx context.Context, req *bo.SelectNamespaceBo) (*bo.SelectNamespaceBoResult, error) {
 	selectNamespaceResponse, err := n.repo.SelectNamespace(ctx, &namespacev1.SelectNamespaceRequest{
 		Keyword: req.Keyword,
-		Limit:   req.Limit,
+		Limit:   req.PageSize,
 		LastUID: req.LastUID.Int64(),
 		Status:  enum.GlobalStatus(req.Status),
+		Cursor:  toPageCursor(req.Cursor),
 	})
 	if err != nil {
 		return nil, err
@@ -123,6 +137,7 @@ func (n *namespaceRepository) SelectNamespace(ctx context.Context, req *bo.Selec
 	for _, namespaceItemSelect := range selectNamespaceResponse.Items {
 		items = append(items, parseNamespaceItemSelect(namespaceItemSelect))
 	}
+	req.WithCursors(parsePageCursor(selectNamespaceResponse.PrevCursor), parsePageCursor(selectNamespaceResponse.NextCursor))
 	return &bo.SelectNamespaceBoResult{
 		Items:   items,
 		Total:   selectNamespaceResponse.Total,
@@ -131,24 +146,164 @@ func (n *namespaceRepository) SelectNamespace(ctx context.Context, req *bo.Selec
 	}, nil
 }
 
+// GetNamespaceStats implements [repository.Namespace].
+func (n *namespaceRepository) GetNamespaceStats(ctx context.Context, req *bo.GetNamespaceStatsBo) (*bo.NamespaceStatsBo, error) {
+	stats, err := n.repo.GetNamespaceStats(ctx, &namespacev1.GetNamespaceStatsRequest{
+		StartAt: req.StartAt().Unix(),
+		EndAt:   req.EndAt().Unix(),
+		TopN:    req.TopN,
+	})
+	if err != nil {
+		return nil, err
+	}
+	statsBo := &bo.NamespaceStatsBo{
+		StartDate:       req.StartDate,
+		EndDate:         req.EndDate,
+		Total:           stats.Total,
+		StatusCounts:    make([]*bo.NamespaceStatusCountBo, 0, len(stats.StatusCounts)),
+		DailyCreations:  make([]*bo.NamespaceDailyCountBo, 0, len(stats.DailyCreations)),
+		TopCreators:     make([]*bo.NamespaceCreatorCountBo, 0, len(stats.TopCreators)),
+		LargestMetadata: make([]*bo.NamespaceMetadataSizeBo, 0, len(stats.LargestMetadata)),
+	}
+	for _, item := range stats.StatusCounts {
+		statsBo.StatusCounts = append(statsBo.StatusCounts, &bo.NamespaceStatusCountBo{Status: vobj.GlobalStatus(item.Status), Count: item.Count})
+	}
+	for _, item := range stats.DailyCreations {
+		statsBo.DailyCreations = append(statsBo.DailyCreations, &bo.NamespaceDailyCountBo{Date: item.Date, Count: item.Count})
+	}
+	for _, item := range stats.TopCreators {
+		statsBo.TopCreators = append(statsBo.TopCreators, &bo.NamespaceCreatorCountBo{Creator: snowflake.ParseInt64(item.Creator), Count: item.Count})
+	}
+	for _, item := range stats.LargestMetadata {
+		statsBo.LargestMetadata = append(statsBo.LargestMetadata, &bo.NamespaceMetadataSizeBo{UID: snowflake.ParseInt64(item.Uid), Name: item.Name, Size: item.Size})
+	}
+	return statsBo, nil
+}
+
 // UpdateNamespace implements [repository.Namespace].
 func (n *namespaceRepository) UpdateNamespace(ctx context.Context, req *bo.UpdateNamespaceBo) error {
-	panic("unimplemented")
+	_, err := n.repo.UpdateNamespace(ctx, &namespacev1.UpdateNamespaceRequest{
+		Uid:         req.UID.Int64(),
+		Name:        req.Name,
+		Metadata:    req.Metadata,
+		Operator:    req.Operator.Int64(),
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Owners:      bo.OwnersToInt64s(req.Owners),
+		Icon:        req.Icon,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
 }
 
 // UpdateNamespaceStatus implements [repository.Namespace].
 func (n *namespaceRepository) UpdateNamespaceStatus(ctx context.Context, req *bo.UpdateNamespaceStatusBo) error {
-	panic("unimplemented")
+	_, err := n.repo.UpdateNamespaceStatus(ctx, &namespacev1.UpdateNamespaceStatusRequest{
+		Uid:      req.UID.Int64(),
+		Status:   enum.GlobalStatus(req.Status),
+		Operator: req.Operator.Int64(),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListNamespaceRevisions implements [repository.Namespace].
+func (n *namespaceRepository) ListNamespaceRevisions(ctx context.Context, req *bo.ListNamespaceRevisionsBo) (*bo.PageResponseBo[*bo.NamespaceRevisionItemBo], error) {
+	listResponse, err := n.repo.ListNamespaceRevisions(ctx, &namespacev1.ListNamespaceRevisionsRequest{
+		NamespaceUid: req.NamespaceUID.Int64(),
+		Page:         req.Page,
+		PageSize:     req.PageSize,
+		Cursor:       toPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.NamespaceRevisionItemBo, 0, len(listResponse.Revisions))
+	for _, revisionModel := range listResponse.Revisions {
+		items = append(items, parseNamespaceRevisionModel(revisionModel))
+	}
+	req.WithTotal(listResponse.Total)
+	req.WithCursors(parsePageCursor(listResponse.PrevCursor), parsePageCursor(listResponse.NextCursor))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// GetNamespaceRevision implements [repository.Namespace].
+func (n *namespaceRepository) GetNamespaceRevision(ctx context.Context, namespaceUID snowflake.ID, revision int32) (*bo.NamespaceRevisionItemBo, error) {
+	revisionModel, err := n.repo.GetNamespaceRevision(ctx, &namespacev1.GetNamespaceRevisionRequest{
+		NamespaceUid: namespaceUID.Int64(),
+		Revision:     revision,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseNamespaceRevisionModel(revisionModel), nil
+}
+
+// RollbackNamespace implements [repository.Namespace].
+func (n *namespaceRepository) RollbackNamespace(ctx context.Context, req *bo.RollbackNamespaceBo) (*bo.NamespaceRevisionItemBo, error) {
+	revisionModel, err := n.repo.RollbackNamespace(ctx, &namespacev1.RollbackNamespaceRequest{
+		NamespaceUid: req.NamespaceUID.Int64(),
+		Revision:     req.Revision,
+		Operator:     req.Operator.Int64(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseNamespaceRevisionModel(revisionModel), nil
+}
+
+// TransferNamespaceOwnership implements [repository.Namespace].
+func (n *namespaceRepository) TransferNamespaceOwnership(ctx context.Context, req *bo.TransferNamespaceOwnershipBo) (*bo.NamespaceRevisionItemBo, error) {
+	revisionModel, err := n.repo.TransferNamespaceOwnership(ctx, &namespacev1.TransferNamespaceOwnershipRequest{
+		Uid:           req.NamespaceUID.Int64(),
+		NewOwner:      req.NewOwner.Int64(),
+		PreviousOwner: req.PreviousOwner.Int64(),
+		Operator:      req.Operator.Int64(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseNamespaceRevisionModel(revisionModel), nil
+}
+
+var namespaceOrderFields = map[vobj.NamespaceOrderField]namespacev1.Field{
+	vobj.NamespaceOrderFieldUID:       namespacev1.Field_UID,
+	vobj.NamespaceOrderFieldName:      namespacev1.Field_NAME,
+	vobj.NamespaceOrderFieldCreatedAt: namespacev1.Field_CREATED_AT,
+	vobj.NamespaceOrderFieldUpdatedAt: namespacev1.Field_UPDATED_AT,
+}
+
+func toPageCursor(cursor *bo.PageCursorBo) *namespacev1.PageCursor {
+	if cursor == nil {
+		return nil
+	}
+	return &namespacev1.PageCursor{Value: cursor.Value, Uid: cursor.UID, Backward: cursor.Backward}
+}
+
+func parsePageCursor(cursor *namespacev1.PageCursor) *bo.PageCursorBo {
+	if cursor == nil {
+		return nil
+	}
+	return &bo.PageCursorBo{Value: cursor.Value, UID: cursor.Uid, Backward: cursor.Backward}
 }
 
 func parseNamespaceModel(namespaceModel *namespacev1.NamespaceModel) *bo.NamespaceItemBo {
 	return &bo.NamespaceItemBo{
-		UID:       snowflake.ParseInt64(namespaceModel.Uid),
-		Name:      namespaceModel.Name,
-		Metadata:  namespaceModel.Metadata,
-		Status:    vobj.GlobalStatus(namespaceModel.Status),
-		CreatedAt: time.Unix(namespaceModel.CreatedAt, 0),
-		UpdatedAt: time.Unix(namespaceModel.UpdatedAt, 0),
+		UID:         snowflake.ParseInt64(namespaceModel.Uid),
+		Name:        namespaceModel.Name,
+		Metadata:    namespaceModel.Metadata,
+		Status:      vobj.GlobalStatus(namespaceModel.Status),
+		DisplayName: namespaceModel.DisplayName,
+		Description: namespaceModel.Description,
+		Owners:      bo.ParseOwners(namespaceModel.Owners),
+		Icon:        namespaceModel.Icon,
+		Creator:     snowflake.ParseInt64(namespaceModel.Creator),
+		CreatedAt:   time.Unix(namespaceModel.CreatedAt, 0),
+		UpdatedAt:   time.Unix(namespaceModel.UpdatedAt, 0),
 	}
 }
 
@@ -160,3 +315,22 @@ func parseNamespaceItemSelect(namespaceItemSelect *namespacev1.NamespaceItemSele
 		Tooltip:  namespaceItemSelect.Tooltip,
 	}
 }
+
+func parseNamespaceRevisionModel(revisionModel *namespacev1.NamespaceRevisionModel) *bo.NamespaceRevisionItemBo {
+	return &bo.NamespaceRevisionItemBo{
+		UID:            snowflake.ParseInt64(revisionModel.Uid),
+		NamespaceUID:   snowflake.ParseInt64(revisionModel.NamespaceUid),
+		Revision:       revisionModel.Revision,
+		Name:           revisionModel.Name,
+		Metadata:       revisionModel.Metadata,
+		Status:         vobj.GlobalStatus(revisionModel.Status),
+		Action:         vobj.NamespaceRevisionAction(revisionModel.Action),
+		SourceRevision: revisionModel.SourceRevision,
+		DisplayName:    revisionModel.DisplayName,
+		Description:    revisionModel.Description,
+		Owners:         bo.ParseOwners(revisionModel.Owners),
+		Icon:           revisionModel.Icon,
+		Creator:        snowflake.ParseInt64(revisionModel.Creator),
+		CreatedAt:      time.Unix(revisionModel.CreatedAt, 0),
+	}
+}
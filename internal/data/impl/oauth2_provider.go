@@ -0,0 +1,136 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/pkg/config"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewOAuth2ProviderRepository(repo authv1.Repository) repository.OAuth2Provider {
+	return &oauth2ProviderRepository{repo: repo}
+}
+
+type oauth2ProviderRepository struct {
+	repo authv1.Repository
+}
+
+// CreateOAuth2Provider implements [repository.OAuth2Provider].
+func (o *oauth2ProviderRepository) CreateOAuth2Provider(ctx context.Context, req *bo.OAuth2ProviderBo) (*bo.OAuth2ProviderBo, error) {
+	providerConfig, err := marshalOAuth2ProviderConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := o.repo.CreateOAuth2Provider(ctx, &authv1.CreateOAuth2ProviderRequest{
+		Name:         req.Name,
+		App:          req.Config.GetApp().String(),
+		Config:       providerConfig,
+		ClientSecret: req.ClientSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseOAuth2ProviderModel(provider)
+}
+
+// UpdateOAuth2Provider implements [repository.OAuth2Provider].
+func (o *oauth2ProviderRepository) UpdateOAuth2Provider(ctx context.Context, req *bo.OAuth2ProviderBo) (*bo.OAuth2ProviderBo, error) {
+	providerConfig, err := marshalOAuth2ProviderConfig(req.Config)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := o.repo.UpdateOAuth2Provider(ctx, &authv1.UpdateOAuth2ProviderRequest{
+		Uid:          req.UID.Int64(),
+		App:          req.Config.GetApp().String(),
+		Config:       providerConfig,
+		ClientSecret: req.ClientSecret,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseOAuth2ProviderModel(provider)
+}
+
+// UpdateOAuth2ProviderStatus implements [repository.OAuth2Provider].
+func (o *oauth2ProviderRepository) UpdateOAuth2ProviderStatus(ctx context.Context, req *bo.UpdateOAuth2ProviderStatusBo) (*bo.OAuth2ProviderBo, error) {
+	provider, err := o.repo.UpdateOAuth2ProviderStatus(ctx, &authv1.UpdateOAuth2ProviderStatusRequest{Uid: req.UID.Int64(), Status: int32(req.Status)})
+	if err != nil {
+		return nil, err
+	}
+	return parseOAuth2ProviderModel(provider)
+}
+
+// DeleteOAuth2Provider implements [repository.OAuth2Provider].
+func (o *oauth2ProviderRepository) DeleteOAuth2Provider(ctx context.Context, uid snowflake.ID) error {
+	_, err := o.repo.DeleteOAuth2Provider(ctx, &authv1.DeleteOAuth2ProviderRequest{Uid: uid.Int64()})
+	return err
+}
+
+// GetOAuth2Provider implements [repository.OAuth2Provider].
+func (o *oauth2ProviderRepository) GetOAuth2Provider(ctx context.Context, uid snowflake.ID) (*bo.OAuth2ProviderBo, error) {
+	provider, err := o.repo.GetOAuth2Provider(ctx, &authv1.GetOAuth2ProviderRequest{Uid: uid.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	return parseOAuth2ProviderModel(provider)
+}
+
+// ListOAuth2Providers implements [repository.OAuth2Provider].
+func (o *oauth2ProviderRepository) ListOAuth2Providers(ctx context.Context, req *bo.ListOAuth2ProvidersBo) (*bo.PageResponseBo[*bo.OAuth2ProviderBo], error) {
+	reply, err := o.repo.ListOAuth2Providers(ctx, &authv1.ListOAuth2ProvidersRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.OAuth2ProviderBo, 0, len(reply.GetItems()))
+	for _, item := range reply.GetItems() {
+		provider, err := parseOAuth2ProviderModel(item)
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, provider)
+	}
+	req.WithTotal(reply.GetTotal())
+	req.WithCursors(parseAuthPageCursor(reply.GetPrevCursor()), parseAuthPageCursor(reply.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// marshalOAuth2ProviderConfig stores the config without its client secret, which is stored encrypted next to it.
+func marshalOAuth2ProviderConfig(providerConfig *config.OAuth2_Config) (string, error) {
+	providerConfig = proto.Clone(providerConfig).(*config.OAuth2_Config)
+	providerConfig.ClientSecret = ""
+	data, err := protojson.Marshal(providerConfig)
+	if err != nil {
+		return "", merr.ErrorInternal("marshal oauth2 provider config failed").WithCause(err)
+	}
+	return string(data), nil
+}
+
+func parseOAuth2ProviderModel(provider *authv1.OAuth2ProviderModel) (*bo.OAuth2ProviderBo, error) {
+	providerConfig := &config.OAuth2_Config{}
+	if err := protojson.Unmarshal([]byte(provider.GetConfig()), providerConfig); err != nil {
+		return nil, merr.ErrorInternal("oauth2 provider %s config is invalid", provider.GetName()).WithCause(err)
+	}
+	return &bo.OAuth2ProviderBo{
+		UID:          snowflake.ParseInt64(provider.GetUid()),
+		Name:         provider.GetName(),
+		Config:       providerConfig,
+		ClientSecret: provider.GetClientSecret(),
+		Status:       vobj.GlobalStatus(provider.GetStatus()),
+		Source:       bo.OAuth2ProviderSourceAPI,
+		CreatedAt:    time.Unix(provider.GetCreatedAt(), 0),
+		UpdatedAt:    time.Unix(provider.GetUpdatedAt(), 0),
+	}, nil
+}
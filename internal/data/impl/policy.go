@@ -0,0 +1,98 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewPolicyRepository(repo authv1.Repository) repository.Policy {
+	return &policyRepository{repo: repo}
+}
+
+type policyRepository struct {
+	repo authv1.Repository
+}
+
+// CreatePolicy implements [repository.Policy].
+func (p *policyRepository) CreatePolicy(ctx context.Context, req *bo.SavePolicyBo) (*bo.PolicyBo, error) {
+	policy, err := p.repo.CreatePolicy(ctx, &authv1.CreatePolicyRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Operations:  req.Operations,
+		Expression:  req.Expression,
+		Message:     req.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parsePolicyModel(policy), nil
+}
+
+// UpdatePolicy implements [repository.Policy].
+func (p *policyRepository) UpdatePolicy(ctx context.Context, req *bo.SavePolicyBo) (*bo.PolicyBo, error) {
+	policy, err := p.repo.UpdatePolicy(ctx, &authv1.UpdatePolicyRequest{
+		Uid:         req.UID.Int64(),
+		Description: req.Description,
+		Operations:  req.Operations,
+		Expression:  req.Expression,
+		Message:     req.Message,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parsePolicyModel(policy), nil
+}
+
+// GetPolicy implements [repository.Policy].
+func (p *policyRepository) GetPolicy(ctx context.Context, uid snowflake.ID) (*bo.PolicyBo, error) {
+	policy, err := p.repo.GetPolicy(ctx, &authv1.GetPolicyRequest{Uid: uid.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	return parsePolicyModel(policy), nil
+}
+
+// DeletePolicy implements [repository.Policy].
+func (p *policyRepository) DeletePolicy(ctx context.Context, uid snowflake.ID) error {
+	_, err := p.repo.DeletePolicy(ctx, &authv1.DeletePolicyRequest{Uid: uid.Int64()})
+	return err
+}
+
+// ListPolicies implements [repository.Policy].
+func (p *policyRepository) ListPolicies(ctx context.Context, req *bo.ListPoliciesBo) (*bo.PageResponseBo[*bo.PolicyBo], error) {
+	reply, err := p.repo.ListPolicies(ctx, &authv1.ListPoliciesRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.PolicyBo, 0, len(reply.GetItems()))
+	for _, item := range reply.GetItems() {
+		items = append(items, parsePolicyModel(item))
+	}
+	req.WithTotal(reply.GetTotal())
+	req.WithCursors(parseAuthPageCursor(reply.GetPrevCursor()), parseAuthPageCursor(reply.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+func parsePolicyModel(policy *authv1.PolicyModel) *bo.PolicyBo {
+	return &bo.PolicyBo{
+		UID:         snowflake.ParseInt64(policy.GetUid()),
+		Name:        policy.GetName(),
+		Description: policy.GetDescription(),
+		Operations:  policy.GetOperations(),
+		Expression:  policy.GetExpression(),
+		Message:     policy.GetMessage(),
+		Source:      bo.PolicySourceAPI,
+		CreatedAt:   time.Unix(policy.GetCreatedAt(), 0),
+		UpdatedAt:   time.Unix(policy.GetUpdatedAt(), 0),
+	}
+}
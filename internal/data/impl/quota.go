@@ -0,0 +1,143 @@
+package impl
+
+import (
+	_ "github.com/aide-family/sovereign/pkg/domain/quota/v1/fileimpl"
+	_ "github.com/aide-family/sovereign/pkg/domain/quota/v1/gormimpl"
+
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data"
+	"github.com/aide-family/sovereign/pkg/domain"
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func NewQuotaRepository(c *conf.Bootstrap, d *data.Data) (repository.Quota, error) {
+	repoConfig := c.GetQuotaConfig()
+	version := repoConfig.GetVersion()
+	driver := repoConfig.GetDriver()
+	switch version {
+	default:
+		factory, ok := domain.GetQuotaV1Factory(driver)
+		if !ok {
+			return nil, merr.ErrorInternalServer("quota repository factory not found")
+		}
+		repoImpl, close, err := factory(repoConfig)
+		if err != nil {
+			return nil, err
+		}
+		d.AppendClose("quotaRepo", close)
+		return &quotaRepository{repo: repoImpl}, nil
+	}
+}
+
+type quotaRepository struct {
+	repo quotav1.Repository
+}
+
+// SetQuota implements [repository.Quota].
+func (q *quotaRepository) SetQuota(ctx context.Context, req *bo.SetQuotaBo) (*bo.QuotaItemBo, error) {
+	quotaModel, err := q.repo.SetQuota(ctx, &quotav1.SetQuotaRequest{
+		NamespaceUid: req.NamespaceUID.Int64(),
+		Resource:     req.Resource,
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseQuotaModel(quotaModel), nil
+}
+
+// GetQuota implements [repository.Quota].
+func (q *quotaRepository) GetQuota(ctx context.Context, namespaceUID snowflake.ID, resource string) (*bo.QuotaItemBo, error) {
+	quotaModel, err := q.repo.GetQuota(ctx, &quotav1.GetQuotaRequest{
+		NamespaceUid: namespaceUID.Int64(),
+		Resource:     resource,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseQuotaModel(quotaModel), nil
+}
+
+// DeleteQuota implements [repository.Quota].
+func (q *quotaRepository) DeleteQuota(ctx context.Context, namespaceUID snowflake.ID, resource string) error {
+	_, err := q.repo.DeleteQuota(ctx, &quotav1.DeleteQuotaRequest{
+		NamespaceUid: namespaceUID.Int64(),
+		Resource:     resource,
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// DeleteNamespaceQuotas implements [repository.Quota].
+func (q *quotaRepository) DeleteNamespaceQuotas(ctx context.Context, namespaceUID snowflake.ID) error {
+	_, err := q.repo.DeleteNamespaceQuotas(ctx, &quotav1.DeleteNamespaceQuotasRequest{
+		NamespaceUid: namespaceUID.Int64(),
+	})
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// ListQuota implements [repository.Quota].
+func (q *quotaRepository) ListQuota(ctx context.Context, namespaceUID snowflake.ID) ([]*bo.QuotaItemBo, error) {
+	listQuotaResponse, err := q.repo.ListQuota(ctx, &quotav1.ListQuotaRequest{
+		NamespaceUid: namespaceUID.Int64(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.QuotaItemBo, 0, len(listQuotaResponse.Quotas))
+	for _, quotaModel := range listQuotaResponse.Quotas {
+		items = append(items, parseQuotaModel(quotaModel))
+	}
+	return items, nil
+}
+
+// Reserve implements [repository.Quota].
+func (q *quotaRepository) Reserve(ctx context.Context, req *bo.QuotaUsageBo) (*bo.QuotaItemBo, error) {
+	quotaModel, err := q.repo.Reserve(ctx, &quotav1.ReserveRequest{
+		NamespaceUid: req.NamespaceUID.Int64(),
+		Resource:     req.Resource,
+		Amount:       req.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseQuotaModel(quotaModel), nil
+}
+
+// Release implements [repository.Quota].
+func (q *quotaRepository) Release(ctx context.Context, req *bo.QuotaUsageBo) (*bo.QuotaItemBo, error) {
+	quotaModel, err := q.repo.Release(ctx, &quotav1.ReleaseRequest{
+		NamespaceUid: req.NamespaceUID.Int64(),
+		Resource:     req.Resource,
+		Amount:       req.Amount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseQuotaModel(quotaModel), nil
+}
+
+func parseQuotaModel(quotaModel *quotav1.QuotaModel) *bo.QuotaItemBo {
+	return &bo.QuotaItemBo{
+		UID:          snowflake.ParseInt64(quotaModel.Uid),
+		NamespaceUID: snowflake.ParseInt64(quotaModel.NamespaceUid),
+		Resource:     quotaModel.Resource,
+		Limit:        quotaModel.Limit,
+		Used:         quotaModel.Used,
+		CreatedAt:    time.Unix(quotaModel.CreatedAt, 0),
+		UpdatedAt:    time.Unix(quotaModel.UpdatedAt, 0),
+	}
+}
@@ -0,0 +1,188 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewRBACRepository(repo authv1.Repository) repository.RBAC {
+	return &rbacRepository{repo: repo}
+}
+
+type rbacRepository struct {
+	repo authv1.Repository
+}
+
+// CreateRole implements [repository.RBAC].
+func (r *rbacRepository) CreateRole(ctx context.Context, req *bo.SaveRoleBo) (*bo.RoleBo, error) {
+	role, err := r.repo.CreateRole(ctx, &authv1.CreateRoleRequest{
+		Name:        req.Name,
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseRoleModel(role), nil
+}
+
+// UpdateRole implements [repository.RBAC].
+func (r *rbacRepository) UpdateRole(ctx context.Context, req *bo.SaveRoleBo) (*bo.RoleBo, error) {
+	role, err := r.repo.UpdateRole(ctx, &authv1.UpdateRoleRequest{
+		Uid:         req.UID.Int64(),
+		Description: req.Description,
+		Permissions: req.Permissions,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseRoleModel(role), nil
+}
+
+// DeleteRole implements [repository.RBAC].
+func (r *rbacRepository) DeleteRole(ctx context.Context, uid snowflake.ID) error {
+	_, err := r.repo.DeleteRole(ctx, &authv1.DeleteRoleRequest{Uid: uid.Int64()})
+	return err
+}
+
+// GetRole implements [repository.RBAC].
+func (r *rbacRepository) GetRole(ctx context.Context, uid snowflake.ID) (*bo.RoleBo, error) {
+	role, err := r.repo.GetRole(ctx, &authv1.GetRoleRequest{Uid: uid.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	return parseRoleModel(role), nil
+}
+
+// ListRoles implements [repository.RBAC].
+func (r *rbacRepository) ListRoles(ctx context.Context, req *bo.ListRolesBo) (*bo.PageResponseBo[*bo.RoleBo], error) {
+	reply, err := r.repo.ListRoles(ctx, &authv1.ListRolesRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := parseRoleModels(reply.GetItems())
+	req.WithTotal(reply.GetTotal())
+	req.WithCursors(parseAuthPageCursor(reply.GetPrevCursor()), parseAuthPageCursor(reply.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// EnsureBuiltinRoles implements [repository.RBAC].
+func (r *rbacRepository) EnsureBuiltinRoles(ctx context.Context, roles []*bo.RoleBo) ([]*bo.RoleBo, error) {
+	req := &authv1.EnsureBuiltinRolesRequest{Roles: make([]*authv1.RoleModel, 0, len(roles))}
+	for _, role := range roles {
+		req.Roles = append(req.Roles, &authv1.RoleModel{
+			Name:        role.Name,
+			Description: role.Description,
+			Permissions: role.Permissions,
+		})
+	}
+	reply, err := r.repo.EnsureBuiltinRoles(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return parseRoleModels(reply.GetItems()), nil
+}
+
+// CreateRoleBinding implements [repository.RBAC].
+func (r *rbacRepository) CreateRoleBinding(ctx context.Context, req *bo.CreateRoleBindingBo) (*bo.RoleBindingBo, error) {
+	binding, err := r.repo.CreateRoleBinding(ctx, &authv1.CreateRoleBindingRequest{
+		UserUID:   req.UserUID.Int64(),
+		RoleUID:   req.RoleUID.Int64(),
+		Namespace: req.Namespace,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseRoleBindingModel(binding), nil
+}
+
+// DeleteRoleBinding implements [repository.RBAC].
+func (r *rbacRepository) DeleteRoleBinding(ctx context.Context, uid snowflake.ID) error {
+	_, err := r.repo.DeleteRoleBinding(ctx, &authv1.DeleteRoleBindingRequest{Uid: uid.Int64()})
+	return err
+}
+
+// ListRoleBindings implements [repository.RBAC].
+func (r *rbacRepository) ListRoleBindings(ctx context.Context, req *bo.ListRoleBindingsBo) (*bo.PageResponseBo[*bo.RoleBindingBo], error) {
+	reply, err := r.repo.ListRoleBindings(ctx, &authv1.ListRoleBindingsRequest{
+		UserUID:   req.UserUID.Int64(),
+		RoleUID:   req.RoleUID.Int64(),
+		Namespace: req.Namespace,
+		Page:      req.Page,
+		PageSize:  req.PageSize,
+		Cursor:    toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.RoleBindingBo, 0, len(reply.GetItems()))
+	for _, item := range reply.GetItems() {
+		items = append(items, parseRoleBindingModel(item))
+	}
+	req.WithTotal(reply.GetTotal())
+	req.WithCursors(parseAuthPageCursor(reply.GetPrevCursor()), parseAuthPageCursor(reply.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// SetRoleMFA implements [repository.RBAC].
+func (r *rbacRepository) SetRoleMFA(ctx context.Context, uid snowflake.ID, requireMFA bool) (*bo.RoleBo, error) {
+	role, err := r.repo.SetRoleMFA(ctx, &authv1.SetRoleMFARequest{Uid: uid.Int64(), RequireMfa: requireMFA})
+	if err != nil {
+		return nil, err
+	}
+	return parseRoleModel(role), nil
+}
+
+// GetUserPermissions implements [repository.RBAC].
+func (r *rbacRepository) GetUserPermissions(ctx context.Context, userUID snowflake.ID, namespace string, mfa bool) (*bo.UserPermissionsBo, error) {
+	reply, err := r.repo.GetUserPermissions(ctx, &authv1.GetUserPermissionsRequest{UserUID: userUID.Int64(), Namespace: namespace, Mfa: mfa})
+	if err != nil {
+		return nil, err
+	}
+	return &bo.UserPermissionsBo{Roles: reply.GetRoles(), Permissions: reply.GetPermissions()}, nil
+}
+
+func parseRoleModel(role *authv1.RoleModel) *bo.RoleBo {
+	return &bo.RoleBo{
+		UID:         snowflake.ParseInt64(role.GetUid()),
+		Name:        role.GetName(),
+		Description: role.GetDescription(),
+		Permissions: role.GetPermissions(),
+		Builtin:     role.GetBuiltin(),
+		RequireMFA:  role.GetRequireMfa(),
+		CreatedAt:   time.Unix(role.GetCreatedAt(), 0),
+		UpdatedAt:   time.Unix(role.GetUpdatedAt(), 0),
+	}
+}
+
+func parseRoleModels(roles []*authv1.RoleModel) []*bo.RoleBo {
+	items := make([]*bo.RoleBo, 0, len(roles))
+	for _, role := range roles {
+		items = append(items, parseRoleModel(role))
+	}
+	return items
+}
+
+func parseRoleBindingModel(binding *authv1.RoleBindingModel) *bo.RoleBindingBo {
+	item := &bo.RoleBindingBo{
+		UID:       snowflake.ParseInt64(binding.GetUid()),
+		UserUID:   snowflake.ParseInt64(binding.GetUserUID()),
+		Username:  binding.GetUsername(),
+		Namespace: binding.GetNamespace(),
+		CreatedAt: time.Unix(binding.GetCreatedAt(), 0),
+	}
+	if binding.GetRole() != nil {
+		item.Role = parseRoleModel(binding.GetRole())
+	}
+	return item
+}
@@ -0,0 +1,58 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewTokenRepository(repo authv1.Repository) repository.Token {
+	return &tokenRepository{repo: repo}
+}
+
+type tokenRepository struct {
+	repo authv1.Repository
+}
+
+// RefreshToken implements [repository.Token].
+func (t *tokenRepository) RefreshToken(ctx context.Context, refreshToken string) (*bo.TokenBo, error) {
+	tokenModel, err := t.repo.RefreshToken(ctx, &authv1.RefreshTokenRequest{RefreshToken: refreshToken})
+	if err != nil {
+		return nil, err
+	}
+	return parseTokenModel(tokenModel), nil
+}
+
+// RevokeToken implements [repository.Token].
+func (t *tokenRepository) RevokeToken(ctx context.Context, req *bo.RevokeTokenBo) error {
+	_, err := t.repo.RevokeToken(ctx, &authv1.RevokeTokenRequest{
+		RefreshToken: req.RefreshToken,
+		TokenID:      req.TokenID,
+		Uid:          req.UID.Int64(),
+		ExpiresAt:    req.ExpiresAt.Unix(),
+	})
+	return err
+}
+
+// IsTokenRevoked implements [repository.Token].
+func (t *tokenRepository) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	reply, err := t.repo.IsTokenRevoked(ctx, &authv1.IsTokenRevokedRequest{TokenID: tokenID})
+	if err != nil {
+		return false, err
+	}
+	return reply.GetRevoked(), nil
+}
+
+func parseTokenModel(tokenModel *authv1.TokenModel) *bo.TokenBo {
+	return &bo.TokenBo{
+		Token:                 tokenModel.GetToken(),
+		ExpiresAt:             time.Unix(tokenModel.GetExpiresAt(), 0),
+		RefreshToken:          tokenModel.GetRefreshToken(),
+		RefreshExpiresAt:      time.Unix(tokenModel.GetRefreshExpiresAt(), 0),
+		MFAChallenge:          tokenModel.GetMfaChallenge(),
+		MFAChallengeExpiresAt: time.Unix(tokenModel.GetMfaChallengeExpiresAt(), 0),
+	}
+}
@@ -0,0 +1,226 @@
+package impl
+
+import (
+	"context"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/repository"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func NewUserRepository(repo authv1.Repository) repository.User {
+	return &userRepository{repo: repo}
+}
+
+type userRepository struct {
+	repo authv1.Repository
+}
+
+// GetUser implements [repository.User].
+func (u *userRepository) GetUser(ctx context.Context, uid snowflake.ID) (*bo.UserItemBo, error) {
+	userModel, err := u.repo.GetUser(ctx, &authv1.GetUserRequest{Uid: uid.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserModel(userModel), nil
+}
+
+// GetIdentityUser implements [repository.User].
+func (u *userRepository) GetIdentityUser(ctx context.Context, app, openID string) (*bo.UserItemBo, error) {
+	userModel, err := u.repo.GetUser(ctx, &authv1.GetUserRequest{App: app, OpenID: openID})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserModel(userModel), nil
+}
+
+// ListUsers implements [repository.User].
+func (u *userRepository) ListUsers(ctx context.Context, req *bo.ListUsersBo) (*bo.PageResponseBo[*bo.UserItemBo], error) {
+	listResponse, err := u.repo.ListUsers(ctx, &authv1.ListUsersRequest{
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Keyword:  req.Keyword,
+		Status:   int32(req.Status),
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.UserItemBo, 0, len(listResponse.GetItems()))
+	for _, userModel := range listResponse.GetItems() {
+		items = append(items, parseUserModel(userModel))
+	}
+	req.WithTotal(listResponse.GetTotal())
+	req.WithCursors(parseAuthPageCursor(listResponse.GetPrevCursor()), parseAuthPageCursor(listResponse.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// UpdateUser implements [repository.User].
+func (u *userRepository) UpdateUser(ctx context.Context, req *bo.UpdateUserBo) (*bo.UserItemBo, error) {
+	userModel, err := u.repo.UpdateUser(ctx, &authv1.UpdateUserRequest{
+		Uid:      req.UID.Int64(),
+		Nickname: req.Nickname,
+		Avatar:   req.Avatar,
+		Remark:   req.Remark,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserModel(userModel), nil
+}
+
+// UpdateUserStatus implements [repository.User].
+func (u *userRepository) UpdateUserStatus(ctx context.Context, req *bo.UpdateUserStatusBo) (*bo.UserItemBo, error) {
+	userModel, err := u.repo.UpdateUserStatus(ctx, &authv1.UpdateUserStatusRequest{Uid: req.UID.Int64(), Status: int32(req.Status)})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserModel(userModel), nil
+}
+
+// UpdateUserEmail implements [repository.User].
+func (u *userRepository) UpdateUserEmail(ctx context.Context, uid snowflake.ID, email string) (*bo.UserItemBo, error) {
+	userModel, err := u.repo.UpdateUserEmail(ctx, &authv1.UpdateUserEmailRequest{Uid: uid.Int64(), Email: email})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserModel(userModel), nil
+}
+
+// ListUserIdentities implements [repository.User].
+func (u *userRepository) ListUserIdentities(ctx context.Context, userUID snowflake.ID) ([]*bo.IdentityBo, error) {
+	reply, err := u.repo.ListUserIdentities(ctx, &authv1.ListUserIdentitiesRequest{UserUID: userUID.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.IdentityBo, 0, len(reply.GetItems()))
+	for _, identity := range reply.GetItems() {
+		items = append(items, parseIdentityModel(identity))
+	}
+	return items, nil
+}
+
+// UnlinkUserIdentity implements [repository.User].
+func (u *userRepository) UnlinkUserIdentity(ctx context.Context, operator, userUID snowflake.ID, identityID uint32) error {
+	_, err := u.repo.UnlinkUserIdentity(ctx, &authv1.UnlinkUserIdentityRequest{
+		UserUID:     userUID.Int64(),
+		Id:          identityID,
+		OperatorUID: operator.Int64(),
+	})
+	return err
+}
+
+// ListIdentityAuditEvents implements [repository.User].
+func (u *userRepository) ListIdentityAuditEvents(ctx context.Context, req *bo.ListIdentityAuditEventsBo) (*bo.PageResponseBo[*bo.IdentityAuditEventBo], error) {
+	listResponse, err := u.repo.ListIdentityAuditEvents(ctx, &authv1.ListIdentityAuditEventsRequest{
+		UserUID:  req.UserUID.Int64(),
+		Page:     req.Page,
+		PageSize: req.PageSize,
+		Cursor:   toAuthPageCursor(req.Cursor),
+	})
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*bo.IdentityAuditEventBo, 0, len(listResponse.GetItems()))
+	for _, event := range listResponse.GetItems() {
+		items = append(items, &bo.IdentityAuditEventBo{
+			ID:            event.GetId(),
+			UserUID:       snowflake.ParseInt64(event.GetUserUID()),
+			ActorUID:      snowflake.ParseInt64(event.GetActorUID()),
+			Action:        event.GetAction(),
+			Reason:        event.GetReason(),
+			App:           event.GetApp(),
+			OpenID:        event.GetOpenID(),
+			Email:         event.GetEmail(),
+			EmailVerified: event.GetEmailVerified(),
+			CreatedAt:     time.Unix(event.GetCreatedAt(), 0),
+		})
+	}
+	req.WithTotal(listResponse.GetTotal())
+	req.WithCursors(parseAuthPageCursor(listResponse.GetPrevCursor()), parseAuthPageCursor(listResponse.GetNextCursor()))
+	return bo.NewPageResponseBo(req.PageRequestBo, items), nil
+}
+
+// GetUserPreferences implements [repository.User].
+func (u *userRepository) GetUserPreferences(ctx context.Context, userUID snowflake.ID) (*bo.UserPreferencesBo, error) {
+	preferences, err := u.repo.GetUserPreferences(ctx, &authv1.GetUserPreferencesRequest{UserUID: userUID.Int64()})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserPreferencesModel(preferences), nil
+}
+
+// SaveUserPreferences implements [repository.User].
+func (u *userRepository) SaveUserPreferences(ctx context.Context, req *bo.UserPreferencesBo) (*bo.UserPreferencesBo, error) {
+	preferences, err := u.repo.SaveUserPreferences(ctx, &authv1.SaveUserPreferencesRequest{
+		UserUID:          req.UserUID.Int64(),
+		DefaultNamespace: req.DefaultNamespace,
+		Locale:           req.Locale,
+		Timezone:         req.Timezone,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return parseUserPreferencesModel(preferences), nil
+}
+
+// toAuthPageCursor converts the cursor of a list of the auth domain, ordered by the id of the rows.
+func toAuthPageCursor(cursor *bo.PageCursorBo) *authv1.PageCursor {
+	if cursor == nil {
+		return nil
+	}
+	return &authv1.PageCursor{Id: uint32(cursor.UID), Backward: cursor.Backward}
+}
+
+func parseAuthPageCursor(cursor *authv1.PageCursor) *bo.PageCursorBo {
+	if cursor == nil {
+		return nil
+	}
+	return &bo.PageCursorBo{UID: int64(cursor.GetId()), Backward: cursor.GetBackward()}
+}
+
+func parseUserPreferencesModel(preferences *authv1.UserPreferencesModel) *bo.UserPreferencesBo {
+	preferencesBo := &bo.UserPreferencesBo{
+		UserUID:          snowflake.ParseInt64(preferences.GetUserUID()),
+		DefaultNamespace: preferences.GetDefaultNamespace(),
+		Locale:           preferences.GetLocale(),
+		Timezone:         preferences.GetTimezone(),
+	}
+	if preferences.GetUpdatedAt() > 0 {
+		preferencesBo.UpdatedAt = time.Unix(preferences.GetUpdatedAt(), 0)
+	}
+	return preferencesBo
+}
+
+func parseUserModel(userModel *authv1.UserModel) *bo.UserItemBo {
+	return &bo.UserItemBo{
+		UID:           snowflake.ParseInt64(userModel.Uid),
+		Name:          userModel.Name,
+		Nickname:      userModel.Nickname,
+		Email:         userModel.Email,
+		Avatar:        userModel.Avatar,
+		Remark:        userModel.Remark,
+		Status:        vobj.GlobalStatus(userModel.Status),
+		CreatedAt:     time.Unix(userModel.CreatedAt, 0),
+		UpdatedAt:     time.Unix(userModel.UpdatedAt, 0),
+		EmailVerified: userModel.EmailVerified,
+	}
+}
+
+func parseIdentityModel(identity *authv1.IdentityModel) *bo.IdentityBo {
+	return &bo.IdentityBo{
+		ID:        identity.GetId(),
+		UserUID:   snowflake.ParseInt64(identity.GetUserUID()),
+		App:       identity.GetApp(),
+		OpenID:    identity.GetOpenID(),
+		Name:      identity.GetName(),
+		Email:     identity.GetEmail(),
+		Avatar:    identity.GetAvatar(),
+		CreatedAt: time.Unix(identity.GetCreatedAt(), 0),
+		UpdatedAt: time.Unix(identity.GetUpdatedAt(), 0),
+	}
+}
@@ -18,21 +18,36 @@ import (
 )
 
 // NewHTTPServer new an HTTP server.
-func NewHTTPServer(bc *conf.Bootstrap, namespaceService *service.NamespaceService, helper *klog.Helper) *http.Server {
-	return newHTTPServer(bc.GetServer().GetHttp(), bc.GetJwt(), namespaceService, helper)
+func NewHTTPServer(bc *conf.Bootstrap, namespaceService *service.NamespaceService, authService *service.AuthService, rbacService *service.RBACService, policyService *service.PolicyService, userService *service.UserService, helper *klog.Helper) (*http.Server, error) {
+	keySet, err := authv1.LoadKeySet(bc.GetJwt())
+	if err != nil {
+		return nil, err
+	}
+	return newHTTPServer(bc.GetServer().GetHttp(), keySet, bc.GetMfa().GetRequiredOperations(), namespaceService, authService, rbacService, policyService, userService, helper), nil
 }
 
-func newHTTPServer(httpConf conf.ServerConfig, jwtConf conf.JWTConfig, namespaceService *service.NamespaceService, helper *klog.Helper) *http.Server {
+func newHTTPServer(httpConf conf.ServerConfig, keySet *authv1.KeySet, mfaOperations []string, namespaceService *service.NamespaceService, authService *service.AuthService, rbacService *service.RBACService, policyService *service.PolicyService, userService *service.UserService, helper *klog.Helper) *http.Server {
 	selectorNamespaceMiddlewares := []middleware.Middleware{
-		sovereignMiddler.MustNamespace(),
+		sovereignMiddler.MustNamespace(userService.GetDefaultNamespace),
+		sovereignMiddler.MustAPIKeyNamespace(unscopedNamespaceOperations...),
 		sovereignMiddler.MustNamespaceExist(namespaceService.HasNamespace),
 	}
 	namespaceMiddleware := selector.Server(selectorNamespaceMiddlewares...).Match(middler.AllowListMatcher(namespaceAllowList...)).Build()
+	selectorNamespaceRequestMiddlewares := []middleware.Middleware{
+		sovereignMiddler.BindRequestNamespace(namespaceService.GetNamespaceName),
+		sovereignMiddler.MustAPIKeyNamespace(unscopedNamespaceOperations...),
+	}
+	namespaceRequestMiddleware := selector.Server(selectorNamespaceRequestMiddlewares...).Prefix(namespaceOperationPrefix).Build()
+	permissionMiddleware := selector.Server(sovereignMiddler.MustPermission(rbacService.GetUserPermissions)).Match(middler.AllowListMatcher(permissionAllowList...)).Build()
 	selectorMustAuthMiddlewares := []middleware.Middleware{
-		sovereignMiddler.JwtServe(jwtConf.GetSecret(), &authv1.JwtClaims{}),
-		sovereignMiddler.MustLogin(),
+		sovereignMiddler.APIKeyServe(sovereignMiddler.JwtServe(keySet, &authv1.JwtClaims{}, authService.VerifyIdentity), authService.VerifyAPIKey),
+		sovereignMiddler.MustLogin(authService.IsTokenRevoked, userService.IsUserDisabled),
 		sovereignMiddler.BindJwtToken(),
 		namespaceMiddleware,
+		namespaceRequestMiddleware,
+		permissionMiddleware,
+		sovereignMiddler.MustMFA(mfaOperations),
+		sovereignMiddler.MustPolicy(policyService.Authorize),
 	}
 	authMiddleware := selector.Server(selectorMustAuthMiddlewares...).Match(middler.AllowListMatcher(authAllowList...)).Build()
 
@@ -3,12 +3,14 @@ package server
 
 import (
 	"embed"
+	stdjson "encoding/json"
 	nethttp "net/http"
 	"strings"
 
 	_ "github.com/aide-family/sovereign/pkg/api/auth/feishu"
 	_ "github.com/aide-family/sovereign/pkg/api/auth/gitee"
 	_ "github.com/aide-family/sovereign/pkg/api/auth/github"
+	_ "github.com/aide-family/sovereign/pkg/api/auth/oidc"
 
 	"buf.build/go/protoyaml"
 	"github.com/go-kratos/kratos/v2/encoding"
@@ -17,6 +19,7 @@ import (
 	"github.com/go-kratos/kratos/v2/transport/grpc"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/google/wire"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"go.yaml.in/yaml/v2"
 	"google.golang.org/protobuf/encoding/protojson"
@@ -27,6 +30,7 @@ import (
 	"github.com/aide-family/sovereign/pkg/api"
 	"github.com/aide-family/sovereign/pkg/api/auth"
 	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
 )
 
 //go:embed swagger
@@ -141,6 +145,15 @@ func BindMetrics(httpSrv *http.Server, bc *conf.Bootstrap) {
 	api.BindHandlerWithAuth(httpSrv, binding)
 }
 
+// registerCollector registers the collector to the default prometheus registry exposed by BindMetrics.
+func registerCollector(collector prometheus.Collector) {
+	if err := prometheus.Register(collector); err != nil {
+		if _, ok := err.(prometheus.AlreadyRegisteredError); !ok {
+			panic(err)
+		}
+	}
+}
+
 // RegisterService registers the service.
 func RegisterService(
 	c *conf.Bootstrap,
@@ -149,6 +162,12 @@ func RegisterService(
 	authService *service.AuthService,
 	healthService *service.HealthService,
 	namespaceService *service.NamespaceService,
+	quotaService *service.QuotaService,
+	rbacService *service.RBACService,
+	policyService *service.PolicyService,
+	userService *service.UserService,
+	oauth2ProviderService *service.OAuth2ProviderService,
+	invitationService *service.InvitationService,
 ) Servers {
 	var srvs Servers
 
@@ -156,10 +175,23 @@ func RegisterService(
 		authService,
 		healthService,
 		namespaceService,
+		quotaService,
+		rbacService,
+		policyService,
+		userService,
+		oauth2ProviderService,
+		invitationService,
 	)...)
 	srvs = append(srvs, RegisterGRPCService(c, grpcSrv,
+		authService,
 		healthService,
 		namespaceService,
+		quotaService,
+		rbacService,
+		policyService,
+		userService,
+		oauth2ProviderService,
+		invitationService,
 	)...)
 	return srvs
 }
@@ -171,14 +203,37 @@ func RegisterHTTPService(
 	authService *service.AuthService,
 	healthService *service.HealthService,
 	namespaceService *service.NamespaceService,
+	quotaService *service.QuotaService,
+	rbacService *service.RBACService,
+	policyService *service.PolicyService,
+	userService *service.UserService,
+	oauth2ProviderService *service.OAuth2ProviderService,
+	invitationService *service.InvitationService,
 ) Servers {
+	apiv1.RegisterAuthHTTPServer(httpSrv, authService)
 	apiv1.RegisterHealthHTTPServer(httpSrv, healthService)
 	apiv1.RegisterNamespaceHTTPServer(httpSrv, namespaceService)
+	apiv1.RegisterQuotaHTTPServer(httpSrv, quotaService)
+	apiv1.RegisterRBACHTTPServer(httpSrv, rbacService)
+	apiv1.RegisterPolicyHTTPServer(httpSrv, policyService)
+	apiv1.RegisterUserHTTPServer(httpSrv, userService)
+	apiv1.RegisterOAuth2ProviderHTTPServer(httpSrv, oauth2ProviderService)
+	apiv1.RegisterInvitationHTTPServer(httpSrv, invitationService)
+	registerCollector(namespaceService.NamespaceStatsCollector())
 
-	oauth2Handler := auth.NewOAuth2Handler(c.GetOauth2(), authService.Login)
+	oauth2Handler := auth.NewOAuth2Handler(c.GetOauth2(), authService.Login,
+		auth.BindStateSecret(c.GetJwt().GetSecret()),
+		auth.BindProviderSource(oauth2ProviderService.EnabledOAuth2Providers),
+		auth.BindLoginLimiter(authService.LoginLimiter()),
+	)
 	if err := oauth2Handler.Handler(httpSrv); err != nil {
 		panic(err)
 	}
+	keySet, err := authv1.LoadKeySet(c.GetJwt())
+	if err != nil {
+		panic(err)
+	}
+	httpSrv.HandleFunc(jwksPath, jwksHandler(keySet))
 	return Servers{newServer("http", httpSrv)}
 }
 
@@ -186,14 +241,60 @@ func RegisterHTTPService(
 func RegisterGRPCService(
 	c *conf.Bootstrap,
 	grpcSrv *grpc.Server,
+	authService *service.AuthService,
 	healthService *service.HealthService,
 	namespaceService *service.NamespaceService,
+	quotaService *service.QuotaService,
+	rbacService *service.RBACService,
+	policyService *service.PolicyService,
+	userService *service.UserService,
+	oauth2ProviderService *service.OAuth2ProviderService,
+	invitationService *service.InvitationService,
 ) Servers {
+	apiv1.RegisterAuthServer(grpcSrv, authService)
 	apiv1.RegisterHealthServer(grpcSrv, healthService)
 	apiv1.RegisterNamespaceServer(grpcSrv, namespaceService)
+	apiv1.RegisterQuotaServer(grpcSrv, quotaService)
+	apiv1.RegisterRBACServer(grpcSrv, rbacService)
+	apiv1.RegisterPolicyServer(grpcSrv, policyService)
+	apiv1.RegisterUserServer(grpcSrv, userService)
+	apiv1.RegisterOAuth2ProviderServer(grpcSrv, oauth2ProviderService)
+	apiv1.RegisterInvitationServer(grpcSrv, invitationService)
 	return Servers{newServer("grpc", grpcSrv)}
 }
 
+// jwksPath publishes the public keys verifying the access tokens, for the services trusting this issuer.
+const jwksPath = "/.well-known/jwks.json"
+
+func jwksHandler(keySet *authv1.KeySet) nethttp.HandlerFunc {
+	return func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodGet && r.Method != nethttp.MethodHead {
+			w.Header().Set("Allow", "GET, HEAD")
+			nethttp.Error(w, nethttp.StatusText(nethttp.StatusMethodNotAllowed), nethttp.StatusMethodNotAllowed)
+			return
+		}
+		body, err := stdjson.Marshal(keySet.JWKS())
+		if err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/jwk-set+json")
+		w.Header().Set("Cache-Control", "public, max-age=300")
+		_, _ = w.Write(body)
+	}
+}
+
+// namespaceOperationPrefix selects the operations on a namespace, which bind the namespace of the uid of the request.
+const namespaceOperationPrefix = "/sovereign.api.v1.Namespace/"
+
+// unscopedNamespaceOperations act across namespaces, an API key restricted to namespaces can not call them.
+var unscopedNamespaceOperations = []string{
+	apiv1.OperationNamespaceCreateNamespace,
+	apiv1.OperationNamespaceListNamespace,
+	apiv1.OperationNamespaceSelectNamespace,
+	apiv1.OperationNamespaceGetNamespaceStats,
+}
+
 var namespaceAllowList = []string{
 	apiv1.OperationNamespaceCreateNamespace,
 	apiv1.OperationNamespaceUpdateNamespace,
@@ -201,10 +302,89 @@ var namespaceAllowList = []string{
 	apiv1.OperationNamespaceDeleteNamespace,
 	apiv1.OperationNamespaceGetNamespace,
 	apiv1.OperationNamespaceListNamespace,
+	apiv1.OperationNamespaceGetNamespaceStats,
+	apiv1.OperationNamespaceListNamespaceRevisions,
+	apiv1.OperationNamespaceGetNamespaceRevision,
+	apiv1.OperationNamespaceDiffNamespaceRevisions,
+	apiv1.OperationNamespaceRollbackNamespace,
+	apiv1.OperationNamespaceTransferNamespaceOwnership,
 	apiv1.OperationHealthHealthCheck,
+	apiv1.OperationAuthPasswordLogin,
+	apiv1.OperationAuthLDAPLogin,
+	apiv1.OperationAuthChangePassword,
+	apiv1.OperationAuthCreateAPIKey,
+	apiv1.OperationAuthListAPIKeys,
+	apiv1.OperationAuthRevokeAPIKey,
+	apiv1.OperationAuthRefreshToken,
+	apiv1.OperationAuthLogout,
+	apiv1.OperationAuthRevokeToken,
+	apiv1.OperationAuthGetMe,
+	apiv1.OperationAuthUpdatePreferences,
+	apiv1.OperationAuthLinkIdentity,
+	apiv1.OperationAuthUpdateEmail,
+	apiv1.OperationAuthVerifyMFA,
+	apiv1.OperationAuthGetMFA,
+	apiv1.OperationAuthEnrollTOTP,
+	apiv1.OperationAuthConfirmTOTP,
+	apiv1.OperationAuthRegenerateRecoveryCodes,
+	apiv1.OperationAuthDisableMFA,
+	apiv1.OperationRBACCreateRole,
+	apiv1.OperationRBACUpdateRole,
+	apiv1.OperationRBACDeleteRole,
+	apiv1.OperationRBACGetRole,
+	apiv1.OperationRBACListRoles,
+	apiv1.OperationRBACCreateRoleBinding,
+	apiv1.OperationRBACDeleteRoleBinding,
+	apiv1.OperationRBACListRoleBindings,
+	apiv1.OperationRBACSetRoleMFA,
+	apiv1.OperationPolicyCreatePolicy,
+	apiv1.OperationPolicyUpdatePolicy,
+	apiv1.OperationPolicyDeletePolicy,
+	apiv1.OperationPolicyListPolicies,
+	apiv1.OperationPolicyExplainPolicy,
+	apiv1.OperationUserListUsers,
+	apiv1.OperationUserGetUser,
+	apiv1.OperationUserUpdateUser,
+	apiv1.OperationUserUpdateUserStatus,
+	apiv1.OperationUserListUserIdentities,
+	apiv1.OperationUserUnlinkUserIdentity,
+	apiv1.OperationUserListIdentityAuditEvents,
+	apiv1.OperationUserListLoginAuditEvents,
+	apiv1.OperationUserResetUserMFA,
+	apiv1.OperationOAuth2ProviderCreateOAuth2Provider,
+	apiv1.OperationOAuth2ProviderUpdateOAuth2Provider,
+	apiv1.OperationOAuth2ProviderUpdateOAuth2ProviderStatus,
+	apiv1.OperationOAuth2ProviderDeleteOAuth2Provider,
+	apiv1.OperationOAuth2ProviderListOAuth2Providers,
+	apiv1.OperationInvitationCreateInvitation,
+	apiv1.OperationInvitationListInvitations,
+	apiv1.OperationInvitationDeleteInvitation,
 }
 
 var authAllowList = []string{
 	apiv1.OperationHealthHealthCheck,
 	auth.OperationOAuth2Reports,
+	apiv1.OperationAuthPasswordLogin,
+	apiv1.OperationAuthLDAPLogin,
+	apiv1.OperationAuthRefreshToken,
+	apiv1.OperationAuthRevokeToken,
+	apiv1.OperationAuthVerifyMFA,
+}
+
+// permissionAllowList lists the operations every signed-in user may call on its own account, the others require a permission.
+var permissionAllowList = []string{
+	apiv1.OperationAuthChangePassword,
+	apiv1.OperationAuthCreateAPIKey,
+	apiv1.OperationAuthListAPIKeys,
+	apiv1.OperationAuthRevokeAPIKey,
+	apiv1.OperationAuthLogout,
+	apiv1.OperationAuthGetMe,
+	apiv1.OperationAuthUpdatePreferences,
+	apiv1.OperationAuthLinkIdentity,
+	apiv1.OperationAuthUpdateEmail,
+	apiv1.OperationAuthGetMFA,
+	apiv1.OperationAuthEnrollTOTP,
+	apiv1.OperationAuthConfirmTOTP,
+	apiv1.OperationAuthRegenerateRecoveryCodes,
+	apiv1.OperationAuthDisableMFA,
 }
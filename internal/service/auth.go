@@ -1,21 +1,210 @@
 package service
 
 import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"golang.org/x/oauth2"
 
 	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
 	"github.com/aide-family/sovereign/pkg/api/auth"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
 )
 
 type AuthService struct {
-	loginBiz *biz.LoginBiz
+	apiv1.UnimplementedAuthServer
+
+	loginBiz  *biz.LoginBiz
+	tokenBiz  *biz.Token
+	apiKeyBiz *biz.APIKey
+	userBiz   *biz.User
+	mfaBiz    *biz.MFA
+}
+
+func NewAuthService(loginBiz *biz.LoginBiz, tokenBiz *biz.Token, apiKeyBiz *biz.APIKey, userBiz *biz.User, mfaBiz *biz.MFA) *AuthService {
+	return &AuthService{loginBiz: loginBiz, tokenBiz: tokenBiz, apiKeyBiz: apiKeyBiz, userBiz: userBiz, mfaBiz: mfaBiz}
+}
+
+func (s *AuthService) Login(ctx http.Context, oauthConfig *oauth2.Config, user auth.User, loginState *auth.LoginState) (string, error) {
+	return s.loginBiz.Login(ctx, oauthConfig, user, loginState)
+}
+
+// LoginLimiter limits the OAuth2 login and callback routes like the password logins, nil when the login rate limit is not enabled.
+func (s *AuthService) LoginLimiter() *auth.LoginLimiter {
+	return s.loginBiz.Limiter()
+}
+
+func (s *AuthService) LinkIdentity(ctx context.Context, req *apiv1.LinkIdentityRequest) (*apiv1.LinkIdentityReply, error) {
+	loginURL, err := s.loginBiz.LinkLoginURL(ctx, req.GetApp(), req.GetReturnTo())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.LinkIdentityReply{LoginURL: loginURL}, nil
+}
+
+func (s *AuthService) RefreshToken(ctx context.Context, req *apiv1.RefreshTokenRequest) (*apiv1.TokenReply, error) {
+	tokenBo, err := s.tokenBiz.RefreshToken(ctx, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	return tokenBo.ToAPIV1TokenReply(), nil
+}
+
+func (s *AuthService) Logout(ctx context.Context, req *apiv1.LogoutRequest) (*apiv1.LogoutReply, error) {
+	if err := s.tokenBiz.Logout(ctx, req.GetRefreshToken()); err != nil {
+		return nil, err
+	}
+	return &apiv1.LogoutReply{}, nil
+}
+
+func (s *AuthService) RevokeToken(ctx context.Context, req *apiv1.RevokeTokenRequest) (*apiv1.RevokeTokenReply, error) {
+	if err := s.tokenBiz.RevokeToken(ctx, req.GetRefreshToken()); err != nil {
+		return nil, err
+	}
+	return &apiv1.RevokeTokenReply{}, nil
+}
+
+// IsTokenRevoked is consulted by the login middleware for every authenticated request.
+func (s *AuthService) IsTokenRevoked(ctx context.Context, tokenID string) (bool, error) {
+	return s.tokenBiz.IsTokenRevoked(ctx, tokenID)
+}
+
+func (s *AuthService) PasswordLogin(ctx context.Context, req *apiv1.PasswordLoginRequest) (*apiv1.TokenReply, error) {
+	tokenBo, err := s.loginBiz.PasswordLogin(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return tokenBo.ToAPIV1TokenReply(), nil
+}
+
+func (s *AuthService) LDAPLogin(ctx context.Context, req *apiv1.PasswordLoginRequest) (*apiv1.TokenReply, error) {
+	tokenBo, err := s.loginBiz.LDAPLogin(ctx, req.GetUsername(), req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	return tokenBo.ToAPIV1TokenReply(), nil
+}
+
+func (s *AuthService) VerifyMFA(ctx context.Context, req *apiv1.VerifyMFARequest) (*apiv1.TokenReply, error) {
+	tokenBo, err := s.mfaBiz.VerifyChallenge(ctx, req.GetChallenge(), req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return tokenBo.ToAPIV1TokenReply(), nil
+}
+
+func (s *AuthService) GetMFA(ctx context.Context, req *apiv1.GetMFARequest) (*apiv1.MFAItem, error) {
+	mfa, err := s.mfaBiz.GetMFA(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return mfa.ToAPIV1MFAItem(), nil
+}
+
+func (s *AuthService) EnrollTOTP(ctx context.Context, req *apiv1.EnrollTOTPRequest) (*apiv1.EnrollTOTPReply, error) {
+	enrolled, err := s.mfaBiz.EnrollTOTP(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return enrolled.ToAPIV1EnrollTOTPReply(), nil
+}
+
+func (s *AuthService) ConfirmTOTP(ctx context.Context, req *apiv1.ConfirmTOTPRequest) (*apiv1.RecoveryCodesReply, error) {
+	recoveryCodes, err := s.mfaBiz.ConfirmTOTP(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.RecoveryCodesReply{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthService) RegenerateRecoveryCodes(ctx context.Context, req *apiv1.MFACodeRequest) (*apiv1.RecoveryCodesReply, error) {
+	recoveryCodes, err := s.mfaBiz.RegenerateRecoveryCodes(ctx, req.GetCode())
+	if err != nil {
+		return nil, err
+	}
+	return &apiv1.RecoveryCodesReply{RecoveryCodes: recoveryCodes}, nil
+}
+
+func (s *AuthService) DisableMFA(ctx context.Context, req *apiv1.MFACodeRequest) (*apiv1.DisableMFAReply, error) {
+	if err := s.mfaBiz.DisableMFA(ctx, req.GetCode()); err != nil {
+		return nil, err
+	}
+	return &apiv1.DisableMFAReply{}, nil
+}
+
+func (s *AuthService) ChangePassword(ctx context.Context, req *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordReply, error) {
+	if err := s.loginBiz.ChangePassword(ctx, req.GetOldPassword(), req.GetNewPassword()); err != nil {
+		return nil, err
+	}
+	return &apiv1.ChangePasswordReply{}, nil
+}
+
+func (s *AuthService) CreateAPIKey(ctx context.Context, req *apiv1.CreateAPIKeyRequest) (*apiv1.CreateAPIKeyReply, error) {
+	created, err := s.apiKeyBiz.CreateAPIKey(ctx, bo.NewCreateAPIKeyBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return created.ToAPIV1CreateAPIKeyReply(), nil
+}
+
+func (s *AuthService) ListAPIKeys(ctx context.Context, req *apiv1.ListAPIKeysRequest) (*apiv1.ListAPIKeysReply, error) {
+	pageResponseBo, err := s.apiKeyBiz.ListAPIKeys(ctx, bo.NewListAPIKeysBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListAPIKeysReply(pageResponseBo), nil
+}
+
+func (s *AuthService) RevokeAPIKey(ctx context.Context, req *apiv1.RevokeAPIKeyRequest) (*apiv1.RevokeAPIKeyReply, error) {
+	if err := s.apiKeyBiz.RevokeAPIKey(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.RevokeAPIKeyReply{}, nil
+}
+
+// VerifyAPIKey is consulted by the login middleware for requests sent with an API key instead of a JWT.
+func (s *AuthService) VerifyAPIKey(ctx context.Context, key string) (*authv1.JwtClaims, *authv1.APIKeyInfo, error) {
+	apiKey, err := s.apiKeyBiz.VerifyAPIKey(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+	claims := &authv1.JwtClaims{BaseInfo: authv1.BaseInfo{UID: apiKey.UserUID, Username: apiKey.Username}}
+	info := &authv1.APIKeyInfo{UID: apiKey.UID, Scopes: apiKey.Scopes, Namespaces: apiKey.Namespaces}
+	return claims, info, nil
+}
+
+// VerifyIdentity is consulted by the login middleware for the tokens of a trusted issuer, the subject is signed in as the user it is linked to.
+func (s *AuthService) VerifyIdentity(ctx context.Context, provider, subject string) (authv1.BaseInfo, error) {
+	user, err := s.userBiz.GetIdentityUser(ctx, provider, subject)
+	if err != nil {
+		return authv1.BaseInfo{}, err
+	}
+	return authv1.BaseInfo{UID: user.UID, Username: user.Email}, nil
+}
+
+func (s *AuthService) GetMe(ctx context.Context, req *apiv1.GetMeRequest) (*apiv1.GetMeReply, error) {
+	profile, err := s.userBiz.GetMe(ctx, operatorFromContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return profile.ToAPIV1GetMeReply(), nil
 }
 
-func NewAuthService(loginBiz *biz.LoginBiz) *AuthService {
-	return &AuthService{loginBiz: loginBiz}
+func (s *AuthService) UpdatePreferences(ctx context.Context, req *apiv1.UpdatePreferencesRequest) (*apiv1.PreferencesItem, error) {
+	preferences, err := s.userBiz.UpdatePreferences(ctx, bo.NewUserPreferencesBo(operatorFromContext(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return preferences.ToAPIV1PreferencesItem(), nil
 }
 
-func (s *AuthService) Login(ctx http.Context, oauthConfig *oauth2.Config, user auth.User) (string, error) {
-	return s.loginBiz.Login(ctx, oauthConfig, user)
+func (s *AuthService) UpdateEmail(ctx context.Context, req *apiv1.UpdateEmailRequest) (*apiv1.UserItem, error) {
+	user, err := s.userBiz.UpdateEmail(ctx, operatorFromContext(ctx), req.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	return user.ToAPIV1UserItem(), nil
 }
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+func NewInvitationService(invitationBiz *biz.Invitation) *InvitationService {
+	return &InvitationService{
+		invitationBiz: invitationBiz,
+	}
+}
+
+type InvitationService struct {
+	apiv1.UnimplementedInvitationServer
+
+	invitationBiz *biz.Invitation
+}
+
+func (s *InvitationService) CreateInvitation(ctx context.Context, req *apiv1.CreateInvitationRequest) (*apiv1.CreateInvitationReply, error) {
+	created, err := s.invitationBiz.CreateInvitation(ctx, bo.NewCreateInvitationBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return created.ToAPIV1CreateInvitationReply(), nil
+}
+
+func (s *InvitationService) ListInvitations(ctx context.Context, req *apiv1.ListInvitationsRequest) (*apiv1.ListInvitationsReply, error) {
+	pageResponseBo, err := s.invitationBiz.ListInvitations(ctx, bo.NewListInvitationsBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListInvitationsReply(pageResponseBo), nil
+}
+
+func (s *InvitationService) DeleteInvitation(ctx context.Context, req *apiv1.DeleteInvitationRequest) (*apiv1.DeleteInvitationReply, error) {
+	if err := s.invitationBiz.DeleteInvitation(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteInvitationReply{}, nil
+}
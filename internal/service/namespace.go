@@ -28,7 +28,7 @@ type NamespaceService struct {
 }
 
 func (s *NamespaceService) CreateNamespace(ctx context.Context, req *apiv1.CreateNamespaceRequest) (*apiv1.CreateNamespaceReply, error) {
-	createNamespaceBo := bo.NewCreateNamespaceBo(req)
+	createNamespaceBo := bo.NewCreateNamespaceBo(operatorFromContext(ctx), req)
 	if err := s.namespaceBiz.CreateNamespace(ctx, createNamespaceBo); err != nil {
 		return nil, err
 	}
@@ -36,7 +36,7 @@ func (s *NamespaceService) CreateNamespace(ctx context.Context, req *apiv1.Creat
 }
 
 func (s *NamespaceService) UpdateNamespace(ctx context.Context, req *apiv1.UpdateNamespaceRequest) (*apiv1.UpdateNamespaceReply, error) {
-	updateNamespaceBo := bo.NewUpdateNamespaceBo(req)
+	updateNamespaceBo := bo.NewUpdateNamespaceBo(operatorFromContext(ctx), req)
 	if err := s.namespaceBiz.UpdateNamespace(ctx, updateNamespaceBo); err != nil {
 		return nil, err
 	}
@@ -44,7 +44,7 @@ func (s *NamespaceService) UpdateNamespace(ctx context.Context, req *apiv1.Updat
 }
 
 func (s *NamespaceService) UpdateNamespaceStatus(ctx context.Context, req *apiv1.UpdateNamespaceStatusRequest) (*apiv1.UpdateNamespaceStatusReply, error) {
-	updateNamespaceStatusBo := bo.NewUpdateNamespaceStatusBo(req)
+	updateNamespaceStatusBo := bo.NewUpdateNamespaceStatusBo(operatorFromContext(ctx), req)
 	if err := s.namespaceBiz.UpdateNamespaceStatus(ctx, updateNamespaceStatusBo); err != nil {
 		return nil, err
 	}
@@ -84,6 +84,67 @@ func (s *NamespaceService) SelectNamespace(ctx context.Context, req *apiv1.Selec
 	return bo.ToAPIV1SelectNamespaceReply(result), nil
 }
 
+func (s *NamespaceService) GetNamespaceStats(ctx context.Context, req *apiv1.GetNamespaceStatsRequest) (*apiv1.GetNamespaceStatsReply, error) {
+	statsBo, err := bo.NewGetNamespaceStatsBo(req)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := s.namespaceBiz.GetNamespaceStats(ctx, statsBo)
+	if err != nil {
+		return nil, err
+	}
+	return stats.ToAPIV1GetNamespaceStatsReply(), nil
+}
+
+func (s *NamespaceService) ListNamespaceRevisions(ctx context.Context, req *apiv1.ListNamespaceRevisionsRequest) (*apiv1.ListNamespaceRevisionsReply, error) {
+	pageResponseBo, err := s.namespaceBiz.ListNamespaceRevisions(ctx, bo.NewListNamespaceRevisionsBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListNamespaceRevisionsReply(pageResponseBo), nil
+}
+
+func (s *NamespaceService) GetNamespaceRevision(ctx context.Context, req *apiv1.GetNamespaceRevisionRequest) (*apiv1.NamespaceRevisionItem, error) {
+	revisionBo, err := s.namespaceBiz.GetNamespaceRevision(ctx, snowflake.ParseInt64(req.Uid), req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return revisionBo.ToAPIV1NamespaceRevisionItem(), nil
+}
+
+func (s *NamespaceService) DiffNamespaceRevisions(ctx context.Context, req *apiv1.DiffNamespaceRevisionsRequest) (*apiv1.DiffNamespaceRevisionsReply, error) {
+	diffBo, err := s.namespaceBiz.DiffNamespaceRevisions(ctx, snowflake.ParseInt64(req.Uid), req.FromRevision, req.ToRevision)
+	if err != nil {
+		return nil, err
+	}
+	return diffBo.ToAPIV1DiffNamespaceRevisionsReply(), nil
+}
+
+func (s *NamespaceService) RollbackNamespace(ctx context.Context, req *apiv1.RollbackNamespaceRequest) (*apiv1.NamespaceRevisionItem, error) {
+	revisionBo, err := s.namespaceBiz.RollbackNamespace(ctx, bo.NewRollbackNamespaceBo(operatorFromContext(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return revisionBo.ToAPIV1NamespaceRevisionItem(), nil
+}
+
+func (s *NamespaceService) TransferNamespaceOwnership(ctx context.Context, req *apiv1.TransferNamespaceOwnershipRequest) (*apiv1.NamespaceRevisionItem, error) {
+	revisionBo, err := s.namespaceBiz.TransferNamespaceOwnership(ctx, bo.NewTransferNamespaceOwnershipBo(operatorFromContext(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return revisionBo.ToAPIV1NamespaceRevisionItem(), nil
+}
+
+// GetNamespaceName returns the name of the namespace with the uid, for the middlewares of the operations on a namespace.
+func (s *NamespaceService) GetNamespaceName(ctx context.Context, uid snowflake.ID) (string, error) {
+	namespaceItemBo, err := s.namespaceBiz.GetNamespace(ctx, uid)
+	if err != nil {
+		return "", err
+	}
+	return namespaceItemBo.Name, nil
+}
+
 func (s *NamespaceService) HasNamespace(ctx context.Context) error {
 	ns := middler.GetNamespace(ctx)
 	if strutil.IsEmpty(ns) {
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/enum"
+)
+
+const namespaceStatsCollectTimeout = 10 * time.Second
+
+var (
+	namespaceTotalDesc = prometheus.NewDesc(
+		"sovereign_namespace_total",
+		"Number of namespaces.",
+		nil, nil,
+	)
+	namespaceStatusDesc = prometheus.NewDesc(
+		"sovereign_namespace_status_total",
+		"Number of namespaces by status.",
+		[]string{"status"}, nil,
+	)
+	namespaceDailyCreationsDesc = prometheus.NewDesc(
+		"sovereign_namespace_daily_creations",
+		"Number of namespaces created per day over the last 30 days.",
+		[]string{"date"}, nil,
+	)
+	namespaceCreatorDesc = prometheus.NewDesc(
+		"sovereign_namespace_creator_total",
+		"Number of namespaces created by the top creators.",
+		[]string{"creator"}, nil,
+	)
+	namespaceMetadataSizeDesc = prometheus.NewDesc(
+		"sovereign_namespace_metadata_size",
+		"Number of metadata entries of the namespaces with the largest metadata sets.",
+		[]string{"namespace"}, nil,
+	)
+)
+
+// NamespaceStatsCollector exports the namespace statistics as prometheus gauges, computed on every scrape.
+func (s *NamespaceService) NamespaceStatsCollector() prometheus.Collector {
+	return &namespaceStatsCollector{namespaceBiz: s.namespaceBiz}
+}
+
+type namespaceStatsCollector struct {
+	namespaceBiz *biz.Namespace
+}
+
+// Describe implements [prometheus.Collector].
+func (c *namespaceStatsCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- namespaceTotalDesc
+	ch <- namespaceStatusDesc
+	ch <- namespaceDailyCreationsDesc
+	ch <- namespaceCreatorDesc
+	ch <- namespaceMetadataSizeDesc
+}
+
+// Collect implements [prometheus.Collector].
+func (c *namespaceStatsCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), namespaceStatsCollectTimeout)
+	defer cancel()
+	statsBo, err := bo.NewGetNamespaceStatsBo(&apiv1.GetNamespaceStatsRequest{})
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(namespaceTotalDesc, err)
+		return
+	}
+	stats, err := c.namespaceBiz.GetNamespaceStats(ctx, statsBo)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(namespaceTotalDesc, err)
+		return
+	}
+	ch <- prometheus.MustNewConstMetric(namespaceTotalDesc, prometheus.GaugeValue, float64(stats.Total))
+	for _, item := range stats.StatusCounts {
+		ch <- prometheus.MustNewConstMetric(namespaceStatusDesc, prometheus.GaugeValue, float64(item.Count), enum.GlobalStatus(item.Status).String())
+	}
+	for _, item := range stats.DailyCreations {
+		ch <- prometheus.MustNewConstMetric(namespaceDailyCreationsDesc, prometheus.GaugeValue, float64(item.Count), item.Date)
+	}
+	for _, item := range stats.TopCreators {
+		ch <- prometheus.MustNewConstMetric(namespaceCreatorDesc, prometheus.GaugeValue, float64(item.Count), strconv.FormatInt(item.Creator.Int64(), 10))
+	}
+	for _, item := range stats.LargestMetadata {
+		ch <- prometheus.MustNewConstMetric(namespaceMetadataSizeDesc, prometheus.GaugeValue, float64(item.Size), item.Name)
+	}
+}
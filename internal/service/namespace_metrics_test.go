@@ -0,0 +1,102 @@
+package service_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	"github.com/aide-family/sovereign/internal/biz/vobj"
+	"github.com/aide-family/sovereign/internal/conf"
+	"github.com/aide-family/sovereign/internal/data"
+	"github.com/aide-family/sovereign/internal/data/impl"
+	"github.com/aide-family/sovereign/internal/service"
+	"github.com/aide-family/sovereign/pkg/config"
+)
+
+func TestNamespaceStatsCollector(t *testing.T) {
+	helper := klog.NewHelper(klog.DefaultLogger)
+	options, err := anypb.New(&config.FileConfig{Path: t.TempDir(), Filename: "namespaces.yaml", StorageInterval: durationpb.New(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := &conf.Bootstrap{NamespaceConfig: &config.DomainConfig{Driver: config.DomainConfig_FILE, Options: options}}
+	d, cleanup, err := data.New(c, helper)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(cleanup)
+	namespaceRepo, err := impl.NewNamespaceRepository(c, d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := context.Background()
+	namespaces := []*bo.CreateNamespaceBo{
+		{Name: "team-a", Status: vobj.GlobalStatusEnabled, Operator: snowflake.ID(7), Metadata: map[string]string{"env": "prod", "owner": "a"}},
+		{Name: "team-b", Status: vobj.GlobalStatusEnabled, Operator: snowflake.ID(7), Metadata: map[string]string{"env": "dev"}},
+		{Name: "team-c", Status: vobj.GlobalStatusDisabled, Operator: snowflake.ID(8)},
+	}
+	for _, namespace := range namespaces {
+		if err := namespaceRepo.CreateNamespace(ctx, namespace); err != nil {
+			t.Fatal(err)
+		}
+	}
+	collector := service.NewNamespaceService(biz.NewNamespace(namespaceRepo, nil, nil, bo.NewPageTokenCodec("secret"), helper)).NamespaceStatsCollector()
+
+	want := `
+# HELP sovereign_namespace_total Number of namespaces.
+# TYPE sovereign_namespace_total gauge
+sovereign_namespace_total 3
+# HELP sovereign_namespace_status_total Number of namespaces by status.
+# TYPE sovereign_namespace_status_total gauge
+sovereign_namespace_status_total{status="DISABLED"} 1
+sovereign_namespace_status_total{status="ENABLED"} 2
+# HELP sovereign_namespace_creator_total Number of namespaces created by the top creators.
+# TYPE sovereign_namespace_creator_total gauge
+sovereign_namespace_creator_total{creator="7"} 2
+sovereign_namespace_creator_total{creator="8"} 1
+# HELP sovereign_namespace_metadata_size Number of metadata entries of the namespaces with the largest metadata sets.
+# TYPE sovereign_namespace_metadata_size gauge
+sovereign_namespace_metadata_size{namespace="team-a"} 2
+sovereign_namespace_metadata_size{namespace="team-b"} 1
+sovereign_namespace_metadata_size{namespace="team-c"} 0
+`
+	if err := testutil.CollectAndCompare(collector, strings.NewReader(want),
+		"sovereign_namespace_total", "sovereign_namespace_status_total", "sovereign_namespace_creator_total", "sovereign_namespace_metadata_size"); err != nil {
+		t.Fatal(err)
+	}
+	// every day of the default range is exported, the days without creations as zero
+	if count := testutil.CollectAndCount(collector, "sovereign_namespace_daily_creations"); count != 30 {
+		t.Fatalf("want 30 daily creations, got %d", count)
+	}
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(collector)
+	families, err := registry.Gather()
+	if err != nil {
+		t.Fatal(err)
+	}
+	today := time.Now().UTC().Format(time.DateOnly)
+	var created float64
+	for _, family := range families {
+		if family.GetName() != "sovereign_namespace_daily_creations" {
+			continue
+		}
+		for _, metric := range family.GetMetric() {
+			if metric.GetLabel()[0].GetValue() == today {
+				created = metric.GetGauge().GetValue()
+			}
+		}
+	}
+	if created != 3 {
+		t.Fatalf("want 3 creations today, got %v", created)
+	}
+}
@@ -0,0 +1,68 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/config"
+)
+
+func NewOAuth2ProviderService(oauth2ProviderBiz *biz.OAuth2Provider) *OAuth2ProviderService {
+	return &OAuth2ProviderService{
+		oauth2ProviderBiz: oauth2ProviderBiz,
+	}
+}
+
+type OAuth2ProviderService struct {
+	apiv1.UnimplementedOAuth2ProviderServer
+
+	oauth2ProviderBiz *biz.OAuth2Provider
+}
+
+func (s *OAuth2ProviderService) CreateOAuth2Provider(ctx context.Context, req *apiv1.CreateOAuth2ProviderRequest) (*apiv1.OAuth2ProviderItem, error) {
+	provider, err := s.oauth2ProviderBiz.CreateOAuth2Provider(ctx, bo.NewCreateOAuth2ProviderBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return provider.ToAPIV1OAuth2ProviderItem(), nil
+}
+
+func (s *OAuth2ProviderService) UpdateOAuth2Provider(ctx context.Context, req *apiv1.UpdateOAuth2ProviderRequest) (*apiv1.OAuth2ProviderItem, error) {
+	provider, err := s.oauth2ProviderBiz.UpdateOAuth2Provider(ctx, bo.NewUpdateOAuth2ProviderBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return provider.ToAPIV1OAuth2ProviderItem(), nil
+}
+
+func (s *OAuth2ProviderService) UpdateOAuth2ProviderStatus(ctx context.Context, req *apiv1.UpdateOAuth2ProviderStatusRequest) (*apiv1.OAuth2ProviderItem, error) {
+	provider, err := s.oauth2ProviderBiz.UpdateOAuth2ProviderStatus(ctx, bo.NewUpdateOAuth2ProviderStatusBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return provider.ToAPIV1OAuth2ProviderItem(), nil
+}
+
+func (s *OAuth2ProviderService) DeleteOAuth2Provider(ctx context.Context, req *apiv1.DeleteOAuth2ProviderRequest) (*apiv1.DeleteOAuth2ProviderReply, error) {
+	if err := s.oauth2ProviderBiz.DeleteOAuth2Provider(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteOAuth2ProviderReply{}, nil
+}
+
+func (s *OAuth2ProviderService) ListOAuth2Providers(ctx context.Context, req *apiv1.ListOAuth2ProvidersRequest) (*apiv1.ListOAuth2ProvidersReply, error) {
+	pageResponseBo, err := s.oauth2ProviderBiz.ListOAuth2Providers(ctx, bo.NewListOAuth2ProvidersBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListOAuth2ProvidersReply(pageResponseBo), nil
+}
+
+// EnabledOAuth2Providers is the provider source of the oauth2 handler, it is consulted by the logins of the providers of the API.
+func (s *OAuth2ProviderService) EnabledOAuth2Providers(ctx context.Context) ([]*config.OAuth2_Config, error) {
+	return s.oauth2ProviderBiz.EnabledProviders(ctx)
+}
@@ -0,0 +1,67 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+func NewPolicyService(policyBiz *biz.Policy) *PolicyService {
+	return &PolicyService{
+		policyBiz: policyBiz,
+	}
+}
+
+type PolicyService struct {
+	apiv1.UnimplementedPolicyServer
+
+	policyBiz *biz.Policy
+}
+
+func (s *PolicyService) CreatePolicy(ctx context.Context, req *apiv1.CreatePolicyRequest) (*apiv1.PolicyItem, error) {
+	policy, err := s.policyBiz.CreatePolicy(ctx, bo.NewCreatePolicyBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return policy.ToAPIV1PolicyItem(), nil
+}
+
+func (s *PolicyService) UpdatePolicy(ctx context.Context, req *apiv1.UpdatePolicyRequest) (*apiv1.PolicyItem, error) {
+	policy, err := s.policyBiz.UpdatePolicy(ctx, bo.NewUpdatePolicyBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return policy.ToAPIV1PolicyItem(), nil
+}
+
+func (s *PolicyService) DeletePolicy(ctx context.Context, req *apiv1.DeletePolicyRequest) (*apiv1.DeletePolicyReply, error) {
+	if err := s.policyBiz.DeletePolicy(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeletePolicyReply{}, nil
+}
+
+func (s *PolicyService) ListPolicies(ctx context.Context, req *apiv1.ListPoliciesRequest) (*apiv1.ListPoliciesReply, error) {
+	pageResponseBo, err := s.policyBiz.ListPolicies(ctx, bo.NewListPoliciesBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListPoliciesReply(pageResponseBo), nil
+}
+
+func (s *PolicyService) ExplainPolicy(ctx context.Context, req *apiv1.ExplainPolicyRequest) (*apiv1.ExplainPolicyReply, error) {
+	decision, err := s.policyBiz.Explain(ctx, bo.NewExplainPolicyBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return decision.ToAPIV1ExplainPolicyReply(), nil
+}
+
+// Authorize is consulted by the policy middleware for every request of a signed-in user.
+func (s *PolicyService) Authorize(ctx context.Context, operation, namespace string, req any) (context.Context, error) {
+	return s.policyBiz.Authorize(ctx, operation, namespace, req)
+}
@@ -0,0 +1,69 @@
+package service
+
+import (
+	"context"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	"github.com/aide-family/sovereign/pkg/middler"
+)
+
+func NewQuotaService(quotaBiz *biz.Quota) *QuotaService {
+	return &QuotaService{
+		quotaBiz: quotaBiz,
+	}
+}
+
+type QuotaService struct {
+	apiv1.UnimplementedQuotaServer
+
+	quotaBiz *biz.Quota
+}
+
+func (s *QuotaService) SetQuota(ctx context.Context, req *apiv1.SetQuotaRequest) (*apiv1.QuotaItem, error) {
+	quotaItemBo, err := s.quotaBiz.SetQuota(ctx, bo.NewSetQuotaBo(middler.GetNamespace(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return quotaItemBo.ToAPIV1QuotaItem(), nil
+}
+
+func (s *QuotaService) DeleteQuota(ctx context.Context, req *apiv1.DeleteQuotaRequest) (*apiv1.DeleteQuotaReply, error) {
+	if err := s.quotaBiz.DeleteQuota(ctx, middler.GetNamespace(ctx), req.Resource); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteQuotaReply{}, nil
+}
+
+func (s *QuotaService) GetQuota(ctx context.Context, req *apiv1.GetQuotaRequest) (*apiv1.QuotaItem, error) {
+	quotaItemBo, err := s.quotaBiz.GetQuota(ctx, middler.GetNamespace(ctx), req.Resource)
+	if err != nil {
+		return nil, err
+	}
+	return quotaItemBo.ToAPIV1QuotaItem(), nil
+}
+
+func (s *QuotaService) ListQuota(ctx context.Context, req *apiv1.ListQuotaRequest) (*apiv1.ListQuotaReply, error) {
+	items, err := s.quotaBiz.ListQuota(ctx, middler.GetNamespace(ctx))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListQuotaReply(items), nil
+}
+
+func (s *QuotaService) Reserve(ctx context.Context, req *apiv1.ReserveRequest) (*apiv1.QuotaItem, error) {
+	quotaItemBo, err := s.quotaBiz.Reserve(ctx, bo.NewReserveQuotaBo(middler.GetNamespace(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return quotaItemBo.ToAPIV1QuotaItem(), nil
+}
+
+func (s *QuotaService) Release(ctx context.Context, req *apiv1.ReleaseRequest) (*apiv1.QuotaItem, error) {
+	quotaItemBo, err := s.quotaBiz.Release(ctx, bo.NewReleaseQuotaBo(middler.GetNamespace(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return quotaItemBo.ToAPIV1QuotaItem(), nil
+}
@@ -0,0 +1,98 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+func NewRBACService(rbacBiz *biz.RBAC) *RBACService {
+	return &RBACService{
+		rbacBiz: rbacBiz,
+	}
+}
+
+type RBACService struct {
+	apiv1.UnimplementedRBACServer
+
+	rbacBiz *biz.RBAC
+}
+
+func (s *RBACService) CreateRole(ctx context.Context, req *apiv1.CreateRoleRequest) (*apiv1.RoleItem, error) {
+	role, err := s.rbacBiz.CreateRole(ctx, bo.NewCreateRoleBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return role.ToAPIV1RoleItem(), nil
+}
+
+func (s *RBACService) UpdateRole(ctx context.Context, req *apiv1.UpdateRoleRequest) (*apiv1.RoleItem, error) {
+	role, err := s.rbacBiz.UpdateRole(ctx, bo.NewUpdateRoleBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return role.ToAPIV1RoleItem(), nil
+}
+
+func (s *RBACService) DeleteRole(ctx context.Context, req *apiv1.DeleteRoleRequest) (*apiv1.DeleteRoleReply, error) {
+	if err := s.rbacBiz.DeleteRole(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteRoleReply{}, nil
+}
+
+func (s *RBACService) GetRole(ctx context.Context, req *apiv1.GetRoleRequest) (*apiv1.RoleItem, error) {
+	role, err := s.rbacBiz.GetRole(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return role.ToAPIV1RoleItem(), nil
+}
+
+func (s *RBACService) ListRoles(ctx context.Context, req *apiv1.ListRolesRequest) (*apiv1.ListRolesReply, error) {
+	pageResponseBo, err := s.rbacBiz.ListRoles(ctx, bo.NewListRolesBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListRolesReply(pageResponseBo), nil
+}
+
+func (s *RBACService) SetRoleMFA(ctx context.Context, req *apiv1.SetRoleMFARequest) (*apiv1.RoleItem, error) {
+	role, err := s.rbacBiz.SetRoleMFA(ctx, snowflake.ParseInt64(req.GetUid()), req.GetRequireMfa())
+	if err != nil {
+		return nil, err
+	}
+	return role.ToAPIV1RoleItem(), nil
+}
+
+func (s *RBACService) CreateRoleBinding(ctx context.Context, req *apiv1.CreateRoleBindingRequest) (*apiv1.RoleBindingItem, error) {
+	binding, err := s.rbacBiz.CreateRoleBinding(ctx, bo.NewCreateRoleBindingBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return binding.ToAPIV1RoleBindingItem(), nil
+}
+
+func (s *RBACService) DeleteRoleBinding(ctx context.Context, req *apiv1.DeleteRoleBindingRequest) (*apiv1.DeleteRoleBindingReply, error) {
+	if err := s.rbacBiz.DeleteRoleBinding(ctx, snowflake.ParseInt64(req.GetUid())); err != nil {
+		return nil, err
+	}
+	return &apiv1.DeleteRoleBindingReply{}, nil
+}
+
+func (s *RBACService) ListRoleBindings(ctx context.Context, req *apiv1.ListRoleBindingsRequest) (*apiv1.ListRoleBindingsReply, error) {
+	pageResponseBo, err := s.rbacBiz.ListRoleBindings(ctx, bo.NewListRoleBindingsBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListRoleBindingsReply(pageResponseBo), nil
+}
+
+// GetUserPermissions is consulted by the permission middleware for every request which needs a permission.
+func (s *RBACService) GetUserPermissions(ctx context.Context, userUID snowflake.ID, namespace string) ([]string, error) {
+	return s.rbacBiz.GetUserPermissions(ctx, userUID, namespace)
+}
@@ -1,11 +1,33 @@
 // Package service is a service package for kratos.
 package service
 
-import "github.com/google/wire"
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/google/wire"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
 
 // ProviderSetService is service providers.
 var ProviderSetService = wire.NewSet(
 	NewHealthService,
 	NewNamespaceService,
+	NewQuotaService,
 	NewAuthService,
+	NewRBACService,
+	NewPolicyService,
+	NewUserService,
+	NewOAuth2ProviderService,
+	NewInvitationService,
 )
+
+// operatorFromContext returns the uid of the signed-in user, or 0 if the request is anonymous.
+func operatorFromContext(ctx context.Context) snowflake.ID {
+	baseInfo, ok := authv1.GetBaseInfo(ctx)
+	if !ok {
+		return 0
+	}
+	return baseInfo.UID
+}
@@ -0,0 +1,119 @@
+package service
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+
+	"github.com/aide-family/sovereign/internal/biz"
+	"github.com/aide-family/sovereign/internal/biz/bo"
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+)
+
+func NewUserService(userBiz *biz.User, loginLimitBiz *biz.LoginLimit, mfaBiz *biz.MFA) *UserService {
+	return &UserService{
+		userBiz:       userBiz,
+		loginLimitBiz: loginLimitBiz,
+		mfaBiz:        mfaBiz,
+	}
+}
+
+type UserService struct {
+	apiv1.UnimplementedUserServer
+
+	userBiz       *biz.User
+	loginLimitBiz *biz.LoginLimit
+	mfaBiz        *biz.MFA
+}
+
+func (s *UserService) ListUsers(ctx context.Context, req *apiv1.ListUsersRequest) (*apiv1.ListUsersReply, error) {
+	pageResponseBo, err := s.userBiz.ListUsers(ctx, bo.NewListUsersBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListUsersReply(pageResponseBo), nil
+}
+
+func (s *UserService) GetUser(ctx context.Context, req *apiv1.GetUserRequest) (*apiv1.UserItem, error) {
+	user, err := s.userBiz.GetUser(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	return user.ToAPIV1UserItem(), nil
+}
+
+func (s *UserService) UpdateUser(ctx context.Context, req *apiv1.UpdateUserRequest) (*apiv1.UserItem, error) {
+	user, err := s.userBiz.UpdateUser(ctx, bo.NewUpdateUserBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return user.ToAPIV1UserItem(), nil
+}
+
+func (s *UserService) UpdateUserStatus(ctx context.Context, req *apiv1.UpdateUserStatusRequest) (*apiv1.UserItem, error) {
+	user, err := s.userBiz.UpdateUserStatus(ctx, bo.NewUpdateUserStatusBo(operatorFromContext(ctx), req))
+	if err != nil {
+		return nil, err
+	}
+	return user.ToAPIV1UserItem(), nil
+}
+
+func (s *UserService) ListUserIdentities(ctx context.Context, req *apiv1.ListUserIdentitiesRequest) (*apiv1.ListUserIdentitiesReply, error) {
+	identities, err := s.userBiz.ListUserIdentities(ctx, snowflake.ParseInt64(req.GetUid()))
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*apiv1.IdentityItem, 0, len(identities))
+	for _, identity := range identities {
+		items = append(items, identity.ToAPIV1IdentityItem())
+	}
+	return &apiv1.ListUserIdentitiesReply{Items: items}, nil
+}
+
+func (s *UserService) UnlinkUserIdentity(ctx context.Context, req *apiv1.UnlinkUserIdentityRequest) (*apiv1.UnlinkUserIdentityReply, error) {
+	if err := s.userBiz.UnlinkUserIdentity(ctx, operatorFromContext(ctx), snowflake.ParseInt64(req.GetUid()), req.GetId()); err != nil {
+		return nil, err
+	}
+	return &apiv1.UnlinkUserIdentityReply{}, nil
+}
+
+func (s *UserService) ListIdentityAuditEvents(ctx context.Context, req *apiv1.ListIdentityAuditEventsRequest) (*apiv1.ListIdentityAuditEventsReply, error) {
+	pageResponseBo, err := s.userBiz.ListIdentityAuditEvents(ctx, bo.NewListIdentityAuditEventsBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListIdentityAuditEventsReply(pageResponseBo), nil
+}
+
+func (s *UserService) ListLoginAuditEvents(ctx context.Context, req *apiv1.ListLoginAuditEventsRequest) (*apiv1.ListLoginAuditEventsReply, error) {
+	pageResponseBo, err := s.loginLimitBiz.ListLoginAuditEvents(ctx, bo.NewListLoginAuditEventsBo(req))
+	if err != nil {
+		return nil, err
+	}
+	return bo.ToAPIV1ListLoginAuditEventsReply(pageResponseBo), nil
+}
+
+func (s *UserService) ResetUserMFA(ctx context.Context, req *apiv1.ResetUserMFARequest) (*apiv1.ResetUserMFAReply, error) {
+	uid := snowflake.ParseInt64(req.GetUid())
+	if _, err := s.userBiz.GetUser(ctx, uid); err != nil {
+		return nil, err
+	}
+	if err := s.mfaBiz.ResetUserMFA(ctx, uid); err != nil {
+		return nil, err
+	}
+	return &apiv1.ResetUserMFAReply{}, nil
+}
+
+// IsUserDisabled is consulted by the login middleware for every authenticated request.
+func (s *UserService) IsUserDisabled(ctx context.Context, uid snowflake.ID) (bool, error) {
+	return s.userBiz.IsUserDisabled(ctx, uid)
+}
+
+// GetDefaultNamespace is consulted by the namespace middleware for the requests without a namespace, anonymous requests have none.
+func (s *UserService) GetDefaultNamespace(ctx context.Context) (string, error) {
+	uid := operatorFromContext(ctx)
+	if uid == 0 {
+		return "", nil
+	}
+	return s.userBiz.GetDefaultNamespace(ctx, uid)
+}
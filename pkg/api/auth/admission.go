@@ -0,0 +1,92 @@
+package auth
+
+import (
+	nethttp "net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	kerrors "github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	// ErrorQueryName and ErrorDescriptionQueryName tell the page a refused login is sent to why it was refused
+	ErrorQueryName            = "error"
+	ErrorDescriptionQueryName = "error_description"
+
+	// loginErrorAccessDenied is the error of a login refused by the admission of the provider or by the user state
+	loginErrorAccessDenied = "access_denied"
+	// loginErrorLoginRequired is the error of a callback whose login state is missing or invalid, the user logs in again
+	loginErrorLoginRequired = "login_required"
+	// loginErrorTemporarilyUnavailable is the error of a login refused by the login rate limit, the user logs in later
+	loginErrorTemporarilyUnavailable = "temporarily_unavailable"
+)
+
+// CheckEmailDomain refuses the user whose email is not a verified email of the allowed domains of the admission,
+// every user is admitted when the admission has no email domains.
+func CheckEmailDomain(admission *config.OAuth2_Admission, user User) error {
+	domains := admission.GetEmailDomains()
+	if len(domains) == 0 {
+		return nil
+	}
+	email := user.GetEmail()
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok || !user.IsEmailVerified() {
+		return merr.ErrorForbidden("signing in with %s requires a verified email of %s", UserProviderName(user), strings.Join(domains, ", "))
+	}
+	if !slices.ContainsFunc(domains, func(item string) bool { return strings.EqualFold(item, domain) }) {
+		return merr.ErrorForbidden("email domain %s is not allowed to sign in with %s", domain, UserProviderName(user))
+	}
+	return nil
+}
+
+// loginError returns the error query parameter of a refused login, false for the errors which are not a refusal.
+func loginError(err error) (string, bool) {
+	switch {
+	case merr.IsForbidden(err):
+		return loginErrorAccessDenied, true
+	case merr.IsUnauthorized(err):
+		return loginErrorLoginRequired, true
+	case merr.IsTooManyRequests(err):
+		return loginErrorTemporarilyUnavailable, true
+	default:
+		return "", false
+	}
+}
+
+// Refuse sends the browser of a refused login to its return_to URL, or to the errorRedirectUri of the config,
+// with the error and error_description query parameters, the error is returned when there is neither.
+func (s *OAuth2State) Refuse(ctx http.Context, loginState *LoginState, err error) error {
+	code, ok := loginError(err)
+	if !ok {
+		return err
+	}
+	var target *url.URL
+	switch {
+	case loginState != nil && loginState.ReturnTo != "":
+		parsed, parseErr := url.Parse(loginState.ReturnTo)
+		if parseErr != nil {
+			return err
+		}
+		target = parsed
+	case s.errorRedirect != nil:
+		target = &url.URL{}
+		*target = *s.errorRedirect
+	default:
+		return err
+	}
+	query := target.Query()
+	query.Set(ErrorQueryName, code)
+	query.Set(ErrorDescriptionQueryName, kerrors.FromError(err).GetMessage())
+	target.RawQuery = query.Encode()
+	req := ctx.Request()
+	resp := ctx.Response()
+	resp.Header().Set("Location", target.String())
+	resp.WriteHeader(nethttp.StatusTemporaryRedirect)
+	ctx.Reset(resp, req)
+	return nil
+}
@@ -0,0 +1,128 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	nethttp "net/http"
+	"net/url"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/aide-family/magicbox/strutil"
+	"golang.org/x/oauth2"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const defaultHTTPTimeout = 10 * time.Second
+
+// providerNamePattern keeps the provider names usable in the login routes
+var providerNamePattern = regexp.MustCompile(`^[A-Za-z0-9_-]{1,64}$`)
+
+// NewHTTPClient creates the client of the requests sent to an app, with the timeout, proxy and CAs of its configuration.
+func NewHTTPClient(conf *config.OAuth2_HTTPClient) (*nethttp.Client, error) {
+	transport := nethttp.DefaultTransport.(*nethttp.Transport).Clone()
+	if proxy := conf.GetProxy(); strutil.IsNotEmpty(proxy) {
+		proxyURL, err := ParseEndpoint("proxy", proxy)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = nethttp.ProxyURL(proxyURL)
+	}
+	if caFile := conf.GetCaFile(); strutil.IsNotEmpty(caFile) {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, merr.ErrorInternal("read oauth2 ca file %s failed", caFile).WithCause(err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, merr.ErrorInternal("oauth2 ca file %s has no certificate", caFile).WithCause(errors.New("invalid pem"))
+		}
+		transport.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+	timeout := conf.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultHTTPTimeout
+	}
+	return &nethttp.Client{Transport: transport, Timeout: timeout}, nil
+}
+
+// WithHTTPClient makes the token exchange and the clients created by an oauth2 config under the context send through the client.
+func WithHTTPClient(ctx context.Context, client *nethttp.Client) context.Context {
+	return context.WithValue(ctx, oauth2.HTTPClient, client)
+}
+
+// TokenClient returns the client sending the token of the user through the client of the app, keeping its timeout.
+func TokenClient(ctx context.Context, oauthConfig *oauth2.Config, token *oauth2.Token, client *nethttp.Client) *nethttp.Client {
+	tokenClient := oauthConfig.Client(WithHTTPClient(ctx, client), token)
+	tokenClient.Timeout = client.Timeout
+	return tokenClient
+}
+
+// ParseEndpoint parses an absolute http or https URL of the configuration, name tells the setting in the error.
+func ParseEndpoint(name, endpoint string) (*url.URL, error) {
+	endpointURL, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, merr.ErrorInternal("oauth2 %s %s is invalid", name, endpoint).WithCause(err)
+	}
+	if (endpointURL.Scheme != "http" && endpointURL.Scheme != "https") || endpointURL.Host == "" {
+		return nil, merr.ErrorInternal("oauth2 %s %s must be an absolute http or https URL", name, endpoint)
+	}
+	return endpointURL, nil
+}
+
+// APIEndpoint returns the endpoint of the app, the configured endpoint wins over the path under the configured
+// or default API base URL, both are validated.
+func APIEndpoint(name, endpoint, apiURL, defaultAPIURL string, elem ...string) (string, error) {
+	if strutil.IsNotEmpty(endpoint) {
+		if _, err := ParseEndpoint(name, endpoint); err != nil {
+			return "", err
+		}
+		return endpoint, nil
+	}
+	if strutil.IsEmpty(apiURL) {
+		apiURL = defaultAPIURL
+	}
+	if _, err := ParseEndpoint("apiUrl", apiURL); err != nil {
+		return "", err
+	}
+	return url.JoinPath(apiURL, elem...)
+}
+
+// ValidateProviderConfig checks the name and the endpoints of an app on startup, only OIDC apps may leave authUrl and tokenUrl to the discovery.
+func ValidateProviderConfig(providerConfig *config.OAuth2_Config) error {
+	app := providerConfig.GetApp()
+	if app == config.OAuth2_UNKNOWN {
+		return merr.ErrorInternal("oauth2 app is required")
+	}
+	if name := providerConfig.GetName(); strutil.IsNotEmpty(name) && !providerNamePattern.MatchString(name) {
+		return merr.ErrorInternal("oauth2 provider name %s must be 1-64 letters, digits, - or _", name)
+	}
+	endpoints := []struct {
+		name     string
+		endpoint string
+		required bool
+	}{
+		{name: "authUrl", endpoint: providerConfig.GetAuthUrl(), required: app != config.OAuth2_OIDC},
+		{name: "tokenUrl", endpoint: providerConfig.GetTokenUrl(), required: app != config.OAuth2_OIDC},
+		{name: "callbackUri", endpoint: providerConfig.GetCallbackUri()},
+		{name: "apiUrl", endpoint: providerConfig.GetApiUrl()},
+		{name: "userInfoUrl", endpoint: providerConfig.GetUserInfoUrl()},
+	}
+	for _, item := range endpoints {
+		if strutil.IsEmpty(item.endpoint) {
+			if item.required {
+				return merr.ErrorInternal("oauth2 app %s %s is required", app, item.name)
+			}
+			continue
+		}
+		if _, err := ParseEndpoint(item.name, item.endpoint); err != nil {
+			return merr.ErrorInternal("oauth2 app %s has an invalid endpoint", app).WithCause(err)
+		}
+	}
+	return nil
+}
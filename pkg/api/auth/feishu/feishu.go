@@ -2,8 +2,11 @@
 package feishu
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
+	nethttp "net/http"
+	"slices"
 
 	"github.com/aide-family/magicbox/pointer"
 	"github.com/go-kratos/kratos/v2/transport/http"
@@ -14,22 +17,60 @@ import (
 	"github.com/aide-family/sovereign/pkg/merr"
 )
 
+// defaultAPIURL is the open API of Feishu, Lark serves it under https://open.larksuite.com/open-apis
+const defaultAPIURL = "https://open.feishu.cn/open-apis"
+
 func init() {
-	auth.RegisterOAuth2LoginFun(config.OAuth2_FEISHU, Login)
+	auth.RegisterOAuth2ProviderFun(config.OAuth2_FEISHU, NewProvider)
+}
+
+var _ auth.OAuth2Provider = (*Provider)(nil)
+
+// NewProvider creates the Feishu provider, the user info endpoint is read from apiUrl,
+// the users of the tenants outside the feishuTenantKeys of the admission are refused.
+func NewProvider(providerConfig *config.OAuth2_Config) (auth.OAuth2Provider, error) {
+	userInfoURL, err := auth.APIEndpoint("userInfoUrl", providerConfig.GetUserInfoUrl(), providerConfig.GetApiUrl(), defaultAPIURL, "authen", "v1", "user_info")
+	if err != nil {
+		return nil, err
+	}
+	client, err := auth.NewHTTPClient(providerConfig.GetHttpClient())
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{userInfoURL: userInfoURL, tenantKeys: providerConfig.GetAdmission().GetFeishuTenantKeys(), client: client}, nil
 }
 
-func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
+// Provider logs users in with Feishu.
+type Provider struct {
+	userInfoURL string
+	// tenantKeys admits the users of the tenants, the users of every tenant are admitted when empty
+	tenantKeys []string
+	client     *nethttp.Client
+}
+
+// AuthCodeOptions implements [auth.OAuth2Provider].
+func (p *Provider) AuthCodeOptions(http.Context, *oauth2.Config) ([]oauth2.AuthCodeOption, error) {
+	return nil, nil
+}
+
+// Login implements [auth.OAuth2Provider].
+func (p *Provider) Login(ctx http.Context, oauthConfig *oauth2.Config, opts ...oauth2.AuthCodeOption) (auth.User, error) {
 	code := ctx.Request().URL.Query().Get("code")
 	if code == "" {
 		return nil, merr.ErrorInvalidArgument("code is required")
 	}
-	verifier := oauth2.GenerateVerifier()
-	token, err := oauthConfig.Exchange(ctx, code, oauth2.VerifierOption(verifier))
+	return p.Exchange(ctx, oauthConfig, code, opts...)
+}
+
+// Exchange exchanges the code for a token and reads the user with it.
+func (p *Provider) Exchange(ctx context.Context, oauthConfig *oauth2.Config, code string, opts ...oauth2.AuthCodeOption) (*User, error) {
+	ctx = auth.WithHTTPClient(ctx, p.client)
+	token, err := oauthConfig.Exchange(ctx, code, opts...)
 	if err != nil {
 		return nil, merr.ErrorInternal("exchange token failed").WithCause(err)
 	}
-	client := oauthConfig.Client(ctx, token)
-	resp, err := client.Get("https://open.feishu.cn/open-apis/authen/v1/user_info")
+	client := auth.TokenClient(ctx, oauthConfig, token, p.client)
+	resp, err := client.Get(p.userInfoURL)
 	if err != nil {
 		return nil, merr.ErrorInternal("get user info failed").WithCause(err)
 	}
@@ -41,6 +82,9 @@ func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
 	if userResponse.Code != 0 || pointer.IsNil(userResponse.Data) {
 		return nil, merr.ErrorInternal("get user info failed").WithCause(errors.New(userResponse.Msg))
 	}
+	if user := userResponse.Data; len(p.tenantKeys) > 0 && !slices.Contains(p.tenantKeys, user.TenantKey) {
+		return nil, merr.ErrorForbidden("the feishu tenant of %s is not allowed to sign in", user.Name)
+	}
 	return userResponse.Data, nil
 }
 
@@ -2,6 +2,7 @@ package feishu
 
 import (
 	"encoding/json"
+	"strings"
 
 	"github.com/aide-family/sovereign/pkg/api/auth"
 	"github.com/aide-family/sovereign/pkg/config"
@@ -66,3 +67,9 @@ func (u *User) GetRemark() string {
 func (u *User) GetNickname() string {
 	return u.EnName
 }
+
+// IsEmailVerified implements [auth.User].
+// Only the enterprise email is assigned by the tenant, the email of the profile is entered by the user.
+func (u *User) IsEmailVerified() bool {
+	return u.EnterpriseEmail != "" && strings.EqualFold(u.Email, u.EnterpriseEmail)
+}
@@ -2,8 +2,12 @@
 package gitee
 
 import (
+	"context"
 	"encoding/json"
+	nethttp "net/http"
+	"slices"
 
+	klog "github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"golang.org/x/oauth2"
 
@@ -12,15 +16,61 @@ import (
 	"github.com/aide-family/sovereign/pkg/merr"
 )
 
+const (
+	// defaultAPIURL is the API of gitee.com, a private deployment serves it under https://<host>/api/v5
+	defaultAPIURL = "https://gitee.com/api/v5"
+	// noreplyDomain is the domain of the emails Gitee uses for the users keeping their email private
+	noreplyDomain = "user.noreply.gitee.com"
+)
+
 func init() {
-	auth.RegisterOAuth2LoginFun(config.OAuth2_GITEE, Login)
+	auth.RegisterOAuth2ProviderFun(config.OAuth2_GITEE, NewProvider)
+	auth.RegisterNoreplyEmailDomain(config.OAuth2_GITEE, noreplyDomain)
+}
+
+var _ auth.OAuth2Provider = (*Provider)(nil)
+
+// NewProvider creates the Gitee provider, the endpoints are read from apiUrl for a private deployment.
+func NewProvider(providerConfig *config.OAuth2_Config) (auth.OAuth2Provider, error) {
+	userURL, err := auth.APIEndpoint("userInfoUrl", providerConfig.GetUserInfoUrl(), providerConfig.GetApiUrl(), defaultAPIURL, "user")
+	if err != nil {
+		return nil, err
+	}
+	emailsURL, err := auth.APIEndpoint("apiUrl", "", providerConfig.GetApiUrl(), defaultAPIURL, "emails")
+	if err != nil {
+		return nil, err
+	}
+	client, err := auth.NewHTTPClient(providerConfig.GetHttpClient())
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{userURL: userURL, emailsURL: emailsURL, client: client}, nil
+}
+
+// Provider logs users in with Gitee or a private Gitee deployment.
+type Provider struct {
+	userURL   string
+	emailsURL string
+	client    *nethttp.Client
+}
+
+// AuthCodeOptions implements [auth.OAuth2Provider].
+func (p *Provider) AuthCodeOptions(http.Context, *oauth2.Config) ([]oauth2.AuthCodeOption, error) {
+	return nil, nil
 }
 
-func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
+// Login implements [auth.OAuth2Provider].
+func (p *Provider) Login(ctx http.Context, oauthConfig *oauth2.Config, opts ...oauth2.AuthCodeOption) (auth.User, error) {
 	code := ctx.Request().URL.Query().Get("code")
 	if code == "" {
 		return nil, merr.ErrorInvalidArgument("code is required")
 	}
+	return p.Exchange(ctx, oauthConfig, code, opts...)
+}
+
+// Exchange exchanges the code for a token and reads the user with it.
+func (p *Provider) Exchange(ctx context.Context, oauthConfig *oauth2.Config, code string, exchangeOpts ...oauth2.AuthCodeOption) (*GiteeUser, error) {
+	ctx = auth.WithHTTPClient(ctx, p.client)
 	opts := []oauth2.AuthCodeOption{
 		// https://gitee.com/oauth/token?grant_type=authorization_code&code={code}&client_id={client_id}&redirect_uri={redirect_uri}&client_secret={client_secret}
 		oauth2.SetAuthURLParam("grant_type", "authorization_code"),
@@ -29,12 +79,12 @@ func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
 		oauth2.SetAuthURLParam("redirect_uri", oauthConfig.RedirectURL),
 		oauth2.SetAuthURLParam("code", code),
 	}
-	token, err := oauthConfig.Exchange(ctx, code, opts...)
+	token, err := oauthConfig.Exchange(ctx, code, append(opts, exchangeOpts...)...)
 	if err != nil {
 		return nil, merr.ErrorInternal("exchange token failed").WithCause(err)
 	}
-	client := oauthConfig.Client(ctx, token)
-	resp, err := client.Get("https://gitee.com/api/v5/user")
+	client := auth.TokenClient(ctx, oauthConfig, token, p.client)
+	resp, err := client.Get(p.userURL)
 	if err != nil {
 		return nil, merr.ErrorInternal("get user info failed").WithCause(err)
 	}
@@ -43,5 +93,43 @@ func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
 		return nil, merr.ErrorInternal("decode user info failed").WithCause(err)
 	}
+	// the emails of the account need the emails scope, the profile email is replaced by the confirmed primary email
+	email, err := p.primaryEmail(client)
+	if err != nil {
+		klog.Context(ctx).Warnw("msg", "get gitee user emails failed", "error", err, "login", user.Login)
+	}
+	if email != "" {
+		user.Email = email
+		user.emailConfirmed = true
+	}
 	return &user, nil
 }
+
+// Email is an email of the account listed by /emails.
+type Email struct {
+	Email string   `json:"email"`
+	State string   `json:"state"`
+	Scope []string `json:"scope"`
+}
+
+// primaryEmail returns the primary email of the account when it is confirmed.
+func (p *Provider) primaryEmail(client *nethttp.Client) (string, error) {
+	resp, err := client.Get(p.emailsURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != nethttp.StatusOK {
+		return "", merr.ErrorInternal("get user emails failed with status %d", resp.StatusCode)
+	}
+	var emails []Email
+	if err := json.NewDecoder(resp.Body).Decode(&emails); err != nil {
+		return "", err
+	}
+	for _, email := range emails {
+		if email.State == "confirmed" && slices.Contains(email.Scope, "primary") {
+			return email.Email, nil
+		}
+	}
+	return "", nil
+}
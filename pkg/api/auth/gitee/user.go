@@ -8,7 +8,10 @@ import (
 	"github.com/aide-family/sovereign/pkg/config"
 )
 
-var _ auth.User = (*GiteeUser)(nil)
+var (
+	_ auth.User             = (*GiteeUser)(nil)
+	_ auth.NoreplyEmailUser = (*GiteeUser)(nil)
+)
 
 type GiteeUser struct {
 	AvatarURL         string `json:"avatar_url"`
@@ -40,6 +43,8 @@ type GiteeUser struct {
 	URL               string `json:"url"`
 	Watched           uint32 `json:"watched"`
 	Weibo             string `json:"weibo"`
+
+	emailConfirmed bool
 }
 
 // GetAPP implements [auth.User].
@@ -82,3 +87,17 @@ func (g *GiteeUser) GetRemark() string {
 func (g *GiteeUser) GetNickname() string {
 	return g.Name
 }
+
+// IsEmailVerified implements [auth.User].
+// The profile of Gitee does not tell whether the email is verified, only the confirmed primary email read from /emails is.
+func (g *GiteeUser) IsEmailVerified() bool {
+	return g.emailConfirmed
+}
+
+// GetNoreplyEmail implements [auth.NoreplyEmailUser].
+func (g *GiteeUser) GetNoreplyEmail() string {
+	if g.Login == "" {
+		return ""
+	}
+	return g.Login + "@" + noreplyDomain
+}
@@ -2,8 +2,15 @@
 package github
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
+	nethttp "net/http"
+	"net/url"
+	"slices"
+	"strings"
 
+	klog "github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"golang.org/x/oauth2"
 
@@ -12,21 +19,97 @@ import (
 	"github.com/aide-family/sovereign/pkg/merr"
 )
 
+const (
+	// defaultAPIURL is the API of github.com, GitHub Enterprise Server serves it under https://<host>/api/v3
+	defaultAPIURL = "https://api.github.com"
+	// noreplyDomain is the domain of the emails GitHub uses for the users keeping their email private
+	noreplyDomain = "users.noreply.github.com"
+	// teamsPageSize is the largest page of /user/teams
+	teamsPageSize = 100
+)
+
 func init() {
-	auth.RegisterOAuth2LoginFun(config.OAuth2_GITHUB, Login)
+	auth.RegisterOAuth2ProviderFun(config.OAuth2_GITHUB, NewProvider)
+	auth.RegisterNoreplyEmailDomain(config.OAuth2_GITHUB, noreplyDomain)
 }
 
-func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
+var _ auth.OAuth2Provider = (*Provider)(nil)
+
+// NewProvider creates the GitHub provider, the endpoints are read from apiUrl for GitHub Enterprise Server.
+// The organizations and teams of the admission are checked through the API on every login.
+func NewProvider(providerConfig *config.OAuth2_Config) (auth.OAuth2Provider, error) {
+	userURL, err := auth.APIEndpoint("userInfoUrl", providerConfig.GetUserInfoUrl(), providerConfig.GetApiUrl(), defaultAPIURL, "user")
+	if err != nil {
+		return nil, err
+	}
+	emailsURL, err := auth.APIEndpoint("apiUrl", "", providerConfig.GetApiUrl(), defaultAPIURL, "user", "emails")
+	if err != nil {
+		return nil, err
+	}
+	membershipsURL, err := auth.APIEndpoint("apiUrl", "", providerConfig.GetApiUrl(), defaultAPIURL, "user", "memberships", "orgs")
+	if err != nil {
+		return nil, err
+	}
+	teamsURL, err := auth.APIEndpoint("apiUrl", "", providerConfig.GetApiUrl(), defaultAPIURL, "user", "teams")
+	if err != nil {
+		return nil, err
+	}
+	admission := providerConfig.GetAdmission()
+	for _, team := range admission.GetGithubTeams() {
+		if org, slug, ok := strings.Cut(team, "/"); !ok || org == "" || slug == "" {
+			return nil, merr.ErrorInternal("github team %s must be written as <org>/<team-slug>", team)
+		}
+	}
+	client, err := auth.NewHTTPClient(providerConfig.GetHttpClient())
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{
+		userURL:        userURL,
+		emailsURL:      emailsURL,
+		membershipsURL: membershipsURL,
+		teamsURL:       teamsURL,
+		organizations:  admission.GetGithubOrganizations(),
+		teams:          admission.GetGithubTeams(),
+		client:         client,
+	}, nil
+}
+
+// Provider logs users in with GitHub or GitHub Enterprise Server.
+type Provider struct {
+	userURL        string
+	emailsURL      string
+	membershipsURL string
+	teamsURL       string
+	// organizations and teams admit their members, everyone is admitted when both are empty
+	organizations []string
+	teams         []string
+	client        *nethttp.Client
+}
+
+// AuthCodeOptions implements [auth.OAuth2Provider].
+func (p *Provider) AuthCodeOptions(http.Context, *oauth2.Config) ([]oauth2.AuthCodeOption, error) {
+	return nil, nil
+}
+
+// Login implements [auth.OAuth2Provider].
+func (p *Provider) Login(ctx http.Context, oauthConfig *oauth2.Config, opts ...oauth2.AuthCodeOption) (auth.User, error) {
 	code := ctx.Request().URL.Query().Get("code")
 	if code == "" {
 		return nil, merr.ErrorInvalidArgument("code is required")
 	}
-	token, err := oauthConfig.Exchange(ctx, code)
+	return p.Exchange(ctx, oauthConfig, code, opts...)
+}
+
+// Exchange exchanges the code for a token and reads the user with it.
+func (p *Provider) Exchange(ctx context.Context, oauthConfig *oauth2.Config, code string, opts ...oauth2.AuthCodeOption) (*User, error) {
+	ctx = auth.WithHTTPClient(ctx, p.client)
+	token, err := oauthConfig.Exchange(ctx, code, opts...)
 	if err != nil {
 		return nil, merr.ErrorInternal("exchange token failed").WithCause(err)
 	}
-	client := oauthConfig.Client(ctx, token)
-	resp, err := client.Get("https://api.github.com/user")
+	client := auth.TokenClient(ctx, oauthConfig, token, p.client)
+	resp, err := client.Get(p.userURL)
 	if err != nil {
 		return nil, merr.ErrorInternal("get user info failed").WithCause(err)
 	}
@@ -35,5 +118,140 @@ func Login(ctx http.Context, oauthConfig *oauth2.Config) (auth.User, error) {
 	if err := json.NewDecoder(resp.Body).Decode(&user); err != nil {
 		return nil, merr.ErrorInternal("decode user info failed").WithCause(err)
 	}
+	// the profile has no email when the user keeps it private, the emails of the account need the user:email scope
+	if user.Email == "" {
+		email, err := p.primaryEmail(client)
+		if err != nil {
+			klog.Context(ctx).Warnw("msg", "get github user emails failed", "error", err, "login", user.Login)
+		}
+		user.Email = email
+	}
+	if err := p.checkMembership(ctx, client, user.Login); err != nil {
+		return nil, err
+	}
 	return &user, nil
 }
+
+// checkMembership refuses the user who is neither an active member of one of the organizations nor a member of one of the teams.
+func (p *Provider) checkMembership(ctx context.Context, client *nethttp.Client, login string) error {
+	if len(p.organizations) == 0 && len(p.teams) == 0 {
+		return nil
+	}
+	for _, org := range p.organizations {
+		member, err := p.isOrganizationMember(client, org)
+		if err != nil {
+			klog.Context(ctx).Warnw("msg", "get github organization membership failed", "error", err, "login", login, "org", org)
+			return merr.ErrorInternal("get github organization membership failed").WithCause(err)
+		}
+		if member {
+			return nil
+		}
+	}
+	if len(p.teams) > 0 {
+		member, err := p.isTeamMember(client)
+		if err != nil {
+			klog.Context(ctx).Warnw("msg", "get github teams failed", "error", err, "login", login)
+			return merr.ErrorInternal("get github teams failed").WithCause(err)
+		}
+		if member {
+			return nil
+		}
+	}
+	klog.Context(ctx).Infow("msg", "github user is not a member of the admitted organizations or teams", "login", login)
+	return merr.ErrorForbidden("github user %s is not a member of the organizations or teams allowed to sign in", login)
+}
+
+// Membership is the membership of the user in an organization, listed by /user/memberships/orgs/{org}.
+type Membership struct {
+	State string `json:"state"`
+}
+
+// isOrganizationMember reports whether the user is an active member of the organization, the read:org scope is needed
+// to see the memberships which are not public.
+func (p *Provider) isOrganizationMember(client *nethttp.Client, org string) (bool, error) {
+	resp, err := client.Get(p.membershipsURL + "/" + url.PathEscape(org))
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+	switch resp.StatusCode {
+	case nethttp.StatusOK:
+	case nethttp.StatusNotFound, nethttp.StatusForbidden:
+		return false, nil
+	default:
+		return false, merr.ErrorInternal("get organization membership failed with status %d", resp.StatusCode)
+	}
+	var membership Membership
+	if err := json.NewDecoder(resp.Body).Decode(&membership); err != nil {
+		return false, err
+	}
+	return membership.State == "active", nil
+}
+
+// Team is a team of the user listed by /user/teams.
+type Team struct {
+	Slug         string `json:"slug"`
+	Organization struct {
+		Login string `json:"login"`
+	} `json:"organization"`
+}
+
+// isTeamMember reports whether the user is a member of one of the teams, reading the teams of the user page by page.
+func (p *Provider) isTeamMember(client *nethttp.Client) (bool, error) {
+	for page := 1; ; page++ {
+		resp, err := client.Get(fmt.Sprintf("%s?per_page=%d&page=%d", p.teamsURL, teamsPageSize, page))
+		if err != nil {
+			return false, err
+		}
+		var teams []Team
+		err = func() error {
+			defer resp.Body.Close()
+			if resp.StatusCode != nethttp.StatusOK {
+				return merr.ErrorInternal("get user teams failed with status %d", resp.StatusCode)
+			}
+			return json.NewDecoder(resp.Body).Decode(&teams)
+		}()
+		if err != nil {
+			return false, err
+		}
+		for _, team := range teams {
+			name := team.Organization.Login + "/" + team.Slug
+			if slices.ContainsFunc(p.teams, func(item string) bool { return strings.EqualFold(item, name) }) {
+				return true, nil
+			}
+		}
+		if len(teams) < teamsPageSize {
+			return false, nil
+		}
+	}
+}
+
+// Email is an email of the account listed by /user/emails.
+type Email struct {
+	Email      string `json:"email"`
+	Primary    bool   `json:"primary"`
+	Verified   bool   `json:"verified"`
+	Visibility string `json:"visibility"`
+}
+
+// primaryEmail returns the primary email of the account when it is verified.
+func (p *Provider) primaryEmail(client *nethttp.Client) (string, error) {
+	resp, err := client.Get(p.emailsURL)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != nethttp.StatusOK {
+		return "", merr.ErrorInternal("get user emails failed with status %d", resp.StatusCode)
+	}
+	var emails []Email
+	if err := json.NewDecoder(resp.Body).Decode(&emails); err != nil {
+		return "", err
+	}
+	for _, email := range emails {
+		if email.Primary && email.Verified {
+			return email.Email, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,170 @@
+package github_test
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"golang.org/x/oauth2"
+
+	"github.com/aide-family/sovereign/pkg/api/auth/github"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// newStubGitHub serves the token endpoint and the API of GitHub Enterprise Server under /api/v3 over TLS,
+// the email of the profile is private unless publicEmail is set, the user is an active member of aide-family and its team moon.
+func newStubGitHub(t *testing.T, publicEmail string, emails []github.Email) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/login/oauth/access_token", func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{"access_token": "token-" + r.FormValue("code"), "token_type": "bearer"})
+	})
+	mux.HandleFunc("/api/v3/user", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer token-code" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"id": 42, "login": "octocat", "email": publicEmail})
+	})
+	mux.HandleFunc("/api/v3/user/emails", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(emails)
+	})
+	mux.HandleFunc("/api/v3/user/memberships/orgs/{org}", func(w http.ResponseWriter, r *http.Request) {
+		if r.PathValue("org") != "aide-family" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(github.Membership{State: "active"})
+	})
+	mux.HandleFunc("/api/v3/user/teams", func(w http.ResponseWriter, r *http.Request) {
+		team := github.Team{Slug: "moon"}
+		team.Organization.Login = "aide-family"
+		_ = json.NewEncoder(w).Encode([]github.Team{team})
+	})
+	server := httptest.NewTLSServer(mux)
+	t.Cleanup(server.Close)
+	return server
+}
+
+// writeCAFile writes the certificate of the stub as the trusted CA file.
+func writeCAFile(t *testing.T, server *httptest.Server) string {
+	t.Helper()
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+	if err := os.WriteFile(caFile, caPEM, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return caFile
+}
+
+func exchange(t *testing.T, server *httptest.Server, caFile string, admission *config.OAuth2_Admission) (*github.User, error) {
+	t.Helper()
+	providerConfig := &config.OAuth2_Config{
+		App:        config.OAuth2_GITHUB,
+		ApiUrl:     server.URL + "/api/v3",
+		HttpClient: &config.OAuth2_HTTPClient{CaFile: caFile},
+		Admission:  admission,
+	}
+	provider, err := github.NewProvider(providerConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	oauthConfig := &oauth2.Config{
+		ClientID:     "client",
+		ClientSecret: "secret",
+		Endpoint:     oauth2.Endpoint{TokenURL: server.URL + "/login/oauth/access_token"},
+	}
+	return provider.(*github.Provider).Exchange(context.Background(), oauthConfig, "code")
+}
+
+func TestExchangePrivateEmail(t *testing.T) {
+	tests := []struct {
+		name         string
+		publicEmail  string
+		emails       []github.Email
+		wantEmail    string
+		wantVerified bool
+	}{
+		{
+			name:         "public email",
+			publicEmail:  "octocat@example.com",
+			wantEmail:    "octocat@example.com",
+			wantVerified: true,
+		},
+		{
+			name: "primary verified email",
+			emails: []github.Email{
+				{Email: "other@example.com", Verified: true},
+				{Email: "octocat@example.com", Primary: true, Verified: true, Visibility: "private"},
+			},
+			wantEmail:    "octocat@example.com",
+			wantVerified: true,
+		},
+		{
+			name:   "primary email not verified",
+			emails: []github.Email{{Email: "octocat@example.com", Primary: true}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStubGitHub(t, tt.publicEmail, tt.emails)
+			user, err := exchange(t, server, writeCAFile(t, server), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if user.GetOpenID() != "42" || user.GetEmail() != tt.wantEmail || user.IsEmailVerified() != tt.wantVerified {
+				t.Fatalf("got openID %q email %q verified %v", user.GetOpenID(), user.GetEmail(), user.IsEmailVerified())
+			}
+			if got := user.GetNoreplyEmail(); got != "octocat@users.noreply.github.com" {
+				t.Fatalf("got noreply email %q", got)
+			}
+		})
+	}
+}
+
+func TestExchangeUntrustedCA(t *testing.T) {
+	server := newStubGitHub(t, "octocat@example.com", nil)
+	if _, err := exchange(t, server, "", nil); err == nil {
+		t.Fatal("want the certificate of the stub rejected without its CA file")
+	}
+}
+
+func TestExchangeMembership(t *testing.T) {
+	tests := []struct {
+		name      string
+		admission *config.OAuth2_Admission
+		wantErr   bool
+	}{
+		{name: "organization member", admission: &config.OAuth2_Admission{GithubOrganizations: []string{"aide-family"}}},
+		{name: "not organization member", admission: &config.OAuth2_Admission{GithubOrganizations: []string{"other"}}, wantErr: true},
+		{name: "team member", admission: &config.OAuth2_Admission{GithubTeams: []string{"aide-family/moon"}}},
+		{name: "not team member", admission: &config.OAuth2_Admission{GithubTeams: []string{"aide-family/other"}}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := newStubGitHub(t, "octocat@example.com", nil)
+			_, err := exchange(t, server, writeCAFile(t, server), tt.admission)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("got error %v, want error %v", err, tt.wantErr)
+			}
+			if err != nil && !merr.IsForbidden(err) {
+				t.Fatalf("want a forbidden error, got %v", err)
+			}
+		})
+	}
+}
+
+func TestNewProviderInvalidAPIURL(t *testing.T) {
+	for _, apiURL := range []string{"ghe.example.com/api/v3", "ftp://ghe.example.com", "://"} {
+		if _, err := github.NewProvider(&config.OAuth2_Config{App: config.OAuth2_GITHUB, ApiUrl: apiURL}); err == nil {
+			t.Fatalf("want apiUrl %q rejected", apiURL)
+		}
+	}
+}
@@ -8,7 +8,10 @@ import (
 	"github.com/aide-family/sovereign/pkg/config"
 )
 
-var _ auth.User = (*User)(nil)
+var (
+	_ auth.User             = (*User)(nil)
+	_ auth.NoreplyEmailUser = (*User)(nil)
+)
 
 type User struct {
 	AvatarURL         string `json:"avatar_url"`
@@ -86,3 +89,17 @@ func (u *User) GetRemark() string {
 func (u *User) GetNickname() string {
 	return u.Name
 }
+
+// IsEmailVerified implements [auth.User].
+// GitHub only lets a verified email be the public email of the profile, and only the verified primary email is read from /user/emails.
+func (u *User) IsEmailVerified() bool {
+	return u.Email != ""
+}
+
+// GetNoreplyEmail implements [auth.NoreplyEmailUser].
+func (u *User) GetNoreplyEmail() string {
+	if u.Login == "" {
+		return ""
+	}
+	return u.Login + "@" + noreplyDomain
+}
@@ -0,0 +1,321 @@
+// Package ldap is the LDAP and Active Directory auth package for the sovereign service.
+package ldap
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"net"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/aide-family/magicbox/pointer"
+	"github.com/aide-family/magicbox/strutil"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	defaultUserFilter        = "(uid={username})"
+	defaultGroupFilter       = "(member={dn})"
+	defaultGroupNameAttr     = "cn"
+	defaultMemberOfAttribute = "memberOf"
+	defaultPoolSize          = 4
+	defaultTimeout           = 10 * time.Second
+
+	usernamePlaceholder = "{username}"
+	dnPlaceholder       = "{dn}"
+)
+
+// NewProvider creates the LDAP provider, the directory is not contacted until the first login.
+func NewProvider(conf *config.LDAP) (*Provider, error) {
+	if pointer.IsNil(conf) || strutil.IsEmpty(conf.GetUrl()) {
+		return nil, merr.ErrorInternal("ldap url is required")
+	}
+	if strutil.IsEmpty(conf.GetBaseDn()) {
+		return nil, merr.ErrorInternal("ldap baseDn is required")
+	}
+	tlsConfig, err := newTLSConfig(conf)
+	if err != nil {
+		return nil, err
+	}
+	timeout := conf.GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+	poolSize := int(conf.GetPoolSize())
+	if poolSize <= 0 {
+		poolSize = defaultPoolSize
+	}
+	return &Provider{
+		conf:      conf,
+		tlsConfig: tlsConfig,
+		timeout:   timeout,
+		idle:      make(chan *ldap.Conn, poolSize),
+	}, nil
+}
+
+// Provider signs users in against the directory with a pool of connections bound as the service account.
+type Provider struct {
+	conf      *config.LDAP
+	tlsConfig *tls.Config
+	timeout   time.Duration
+	idle      chan *ldap.Conn
+}
+
+// Login finds the user by the user filter, checks the password by binding as its DN and reads its groups.
+func (p *Provider) Login(ctx context.Context, username, password string) (auth.User, error) {
+	// an empty password is an unauthenticated bind which most directories accept
+	if strutil.IsEmpty(username) || strutil.IsEmpty(password) {
+		return nil, merr.ErrorUnauthorized("username or password is incorrect")
+	}
+	conn, pooled, err := p.get()
+	if err != nil {
+		return nil, err
+	}
+	user, err := p.login(ctx, conn, username, password)
+	if err != nil && pooled && conn.IsClosing() {
+		// the idle connection was closed by the directory, the login is retried once on a new connection
+		if conn, err = p.dial(); err != nil {
+			return nil, err
+		}
+		user, err = p.login(ctx, conn, username, password)
+	}
+	if err != nil {
+		// the connection is bound as the user or broken, it is only reused once the service account is bound again
+		if bindErr := p.bind(conn); bindErr != nil {
+			conn.Close()
+			return nil, err
+		}
+		p.put(conn)
+		return nil, err
+	}
+	p.put(conn)
+	return user, nil
+}
+
+// Close closes the idle connections.
+func (p *Provider) Close() error {
+	for {
+		select {
+		case conn := <-p.idle:
+			conn.Close()
+		default:
+			return nil
+		}
+	}
+}
+
+func (p *Provider) login(ctx context.Context, conn *ldap.Conn, username, password string) (*User, error) {
+	entry, err := p.searchUser(conn, username)
+	if err != nil {
+		return nil, err
+	}
+	groups, err := p.searchGroups(conn, entry, username)
+	if err != nil {
+		return nil, err
+	}
+	if err := conn.Bind(entry.DN, password); err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			klog.Context(ctx).Debugw("msg", "ldap bind failed", "dn", entry.DN)
+			return nil, merr.ErrorUnauthorized("username or password is incorrect")
+		}
+		return nil, merr.ErrorInternal("ldap bind failed").WithCause(err)
+	}
+	if err := p.bind(conn); err != nil {
+		return nil, err
+	}
+	user := &User{entry: entry, groups: groups, mapping: p.conf.GetAttributes()}
+	if strutil.IsEmpty(user.GetEmail()) {
+		return nil, merr.ErrorForbidden("ldap user %s has no email", username)
+	}
+	return user, nil
+}
+
+func (p *Provider) searchUser(conn *ldap.Conn, username string) (*ldap.Entry, error) {
+	filter := p.conf.GetUserFilter()
+	if strutil.IsEmpty(filter) {
+		filter = defaultUserFilter
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		p.conf.GetBaseDn(),
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 2, int(p.timeout.Seconds()), false,
+		strings.ReplaceAll(filter, usernamePlaceholder, ldap.EscapeFilter(username)),
+		p.userAttributes(),
+		nil,
+	))
+	if err != nil && !ldap.IsErrorWithCode(err, ldap.LDAPResultSizeLimitExceeded) {
+		return nil, merr.ErrorInternal("ldap search user failed").WithCause(err)
+	}
+	if pointer.IsNil(result) || len(result.Entries) != 1 {
+		// missing and ambiguous users are rejected alike, so the response does not tell which usernames exist
+		return nil, merr.ErrorUnauthorized("username or password is incorrect")
+	}
+	return result.Entries[0], nil
+}
+
+// userAttributes asks for the user attributes along with the operational ones the mapping needs, such as memberOf of OpenLDAP.
+func (p *Provider) userAttributes() []string {
+	attributes := []string{"*"}
+	if openID := p.conf.GetAttributes().GetOpenId(); strutil.IsNotEmpty(openID) && !strings.EqualFold(openID, dnAttribute) {
+		attributes = append(attributes, openID)
+	}
+	if strutil.IsEmpty(p.conf.GetGroups().GetBaseDn()) {
+		memberOfAttribute := p.conf.GetGroups().GetMemberOfAttribute()
+		if strutil.IsEmpty(memberOfAttribute) {
+			memberOfAttribute = defaultMemberOfAttribute
+		}
+		attributes = append(attributes, memberOfAttribute)
+	}
+	return attributes
+}
+
+// searchGroups searches the groups of the user below the group base DN, or reads them from its memberOf attribute.
+func (p *Provider) searchGroups(conn *ldap.Conn, entry *ldap.Entry, username string) ([]string, error) {
+	groupsConfig := p.conf.GetGroups()
+	nameAttribute := groupsConfig.GetNameAttribute()
+	if strutil.IsEmpty(nameAttribute) {
+		nameAttribute = defaultGroupNameAttr
+	}
+	if strutil.IsEmpty(groupsConfig.GetBaseDn()) {
+		memberOfAttribute := groupsConfig.GetMemberOfAttribute()
+		if strutil.IsEmpty(memberOfAttribute) {
+			memberOfAttribute = defaultMemberOfAttribute
+		}
+		groupDNs := entry.GetAttributeValues(memberOfAttribute)
+		groups := make([]string, 0, len(groupDNs))
+		for _, groupDN := range groupDNs {
+			groups = append(groups, groupName(groupDN, nameAttribute))
+		}
+		return groups, nil
+	}
+	filter := groupsConfig.GetFilter()
+	if strutil.IsEmpty(filter) {
+		filter = defaultGroupFilter
+	}
+	filter = strings.ReplaceAll(filter, dnPlaceholder, ldap.EscapeFilter(entry.DN))
+	filter = strings.ReplaceAll(filter, usernamePlaceholder, ldap.EscapeFilter(username))
+	result, err := conn.Search(ldap.NewSearchRequest(
+		groupsConfig.GetBaseDn(),
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, int(p.timeout.Seconds()), false,
+		filter,
+		[]string{nameAttribute},
+		nil,
+	))
+	if err != nil {
+		return nil, merr.ErrorInternal("ldap search groups failed").WithCause(err)
+	}
+	groups := make([]string, 0, len(result.Entries))
+	for _, groupEntry := range result.Entries {
+		if name := groupEntry.GetAttributeValue(nameAttribute); strutil.IsNotEmpty(name) {
+			groups = append(groups, name)
+		}
+	}
+	return groups, nil
+}
+
+// get takes an idle connection or dials a new one when the pool is empty, pooled reports an idle connection.
+func (p *Provider) get() (conn *ldap.Conn, pooled bool, err error) {
+	select {
+	case conn := <-p.idle:
+		if !conn.IsClosing() {
+			return conn, true, nil
+		}
+	default:
+	}
+	conn, err = p.dial()
+	return conn, false, err
+}
+
+// put returns the connection to the pool, connections beyond the pool size are closed.
+func (p *Provider) put(conn *ldap.Conn) {
+	if conn.IsClosing() {
+		return
+	}
+	select {
+	case p.idle <- conn:
+	default:
+		conn.Close()
+	}
+}
+
+func (p *Provider) dial() (*ldap.Conn, error) {
+	conn, err := ldap.DialURL(p.conf.GetUrl(),
+		ldap.DialWithDialer(&net.Dialer{Timeout: p.timeout}),
+		ldap.DialWithTLSConfig(p.tlsConfig),
+	)
+	if err != nil {
+		return nil, merr.ErrorInternal("dial ldap %s failed", p.conf.GetUrl()).WithCause(err)
+	}
+	conn.SetTimeout(p.timeout)
+	if strings.EqualFold(p.conf.GetStartTls(), "true") {
+		if err := conn.StartTLS(p.tlsConfig); err != nil {
+			conn.Close()
+			return nil, merr.ErrorInternal("ldap start tls failed").WithCause(err)
+		}
+	}
+	if err := p.bind(conn); err != nil {
+		conn.Close()
+		return nil, err
+	}
+	return conn, nil
+}
+
+// bind binds the connection as the service account, anonymously when no bind DN is configured.
+func (p *Provider) bind(conn *ldap.Conn) error {
+	var err error
+	if strutil.IsEmpty(p.conf.GetBindDn()) {
+		err = conn.UnauthenticatedBind("")
+	} else {
+		err = conn.Bind(p.conf.GetBindDn(), p.conf.GetBindPassword())
+	}
+	if err != nil {
+		return merr.ErrorInternal("ldap service account bind failed").WithCause(err)
+	}
+	return nil
+}
+
+func newTLSConfig(conf *config.LDAP) (*tls.Config, error) {
+	target, err := url.Parse(conf.GetUrl())
+	if err != nil {
+		return nil, merr.ErrorInternal("invalid ldap url %s", conf.GetUrl()).WithCause(err)
+	}
+	tlsConfig := &tls.Config{
+		ServerName:         target.Hostname(),
+		InsecureSkipVerify: strings.EqualFold(conf.GetInsecureSkipVerify(), "true"),
+		MinVersion:         tls.VersionTLS12,
+	}
+	if caFile := conf.GetCaFile(); strutil.IsNotEmpty(caFile) {
+		pem, err := os.ReadFile(caFile)
+		if err != nil {
+			return nil, merr.ErrorInternal("read ldap ca file %s failed", caFile).WithCause(err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return nil, merr.ErrorInternal("ldap ca file %s has no certificate", caFile).WithCause(errors.New("invalid pem"))
+		}
+		tlsConfig.RootCAs = pool
+	}
+	return tlsConfig, nil
+}
+
+// groupName returns the value of the nameAttribute RDN of a group DN, or the DN when it has none.
+func groupName(groupDN, nameAttribute string) string {
+	dn, err := ldap.ParseDN(groupDN)
+	if err != nil || len(dn.RDNs) == 0 {
+		return groupDN
+	}
+	for _, attribute := range dn.RDNs[0].Attributes {
+		if strings.EqualFold(attribute.Type, nameAttribute) {
+			return attribute.Value
+		}
+	}
+	return groupDN
+}
@@ -0,0 +1,390 @@
+package ldap_test
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	ber "github.com/go-asn1-ber/asn1-ber"
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	ldapauth "github.com/aide-family/sovereign/pkg/api/auth/ldap"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	testBaseDN       = "dc=example,dc=org"
+	testBindDN       = "cn=svc,dc=example,dc=org"
+	testBindPassword = "svc-secret"
+	testAliceDN      = "uid=alice,ou=people,dc=example,dc=org"
+	startTLSOID      = "1.3.6.1.4.1.1466.20037"
+)
+
+type testEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+// stubDirectory is a minimal in-process LDAP server answering simple binds, searches with
+// equality, presence, and, or filters, and StartTLS, which is enough for the bind+search login.
+type stubDirectory struct {
+	listener  net.Listener
+	tlsConfig *tls.Config
+	entries   []*testEntry
+
+	mu      sync.Mutex
+	accepts int
+	tlsUsed bool
+}
+
+func newStubDirectory(t *testing.T, ldaps bool) *stubDirectory {
+	t.Helper()
+	d := &stubDirectory{tlsConfig: newTestTLSConfig(t)}
+	d.entries = []*testEntry{
+		{dn: testBindDN, password: testBindPassword, attributes: map[string][]string{"cn": {"svc"}}},
+		{dn: testAliceDN, password: "alice-secret", attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"alice"},
+			"mail":        {"alice@example.org"},
+			"displayName": {"Alice"},
+			"memberOf":    {"cn=dev,ou=groups,dc=example,dc=org", "cn=ops,ou=groups,dc=example,dc=org"},
+			"objectGUID":  {string([]byte{0x78, 0x56, 0x34, 0x12, 0x34, 0x12, 0x78, 0x56, 0x12, 0x34, 0x56, 0x78, 0x90, 0xab, 0xcd, 0xef})},
+		}},
+		{dn: "uid=bob,ou=people,dc=example,dc=org", password: "bob-secret", attributes: map[string][]string{
+			"objectClass": {"person"},
+			"uid":         {"bob"},
+		}},
+		{dn: "cn=dev,ou=groups,dc=example,dc=org", attributes: map[string][]string{
+			"objectClass": {"groupOfNames"},
+			"cn":          {"dev"},
+			"member":      {testAliceDN},
+		}},
+	}
+	var err error
+	if ldaps {
+		d.listener, err = tls.Listen("tcp", "127.0.0.1:0", d.tlsConfig)
+		d.tlsUsed = true
+	} else {
+		d.listener, err = net.Listen("tcp", "127.0.0.1:0")
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { d.listener.Close() })
+	go d.serve()
+	return d
+}
+
+func (d *stubDirectory) addr() string {
+	return d.listener.Addr().String()
+}
+
+func (d *stubDirectory) serve() {
+	for {
+		conn, err := d.listener.Accept()
+		if err != nil {
+			return
+		}
+		d.mu.Lock()
+		d.accepts++
+		d.mu.Unlock()
+		go d.handle(conn)
+	}
+}
+
+func (d *stubDirectory) handle(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID := packet.Children[0].Value.(int64)
+		op := packet.Children[1]
+		switch op.Tag {
+		case ldap.ApplicationBindRequest:
+			dn := op.Children[1].Data.String()
+			password := op.Children[2].Data.String()
+			code := uint16(ldap.LDAPResultInvalidCredentials)
+			if dn == "" && password == "" {
+				code = ldap.LDAPResultSuccess
+			}
+			for _, entry := range d.entries {
+				if entry.dn == dn && entry.password != "" && entry.password == password {
+					code = ldap.LDAPResultSuccess
+				}
+			}
+			d.write(conn, messageID, newResult(ldap.ApplicationBindResponse, code))
+		case ldap.ApplicationUnbindRequest:
+			return
+		case ldap.ApplicationSearchRequest:
+			d.search(conn, messageID, op)
+		case ldap.ApplicationExtendedRequest:
+			if op.Children[0].Data.String() != startTLSOID {
+				d.write(conn, messageID, newResult(ldap.ApplicationExtendedResponse, ldap.LDAPResultProtocolError))
+				continue
+			}
+			d.write(conn, messageID, newResult(ldap.ApplicationExtendedResponse, ldap.LDAPResultSuccess))
+			tlsConn := tls.Server(conn, d.tlsConfig)
+			if err := tlsConn.Handshake(); err != nil {
+				return
+			}
+			d.mu.Lock()
+			d.tlsUsed = true
+			d.mu.Unlock()
+			conn = tlsConn
+		default:
+			return
+		}
+	}
+}
+
+func (d *stubDirectory) search(conn net.Conn, messageID int64, op *ber.Packet) {
+	baseDN := strings.ToLower(op.Children[0].Data.String())
+	sizeLimit := op.Children[3].Value.(int64)
+	filter := op.Children[6]
+	found := 0
+	for _, entry := range d.entries {
+		if !strings.HasSuffix(strings.ToLower(entry.dn), baseDN) || !matchFilter(entry, filter) {
+			continue
+		}
+		if sizeLimit > 0 && int64(found) >= sizeLimit {
+			d.write(conn, messageID, newResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSizeLimitExceeded))
+			return
+		}
+		found++
+		result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "")
+		result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, entry.dn, ""))
+		attributes := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+		for name, values := range entry.attributes {
+			attribute := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+			attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, ""))
+			set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "")
+			for _, value := range values {
+				set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, ""))
+			}
+			attribute.AppendChild(set)
+			attributes.AppendChild(attribute)
+		}
+		result.AppendChild(attributes)
+		d.write(conn, messageID, result)
+	}
+	d.write(conn, messageID, newResult(ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess))
+}
+
+func (d *stubDirectory) write(conn net.Conn, messageID int64, op *ber.Packet) {
+	packet := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSequence, nil, "")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, ""))
+	packet.AppendChild(op)
+	_, _ = conn.Write(packet.Bytes())
+}
+
+func (d *stubDirectory) stats() (accepts int, tlsUsed bool) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	return d.accepts, d.tlsUsed
+}
+
+func newResult(tag ber.Tag, code uint16) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, int64(code), ""))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", ""))
+	return result
+}
+
+func matchFilter(entry *testEntry, filter *ber.Packet) bool {
+	switch filter.Tag {
+	case ldap.FilterAnd:
+		for _, child := range filter.Children {
+			if !matchFilter(entry, child) {
+				return false
+			}
+		}
+		return true
+	case ldap.FilterOr:
+		for _, child := range filter.Children {
+			if matchFilter(entry, child) {
+				return true
+			}
+		}
+		return false
+	case ldap.FilterEqualityMatch:
+		name, want := filter.Children[0].Data.String(), filter.Children[1].Data.String()
+		for attribute, values := range entry.attributes {
+			if !strings.EqualFold(attribute, name) {
+				continue
+			}
+			for _, value := range values {
+				if strings.EqualFold(value, want) {
+					return true
+				}
+			}
+		}
+		return false
+	case ldap.FilterPresent:
+		_, ok := entry.attributes[filter.Data.String()]
+		return ok
+	default:
+		return false
+	}
+}
+
+func newTestTLSConfig(t *testing.T) *tls.Config {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "127.0.0.1"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv("TEST_LDAP_CA_FILE", caFile)
+	return &tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}}
+}
+
+func newTestConfig(url string) *config.LDAP {
+	return &config.LDAP{
+		Url:          url,
+		BindDn:       testBindDN,
+		BindPassword: testBindPassword,
+		BaseDn:       testBaseDN,
+		UserFilter:   "(&(objectClass=person)(uid={username}))",
+		CaFile:       os.Getenv("TEST_LDAP_CA_FILE"),
+		PoolSize:     1,
+	}
+}
+
+func TestLogin(t *testing.T) {
+	directory := newStubDirectory(t, false)
+	provider, err := ldapauth.NewProvider(newTestConfig("ldap://" + directory.addr()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close()
+
+	user, err := provider.Login(context.Background(), "alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	if user.GetOpenID() != testAliceDN || user.GetEmail() != "alice@example.org" || user.GetName() != "alice" || user.GetNickname() != "Alice" {
+		t.Fatalf("unexpected user: %s %s %s %s", user.GetOpenID(), user.GetEmail(), user.GetName(), user.GetNickname())
+	}
+	if user.GetAPP() != config.OAuth2_LDAP {
+		t.Fatalf("unexpected app: %s", user.GetAPP())
+	}
+	assertGroups(t, user, "dev", "ops")
+
+	for _, tc := range []struct{ username, password string }{
+		{"alice", "wrong"},
+		{"alice", ""},
+		{"nobody", "alice-secret"},
+		{"*", "alice-secret"},
+	} {
+		if _, err := provider.Login(context.Background(), tc.username, tc.password); !merr.IsUnauthorized(err) {
+			t.Fatalf("login %s/%s: expected unauthorized, got %v", tc.username, tc.password, err)
+		}
+	}
+	if _, err := provider.Login(context.Background(), "bob", "bob-secret"); !merr.IsForbidden(err) {
+		t.Fatalf("login without email: expected forbidden, got %v", err)
+	}
+	// the failed logins rebind the service account, so every login reuses the pooled connection
+	if _, err := provider.Login(context.Background(), "alice", "alice-secret"); err != nil {
+		t.Fatalf("login after failures failed: %v", err)
+	}
+	if accepts, _ := directory.stats(); accepts != 1 {
+		t.Fatalf("expected a single pooled connection, got %d", accepts)
+	}
+}
+
+func TestLoginGroupSearchAndObjectGUID(t *testing.T) {
+	directory := newStubDirectory(t, false)
+	conf := newTestConfig("ldap://" + directory.addr())
+	conf.Attributes = &config.LDAP_Attributes{OpenId: "objectGUID"}
+	conf.Groups = &config.LDAP_Groups{BaseDn: "ou=groups," + testBaseDN}
+	provider, err := ldapauth.NewProvider(conf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close()
+
+	user, err := provider.Login(context.Background(), "alice", "alice-secret")
+	if err != nil {
+		t.Fatalf("login failed: %v", err)
+	}
+	if user.GetOpenID() != "12345678-1234-5678-1234-567890abcdef" {
+		t.Fatalf("unexpected objectGUID open id: %s", user.GetOpenID())
+	}
+	assertGroups(t, user, "dev")
+}
+
+func TestLoginOverTLS(t *testing.T) {
+	for name, ldaps := range map[string]bool{"starttls": false, "ldaps": true} {
+		t.Run(name, func(t *testing.T) {
+			directory := newStubDirectory(t, ldaps)
+			conf := newTestConfig("ldap://" + directory.addr())
+			if ldaps {
+				conf.Url = "ldaps://" + directory.addr()
+			} else {
+				conf.StartTls = "true"
+			}
+			provider, err := ldapauth.NewProvider(conf)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer provider.Close()
+			if _, err := provider.Login(context.Background(), "alice", "alice-secret"); err != nil {
+				t.Fatalf("login failed: %v", err)
+			}
+			if _, tlsUsed := directory.stats(); !tlsUsed {
+				t.Fatal("expected the login to use TLS")
+			}
+		})
+	}
+}
+
+func assertGroups(t *testing.T, user auth.User, want ...string) {
+	t.Helper()
+	var raw struct {
+		Groups []string `json:"groups"`
+	}
+	if err := json.Unmarshal(user.GetRaw(), &raw); err != nil {
+		t.Fatal(err)
+	}
+	if strings.Join(raw.Groups, ",") != strings.Join(want, ",") {
+		t.Fatalf("unexpected groups: %v, want %v", raw.Groups, want)
+	}
+}
@@ -0,0 +1,121 @@
+package ldap
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/go-ldap/ldap/v3"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+)
+
+const (
+	dnAttribute         = "dn"
+	objectGUIDAttribute = "objectGUID"
+)
+
+var _ auth.User = (*User)(nil)
+
+// User is the signed-in directory user read through the configured attribute mapping.
+type User struct {
+	entry   *ldap.Entry
+	groups  []string
+	mapping *config.LDAP_Attributes
+}
+
+func (u *User) attribute(name, fallback string) string {
+	if name == "" {
+		name = fallback
+	}
+	if name == "" {
+		return ""
+	}
+	return u.entry.GetAttributeValue(name)
+}
+
+// GetGroups returns the names of the directory groups of the user.
+func (u *User) GetGroups() []string {
+	return u.groups
+}
+
+// GetAPP implements [auth.User].
+func (u *User) GetAPP() config.OAuth2_APP {
+	return config.OAuth2_LDAP
+}
+
+// GetAvatar implements [auth.User].
+func (u *User) GetAvatar() string {
+	return ""
+}
+
+// GetEmail implements [auth.User].
+func (u *User) GetEmail() string {
+	return u.attribute(u.mapping.GetEmail(), "mail")
+}
+
+// GetName implements [auth.User].
+func (u *User) GetName() string {
+	return u.attribute(u.mapping.GetName(), "uid")
+}
+
+// GetNickname implements [auth.User].
+func (u *User) GetNickname() string {
+	return u.attribute(u.mapping.GetNickname(), "displayName")
+}
+
+// GetOpenID implements [auth.User].
+// The DN changes when the user is moved or renamed, objectGUID of Active Directory stays the same.
+func (u *User) GetOpenID() string {
+	name := u.mapping.GetOpenId()
+	switch {
+	case name == "" || strings.EqualFold(name, dnAttribute):
+		return u.entry.DN
+	case strings.EqualFold(name, objectGUIDAttribute):
+		return formatGUID(u.entry.GetRawAttributeValue(name))
+	default:
+		return u.entry.GetAttributeValue(name)
+	}
+}
+
+// GetRaw implements [auth.User].
+func (u *User) GetRaw() []byte {
+	attributes := make(map[string][]string, len(u.entry.Attributes))
+	for _, attribute := range u.entry.Attributes {
+		if strings.EqualFold(attribute.Name, objectGUIDAttribute) {
+			attributes[attribute.Name] = []string{formatGUID(u.entry.GetRawAttributeValue(attribute.Name))}
+			continue
+		}
+		attributes[attribute.Name] = attribute.Values
+	}
+	raw, _ := json.Marshal(map[string]any{
+		"dn":         u.entry.DN,
+		"attributes": attributes,
+		"groups":     u.groups,
+	})
+	return raw
+}
+
+// GetRemark implements [auth.User].
+func (u *User) GetRemark() string {
+	return u.attribute(u.mapping.GetRemark(), "")
+}
+
+// IsEmailVerified implements [auth.User].
+// The emails of the directory are managed by its operator.
+func (u *User) IsEmailVerified() bool {
+	return u.GetEmail() != ""
+}
+
+// formatGUID formats an objectGUID as a UUID, its first three fields are stored little endian.
+func formatGUID(guid []byte) string {
+	if len(guid) != 16 {
+		return fmt.Sprintf("%x", guid)
+	}
+	return fmt.Sprintf("%02x%02x%02x%02x-%02x%02x-%02x%02x-%x-%x",
+		guid[3], guid[2], guid[1], guid[0],
+		guid[5], guid[4],
+		guid[7], guid[6],
+		guid[8:10], guid[10:])
+}
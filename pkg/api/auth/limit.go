@@ -0,0 +1,420 @@
+package auth
+
+import (
+	"context"
+	"net"
+	nethttp "net/http"
+	"net/netip"
+	"strings"
+	"sync"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/grpc/peer"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	// LoginLimitKindIP, LoginLimitKindAccount and LoginLimitKindProvider are the kinds of the keys of the login limits,
+	// the IPs and the accounts are locked out by repeated failures
+	LoginLimitKindIP       = "ip"
+	LoginLimitKindAccount  = "account"
+	LoginLimitKindProvider = "provider"
+
+	// LoginAuditActionLockout is the action of the audit event of a lockout
+	LoginAuditActionLockout = "lockout"
+
+	// PasswordProviderName is the provider of the logins with the password of a local account
+	PasswordProviderName = "password"
+
+	defaultLoginLimitWindow     = time.Minute
+	defaultLockoutWindow        = 15 * time.Minute
+	defaultLockoutDuration      = 15 * time.Minute
+	memoryLoginLimitSweepPeriod = time.Minute
+)
+
+// LoginAttempt is a login of a client IP, an account and a provider, an empty field is not limited.
+type LoginAttempt struct {
+	IP       string
+	Account  string
+	Provider string
+}
+
+// LockoutEvent is emitted when an IP or an account is locked out after repeated failed logins.
+type LockoutEvent struct {
+	Kind        string
+	Subject     string
+	Provider    string
+	IP          string
+	Failures    int64
+	LockedUntil time.Time
+}
+
+// LockoutFunc records the lockouts, such as the audit events of the login limit repository.
+type LockoutFunc func(ctx context.Context, event *LockoutEvent)
+
+// LoginLimitStore counts the hits of the login limit keys, the memory store serves a single replica,
+// a shared store such as the database serves all replicas.
+type LoginLimitStore interface {
+	// Hit counts a hit of the key and returns the hits of its window, a new window starts when the last one ended.
+	Hit(ctx context.Context, key string, window time.Duration) (int64, error)
+	// Lock locks the key out until the time.
+	Lock(ctx context.Context, key string, until time.Time) error
+	// LockedUntil returns the end of the lockout of the key, the zero time when it was never locked.
+	LockedUntil(ctx context.Context, key string) (time.Time, error)
+	// Reset clears the hits of the key, its lockout is kept.
+	Reset(ctx context.Context, key string) error
+}
+
+// LoginLimiter limits the logins by the client IP, the account and the provider, and locks an IP or an account out
+// after repeated failures, a nil limiter allows every login.
+type LoginLimiter struct {
+	conf           *config.LoginRateLimit
+	store          LoginLimitStore
+	trustedProxies []netip.Prefix
+	onLockout      LockoutFunc
+}
+
+type LoginLimiterOption func(*LoginLimiter)
+
+// BindLockoutFunc sets the function recording the lockouts.
+func BindLockoutFunc(fn LockoutFunc) LoginLimiterOption {
+	return func(l *LoginLimiter) {
+		l.onLockout = fn
+	}
+}
+
+// NewLoginLimiter returns nil when the config does not enable the login limits.
+func NewLoginLimiter(conf *config.LoginRateLimit, store LoginLimitStore, opts ...LoginLimiterOption) (*LoginLimiter, error) {
+	if !strings.EqualFold(conf.GetEnable(), "true") {
+		return nil, nil
+	}
+	if store == nil {
+		return nil, merr.ErrorInternal("login rate limit store is required")
+	}
+	trustedProxies := make([]netip.Prefix, 0, len(conf.GetTrustedProxies()))
+	for _, item := range conf.GetTrustedProxies() {
+		prefix, err := parsePrefix(item)
+		if err != nil {
+			return nil, merr.ErrorInternal("login rate limit trusted proxy %s is invalid", item).WithCause(err)
+		}
+		trustedProxies = append(trustedProxies, prefix)
+	}
+	l := &LoginLimiter{conf: conf, store: store, trustedProxies: trustedProxies}
+	for _, opt := range opts {
+		opt(l)
+	}
+	return l, nil
+}
+
+// parsePrefix parses a CIDR, or an IP as the prefix of the single IP.
+func parsePrefix(value string) (netip.Prefix, error) {
+	if strings.Contains(value, "/") {
+		prefix, err := netip.ParsePrefix(value)
+		return prefix.Masked(), err
+	}
+	addr, err := netip.ParseAddr(value)
+	if err != nil {
+		return netip.Prefix{}, err
+	}
+	return netip.PrefixFrom(addr.Unmap(), addr.Unmap().BitLen()), nil
+}
+
+// Allow counts the attempt against the limits of its IP, account and provider,
+// an attempt over a limit or of a locked IP or account is refused with a too many requests error.
+func (l *LoginLimiter) Allow(ctx context.Context, attempt *LoginAttempt) error {
+	if l == nil {
+		return nil
+	}
+	now := time.Now()
+	for _, subject := range l.lockoutSubjects(attempt) {
+		lockedUntil, err := l.store.LockedUntil(ctx, failureKey(subject.kind, subject.value))
+		if err != nil {
+			return merr.ErrorInternal("check login lockout failed").WithCause(err)
+		}
+		if now.Before(lockedUntil) {
+			return merr.ErrorTooManyRequests("too many failed logins, try again after %s", lockedUntil.Format(time.DateTime))
+		}
+	}
+	rules := []struct {
+		kind    string
+		subject string
+		rule    *config.LoginRateLimit_Rule
+	}{
+		{kind: LoginLimitKindIP, subject: attempt.IP, rule: l.conf.GetIp()},
+		{kind: LoginLimitKindAccount, subject: accountSubject(attempt), rule: l.conf.GetAccount()},
+		{kind: LoginLimitKindProvider, subject: strings.ToLower(attempt.Provider), rule: l.conf.GetProvider()},
+	}
+	for _, item := range rules {
+		limit := item.rule.GetLimit()
+		if limit <= 0 || item.subject == "" {
+			continue
+		}
+		hits, err := l.store.Hit(ctx, attemptKey(item.kind, item.subject), durationOr(item.rule.GetWindow().AsDuration(), defaultLoginLimitWindow))
+		if err != nil {
+			return merr.ErrorInternal("check login rate limit failed").WithCause(err)
+		}
+		if hits > int64(limit) {
+			klog.Context(ctx).Warnw("msg", "login rate limited", "kind", item.kind, "subject", item.subject, "hits", hits)
+			return merr.ErrorTooManyRequests("too many logins, try again later")
+		}
+	}
+	return nil
+}
+
+// Fail counts a failed login of the IP and the account of the attempt, and locks them out when the failures reach
+// the maxFailures of the lockout, the failures of a locked subject count from zero again after the lockout.
+func (l *LoginLimiter) Fail(ctx context.Context, attempt *LoginAttempt) {
+	maxFailures := l.lockout().GetMaxFailures()
+	if maxFailures <= 0 {
+		return
+	}
+	window := durationOr(l.lockout().GetWindow().AsDuration(), defaultLockoutWindow)
+	duration := durationOr(l.lockout().GetDuration().AsDuration(), defaultLockoutDuration)
+	for _, subject := range l.lockoutSubjects(attempt) {
+		key := failureKey(subject.kind, subject.value)
+		failures, err := l.store.Hit(ctx, key, window)
+		if err != nil {
+			klog.Context(ctx).Warnw("msg", "count login failure failed", "error", err, "kind", subject.kind, "subject", subject.value)
+			continue
+		}
+		if failures < int64(maxFailures) {
+			continue
+		}
+		event := &LockoutEvent{
+			Kind:        subject.kind,
+			Subject:     subject.value,
+			Provider:    attempt.Provider,
+			IP:          attempt.IP,
+			Failures:    failures,
+			LockedUntil: time.Now().Add(duration),
+		}
+		if err := l.store.Lock(ctx, key, event.LockedUntil); err != nil {
+			klog.Context(ctx).Warnw("msg", "lock login out failed", "error", err, "kind", subject.kind, "subject", subject.value)
+			continue
+		}
+		if err := l.store.Reset(ctx, key); err != nil {
+			klog.Context(ctx).Warnw("msg", "reset login failures failed", "error", err, "kind", subject.kind, "subject", subject.value)
+		}
+		klog.Context(ctx).Warnw("msg", "login locked out", "kind", subject.kind, "subject", subject.value, "provider", attempt.Provider, "ip", attempt.IP, "failures", failures, "lockedUntil", event.LockedUntil)
+		if l.onLockout != nil {
+			l.onLockout(ctx, event)
+		}
+	}
+}
+
+// Succeed clears the failures of the account of the attempt, the failures of the IP are kept,
+// so that the logins of one account do not hide the guesses of the others.
+func (l *LoginLimiter) Succeed(ctx context.Context, attempt *LoginAttempt) {
+	if l.lockout().GetMaxFailures() <= 0 {
+		return
+	}
+	subject := accountSubject(attempt)
+	if subject == "" {
+		return
+	}
+	if err := l.store.Reset(ctx, failureKey(LoginLimitKindAccount, subject)); err != nil {
+		klog.Context(ctx).Warnw("msg", "reset login failures failed", "error", err, "kind", LoginLimitKindAccount, "subject", subject)
+	}
+}
+
+func (l *LoginLimiter) lockout() *config.LoginRateLimit_Lockout {
+	if l == nil {
+		return nil
+	}
+	return l.conf.GetLockout()
+}
+
+type lockoutSubject struct {
+	kind  string
+	value string
+}
+
+func (l *LoginLimiter) lockoutSubjects(attempt *LoginAttempt) []lockoutSubject {
+	subjects := make([]lockoutSubject, 0, 2)
+	if attempt.IP != "" {
+		subjects = append(subjects, lockoutSubject{kind: LoginLimitKindIP, value: attempt.IP})
+	}
+	if account := accountSubject(attempt); account != "" {
+		subjects = append(subjects, lockoutSubject{kind: LoginLimitKindAccount, value: account})
+	}
+	return subjects
+}
+
+// accountSubject is the account of the attempt under its provider, the same username of two providers are two accounts.
+func accountSubject(attempt *LoginAttempt) string {
+	if attempt.Account == "" {
+		return ""
+	}
+	return strings.ToLower(attempt.Provider) + "/" + strings.ToLower(attempt.Account)
+}
+
+func attemptKey(kind, subject string) string {
+	return "login:attempt:" + kind + ":" + subject
+}
+
+func failureKey(kind, subject string) string {
+	return "login:failure:" + kind + ":" + subject
+}
+
+func durationOr(duration, fallback time.Duration) time.Duration {
+	if duration <= 0 {
+		return fallback
+	}
+	return duration
+}
+
+// ClientIP returns the IP of the client of the request, the X-Forwarded-For and X-Real-IP headers are read
+// only when the request comes from a trusted proxy, and the first address of X-Forwarded-For which is not
+// a trusted proxy, from the right, is the client.
+func (l *LoginLimiter) ClientIP(req *nethttp.Request) string {
+	if l == nil || req == nil {
+		return ""
+	}
+	remote := remoteAddr(req.RemoteAddr)
+	if !remote.IsValid() {
+		return ""
+	}
+	if !l.isTrustedProxy(remote) {
+		return remote.String()
+	}
+	forwarded := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		addr, err := netip.ParseAddr(strings.TrimSpace(forwarded[i]))
+		if err != nil {
+			break
+		}
+		if addr = addr.Unmap(); !l.isTrustedProxy(addr) {
+			return addr.String()
+		}
+	}
+	if addr, err := netip.ParseAddr(strings.TrimSpace(req.Header.Get("X-Real-IP"))); err == nil {
+		return addr.Unmap().String()
+	}
+	return remote.String()
+}
+
+// ContextClientIP returns the client IP of the request of the server context, the peer address for a gRPC request.
+func (l *LoginLimiter) ContextClientIP(ctx context.Context) string {
+	if l == nil {
+		return ""
+	}
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		if httpTr, ok := tr.(http.Transporter); ok {
+			return l.ClientIP(httpTr.Request())
+		}
+	}
+	if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+		if addr := remoteAddr(p.Addr.String()); addr.IsValid() {
+			return addr.String()
+		}
+	}
+	return ""
+}
+
+func (l *LoginLimiter) isTrustedProxy(addr netip.Addr) bool {
+	for _, prefix := range l.trustedProxies {
+		if prefix.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+func remoteAddr(value string) netip.Addr {
+	host, _, err := net.SplitHostPort(value)
+	if err != nil {
+		host = value
+	}
+	addr, err := netip.ParseAddr(host)
+	if err != nil {
+		return netip.Addr{}
+	}
+	return addr.Unmap()
+}
+
+// NewMemoryLoginLimitStore counts in the memory of the replica, the expired keys are swept as the keys are hit.
+func NewMemoryLoginLimitStore() LoginLimitStore {
+	return &memoryLoginLimitStore{entries: make(map[string]*memoryLoginLimit)}
+}
+
+type memoryLoginLimit struct {
+	hits        int64
+	windowEnd   time.Time
+	lockedUntil time.Time
+}
+
+type memoryLoginLimitStore struct {
+	mu      sync.Mutex
+	entries map[string]*memoryLoginLimit
+	sweptAt time.Time
+}
+
+// Hit implements [LoginLimitStore].
+func (s *memoryLoginLimitStore) Hit(_ context.Context, key string, window time.Duration) (int64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.sweep(now)
+	entry := s.entry(key)
+	if !now.Before(entry.windowEnd) {
+		entry.hits = 0
+		entry.windowEnd = now.Add(window)
+	}
+	entry.hits++
+	return entry.hits, nil
+}
+
+// Lock implements [LoginLimitStore].
+func (s *memoryLoginLimitStore) Lock(_ context.Context, key string, until time.Time) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.entry(key).lockedUntil = until
+	return nil
+}
+
+// LockedUntil implements [LoginLimitStore].
+func (s *memoryLoginLimitStore) LockedUntil(_ context.Context, key string) (time.Time, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.entries[key]; ok {
+		return entry.lockedUntil, nil
+	}
+	return time.Time{}, nil
+}
+
+// Reset implements [LoginLimitStore].
+func (s *memoryLoginLimitStore) Reset(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if entry, ok := s.entries[key]; ok {
+		entry.hits = 0
+	}
+	return nil
+}
+
+func (s *memoryLoginLimitStore) entry(key string) *memoryLoginLimit {
+	entry, ok := s.entries[key]
+	if !ok {
+		entry = &memoryLoginLimit{}
+		s.entries[key] = entry
+	}
+	return entry
+}
+
+// sweep removes the keys whose window and lockout ended, at most once a sweep period.
+func (s *memoryLoginLimitStore) sweep(now time.Time) {
+	if now.Sub(s.sweptAt) < memoryLoginLimitSweepPeriod {
+		return
+	}
+	s.sweptAt = now
+	for key, entry := range s.entries {
+		if !now.Before(entry.windowEnd) && !now.Before(entry.lockedUntil) {
+			delete(s.entries, key)
+		}
+	}
+}
@@ -0,0 +1,114 @@
+package auth_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func newLoginLimiter(t *testing.T, conf *config.LoginRateLimit, opts ...auth.LoginLimiterOption) *auth.LoginLimiter {
+	t.Helper()
+	conf.Enable = "true"
+	limiter, err := auth.NewLoginLimiter(conf, auth.NewMemoryLoginLimitStore(), opts...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return limiter
+}
+
+func TestLoginLimiterRules(t *testing.T) {
+	limiter := newLoginLimiter(t, &config.LoginRateLimit{
+		Ip:      &config.LoginRateLimit_Rule{Limit: 3, Window: durationpb.New(time.Minute)},
+		Account: &config.LoginRateLimit_Rule{Limit: 2, Window: durationpb.New(time.Minute)},
+	})
+	ctx := context.Background()
+	for i := range 2 {
+		if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.1", Account: "alice", Provider: auth.PasswordProviderName}); err != nil {
+			t.Fatalf("attempt %d: %v", i, err)
+		}
+	}
+	if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.2", Account: "Alice", Provider: auth.PasswordProviderName}); !merr.IsTooManyRequests(err) {
+		t.Fatalf("want the third attempt of the account limited, got %v", err)
+	}
+	if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.1", Account: "carol", Provider: auth.PasswordProviderName}); err != nil {
+		t.Fatalf("want the third attempt of the IP allowed, got %v", err)
+	}
+	if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.1", Account: "alice", Provider: "ldap"}); !merr.IsTooManyRequests(err) {
+		t.Fatalf("want the fourth attempt of the IP limited, got %v", err)
+	}
+	if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.3", Account: "bob", Provider: auth.PasswordProviderName}); err != nil {
+		t.Fatalf("want another IP and account allowed, got %v", err)
+	}
+}
+
+func TestLoginLimiterLockout(t *testing.T) {
+	var events []*auth.LockoutEvent
+	limiter := newLoginLimiter(t, &config.LoginRateLimit{
+		Lockout: &config.LoginRateLimit_Lockout{MaxFailures: 3, Duration: durationpb.New(time.Hour)},
+	}, auth.BindLockoutFunc(func(_ context.Context, event *auth.LockoutEvent) {
+		events = append(events, event)
+	}))
+	ctx := context.Background()
+	attempt := &auth.LoginAttempt{IP: "192.0.2.1", Account: "alice", Provider: auth.PasswordProviderName}
+	limiter.Fail(ctx, attempt)
+	limiter.Fail(ctx, attempt)
+	// a successful login clears the failures of the account, not of the IP
+	limiter.Succeed(ctx, attempt)
+	limiter.Fail(ctx, attempt)
+	if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.1"}); !merr.IsTooManyRequests(err) {
+		t.Fatalf("want the IP locked out, got %v", err)
+	}
+	if err := limiter.Allow(ctx, &auth.LoginAttempt{IP: "192.0.2.2", Account: "alice", Provider: auth.PasswordProviderName}); err != nil {
+		t.Fatalf("want the account allowed after its failures were cleared, got %v", err)
+	}
+	if len(events) != 1 || events[0].Kind != auth.LoginLimitKindIP || events[0].Subject != "192.0.2.1" || events[0].Failures != 3 {
+		t.Fatalf("got lockout events %+v", events)
+	}
+}
+
+func TestNilLoginLimiter(t *testing.T) {
+	limiter, err := auth.NewLoginLimiter(&config.LoginRateLimit{Enable: "false"}, nil)
+	if err != nil || limiter != nil {
+		t.Fatalf("want no limiter, got %v %v", limiter, err)
+	}
+	ctx := context.Background()
+	attempt := &auth.LoginAttempt{IP: "192.0.2.1", Account: "alice"}
+	limiter.Fail(ctx, attempt)
+	limiter.Succeed(ctx, attempt)
+	if err := limiter.Allow(ctx, attempt); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestLoginLimiterClientIP(t *testing.T) {
+	limiter := newLoginLimiter(t, &config.LoginRateLimit{TrustedProxies: []string{"10.0.0.0/8", "192.0.2.10"}})
+	tests := []struct {
+		name       string
+		remoteAddr string
+		forwarded  string
+		want       string
+	}{
+		{name: "direct client", remoteAddr: "198.51.100.7:5000", forwarded: "203.0.113.1", want: "198.51.100.7"},
+		{name: "trusted proxy", remoteAddr: "10.1.2.3:5000", forwarded: "203.0.113.1", want: "203.0.113.1"},
+		{name: "proxy chain", remoteAddr: "10.1.2.3:5000", forwarded: "203.0.113.9, 203.0.113.1, 192.0.2.10", want: "203.0.113.1"},
+		{name: "no header", remoteAddr: "10.1.2.3:5000", want: "10.1.2.3"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := &nethttp.Request{RemoteAddr: tt.remoteAddr, Header: nethttp.Header{}}
+			if tt.forwarded != "" {
+				req.Header.Set("X-Forwarded-For", tt.forwarded)
+			}
+			if got := limiter.ClientIP(req); got != tt.want {
+				t.Fatalf("got client IP %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -9,9 +9,11 @@ import (
 
 	"github.com/aide-family/magicbox/pointer"
 	"github.com/aide-family/magicbox/safety"
+	"github.com/aide-family/magicbox/strutil"
 	klog "github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"golang.org/x/oauth2"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/aide-family/sovereign/pkg/config"
 	"github.com/aide-family/sovereign/pkg/merr"
@@ -21,20 +23,32 @@ const (
 	loginRoutePath  = "login"
 	reportRoutePath = "reports"
 
+	// providerNameKey is the path variable of the provider name in the login routes
+	providerNameKey = "name"
+	providerNameVar = "{" + providerNameKey + "}"
+
+	// CompleteProfileQueryName is added to the login redirect of a user still signed in with a noreply email under the COMPLETE_PROFILE fallback
+	CompleteProfileQueryName = "complete_profile"
+
 	OperationOAuth2Reports = "/sovereign.api.auth.OAuth2/OAuth2Reports"
 )
 
-func NewOAuth2Handler(conf *config.OAuth2, redirectURLFunc RedirectURLFunc) *OAuth2Handler {
-	return &OAuth2Handler{
-		conf:            conf,
-		redirectURLFunc: redirectURLFunc,
-		oauth2RoutePath: "/oauth2",
-		loginPath:       "/",
-		callbackPath:    "/callback",
-		loginHandler:    DefaultLoginHandler,
-		callbackHandler: DefaultCallbackHandler,
-		oauth2Configs:   safety.NewMap(make(map[config.OAuth2_APP]*oauth2.Config)),
+func NewOAuth2Handler(conf *config.OAuth2, redirectURLFunc RedirectURLFunc, opts ...OAuth2HandlerOption) *OAuth2Handler {
+	h := &OAuth2Handler{
+		conf:             conf,
+		redirectURLFunc:  redirectURLFunc,
+		oauth2RoutePath:  "/oauth2",
+		loginPath:        "/",
+		callbackPath:     "/callback",
+		loginHandler:     DefaultLoginHandler,
+		callbackHandler:  DefaultCallbackHandler,
+		providers:        safety.NewMap(make(map[string]*providerHandler)),
+		runtimeProviders: safety.NewSyncMap(make(map[string]*providerHandler)),
 	}
+	for _, opt := range opts {
+		opt(h)
+	}
+	return h
 }
 
 type OAuth2Handler struct {
@@ -42,20 +56,69 @@ type OAuth2Handler struct {
 	loginHandler    OAuth2LoginHandlerFunc
 	callbackHandler OAuth2CallbackHandlerFunc
 	redirectURLFunc RedirectURLFunc
+	stateSecret     string
+	providerSource  ProviderSource
+	limiter         *LoginLimiter
+	state           *OAuth2State
 
 	oauth2RoutePath string
 	loginPath       string
 	callbackPath    string
 
-	oauth2Configs *safety.Map[config.OAuth2_APP, *oauth2.Config]
+	// providers are the providers of the config, keyed by the lowercase provider name
+	providers *safety.Map[string, *providerHandler]
+	// runtimeProviders caches the providers of the provider source, keyed by the lowercase provider name,
+	// the handlers of a provider are built again when its config changes
+	runtimeProviders *safety.SyncMap[string, *providerHandler]
+}
+
+// providerHandler is the login and the callback of a provider instance.
+type providerHandler struct {
+	config   *config.OAuth2_Config
+	login    http.HandlerFunc
+	callback http.HandlerFunc
 }
 
 type OAuth2HandlerOption func(*OAuth2Handler)
 
-type OAuth2CallbackHandlerFunc func(app config.OAuth2_APP, oauthConfig *oauth2.Config, redirectURLFunc RedirectURLFunc) (http.HandlerFunc, error)
-type OAuth2LoginHandlerFunc func(app config.OAuth2_APP, oauthConfig *oauth2.Config) (http.HandlerFunc, error)
-type OAuth2LoginFun func(ctx http.Context, oauthConfig *oauth2.Config) (User, error)
-type RedirectURLFunc func(ctx http.Context, oauthConfig *oauth2.Config, user User) (string, error)
+type OAuth2CallbackHandlerFunc func(providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State, redirectURLFunc RedirectURLFunc) (http.HandlerFunc, error)
+type OAuth2LoginHandlerFunc func(providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State) (http.HandlerFunc, error)
+
+// OAuth2LoginFun exchanges the authorization code of the callback for the signed-in user, opts carry the PKCE verifier into the exchange.
+type OAuth2LoginFun func(ctx http.Context, oauthConfig *oauth2.Config, opts ...oauth2.AuthCodeOption) (User, error)
+
+// OAuth2Provider is implemented by apps which need more than the oauth2 endpoints, such as OIDC.
+type OAuth2Provider interface {
+	// AuthCodeOptions returns the extra authorization parameters of a login and may remember them on the response, e.g. the OIDC nonce.
+	AuthCodeOptions(ctx http.Context, oauthConfig *oauth2.Config) ([]oauth2.AuthCodeOption, error)
+	// Login exchanges the authorization code of the callback for the signed-in user.
+	Login(ctx http.Context, oauthConfig *oauth2.Config, opts ...oauth2.AuthCodeOption) (User, error)
+}
+
+// OAuth2ProviderFun creates the provider of an app from its configuration, it takes precedence over a registered OAuth2LoginFun.
+type OAuth2ProviderFun func(providerConfig *config.OAuth2_Config) (OAuth2Provider, error)
+
+// ProviderSource returns the enabled providers managed at runtime, such as the providers of the OAuth2 provider API.
+// It is consulted by every login and callback of a provider which is not in the config, so it should be cheap,
+// and a provider of the config hides a provider of the source with the same name.
+type ProviderSource func(ctx context.Context) ([]*config.OAuth2_Config, error)
+
+// RedirectURLFunc signs the user in, or links the identity when the login state has a LinkUID, and returns the URL the browser is sent to.
+type RedirectURLFunc func(ctx http.Context, oauthConfig *oauth2.Config, user User, loginState *LoginState) (string, error)
+
+// LoginPath returns the login route of a provider under the default route paths.
+func LoginPath(name string) string {
+	loginPath, _ := url.JoinPath("/oauth2", loginRoutePath, strings.ToLower(name), "/")
+	return loginPath
+}
+
+// ProviderName returns the name of a provider instance, the app when no name is configured.
+func ProviderName(providerConfig *config.OAuth2_Config) string {
+	if name := providerConfig.GetName(); strutil.IsNotEmpty(name) {
+		return name
+	}
+	return providerConfig.GetApp().String()
+}
 
 func RegisterLoginHandler(handler OAuth2LoginHandlerFunc) OAuth2HandlerOption {
 	return func(h *OAuth2Handler) {
@@ -69,6 +132,27 @@ func RegisterCallbackHandler(handler OAuth2CallbackHandlerFunc) OAuth2HandlerOpt
 	}
 }
 
+// BindStateSecret sets the secret signing the login state when the oauth2 config has no state secret.
+func BindStateSecret(secret string) OAuth2HandlerOption {
+	return func(h *OAuth2Handler) {
+		h.stateSecret = secret
+	}
+}
+
+// BindProviderSource makes the providers of the source available without a restart, next to the providers of the config.
+func BindProviderSource(source ProviderSource) OAuth2HandlerOption {
+	return func(h *OAuth2Handler) {
+		h.providerSource = source
+	}
+}
+
+// BindLoginLimiter limits the login and callback requests of the providers, and locks the clients out after repeated failed callbacks.
+func BindLoginLimiter(limiter *LoginLimiter) OAuth2HandlerOption {
+	return func(h *OAuth2Handler) {
+		h.limiter = limiter
+	}
+}
+
 func BindOAuth2RoutePath(routePath string) OAuth2HandlerOption {
 	return func(h *OAuth2Handler) {
 		h.oauth2RoutePath = routePath
@@ -87,44 +171,39 @@ func BindCallbackPath(callbackPath string) OAuth2HandlerOption {
 	}
 }
 
+// Handler registers the login and callback routes of the providers, the route of a provider is found by its name
+// on every request, so that the providers of the provider source are served as soon as they are enabled.
 func (h *OAuth2Handler) Handler(srv *http.Server) error {
 	if pointer.IsNil(h.conf) || !strings.EqualFold(h.conf.GetEnable(), "true") {
 		klog.Debug("oauth2 is not enabled")
 		return nil
 	}
 
-	routePrintList := make([]string, 0, len(h.conf.GetConfigs()))
-	oauth2Route := srv.Route(h.oauth2RoutePath)
-	loginRoute := oauth2Route.Group(loginRoutePath)
+	state, err := NewOAuth2State(h.conf, h.stateSecret)
+	if err != nil {
+		return err
+	}
+	state.limiter = h.limiter
+	h.state = state
+	routePrintList := make([]string, 0, len(h.conf.GetConfigs())+1)
 	for _, config := range h.conf.GetConfigs() {
-		app := config.GetApp()
-		authConfigItem := &oauth2.Config{
-			ClientID:     config.GetClientId(),
-			ClientSecret: config.GetClientSecret(),
-			RedirectURL:  config.GetCallbackUri(),
-			Scopes:       config.GetScopes(),
-			Endpoint: oauth2.Endpoint{
-				AuthURL:  config.GetAuthUrl(),
-				TokenURL: config.GetTokenUrl(),
-			},
-		}
-		h.oauth2Configs.Set(app, authConfigItem)
-		appPath := strings.ToLower(app.String())
-		appRoute := loginRoute.Group(appPath)
-		loginHandler, err := h.loginHandler(app, authConfigItem)
-		if err != nil {
-			return err
+		appPath := strings.ToLower(ProviderName(config))
+		if _, ok := h.providers.Get(appPath); ok {
+			return merr.ErrorInternal("oauth2 provider name %s is duplicated, set a unique name for each instance of an app", ProviderName(config))
 		}
-		appRoute.GET(h.loginPath, loginHandler)
-		callbackHandler, err := h.callbackHandler(app, authConfigItem, h.redirectURLFunc)
+		provider, err := h.newProviderHandler(config)
 		if err != nil {
 			return err
 		}
-		appRoute.GET(h.callbackPath, callbackHandler)
+		h.providers.Set(appPath, provider)
 		loginURL, _ := url.JoinPath(h.oauth2RoutePath, loginRoutePath, appPath, h.loginPath)
 		callbackURL, _ := url.JoinPath(h.oauth2RoutePath, loginRoutePath, appPath, h.callbackPath)
 		routePrintList = append(routePrintList, loginURL, callbackURL)
 	}
+	oauth2Route := srv.Route(h.oauth2RoutePath)
+	appRoute := oauth2Route.Group(loginRoutePath).Group(providerNameVar)
+	appRoute.GET(h.loginPath, h.dispatch(func(provider *providerHandler) http.HandlerFunc { return provider.login }))
+	appRoute.GET(h.callbackPath, h.dispatch(func(provider *providerHandler) http.HandlerFunc { return provider.callback }))
 	oauth2Route.GET(reportRoutePath, h.OAuth2Reports())
 	reportURL, _ := url.JoinPath(h.oauth2RoutePath, reportRoutePath)
 	routePrintList = append(routePrintList, reportURL)
@@ -134,20 +213,110 @@ func (h *OAuth2Handler) Handler(srv *http.Server) error {
 	return nil
 }
 
-func (h *OAuth2Handler) OAuth2Reports() http.HandlerFunc {
-	reports := make([]OAuth2ReportItem, 0, len(h.conf.GetConfigs()))
-	for _, config := range h.conf.GetConfigs() {
-		reports = append(reports, OAuth2ReportItem{
-			App:      config.GetApp().String(),
-			LoginUrl: config.GetLoginUrl(),
-		})
+// newProviderHandler validates the provider config and builds its login and callback.
+func (h *OAuth2Handler) newProviderHandler(providerConfig *config.OAuth2_Config) (*providerHandler, error) {
+	if err := ValidateProviderConfig(providerConfig); err != nil {
+		return nil, err
+	}
+	oauthConfig := &oauth2.Config{
+		ClientID:     providerConfig.GetClientId(),
+		ClientSecret: providerConfig.GetClientSecret(),
+		RedirectURL:  providerConfig.GetCallbackUri(),
+		Scopes:       providerConfig.GetScopes(),
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  providerConfig.GetAuthUrl(),
+			TokenURL: providerConfig.GetTokenUrl(),
+		},
+	}
+	loginHandler, callbackHandler, err := h.appHandlers(providerConfig, oauthConfig, h.state)
+	if err != nil {
+		return nil, err
+	}
+	return &providerHandler{config: providerConfig, login: loginHandler, callback: callbackHandler}, nil
+}
+
+// dispatch serves the route with the handler of the provider named in the path, the requests over the login limits are refused first.
+func (h *OAuth2Handler) dispatch(handler func(provider *providerHandler) http.HandlerFunc) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		name := ctx.Vars().Get(providerNameKey)
+		if err := h.limiter.Allow(ctx, h.loginAttempt(ctx, name)); err != nil {
+			return h.state.Refuse(ctx, nil, err)
+		}
+		provider, err := h.provider(ctx, name)
+		if err != nil {
+			return err
+		}
+		return handler(provider)(ctx)
+	}
+}
+
+// loginAttempt is the attempt of a login or callback request, its account is not known before the callback signs the user in.
+func (h *OAuth2Handler) loginAttempt(ctx http.Context, name string) *LoginAttempt {
+	return &LoginAttempt{IP: h.limiter.ClientIP(ctx.Request()), Provider: strings.ToLower(name)}
+}
+
+// provider returns the provider of the name, the providers of the config before the providers of the source.
+func (h *OAuth2Handler) provider(ctx context.Context, name string) (*providerHandler, error) {
+	name = strings.ToLower(name)
+	if provider, ok := h.providers.Get(name); ok {
+		return provider, nil
+	}
+	if h.providerSource == nil {
+		return nil, merr.ErrorNotFound("oauth2 provider %s not found", name)
+	}
+	providerConfigs, err := h.providerSource(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, providerConfig := range providerConfigs {
+		if !strings.EqualFold(ProviderName(providerConfig), name) {
+			continue
+		}
+		if provider, ok := h.runtimeProviders.Get(name); ok && proto.Equal(provider.config, providerConfig) {
+			return provider, nil
+		}
+		provider, err := h.newProviderHandler(providerConfig)
+		if err != nil {
+			klog.Context(ctx).Warnw("msg", "oauth2 provider is invalid", "error", err, "name", name)
+			return nil, err
+		}
+		h.runtimeProviders.Set(name, provider)
+		return provider, nil
+	}
+	// the provider was disabled or deleted
+	h.runtimeProviders.Delete(name)
+	return nil, merr.ErrorNotFound("oauth2 provider %s not found", name)
+}
+
+// appHandlers returns the login and callback handlers of an app, apps with a registered provider use the provider handlers.
+func (h *OAuth2Handler) appHandlers(providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State) (http.HandlerFunc, http.HandlerFunc, error) {
+	app := providerConfig.GetApp()
+	if providerFun, ok := GetOAuth2ProviderFun(app); ok {
+		provider, err := providerFun(providerConfig)
+		if err != nil {
+			return nil, nil, err
+		}
+		return ProviderLoginHandler(provider, providerConfig, oauthConfig, state), ProviderCallbackHandler(provider, providerConfig, oauthConfig, state, h.redirectURLFunc), nil
 	}
+	loginHandler, err := h.loginHandler(providerConfig, oauthConfig, state)
+	if err != nil {
+		return nil, nil, err
+	}
+	callbackHandler, err := h.callbackHandler(providerConfig, oauthConfig, state, h.redirectURLFunc)
+	if err != nil {
+		return nil, nil, err
+	}
+	return loginHandler, callbackHandler, nil
+}
+
+// OAuth2Reports lists the providers of the config and the enabled providers of the source.
+func (h *OAuth2Handler) OAuth2Reports() http.HandlerFunc {
 	return func(ctx http.Context) error {
 		http.SetOperation(ctx, OperationOAuth2Reports)
-		h := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
-			return append([]OAuth2ReportItem{}, reports...), nil
+		next := ctx.Middleware(func(ctx context.Context, _ interface{}) (interface{}, error) {
+			return h.reports(ctx)
 		})
-		out, err := h(ctx, nil)
+		out, err := next(ctx, nil)
 		if err != nil {
 			return err
 		}
@@ -156,10 +325,43 @@ func (h *OAuth2Handler) OAuth2Reports() http.HandlerFunc {
 	}
 }
 
-func DefaultLoginHandler(app config.OAuth2_APP, oauthConfig *oauth2.Config) (http.HandlerFunc, error) {
+func (h *OAuth2Handler) reports(ctx context.Context) ([]OAuth2ReportItem, error) {
+	providerConfigs := append([]*config.OAuth2_Config{}, h.conf.GetConfigs()...)
+	if h.providerSource != nil {
+		runtimeConfigs, err := h.providerSource(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for _, providerConfig := range runtimeConfigs {
+			if _, ok := h.providers.Get(strings.ToLower(ProviderName(providerConfig))); !ok {
+				providerConfigs = append(providerConfigs, providerConfig)
+			}
+		}
+	}
+	reports := make([]OAuth2ReportItem, 0, len(providerConfigs))
+	for _, config := range providerConfigs {
+		loginPath, _ := url.JoinPath(h.oauth2RoutePath, loginRoutePath, strings.ToLower(ProviderName(config)), h.loginPath)
+		reports = append(reports, OAuth2ReportItem{
+			App:       config.GetApp().String(),
+			LoginUrl:  config.GetLoginUrl(),
+			Name:      ProviderName(config),
+			LoginPath: loginPath,
+		})
+	}
+	return reports, nil
+}
+
+// DefaultLoginHandler redirects to the authorization URL with a signed state bound to the browser,
+// the return_to query parameter is carried in the state when the allowlist accepts it and the S256 challenge is sent when PKCE is on.
+func DefaultLoginHandler(providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State) (http.HandlerFunc, error) {
+	pkce := providerConfig.GetPkce()
 	return func(ctx http.Context) error {
+		stateValue, opts, err := state.Issue(ctx, pkce)
+		if err != nil {
+			return err
+		}
 		// Redirect to the specified URL
-		url := oauthConfig.AuthCodeURL("state", oauth2.AccessTypeOnline)
+		url := oauthConfig.AuthCodeURL(stateValue, append(opts, oauth2.AccessTypeOnline)...)
 		req := ctx.Request()
 		resp := ctx.Response()
 		resp.Header().Set("Location", url)
@@ -169,23 +371,101 @@ func DefaultLoginHandler(app config.OAuth2_APP, oauthConfig *oauth2.Config) (htt
 	}, nil
 }
 
-func DefaultCallbackHandler(app config.OAuth2_APP, oauthConfig *oauth2.Config, redirectURLFunc RedirectURLFunc) (http.HandlerFunc, error) {
+// DefaultCallbackHandler verifies the state before signing the user in, and redirects to the return_to URL of the login when it has one,
+// a refused login is redirected with the error parameters as well, see [OAuth2State.Refuse].
+func DefaultCallbackHandler(providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State, redirectURLFunc RedirectURLFunc) (http.HandlerFunc, error) {
+	app := providerConfig.GetApp()
 	login, ok := GetOAuth2LoginFun(app)
 	if !ok {
 		return nil, merr.ErrorInternal("app %s login fun not registered", app)
 	}
+	return callbackHandler(login, providerConfig, oauthConfig, state, redirectURLFunc), nil
+}
+
+// ProviderLoginHandler redirects to the authorization URL with the extra parameters of the provider.
+func ProviderLoginHandler(provider OAuth2Provider, providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State) http.HandlerFunc {
+	pkce := providerConfig.GetPkce()
 	return func(ctx http.Context) error {
-		user, err := login(ctx, oauthConfig)
+		stateValue, opts, err := state.Issue(ctx, pkce)
+		if err != nil {
+			return err
+		}
+		providerOpts, err := provider.AuthCodeOptions(ctx, oauthConfig)
+		if err != nil {
+			return err
+		}
+		opts = append(opts, providerOpts...)
+		url := oauthConfig.AuthCodeURL(stateValue, append(opts, oauth2.AccessTypeOnline)...)
+		req := ctx.Request()
+		resp := ctx.Response()
+		resp.Header().Set("Location", url)
+		resp.WriteHeader(nethttp.StatusTemporaryRedirect)
+		ctx.Reset(resp, req)
+		return nil
+	}
+}
+
+func ProviderCallbackHandler(provider OAuth2Provider, providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State, redirectURLFunc RedirectURLFunc) http.HandlerFunc {
+	return callbackHandler(provider.Login, providerConfig, oauthConfig, state, redirectURLFunc)
+}
+
+func callbackHandler(login OAuth2LoginFun, providerConfig *config.OAuth2_Config, oauthConfig *oauth2.Config, state *OAuth2State, redirectURLFunc RedirectURLFunc) http.HandlerFunc {
+	trustEmail := providerConfig.GetTrustEmail()
+	emailFallback := providerConfig.GetEmailFallback()
+	admission := providerConfig.GetAdmission()
+	name := ProviderName(providerConfig)
+	signIn := func(ctx http.Context, loginState *LoginState) (*url.URL, error) {
+		user, err := login(ctx, oauthConfig, loginState.ExchangeOptions...)
 		if err != nil {
-			return merr.ErrorInternal("login failed").WithCause(err)
+			// the provider refuses the users its admission does not allow, such as the users outside the GitHub organizations
+			if merr.IsForbidden(err) {
+				return nil, err
+			}
+			return nil, merr.ErrorInternal("login failed").WithCause(err)
 		}
-		redirectURLStr, err := redirectURLFunc(ctx, oauthConfig, user)
+		// a link does not need the email, the login of a user without one falls back to the noreply email of the app
+		switch {
+		case user.GetEmail() == "" && loginState.GetLinkUID() == 0:
+			if user, err = withNoreplyEmail(user, emailFallback); err != nil {
+				return nil, err
+			}
+		case trustEmail:
+			user = &trustedEmailUser{User: user}
+		}
+		user = &namedUser{User: user, name: name}
+		if loginState.GetLinkUID() == 0 {
+			if err := CheckEmailDomain(admission, user); err != nil {
+				return nil, err
+			}
+		}
+		redirectURLStr, err := redirectURLFunc(ctx, oauthConfig, user, loginState)
 		if err != nil {
-			return merr.ErrorInternal("redirect URL function failed").WithCause(err)
+			// a refused login or link, such as a disabled user or an email which needs an explicit link, is told to the user
+			if merr.IsForbidden(err) || merr.IsUnauthorized(err) {
+				return nil, err
+			}
+			return nil, merr.ErrorInternal("redirect URL function failed").WithCause(err)
 		}
 		redirectURL, err := url.Parse(redirectURLStr)
 		if err != nil {
-			return merr.ErrorInternal("invalid redirect URL").WithCause(err)
+			return nil, merr.ErrorInternal("invalid redirect URL").WithCause(err)
+		}
+		if loginState.ReturnTo != "" {
+			return withReturnTo(redirectURL, loginState.ReturnTo)
+		}
+		return redirectURL, nil
+	}
+	return func(ctx http.Context) error {
+		attempt := &LoginAttempt{IP: state.limiter.ClientIP(ctx.Request()), Provider: strings.ToLower(name)}
+		loginState, err := state.Verify(ctx)
+		if err != nil {
+			state.limiter.Fail(ctx, attempt)
+			return state.Refuse(ctx, nil, err)
+		}
+		redirectURL, err := signIn(ctx, loginState)
+		if err != nil {
+			state.limiter.Fail(ctx, attempt)
+			return state.Refuse(ctx, loginState, err)
 		}
 		req := ctx.Request()
 		resp := ctx.Response()
@@ -193,5 +473,51 @@ func DefaultCallbackHandler(app config.OAuth2_APP, oauthConfig *oauth2.Config, r
 		resp.WriteHeader(nethttp.StatusTemporaryRedirect)
 		ctx.Reset(resp, req)
 		return nil
-	}, nil
+	}
+}
+
+// trustedEmailUser is the user of an app configured with trustEmail, its email counts as verified.
+type trustedEmailUser struct {
+	User
+}
+
+// IsEmailVerified implements [User].
+func (u *trustedEmailUser) IsEmailVerified() bool {
+	return u.GetEmail() != ""
+}
+
+// noreplyEmailUser is the user signed in with the noreply email of its app, the email is not verified.
+type noreplyEmailUser struct {
+	User
+	email string
+}
+
+// GetEmail implements [User].
+func (u *noreplyEmailUser) GetEmail() string {
+	return u.email
+}
+
+// IsEmailVerified implements [User].
+func (u *noreplyEmailUser) IsEmailVerified() bool {
+	return false
+}
+
+// withNoreplyEmail gives the user without an email the noreply email of its app, unless the fallback refuses the login.
+func withNoreplyEmail(user User, fallback config.OAuth2_EmailFallback) (User, error) {
+	noreplyUser, ok := user.(NoreplyEmailUser)
+	if fallback == config.OAuth2_REFUSE || !ok || noreplyUser.GetNoreplyEmail() == "" {
+		return nil, merr.ErrorForbidden("the %s account has no email available, make its email public or verified and login again", user.GetAPP())
+	}
+	return &noreplyEmailUser{User: user, email: noreplyUser.GetNoreplyEmail()}, nil
+}
+
+// namedUser is the user signed in with a named provider instance.
+type namedUser struct {
+	User
+	name string
+}
+
+// GetProviderName implements [NamedUser].
+func (u *namedUser) GetProviderName() string {
+	return u.name
 }
@@ -0,0 +1,177 @@
+// Package oidc is the OpenID Connect auth package for the sovereign service.
+package oidc
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	nethttp "net/http"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aide-family/magicbox/pointer"
+	"github.com/aide-family/magicbox/strutil"
+	"github.com/coreos/go-oidc/v3/oidc"
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"golang.org/x/oauth2"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func init() {
+	auth.RegisterOAuth2ProviderFun(config.OAuth2_OIDC, NewProvider)
+}
+
+const (
+	nonceCookieName   = "sovereign_oidc_nonce"
+	nonceCookieMaxAge = 10 * time.Minute
+)
+
+var _ auth.OAuth2Provider = (*Provider)(nil)
+
+func NewProvider(providerConfig *config.OAuth2_Config) (auth.OAuth2Provider, error) {
+	oidcConfig := providerConfig.GetOidc()
+	if pointer.IsNil(oidcConfig) || strutil.IsEmpty(oidcConfig.GetIssuer()) {
+		return nil, merr.ErrorInternal("oidc issuer is required")
+	}
+	if _, err := auth.ParseEndpoint("oidc issuer", oidcConfig.GetIssuer()); err != nil {
+		return nil, err
+	}
+	client, err := auth.NewHTTPClient(providerConfig.GetHttpClient())
+	if err != nil {
+		return nil, err
+	}
+	return &Provider{clientID: providerConfig.GetClientId(), conf: oidcConfig, client: client}, nil
+}
+
+// Provider logs users in with a standard OpenID Connect identity provider.
+type Provider struct {
+	clientID string
+	conf     *config.OAuth2_OIDCConfig
+	client   *nethttp.Client
+
+	mu       sync.Mutex
+	provider *oidc.Provider
+	verifier *oidc.IDTokenVerifier
+}
+
+// discover reads the discovery document on first use, a failed discovery is retried by the next login.
+// The verifier caches the JWKS and refetches it when a token is signed by an unknown key, which follows the key rotation of the IdP.
+func (p *Provider) discover(ctx context.Context, oauthConfig *oauth2.Config) (*oidc.Provider, *oidc.IDTokenVerifier, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if pointer.IsNotNil(p.provider) {
+		return p.provider, p.verifier, nil
+	}
+	// the provider keeps the client of the context for the JWKS
+	provider, err := oidc.NewProvider(auth.WithHTTPClient(ctx, p.client), p.conf.GetIssuer())
+	if err != nil {
+		return nil, nil, merr.ErrorInternal("discover oidc provider %s failed", p.conf.GetIssuer()).WithCause(err)
+	}
+	if strutil.IsEmpty(oauthConfig.Endpoint.AuthURL) {
+		oauthConfig.Endpoint.AuthURL = provider.Endpoint().AuthURL
+	}
+	if strutil.IsEmpty(oauthConfig.Endpoint.TokenURL) {
+		oauthConfig.Endpoint.TokenURL = provider.Endpoint().TokenURL
+	}
+	if !slices.Contains(oauthConfig.Scopes, oidc.ScopeOpenID) {
+		oauthConfig.Scopes = append([]string{oidc.ScopeOpenID}, oauthConfig.Scopes...)
+	}
+	p.provider = provider
+	p.verifier = provider.Verifier(&oidc.Config{ClientID: p.clientID})
+	return p.provider, p.verifier, nil
+}
+
+// AuthCodeOptions implements [auth.OAuth2Provider].
+func (p *Provider) AuthCodeOptions(ctx http.Context, oauthConfig *oauth2.Config) ([]oauth2.AuthCodeOption, error) {
+	if _, _, err := p.discover(ctx, oauthConfig); err != nil {
+		return nil, err
+	}
+	nonce, err := newNonce()
+	if err != nil {
+		return nil, err
+	}
+	req := ctx.Request()
+	nethttp.SetCookie(ctx.Response(), &nethttp.Cookie{
+		Name:     nonceCookieName,
+		Value:    nonce,
+		Path:     "/",
+		MaxAge:   int(nonceCookieMaxAge.Seconds()),
+		HttpOnly: true,
+		Secure:   auth.IsSecureRequest(req),
+		SameSite: nethttp.SameSiteLaxMode,
+	})
+	return []oauth2.AuthCodeOption{oidc.Nonce(nonce)}, nil
+}
+
+// Login implements [auth.OAuth2Provider].
+func (p *Provider) Login(ctx http.Context, oauthConfig *oauth2.Config, opts ...oauth2.AuthCodeOption) (auth.User, error) {
+	code := ctx.Request().URL.Query().Get("code")
+	if code == "" {
+		return nil, merr.ErrorInvalidArgument("code is required")
+	}
+	nonceCookie, err := ctx.Request().Cookie(nonceCookieName)
+	if err != nil {
+		return nil, merr.ErrorUnauthorized("oidc nonce is missing, please login again")
+	}
+	nethttp.SetCookie(ctx.Response(), &nethttp.Cookie{Name: nonceCookieName, Path: "/", MaxAge: -1})
+	return p.Exchange(ctx, oauthConfig, code, nonceCookie.Value, opts...)
+}
+
+// Exchange exchanges the code for tokens and verifies the issuer, audience, expiry, signature and nonce of the ID token.
+func (p *Provider) Exchange(ctx context.Context, oauthConfig *oauth2.Config, code, nonce string, opts ...oauth2.AuthCodeOption) (*User, error) {
+	ctx = auth.WithHTTPClient(ctx, p.client)
+	provider, verifier, err := p.discover(ctx, oauthConfig)
+	if err != nil {
+		return nil, err
+	}
+	token, err := oauthConfig.Exchange(ctx, code, opts...)
+	if err != nil {
+		return nil, merr.ErrorInternal("exchange token failed").WithCause(err)
+	}
+	rawIDToken, ok := token.Extra("id_token").(string)
+	if !ok || strutil.IsEmpty(rawIDToken) {
+		return nil, merr.ErrorUnauthorized("id_token is missing in the token response")
+	}
+	idToken, err := verifier.Verify(ctx, rawIDToken)
+	if err != nil {
+		return nil, merr.ErrorUnauthorized("verify id_token failed").WithCause(err)
+	}
+	if strutil.IsEmpty(nonce) || idToken.Nonce != nonce {
+		return nil, merr.ErrorUnauthorized("id_token nonce mismatch")
+	}
+	claims := make(map[string]any)
+	if err := idToken.Claims(&claims); err != nil {
+		return nil, merr.ErrorInternal("decode id_token claims failed").WithCause(err)
+	}
+	if p.conf.GetUserInfo() {
+		userInfo, err := provider.UserInfo(ctx, oauth2.StaticTokenSource(token))
+		if err != nil {
+			return nil, merr.ErrorInternal("get user info failed").WithCause(err)
+		}
+		if userInfo.Subject != idToken.Subject {
+			return nil, merr.ErrorUnauthorized("userinfo subject mismatch")
+		}
+		userInfoClaims := make(map[string]any)
+		if err := userInfo.Claims(&userInfoClaims); err != nil {
+			return nil, merr.ErrorInternal("decode user info failed").WithCause(err)
+		}
+		for name, value := range userInfoClaims {
+			if _, ok := claims[name]; !ok {
+				claims[name] = value
+			}
+		}
+	}
+	return &User{claims: claims, mapping: p.conf.GetClaims()}, nil
+}
+
+func newNonce() (string, error) {
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", merr.ErrorInternal("generate oidc nonce failed").WithCause(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(nonce), nil
+}
@@ -0,0 +1,208 @@
+package oidc_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"golang.org/x/oauth2"
+
+	"github.com/aide-family/sovereign/pkg/api/auth/oidc"
+	"github.com/aide-family/sovereign/pkg/config"
+)
+
+const testClientID = "sovereign"
+
+// stubIdP is a minimal OpenID Connect provider serving discovery, JWKS and a token endpoint.
+// The authorization code sent to the token endpoint is echoed back as the nonce of the ID token.
+type stubIdP struct {
+	*httptest.Server
+	mu       sync.Mutex
+	key      *rsa.PrivateKey
+	kid      string
+	issuer   string
+	audience string
+	claims   map[string]any
+	jwksHits int
+}
+
+func newStubIdP(t *testing.T) *stubIdP {
+	t.Helper()
+	idp := &stubIdP{audience: testClientID, claims: map[string]any{}}
+	idp.rotateKey(t, "key-1")
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"issuer":                                idp.issuer,
+			"authorization_endpoint":                idp.URL + "/auth",
+			"token_endpoint":                        idp.URL + "/token",
+			"jwks_uri":                              idp.URL + "/jwks",
+			"id_token_signing_alg_values_supported": []string{"RS256"},
+		})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		idp.mu.Lock()
+		defer idp.mu.Unlock()
+		idp.jwksHits++
+		_ = json.NewEncoder(w).Encode(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+			{Key: &idp.key.PublicKey, KeyID: idp.kid, Algorithm: string(jose.RS256), Use: "sig"},
+		}})
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+			"id_token":     idp.signIDToken(t, r.Form.Get("code")),
+		})
+	})
+	idp.Server = httptest.NewServer(mux)
+	idp.issuer = idp.URL
+	t.Cleanup(idp.Close)
+	return idp
+}
+
+func (idp *stubIdP) rotateKey(t *testing.T, kid string) {
+	t.Helper()
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatalf("GenerateKey failed: %v", err)
+	}
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	idp.key, idp.kid = key, kid
+}
+
+func (idp *stubIdP) signIDToken(t *testing.T, nonce string) string {
+	idp.mu.Lock()
+	defer idp.mu.Unlock()
+	signer, err := jose.NewSigner(jose.SigningKey{
+		Algorithm: jose.RS256,
+		Key:       jose.JSONWebKey{Key: idp.key, KeyID: idp.kid},
+	}, (&jose.SignerOptions{}).WithType("JWT"))
+	if err != nil {
+		t.Errorf("NewSigner failed: %v", err)
+		return ""
+	}
+	claims := map[string]any{
+		"iss":   idp.issuer,
+		"aud":   idp.audience,
+		"sub":   "user-1",
+		"nonce": nonce,
+		"iat":   time.Now().Unix(),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+	}
+	for name, value := range idp.claims {
+		claims[name] = value
+	}
+	payload, _ := json.Marshal(claims)
+	signed, err := signer.Sign(payload)
+	if err != nil {
+		t.Errorf("Sign failed: %v", err)
+		return ""
+	}
+	token, _ := signed.CompactSerialize()
+	return token
+}
+
+func newProvider(t *testing.T, idp *stubIdP, claims *config.OAuth2_OIDCConfig_Claims) (*oidc.Provider, *oauth2.Config) {
+	t.Helper()
+	provider, err := oidc.NewProvider(&config.OAuth2_Config{
+		App:      config.OAuth2_OIDC,
+		ClientId: testClientID,
+		Oidc:     &config.OAuth2_OIDCConfig{Issuer: idp.URL, Claims: claims},
+	})
+	if err != nil {
+		t.Fatalf("NewProvider failed: %v", err)
+	}
+	return provider.(*oidc.Provider), &oauth2.Config{ClientID: testClientID, ClientSecret: "secret"}
+}
+
+func TestExchange(t *testing.T) {
+	idp := newStubIdP(t)
+	idp.claims = map[string]any{"email": "alice@example.com", "login": "alice", "name": "Alice"}
+	provider, oauthConfig := newProvider(t, idp, &config.OAuth2_OIDCConfig_Claims{Name: "login"})
+
+	user, err := provider.Exchange(context.Background(), oauthConfig, "nonce-1", "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	if user.GetOpenID() != "user-1" || user.GetName() != "alice" || user.GetNickname() != "Alice" || user.GetEmail() != "alice@example.com" {
+		t.Fatalf("unexpected user: %s", user.GetRaw())
+	}
+	if oauthConfig.Endpoint.TokenURL != idp.URL+"/token" {
+		t.Fatalf("token endpoint not discovered: %s", oauthConfig.Endpoint.TokenURL)
+	}
+}
+
+func TestExchangeEmailVerified(t *testing.T) {
+	idp := newStubIdP(t)
+	provider, oauthConfig := newProvider(t, idp, nil)
+
+	idp.claims = map[string]any{"email": "alice@example.com"}
+	user, err := provider.Exchange(context.Background(), oauthConfig, "nonce-1", "nonce-1")
+	if err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	if user.IsEmailVerified() {
+		t.Fatalf("email without email_verified claim is verified")
+	}
+	idp.claims = map[string]any{"email": "alice@example.com", "email_verified": true}
+	if user, err = provider.Exchange(context.Background(), oauthConfig, "nonce-1", "nonce-1"); err != nil {
+		t.Fatalf("Exchange failed: %v", err)
+	}
+	if !user.IsEmailVerified() {
+		t.Fatalf("email with email_verified claim is not verified")
+	}
+}
+
+func TestExchangeRejectsInvalidIDToken(t *testing.T) {
+	idp := newStubIdP(t)
+	provider, oauthConfig := newProvider(t, idp, nil)
+
+	if _, err := provider.Exchange(context.Background(), oauthConfig, "nonce-1", "nonce-2"); err == nil {
+		t.Fatalf("Exchange accepted a mismatched nonce")
+	}
+	idp.audience = "another-client"
+	if _, err := provider.Exchange(context.Background(), oauthConfig, "nonce-1", "nonce-1"); err == nil {
+		t.Fatalf("Exchange accepted a foreign audience")
+	}
+	idp.audience = testClientID
+	idp.issuer = "https://issuer.invalid"
+	if _, err := provider.Exchange(context.Background(), oauthConfig, "nonce-1", "nonce-1"); err == nil {
+		t.Fatalf("Exchange accepted a foreign issuer")
+	}
+}
+
+func TestExchangeFollowsKeyRotation(t *testing.T) {
+	idp := newStubIdP(t)
+	provider, oauthConfig := newProvider(t, idp, nil)
+
+	for _, nonce := range []string{"nonce-1", "nonce-2"} {
+		if _, err := provider.Exchange(context.Background(), oauthConfig, nonce, nonce); err != nil {
+			t.Fatalf("Exchange failed: %v", err)
+		}
+	}
+	if idp.jwksHits != 1 {
+		t.Fatalf("expected the key set to be cached, fetched %d times", idp.jwksHits)
+	}
+	idp.rotateKey(t, "key-2")
+	if _, err := provider.Exchange(context.Background(), oauthConfig, "nonce-3", "nonce-3"); err != nil {
+		t.Fatalf("Exchange after key rotation failed: %v", err)
+	}
+	if idp.jwksHits != 2 {
+		t.Fatalf("expected the key set to be refetched once after rotation, fetched %d times", idp.jwksHits)
+	}
+}
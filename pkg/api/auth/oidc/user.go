@@ -0,0 +1,83 @@
+package oidc
+
+import (
+	"encoding/json"
+	"strconv"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+)
+
+var _ auth.User = (*User)(nil)
+
+// User is the signed-in user read from the ID token claims through the configured claim mapping.
+type User struct {
+	claims  map[string]any
+	mapping *config.OAuth2_OIDCConfig_Claims
+}
+
+func (u *User) claim(name, fallback string) string {
+	if name == "" {
+		name = fallback
+	}
+	if name == "" {
+		return ""
+	}
+	switch value := u.claims[name].(type) {
+	case string:
+		return value
+	case float64:
+		return strconv.FormatFloat(value, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(value)
+	default:
+		return ""
+	}
+}
+
+// GetAPP implements [auth.User].
+func (u *User) GetAPP() config.OAuth2_APP {
+	return config.OAuth2_OIDC
+}
+
+// GetAvatar implements [auth.User].
+func (u *User) GetAvatar() string {
+	return u.claim(u.mapping.GetAvatar(), "picture")
+}
+
+// GetEmail implements [auth.User].
+func (u *User) GetEmail() string {
+	return u.claim(u.mapping.GetEmail(), "email")
+}
+
+// GetName implements [auth.User].
+func (u *User) GetName() string {
+	return u.claim(u.mapping.GetName(), "preferred_username")
+}
+
+// GetNickname implements [auth.User].
+func (u *User) GetNickname() string {
+	return u.claim(u.mapping.GetNickname(), "name")
+}
+
+// GetOpenID implements [auth.User].
+func (u *User) GetOpenID() string {
+	return u.claim(u.mapping.GetOpenId(), "sub")
+}
+
+// GetRaw implements [auth.User].
+func (u *User) GetRaw() []byte {
+	raw, _ := json.Marshal(u.claims)
+	return raw
+}
+
+// GetRemark implements [auth.User].
+func (u *User) GetRemark() string {
+	return u.claim(u.mapping.GetRemark(), "")
+}
+
+// IsEmailVerified implements [auth.User].
+func (u *User) IsEmailVerified() bool {
+	verified, _ := strconv.ParseBool(u.claim(u.mapping.GetEmailVerified(), "email_verified"))
+	return verified && u.GetEmail() != ""
+}
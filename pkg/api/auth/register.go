@@ -1,6 +1,8 @@
 package auth
 
 import (
+	"strings"
+
 	"github.com/aide-family/magicbox/safety"
 	"github.com/aide-family/sovereign/pkg/config"
 )
@@ -9,7 +11,9 @@ var globalRegistry = NewRegistry()
 
 func NewRegistry() Register {
 	return &registry{
-		oauth2LoginFuns: safety.NewSyncMap(make(map[config.OAuth2_APP]OAuth2LoginFun)),
+		oauth2LoginFuns:    safety.NewSyncMap(make(map[config.OAuth2_APP]OAuth2LoginFun)),
+		oauth2ProviderFuns: safety.NewSyncMap(make(map[config.OAuth2_APP]OAuth2ProviderFun)),
+		noreplyDomains:     safety.NewSyncMap(make(map[string]config.OAuth2_APP)),
 	}
 }
 
@@ -21,13 +25,37 @@ func GetOAuth2LoginFun(app config.OAuth2_APP) (OAuth2LoginFun, bool) {
 	return globalRegistry.GetOAuth2LoginFun(app)
 }
 
+func RegisterOAuth2ProviderFun(app config.OAuth2_APP, providerFun OAuth2ProviderFun) {
+	globalRegistry.RegisterOAuth2ProviderFun(app, providerFun)
+}
+
+func GetOAuth2ProviderFun(app config.OAuth2_APP) (OAuth2ProviderFun, bool) {
+	return globalRegistry.GetOAuth2ProviderFun(app)
+}
+
+// RegisterNoreplyEmailDomain registers the domain of the noreply emails an app hands out for private users.
+func RegisterNoreplyEmailDomain(app config.OAuth2_APP, domain string) {
+	globalRegistry.RegisterNoreplyEmailDomain(app, domain)
+}
+
+// IsNoreplyEmail reports whether the email is a noreply email of a registered app.
+func IsNoreplyEmail(email string) bool {
+	return globalRegistry.IsNoreplyEmail(email)
+}
+
 type Register interface {
 	RegisterOAuth2LoginFun(app config.OAuth2_APP, loginFun OAuth2LoginFun)
 	GetOAuth2LoginFun(app config.OAuth2_APP) (OAuth2LoginFun, bool)
+	RegisterOAuth2ProviderFun(app config.OAuth2_APP, providerFun OAuth2ProviderFun)
+	GetOAuth2ProviderFun(app config.OAuth2_APP) (OAuth2ProviderFun, bool)
+	RegisterNoreplyEmailDomain(app config.OAuth2_APP, domain string)
+	IsNoreplyEmail(email string) bool
 }
 
 type registry struct {
-	oauth2LoginFuns *safety.SyncMap[config.OAuth2_APP, OAuth2LoginFun]
+	oauth2LoginFuns    *safety.SyncMap[config.OAuth2_APP, OAuth2LoginFun]
+	oauth2ProviderFuns *safety.SyncMap[config.OAuth2_APP, OAuth2ProviderFun]
+	noreplyDomains     *safety.SyncMap[string, config.OAuth2_APP]
 }
 
 func (r *registry) RegisterOAuth2LoginFun(app config.OAuth2_APP, loginFun OAuth2LoginFun) {
@@ -37,3 +65,24 @@ func (r *registry) RegisterOAuth2LoginFun(app config.OAuth2_APP, loginFun OAuth2
 func (r *registry) GetOAuth2LoginFun(app config.OAuth2_APP) (OAuth2LoginFun, bool) {
 	return r.oauth2LoginFuns.Get(app)
 }
+
+func (r *registry) RegisterOAuth2ProviderFun(app config.OAuth2_APP, providerFun OAuth2ProviderFun) {
+	r.oauth2ProviderFuns.Set(app, providerFun)
+}
+
+func (r *registry) GetOAuth2ProviderFun(app config.OAuth2_APP) (OAuth2ProviderFun, bool) {
+	return r.oauth2ProviderFuns.Get(app)
+}
+
+func (r *registry) RegisterNoreplyEmailDomain(app config.OAuth2_APP, domain string) {
+	r.noreplyDomains.Set(strings.ToLower(domain), app)
+}
+
+func (r *registry) IsNoreplyEmail(email string) bool {
+	_, domain, ok := strings.Cut(email, "@")
+	if !ok {
+		return false
+	}
+	_, ok = r.noreplyDomains.Get(strings.ToLower(domain))
+	return ok
+}
@@ -0,0 +1,313 @@
+package auth
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	nethttp "net/http"
+	"net/url"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"golang.org/x/oauth2"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	stateCookieName     = "sovereign_oauth2_state"
+	defaultStateExpire  = 10 * time.Minute
+	ReturnToQueryName   = "return_to"
+	stateQueryName      = "state"
+	stateSignatureLabel = "oauth2-state"
+	pkceVerifierLabel   = "pkce-verifier:"
+	// LinkTicketQueryName carries the link ticket of a signed-in user into the login which links the identity to that user
+	LinkTicketQueryName = "link_ticket"
+	linkTicketLabel     = "link-ticket:"
+	linkTicketExpire    = 5 * time.Minute
+	// InvitationQueryName carries the invitation code of a sign-up with an invite-only provider into the login
+	InvitationQueryName = "invitation"
+	// maxInvitationLength keeps the state short, the issued codes are much shorter
+	maxInvitationLength = 128
+)
+
+// statePayload is the content of the login state, Nonce must equal the state cookie of the browser.
+type statePayload struct {
+	Nonce     string `json:"n"`
+	ReturnTo  string `json:"r,omitempty"`
+	ExpiresAt int64  `json:"e"`
+	PKCE      bool   `json:"p,omitempty"`
+	Link      int64  `json:"l,omitempty"`
+	Invite    string `json:"i,omitempty"`
+}
+
+// linkTicketPayload is the content of a link ticket, UID is the signed-in user the identity is linked to.
+type linkTicketPayload struct {
+	UID       int64 `json:"u"`
+	ExpiresAt int64 `json:"e"`
+}
+
+// LoginState is the verified state of a callback.
+type LoginState struct {
+	// ReturnTo is the return_to URL of the login, empty when the login did not ask for one.
+	ReturnTo string
+	// ExchangeOptions carries the PKCE verifier of the login into the token exchange.
+	ExchangeOptions []oauth2.AuthCodeOption
+	// LinkUID is the signed-in user the identity is linked to, zero for a login.
+	LinkUID int64
+	// Invitation is the invitation code the login started with, it is used up when the login signs up a new user.
+	Invitation string
+}
+
+// OAuth2State issues and verifies the login state, the signed state is bound to a random cookie and expires,
+// which keeps a callback started by another browser or replayed later from signing anyone in.
+type OAuth2State struct {
+	secret    []byte
+	expire    time.Duration
+	allowlist []*url.URL
+	// errorRedirect is where the refused logins without a return_to URL are sent, nil to return the error
+	errorRedirect *url.URL
+	// limiter counts the failed callbacks, nil when the login rate limit is not enabled
+	limiter *LoginLimiter
+	now     func() time.Time
+}
+
+type OAuth2StateOption func(*OAuth2State)
+
+// BindStateClock sets the clock the state and the link tickets expire by.
+func BindStateClock(now func() time.Time) OAuth2StateOption {
+	return func(s *OAuth2State) {
+		s.now = now
+	}
+}
+
+// NewOAuth2State creates the login state from the oauth2 config, defaultSecret is used when no state secret is configured.
+func NewOAuth2State(conf *config.OAuth2, defaultSecret string, opts ...OAuth2StateOption) (*OAuth2State, error) {
+	secret := conf.GetStateSecret()
+	if secret == "" {
+		secret = defaultSecret
+	}
+	if secret == "" {
+		return nil, merr.ErrorInternal("oauth2 state secret is required")
+	}
+	expire := conf.GetStateExpire().AsDuration()
+	if expire <= 0 {
+		expire = defaultStateExpire
+	}
+	allowlist := make([]*url.URL, 0, len(conf.GetReturnToAllowlist()))
+	for _, item := range conf.GetReturnToAllowlist() {
+		allowed, err := url.Parse(item)
+		if err != nil || allowed.Scheme == "" || allowed.Host == "" {
+			return nil, merr.ErrorInternal("invalid oauth2 return_to allowlist item %q, an absolute URL is required", item)
+		}
+		allowlist = append(allowlist, allowed)
+	}
+	var errorRedirect *url.URL
+	if item := conf.GetErrorRedirectUri(); item != "" {
+		parsed, err := url.Parse(item)
+		if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+			return nil, merr.ErrorInternal("invalid oauth2 errorRedirectUri %q, an absolute URL is required", item)
+		}
+		errorRedirect = parsed
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(stateSignatureLabel))
+	state := &OAuth2State{secret: mac.Sum(nil), expire: expire, allowlist: allowlist, errorRedirect: errorRedirect, now: time.Now}
+	for _, opt := range opts {
+		opt(state)
+	}
+	return state, nil
+}
+
+// Issue creates the state of a login started by the request and binds it to a cookie on the response.
+// With pkce it also returns the S256 challenge of the login, the verifier is derived from the cookie and never leaves the service.
+// A login with a link ticket links the identity to the user of the ticket instead of signing in.
+func (s *OAuth2State) Issue(ctx http.Context, pkce bool) (string, []oauth2.AuthCodeOption, error) {
+	req := ctx.Request()
+	returnTo := req.URL.Query().Get(ReturnToQueryName)
+	if returnTo != "" && !s.AllowReturnTo(returnTo) {
+		return "", nil, merr.ErrorParams("return_to %s is not allowed", returnTo)
+	}
+	var linkUID int64
+	if ticket := req.URL.Query().Get(LinkTicketQueryName); ticket != "" {
+		uid, err := s.VerifyLinkTicket(ticket)
+		if err != nil {
+			return "", nil, err
+		}
+		linkUID = uid
+	}
+	invitation := req.URL.Query().Get(InvitationQueryName)
+	if len(invitation) > maxInvitationLength {
+		return "", nil, merr.ErrorParams("invitation is invalid")
+	}
+	nonce := make([]byte, 32)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", nil, merr.ErrorInternal("generate oauth2 state failed").WithCause(err)
+	}
+	payload := &statePayload{
+		Nonce:     base64.RawURLEncoding.EncodeToString(nonce),
+		ReturnTo:  returnTo,
+		ExpiresAt: s.now().Add(s.expire).Unix(),
+		PKCE:      pkce,
+		Link:      linkUID,
+		Invite:    invitation,
+	}
+	raw, _ := json.Marshal(payload)
+	encoded := base64.RawURLEncoding.EncodeToString(raw)
+	nethttp.SetCookie(ctx.Response(), &nethttp.Cookie{
+		Name:     stateCookieName,
+		Value:    payload.Nonce,
+		Path:     "/",
+		MaxAge:   int(s.expire.Seconds()),
+		HttpOnly: true,
+		Secure:   IsSecureRequest(req),
+		SameSite: nethttp.SameSiteLaxMode,
+	})
+	var opts []oauth2.AuthCodeOption
+	if pkce {
+		opts = append(opts, oauth2.S256ChallengeOption(s.verifier(payload.Nonce)))
+	}
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(encoded)), opts, nil
+}
+
+// Verify checks the state of the callback against its signature, expiry and the state cookie, and clears the cookie.
+func (s *OAuth2State) Verify(ctx http.Context) (*LoginState, error) {
+	req := ctx.Request()
+	state := req.URL.Query().Get(stateQueryName)
+	cookie, err := req.Cookie(stateCookieName)
+	if err != nil || cookie.Value == "" {
+		return nil, merr.ErrorUnauthorized("oauth2 state cookie is missing, please login again")
+	}
+	nethttp.SetCookie(ctx.Response(), &nethttp.Cookie{Name: stateCookieName, Path: "/", MaxAge: -1})
+	encoded, signature, ok := strings.Cut(state, ".")
+	if !ok {
+		return nil, merr.ErrorUnauthorized("invalid oauth2 state")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, s.sign(encoded)) {
+		return nil, merr.ErrorUnauthorized("invalid oauth2 state")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return nil, merr.ErrorUnauthorized("invalid oauth2 state")
+	}
+	var payload statePayload
+	if err := json.Unmarshal(raw, &payload); err != nil {
+		return nil, merr.ErrorUnauthorized("invalid oauth2 state")
+	}
+	if s.now().Unix() > payload.ExpiresAt {
+		return nil, merr.ErrorUnauthorized("oauth2 state is expired, please login again")
+	}
+	if !hmac.Equal([]byte(payload.Nonce), []byte(cookie.Value)) {
+		return nil, merr.ErrorUnauthorized("oauth2 state does not belong to this browser")
+	}
+	loginState := &LoginState{ReturnTo: payload.ReturnTo, LinkUID: payload.Link, Invitation: payload.Invite}
+	if payload.PKCE {
+		loginState.ExchangeOptions = append(loginState.ExchangeOptions, oauth2.VerifierOption(s.verifier(payload.Nonce)))
+	}
+	return loginState, nil
+}
+
+// IssueLinkTicket signs a short-lived ticket of the signed-in user, the login started with it links the identity to the user.
+func (s *OAuth2State) IssueLinkTicket(uid int64) string {
+	raw, _ := json.Marshal(&linkTicketPayload{UID: uid, ExpiresAt: s.now().Add(linkTicketExpire).Unix()})
+	encoded := base64.RawURLEncoding.EncodeToString(raw)
+	return encoded + "." + base64.RawURLEncoding.EncodeToString(s.sign(linkTicketLabel+encoded))
+}
+
+// VerifyLinkTicket returns the user of a link ticket issued by IssueLinkTicket.
+func (s *OAuth2State) VerifyLinkTicket(ticket string) (int64, error) {
+	encoded, signature, ok := strings.Cut(ticket, ".")
+	if !ok {
+		return 0, merr.ErrorUnauthorized("invalid link ticket")
+	}
+	sig, err := base64.RawURLEncoding.DecodeString(signature)
+	if err != nil || !hmac.Equal(sig, s.sign(linkTicketLabel+encoded)) {
+		return 0, merr.ErrorUnauthorized("invalid link ticket")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(encoded)
+	if err != nil {
+		return 0, merr.ErrorUnauthorized("invalid link ticket")
+	}
+	var payload linkTicketPayload
+	if err := json.Unmarshal(raw, &payload); err != nil || payload.UID == 0 {
+		return 0, merr.ErrorUnauthorized("invalid link ticket")
+	}
+	if s.now().Unix() > payload.ExpiresAt {
+		return 0, merr.ErrorUnauthorized("link ticket is expired, please link the account again")
+	}
+	return payload.UID, nil
+}
+
+// GetInvitation returns the Invitation of the state, empty for a nil state.
+func (s *LoginState) GetInvitation() string {
+	if s == nil {
+		return ""
+	}
+	return s.Invitation
+}
+
+// GetLinkUID returns the LinkUID of the state, zero for a nil state.
+func (s *LoginState) GetLinkUID() int64 {
+	if s == nil {
+		return 0
+	}
+	return s.LinkUID
+}
+
+// AllowReturnTo reports whether returnTo has the scheme and host of an allowlist item and its path is the path
+// of the item or below it, the dot segments are resolved first as the browser would. It must not have a fragment,
+// which is taken by the issued tokens.
+func (s *OAuth2State) AllowReturnTo(returnTo string) bool {
+	target, err := url.Parse(returnTo)
+	if err != nil || target.User != nil || target.Fragment != "" {
+		return false
+	}
+	targetPath := path.Clean("/" + target.Path)
+	for _, allowed := range s.allowlist {
+		if !strings.EqualFold(target.Scheme, allowed.Scheme) || !strings.EqualFold(target.Host, allowed.Host) {
+			continue
+		}
+		allowedPath := path.Clean("/" + allowed.Path)
+		if targetPath == allowedPath || strings.HasPrefix(targetPath, strings.TrimSuffix(allowedPath, "/")+"/") {
+			return true
+		}
+	}
+	return false
+}
+
+// verifier derives the PKCE verifier of a login from its cookie nonce, so the verifier is kept with the state without being sent to the IdP.
+func (s *OAuth2State) verifier(nonce string) string {
+	return base64.RawURLEncoding.EncodeToString(s.sign(pkceVerifierLabel + nonce))
+}
+
+func (s *OAuth2State) sign(encoded string) []byte {
+	mac := hmac.New(sha256.New, s.secret)
+	mac.Write([]byte(encoded))
+	return mac.Sum(nil)
+}
+
+// IsSecureRequest reports whether the request reached the service over TLS, directly or through a proxy.
+func IsSecureRequest(req *nethttp.Request) bool {
+	return req.TLS != nil || strings.EqualFold(req.Header.Get("X-Forwarded-Proto"), "https")
+}
+
+// withReturnTo moves the query and the fragment of the redirect URL, such as the issued tokens, onto the return_to URL.
+func withReturnTo(redirectURL *url.URL, returnTo string) (*url.URL, error) {
+	target, err := url.Parse(returnTo)
+	if err != nil {
+		return nil, merr.ErrorInternal("invalid return_to URL").WithCause(err)
+	}
+	query := target.Query()
+	for name, values := range redirectURL.Query() {
+		query[name] = values
+	}
+	target.RawQuery = query.Encode()
+	target.Fragment, target.RawFragment = redirectURL.Fragment, redirectURL.RawFragment
+	return target, nil
+}
@@ -0,0 +1,167 @@
+package auth_test
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	"github.com/aide-family/sovereign/pkg/api/auth"
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// stateFlow serves the login and the callback of one OAuth2State, the handlers keep the result of the last call.
+type stateFlow struct {
+	server *http.Server
+	state  string
+	login  *auth.LoginState
+	err    error
+}
+
+func newStateFlow(t *testing.T, state *auth.OAuth2State) *stateFlow {
+	t.Helper()
+	flow := &stateFlow{server: http.NewServer()}
+	route := flow.server.Route("/")
+	route.GET("/login", func(ctx http.Context) error {
+		flow.state, _, flow.err = state.Issue(ctx, false)
+		return nil
+	})
+	route.GET("/callback", func(ctx http.Context) error {
+		flow.login, flow.err = state.Verify(ctx)
+		return nil
+	})
+	return flow
+}
+
+// issue starts a login and returns the state and the state cookie set on the browser.
+func (f *stateFlow) issue(t *testing.T, returnTo string) (string, *nethttp.Cookie) {
+	t.Helper()
+	target := "/login"
+	if returnTo != "" {
+		target += "?" + url.Values{auth.ReturnToQueryName: {returnTo}}.Encode()
+	}
+	recorder := httptest.NewRecorder()
+	f.server.ServeHTTP(recorder, httptest.NewRequest(nethttp.MethodGet, target, nil))
+	if f.err != nil {
+		t.Fatal(f.err)
+	}
+	cookies := recorder.Result().Cookies()
+	if len(cookies) != 1 {
+		t.Fatalf("want the state cookie set, got %v", cookies)
+	}
+	return f.state, cookies[0]
+}
+
+func (f *stateFlow) verify(state string, cookie *nethttp.Cookie) (*auth.LoginState, error) {
+	req := httptest.NewRequest(nethttp.MethodGet, "/callback?"+url.Values{"state": {state}}.Encode(), nil)
+	if cookie != nil {
+		req.AddCookie(cookie)
+	}
+	f.server.ServeHTTP(httptest.NewRecorder(), req)
+	return f.login, f.err
+}
+
+func newOAuth2State(t *testing.T, now *time.Time, allowlist ...string) *auth.OAuth2State {
+	t.Helper()
+	state, err := auth.NewOAuth2State(&config.OAuth2{ReturnToAllowlist: allowlist}, "secret", auth.BindStateClock(func() time.Time { return *now }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return state
+}
+
+func TestOAuth2StateVerify(t *testing.T) {
+	now := time.Now()
+	flow := newStateFlow(t, newOAuth2State(t, &now, "https://app.example.com/console"))
+	state, cookie := flow.issue(t, "https://app.example.com/console/namespaces")
+	loginState, err := flow.verify(state, cookie)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if loginState.ReturnTo != "https://app.example.com/console/namespaces" {
+		t.Fatalf("want the return_to of the login, got %q", loginState.ReturnTo)
+	}
+
+	encoded, signature, _ := strings.Cut(state, ".")
+	_, otherCookie := flow.issue(t, "")
+	otherState, _ := newStateFlow(t, newOAuth2State(t, &now)).issue(t, "")
+	tests := []struct {
+		name   string
+		state  string
+		cookie *nethttp.Cookie
+	}{
+		{name: "missing cookie", state: state},
+		{name: "cookie of another login", state: state, cookie: otherCookie},
+		{name: "garbage", state: "garbage", cookie: cookie},
+		{name: "tampered payload", state: encoded + "x." + signature, cookie: cookie},
+		{name: "tampered signature", state: encoded + ".x" + signature, cookie: cookie},
+		{name: "signed with another secret", state: otherState, cookie: cookie},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := flow.verify(tt.state, tt.cookie); !merr.IsUnauthorized(err) {
+				t.Fatalf("want the state refused, got %v", err)
+			}
+		})
+	}
+}
+
+func TestOAuth2StateExpire(t *testing.T) {
+	now := time.Now()
+	flow := newStateFlow(t, newOAuth2State(t, &now))
+	state, cookie := flow.issue(t, "")
+	now = now.Add(10*time.Minute + time.Second)
+	if _, err := flow.verify(state, cookie); !merr.IsUnauthorized(err) {
+		t.Fatalf("want an expired state refused, got %v", err)
+	}
+
+	ticket := newOAuth2State(t, &now).IssueLinkTicket(42)
+	now = now.Add(5*time.Minute + time.Second)
+	if _, err := newOAuth2State(t, &now).VerifyLinkTicket(ticket); !merr.IsUnauthorized(err) {
+		t.Fatalf("want an expired link ticket refused, got %v", err)
+	}
+}
+
+func TestOAuth2StateReturnTo(t *testing.T) {
+	now := time.Now()
+	state := newOAuth2State(t, &now, "https://app.example.com/app", "https://admin.example.com/", "http://localhost:3000")
+	tests := []struct {
+		returnTo string
+		want     bool
+	}{
+		{returnTo: "https://app.example.com/app", want: true},
+		{returnTo: "https://app.example.com/app/", want: true},
+		{returnTo: "https://app.example.com/app/namespaces?tab=quota", want: true},
+		{returnTo: "HTTPS://APP.example.com/app/namespaces", want: true},
+		{returnTo: "https://app.example.com/application", want: false},
+		{returnTo: "https://app.example.com/app-evil", want: false},
+		{returnTo: "https://app.example.com/app/../admin", want: false},
+		{returnTo: "https://app.example.com/", want: false},
+		{returnTo: "http://app.example.com/app", want: false},
+		{returnTo: "https://app.example.com.evil.com/app", want: false},
+		{returnTo: "https://user@app.example.com/app", want: false},
+		{returnTo: "https://app.example.com/app/#/namespaces", want: false},
+		{returnTo: "https://admin.example.com", want: true},
+		{returnTo: "https://admin.example.com/any/path", want: true},
+		{returnTo: "http://localhost:3000/callback", want: true},
+		{returnTo: "http://localhost:3001/callback", want: false},
+		{returnTo: "/app", want: false},
+		{returnTo: "://bad", want: false},
+	}
+	for _, tt := range tests {
+		if got := state.AllowReturnTo(tt.returnTo); got != tt.want {
+			t.Errorf("AllowReturnTo(%q) = %v, want %v", tt.returnTo, got, tt.want)
+		}
+	}
+
+	flow := newStateFlow(t, state)
+	flow.server.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(nethttp.MethodGet, "/login?"+url.Values{auth.ReturnToQueryName: {"https://app.example.com/app-evil"}}.Encode(), nil))
+	if !merr.IsParams(flow.err) {
+		t.Fatalf("want a login with a return_to out of the allowlist refused, got %v", flow.err)
+	}
+}
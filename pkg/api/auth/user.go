@@ -11,4 +11,27 @@ type User interface {
 	GetAPP() config.OAuth2_APP
 	GetRemark() string
 	GetRaw() []byte
+	// IsEmailVerified reports whether the app verified that the user owns the email, only verified emails link an identity to an existing user.
+	IsEmailVerified() bool
+}
+
+// NoreplyEmailUser is implemented by the users of the apps which hand out a noreply email for the users keeping their email private.
+type NoreplyEmailUser interface {
+	// GetNoreplyEmail returns the noreply email of the user, empty when the app has none for it.
+	GetNoreplyEmail() string
+}
+
+// NamedUser is implemented by the users signed in with an OAuth2 provider instance.
+type NamedUser interface {
+	// GetProviderName returns the name of the provider instance, see [ProviderName].
+	GetProviderName() string
+}
+
+// UserProviderName returns the name of the provider instance the user signed in with, which is stored as the app of its identity,
+// users signed in without an instance, such as LDAP users, use their app.
+func UserProviderName(user User) string {
+	if namedUser, ok := user.(NamedUser); ok {
+		return namedUser.GetProviderName()
+	}
+	return user.GetAPP().String()
 }
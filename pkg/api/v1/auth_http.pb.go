@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: api/v1/auth.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationAuthChangePassword = "/sovereign.api.v1.Auth/ChangePassword"
+const OperationAuthConfirmTOTP = "/sovereign.api.v1.Auth/ConfirmTOTP"
+const OperationAuthCreateAPIKey = "/sovereign.api.v1.Auth/CreateAPIKey"
+const OperationAuthDisableMFA = "/sovereign.api.v1.Auth/DisableMFA"
+const OperationAuthEnrollTOTP = "/sovereign.api.v1.Auth/EnrollTOTP"
+const OperationAuthGetMFA = "/sovereign.api.v1.Auth/GetMFA"
+const OperationAuthGetMe = "/sovereign.api.v1.Auth/GetMe"
+const OperationAuthLDAPLogin = "/sovereign.api.v1.Auth/LDAPLogin"
+const OperationAuthLinkIdentity = "/sovereign.api.v1.Auth/LinkIdentity"
+const OperationAuthListAPIKeys = "/sovereign.api.v1.Auth/ListAPIKeys"
+const OperationAuthLogout = "/sovereign.api.v1.Auth/Logout"
+const OperationAuthPasswordLogin = "/sovereign.api.v1.Auth/PasswordLogin"
+const OperationAuthRefreshToken = "/sovereign.api.v1.Auth/RefreshToken"
+const OperationAuthRegenerateRecoveryCodes = "/sovereign.api.v1.Auth/RegenerateRecoveryCodes"
+const OperationAuthRevokeAPIKey = "/sovereign.api.v1.Auth/RevokeAPIKey"
+const OperationAuthRevokeToken = "/sovereign.api.v1.Auth/RevokeToken"
+const OperationAuthUpdateEmail = "/sovereign.api.v1.Auth/UpdateEmail"
+const OperationAuthUpdatePreferences = "/sovereign.api.v1.Auth/UpdatePreferences"
+const OperationAuthVerifyMFA = "/sovereign.api.v1.Auth/VerifyMFA"
+
+type AuthHTTPServer interface {
+	// ChangePassword ChangePassword changes the password of the signed-in user, users without a password set their first one
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordReply, error)
+	// ConfirmTOTP ConfirmTOTP enables the enrolled TOTP secret with a code of the authenticator app, the recovery codes are only returned once
+	ConfirmTOTP(context.Context, *ConfirmTOTPRequest) (*RecoveryCodesReply, error)
+	// CreateAPIKey CreateAPIKey creates a personal access token for automation, the key is only returned once
+	CreateAPIKey(context.Context, *CreateAPIKeyRequest) (*CreateAPIKeyReply, error)
+	// DisableMFA DisableMFA removes the TOTP secret and the recovery codes of the signed-in user
+	DisableMFA(context.Context, *MFACodeRequest) (*DisableMFAReply, error)
+	// EnrollTOTP EnrollTOTP creates a new TOTP secret for the signed-in user, the provisioning URI is shown as a QR code to the authenticator app,
+	// the secret is used after ConfirmTOTP
+	EnrollTOTP(context.Context, *EnrollTOTPRequest) (*EnrollTOTPReply, error)
+	// GetMFA GetMFA returns the MFA status of the signed-in user
+	GetMFA(context.Context, *GetMFARequest) (*MFAItem, error)
+	// GetMe GetMe returns the signed-in user with its linked identities, role bindings and preferences
+	GetMe(context.Context, *GetMeRequest) (*GetMeReply, error)
+	// LDAPLogin LDAPLogin signs a user in with the username and password of the LDAP directory, the directory account is linked like an OAuth2 account
+	LDAPLogin(context.Context, *PasswordLoginRequest) (*TokenReply, error)
+	// LinkIdentity LinkIdentity returns the login URL which links the account of an OAuth2 app to the signed-in user,
+	// the URL carries a link ticket valid for 5 minutes and is opened in the browser of the user
+	LinkIdentity(context.Context, *LinkIdentityRequest) (*LinkIdentityReply, error)
+	// ListAPIKeys ListAPIKeys lists the API keys of the signed-in user
+	ListAPIKeys(context.Context, *ListAPIKeysRequest) (*ListAPIKeysReply, error)
+	// Logout Logout revokes the access token of the request and the refresh token of the same login
+	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// PasswordLogin PasswordLogin signs a local account in with its username or email
+	PasswordLogin(context.Context, *PasswordLoginRequest) (*TokenReply, error)
+	// RefreshToken RefreshToken exchanges a refresh token for a new access token, the refresh token is rotated and can not be used again
+	RefreshToken(context.Context, *RefreshTokenRequest) (*TokenReply, error)
+	// RegenerateRecoveryCodes RegenerateRecoveryCodes replaces the recovery codes of the signed-in user, the recovery codes are only returned once
+	RegenerateRecoveryCodes(context.Context, *MFACodeRequest) (*RecoveryCodesReply, error)
+	// RevokeAPIKey RevokeAPIKey revokes an API key of the signed-in user
+	RevokeAPIKey(context.Context, *RevokeAPIKeyRequest) (*RevokeAPIKeyReply, error)
+	// RevokeToken RevokeToken revokes a refresh token and every token rotated from the same login
+	RevokeToken(context.Context, *RevokeTokenRequest) (*RevokeTokenReply, error)
+	// UpdateEmail UpdateEmail sets the email of the signed-in user whose account got a noreply email on sign-up,
+	// the login redirect carries complete_profile=true for such a user, the new email is not verified
+	UpdateEmail(context.Context, *UpdateEmailRequest) (*UserItem, error)
+	// UpdatePreferences UpdatePreferences replaces the preferences of the signed-in user
+	UpdatePreferences(context.Context, *UpdatePreferencesRequest) (*PreferencesItem, error)
+	// VerifyMFA VerifyMFA answers the MFA challenge of a login with a TOTP code or a recovery code, and returns the tokens of the login.
+	// The password and LDAP logins return the challenge instead of the tokens, the OAuth2 login redirects with mfa_challenge.
+	VerifyMFA(context.Context, *VerifyMFARequest) (*TokenReply, error)
+}
+
+func RegisterAuthHTTPServer(s *http.Server, srv AuthHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/auth/login", _Auth_PasswordLogin0_HTTP_Handler(srv))
+	r.POST("/v1/auth/ldap/login", _Auth_LDAPLogin0_HTTP_Handler(srv))
+	r.POST("/v1/auth/mfa/verify", _Auth_VerifyMFA0_HTTP_Handler(srv))
+	r.POST("/v1/auth/password", _Auth_ChangePassword0_HTTP_Handler(srv))
+	r.POST("/v1/auth/api-keys", _Auth_CreateAPIKey0_HTTP_Handler(srv))
+	r.GET("/v1/auth/api-keys", _Auth_ListAPIKeys0_HTTP_Handler(srv))
+	r.DELETE("/v1/auth/api-keys/{uid}", _Auth_RevokeAPIKey0_HTTP_Handler(srv))
+	r.POST("/v1/auth/refresh", _Auth_RefreshToken0_HTTP_Handler(srv))
+	r.POST("/v1/auth/logout", _Auth_Logout0_HTTP_Handler(srv))
+	r.POST("/v1/auth/revoke", _Auth_RevokeToken0_HTTP_Handler(srv))
+	r.GET("/v1/auth/me", _Auth_GetMe0_HTTP_Handler(srv))
+	r.PUT("/v1/auth/me/preferences", _Auth_UpdatePreferences0_HTTP_Handler(srv))
+	r.POST("/v1/auth/me/identities/link", _Auth_LinkIdentity0_HTTP_Handler(srv))
+	r.PUT("/v1/auth/me/email", _Auth_UpdateEmail0_HTTP_Handler(srv))
+	r.GET("/v1/auth/me/mfa", _Auth_GetMFA0_HTTP_Handler(srv))
+	r.POST("/v1/auth/me/mfa/totp", _Auth_EnrollTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/auth/me/mfa/totp/confirm", _Auth_ConfirmTOTP0_HTTP_Handler(srv))
+	r.POST("/v1/auth/me/mfa/recovery-codes", _Auth_RegenerateRecoveryCodes0_HTTP_Handler(srv))
+	r.POST("/v1/auth/me/mfa/disable", _Auth_DisableMFA0_HTTP_Handler(srv))
+}
+
+func _Auth_PasswordLogin0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PasswordLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthPasswordLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.PasswordLogin(ctx, req.(*PasswordLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_LDAPLogin0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in PasswordLoginRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLDAPLogin)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LDAPLogin(ctx, req.(*PasswordLoginRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_VerifyMFA0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthVerifyMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyMFA(ctx, req.(*VerifyMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ChangePassword0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ChangePasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthChangePassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ChangePassword(ctx, req.(*ChangePasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ChangePasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_CreateAPIKey0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateAPIKeyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthCreateAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateAPIKey(ctx, req.(*CreateAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ListAPIKeys0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListAPIKeysRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthListAPIKeys)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListAPIKeys(ctx, req.(*ListAPIKeysRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListAPIKeysReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeAPIKey0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeAPIKeyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeAPIKey)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeAPIKey(ctx, req.(*RevokeAPIKeyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeAPIKeyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RefreshToken0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RefreshTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRefreshToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RefreshToken(ctx, req.(*RefreshTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*TokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_Logout0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LogoutRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLogout)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Logout(ctx, req.(*LogoutRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LogoutReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RevokeToken0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeTokenRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRevokeToken)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeToken(ctx, req.(*RevokeTokenRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeTokenReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_GetMe0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMeRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthGetMe)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMe(ctx, req.(*GetMeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetMeReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_UpdatePreferences0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePreferencesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthUpdatePreferences)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePreferences(ctx, req.(*UpdatePreferencesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PreferencesItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_LinkIdentity0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in LinkIdentityRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthLinkIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.LinkIdentity(ctx, req.(*LinkIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*LinkIdentityReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_UpdateEmail0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateEmailRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthUpdateEmail)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateEmail(ctx, req.(*UpdateEmailRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_GetMFA0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetMFARequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthGetMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetMFA(ctx, req.(*GetMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MFAItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_EnrollTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in EnrollTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthEnrollTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.EnrollTOTP(ctx, req.(*EnrollTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*EnrollTOTPReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_ConfirmTOTP0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ConfirmTOTPRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthConfirmTOTP)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ConfirmTOTP(ctx, req.(*ConfirmTOTPRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_RegenerateRecoveryCodes0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MFACodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthRegenerateRecoveryCodes)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RegenerateRecoveryCodes(ctx, req.(*MFACodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RecoveryCodesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Auth_DisableMFA0_HTTP_Handler(srv AuthHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MFACodeRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationAuthDisableMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DisableMFA(ctx, req.(*MFACodeRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DisableMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type AuthHTTPClient interface {
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest, opts ...http.CallOption) (rsp *ChangePasswordReply, err error)
+	ConfirmTOTP(ctx context.Context, req *ConfirmTOTPRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest, opts ...http.CallOption) (rsp *CreateAPIKeyReply, err error)
+	DisableMFA(ctx context.Context, req *MFACodeRequest, opts ...http.CallOption) (rsp *DisableMFAReply, err error)
+	EnrollTOTP(ctx context.Context, req *EnrollTOTPRequest, opts ...http.CallOption) (rsp *EnrollTOTPReply, err error)
+	GetMFA(ctx context.Context, req *GetMFARequest, opts ...http.CallOption) (rsp *MFAItem, err error)
+	GetMe(ctx context.Context, req *GetMeRequest, opts ...http.CallOption) (rsp *GetMeReply, err error)
+	LDAPLogin(ctx context.Context, req *PasswordLoginRequest, opts ...http.CallOption) (rsp *TokenReply, err error)
+	LinkIdentity(ctx context.Context, req *LinkIdentityRequest, opts ...http.CallOption) (rsp *LinkIdentityReply, err error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest, opts ...http.CallOption) (rsp *ListAPIKeysReply, err error)
+	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	PasswordLogin(ctx context.Context, req *PasswordLoginRequest, opts ...http.CallOption) (rsp *TokenReply, err error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest, opts ...http.CallOption) (rsp *TokenReply, err error)
+	RegenerateRecoveryCodes(ctx context.Context, req *MFACodeRequest, opts ...http.CallOption) (rsp *RecoveryCodesReply, err error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest, opts ...http.CallOption) (rsp *RevokeAPIKeyReply, err error)
+	RevokeToken(ctx context.Context, req *RevokeTokenRequest, opts ...http.CallOption) (rsp *RevokeTokenReply, err error)
+	UpdateEmail(ctx context.Context, req *UpdateEmailRequest, opts ...http.CallOption) (rsp *UserItem, err error)
+	UpdatePreferences(ctx context.Context, req *UpdatePreferencesRequest, opts ...http.CallOption) (rsp *PreferencesItem, err error)
+	VerifyMFA(ctx context.Context, req *VerifyMFARequest, opts ...http.CallOption) (rsp *TokenReply, err error)
+}
+
+type AuthHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewAuthHTTPClient(client *http.Client) AuthHTTPClient {
+	return &AuthHTTPClientImpl{client}
+}
+
+func (c *AuthHTTPClientImpl) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...http.CallOption) (*ChangePasswordReply, error) {
+	var out ChangePasswordReply
+	pattern := "/v1/auth/password"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthChangePassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ConfirmTOTP(ctx context.Context, in *ConfirmTOTPRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/v1/auth/me/mfa/totp/confirm"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthConfirmTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) CreateAPIKey(ctx context.Context, in *CreateAPIKeyRequest, opts ...http.CallOption) (*CreateAPIKeyReply, error) {
+	var out CreateAPIKeyReply
+	pattern := "/v1/auth/api-keys"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthCreateAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) DisableMFA(ctx context.Context, in *MFACodeRequest, opts ...http.CallOption) (*DisableMFAReply, error) {
+	var out DisableMFAReply
+	pattern := "/v1/auth/me/mfa/disable"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthDisableMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) EnrollTOTP(ctx context.Context, in *EnrollTOTPRequest, opts ...http.CallOption) (*EnrollTOTPReply, error) {
+	var out EnrollTOTPReply
+	pattern := "/v1/auth/me/mfa/totp"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthEnrollTOTP))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) GetMFA(ctx context.Context, in *GetMFARequest, opts ...http.CallOption) (*MFAItem, error) {
+	var out MFAItem
+	pattern := "/v1/auth/me/mfa"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthGetMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) GetMe(ctx context.Context, in *GetMeRequest, opts ...http.CallOption) (*GetMeReply, error) {
+	var out GetMeReply
+	pattern := "/v1/auth/me"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthGetMe))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) LDAPLogin(ctx context.Context, in *PasswordLoginRequest, opts ...http.CallOption) (*TokenReply, error) {
+	var out TokenReply
+	pattern := "/v1/auth/ldap/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLDAPLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) LinkIdentity(ctx context.Context, in *LinkIdentityRequest, opts ...http.CallOption) (*LinkIdentityReply, error) {
+	var out LinkIdentityReply
+	pattern := "/v1/auth/me/identities/link"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLinkIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) ListAPIKeys(ctx context.Context, in *ListAPIKeysRequest, opts ...http.CallOption) (*ListAPIKeysReply, error) {
+	var out ListAPIKeysReply
+	pattern := "/v1/auth/api-keys"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthListAPIKeys))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, opts ...http.CallOption) (*LogoutReply, error) {
+	var out LogoutReply
+	pattern := "/v1/auth/logout"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthLogout))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) PasswordLogin(ctx context.Context, in *PasswordLoginRequest, opts ...http.CallOption) (*TokenReply, error) {
+	var out TokenReply
+	pattern := "/v1/auth/login"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthPasswordLogin))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RefreshToken(ctx context.Context, in *RefreshTokenRequest, opts ...http.CallOption) (*TokenReply, error) {
+	var out TokenReply
+	pattern := "/v1/auth/refresh"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRefreshToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RegenerateRecoveryCodes(ctx context.Context, in *MFACodeRequest, opts ...http.CallOption) (*RecoveryCodesReply, error) {
+	var out RecoveryCodesReply
+	pattern := "/v1/auth/me/mfa/recovery-codes"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRegenerateRecoveryCodes))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeAPIKey(ctx context.Context, in *RevokeAPIKeyRequest, opts ...http.CallOption) (*RevokeAPIKeyReply, error) {
+	var out RevokeAPIKeyReply
+	pattern := "/v1/auth/api-keys/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationAuthRevokeAPIKey))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) RevokeToken(ctx context.Context, in *RevokeTokenRequest, opts ...http.CallOption) (*RevokeTokenReply, error) {
+	var out RevokeTokenReply
+	pattern := "/v1/auth/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthRevokeToken))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) UpdateEmail(ctx context.Context, in *UpdateEmailRequest, opts ...http.CallOption) (*UserItem, error) {
+	var out UserItem
+	pattern := "/v1/auth/me/email"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthUpdateEmail))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) UpdatePreferences(ctx context.Context, in *UpdatePreferencesRequest, opts ...http.CallOption) (*PreferencesItem, error) {
+	var out PreferencesItem
+	pattern := "/v1/auth/me/preferences"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthUpdatePreferences))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *AuthHTTPClientImpl) VerifyMFA(ctx context.Context, in *VerifyMFARequest, opts ...http.CallOption) (*TokenReply, error) {
+	var out TokenReply
+	pattern := "/v1/auth/mfa/verify"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationAuthVerifyMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,205 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/v1/invitation.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Invitation_CreateInvitation_FullMethodName = "/sovereign.api.v1.Invitation/CreateInvitation"
+	Invitation_ListInvitations_FullMethodName  = "/sovereign.api.v1.Invitation/ListInvitations"
+	Invitation_DeleteInvitation_FullMethodName = "/sovereign.api.v1.Invitation/DeleteInvitation"
+)
+
+// InvitationClient is the client API for Invitation service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// Invitation manages the invitation codes signing up new users with the providers in inviteOnly admission,
+// the code is passed as the invitation query parameter of the login route, e.g. /oauth2/login/github/?invitation=<code>
+type InvitationClient interface {
+	CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationReply, error)
+	ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsReply, error)
+	// DeleteInvitation revokes the invitation, the users who signed up with it are kept
+	DeleteInvitation(ctx context.Context, in *DeleteInvitationRequest, opts ...grpc.CallOption) (*DeleteInvitationReply, error)
+}
+
+type invitationClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewInvitationClient(cc grpc.ClientConnInterface) InvitationClient {
+	return &invitationClient{cc}
+}
+
+func (c *invitationClient) CreateInvitation(ctx context.Context, in *CreateInvitationRequest, opts ...grpc.CallOption) (*CreateInvitationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateInvitationReply)
+	err := c.cc.Invoke(ctx, Invitation_CreateInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationClient) ListInvitations(ctx context.Context, in *ListInvitationsRequest, opts ...grpc.CallOption) (*ListInvitationsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListInvitationsReply)
+	err := c.cc.Invoke(ctx, Invitation_ListInvitations_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *invitationClient) DeleteInvitation(ctx context.Context, in *DeleteInvitationRequest, opts ...grpc.CallOption) (*DeleteInvitationReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteInvitationReply)
+	err := c.cc.Invoke(ctx, Invitation_DeleteInvitation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// InvitationServer is the server API for Invitation service.
+// All implementations must embed UnimplementedInvitationServer
+// for forward compatibility.
+//
+// Invitation manages the invitation codes signing up new users with the providers in inviteOnly admission,
+// the code is passed as the invitation query parameter of the login route, e.g. /oauth2/login/github/?invitation=<code>
+type InvitationServer interface {
+	CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationReply, error)
+	ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsReply, error)
+	// DeleteInvitation revokes the invitation, the users who signed up with it are kept
+	DeleteInvitation(context.Context, *DeleteInvitationRequest) (*DeleteInvitationReply, error)
+	mustEmbedUnimplementedInvitationServer()
+}
+
+// UnimplementedInvitationServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedInvitationServer struct{}
+
+func (UnimplementedInvitationServer) CreateInvitation(context.Context, *CreateInvitationRequest) (*CreateInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateInvitation not implemented")
+}
+func (UnimplementedInvitationServer) ListInvitations(context.Context, *ListInvitationsRequest) (*ListInvitationsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListInvitations not implemented")
+}
+func (UnimplementedInvitationServer) DeleteInvitation(context.Context, *DeleteInvitationRequest) (*DeleteInvitationReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteInvitation not implemented")
+}
+func (UnimplementedInvitationServer) mustEmbedUnimplementedInvitationServer() {}
+func (UnimplementedInvitationServer) testEmbeddedByValue()                    {}
+
+// UnsafeInvitationServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to InvitationServer will
+// result in compilation errors.
+type UnsafeInvitationServer interface {
+	mustEmbedUnimplementedInvitationServer()
+}
+
+func RegisterInvitationServer(s grpc.ServiceRegistrar, srv InvitationServer) {
+	// If the following call pancis, it indicates UnimplementedInvitationServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Invitation_ServiceDesc, srv)
+}
+
+func _Invitation_CreateInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationServer).CreateInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Invitation_CreateInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationServer).CreateInvitation(ctx, req.(*CreateInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invitation_ListInvitations_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListInvitationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationServer).ListInvitations(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Invitation_ListInvitations_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationServer).ListInvitations(ctx, req.(*ListInvitationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Invitation_DeleteInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInvitationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(InvitationServer).DeleteInvitation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Invitation_DeleteInvitation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(InvitationServer).DeleteInvitation(ctx, req.(*DeleteInvitationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Invitation_ServiceDesc is the grpc.ServiceDesc for Invitation service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Invitation_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sovereign.api.v1.Invitation",
+	HandlerType: (*InvitationServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateInvitation",
+			Handler:    _Invitation_CreateInvitation_Handler,
+		},
+		{
+			MethodName: "ListInvitations",
+			Handler:    _Invitation_ListInvitations_Handler,
+		},
+		{
+			MethodName: "DeleteInvitation",
+			Handler:    _Invitation_DeleteInvitation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/invitation.proto",
+}
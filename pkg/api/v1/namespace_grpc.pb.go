@@ -19,13 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Namespace_CreateNamespace_FullMethodName       = "/sovereign.api.v1.Namespace/CreateNamespace"
-	Namespace_UpdateNamespace_FullMethodName       = "/sovereign.api.v1.Namespace/UpdateNamespace"
-	Namespace_UpdateNamespaceStatus_FullMethodName = "/sovereign.api.v1.Namespace/UpdateNamespaceStatus"
-	Namespace_DeleteNamespace_FullMethodName       = "/sovereign.api.v1.Namespace/DeleteNamespace"
-	Namespace_GetNamespace_FullMethodName          = "/sovereign.api.v1.Namespace/GetNamespace"
-	Namespace_ListNamespace_FullMethodName         = "/sovereign.api.v1.Namespace/ListNamespace"
-	Namespace_SelectNamespace_FullMethodName       = "/sovereign.api.v1.Namespace/SelectNamespace"
+	Namespace_CreateNamespace_FullMethodName            = "/sovereign.api.v1.Namespace/CreateNamespace"
+	Namespace_UpdateNamespace_FullMethodName            = "/sovereign.api.v1.Namespace/UpdateNamespace"
+	Namespace_UpdateNamespaceStatus_FullMethodName      = "/sovereign.api.v1.Namespace/UpdateNamespaceStatus"
+	Namespace_DeleteNamespace_FullMethodName            = "/sovereign.api.v1.Namespace/DeleteNamespace"
+	Namespace_GetNamespace_FullMethodName               = "/sovereign.api.v1.Namespace/GetNamespace"
+	Namespace_ListNamespace_FullMethodName              = "/sovereign.api.v1.Namespace/ListNamespace"
+	Namespace_SelectNamespace_FullMethodName            = "/sovereign.api.v1.Namespace/SelectNamespace"
+	Namespace_GetNamespaceStats_FullMethodName          = "/sovereign.api.v1.Namespace/GetNamespaceStats"
+	Namespace_ListNamespaceRevisions_FullMethodName     = "/sovereign.api.v1.Namespace/ListNamespaceRevisions"
+	Namespace_GetNamespaceRevision_FullMethodName       = "/sovereign.api.v1.Namespace/GetNamespaceRevision"
+	Namespace_DiffNamespaceRevisions_FullMethodName     = "/sovereign.api.v1.Namespace/DiffNamespaceRevisions"
+	Namespace_RollbackNamespace_FullMethodName          = "/sovereign.api.v1.Namespace/RollbackNamespace"
+	Namespace_TransferNamespaceOwnership_FullMethodName = "/sovereign.api.v1.Namespace/TransferNamespaceOwnership"
 )
 
 // NamespaceClient is the client API for Namespace service.
@@ -39,6 +45,12 @@ type NamespaceClient interface {
 	GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*NamespaceItem, error)
 	ListNamespace(ctx context.Context, in *ListNamespaceRequest, opts ...grpc.CallOption) (*ListNamespaceReply, error)
 	SelectNamespace(ctx context.Context, in *SelectNamespaceRequest, opts ...grpc.CallOption) (*SelectNamespaceReply, error)
+	GetNamespaceStats(ctx context.Context, in *GetNamespaceStatsRequest, opts ...grpc.CallOption) (*GetNamespaceStatsReply, error)
+	ListNamespaceRevisions(ctx context.Context, in *ListNamespaceRevisionsRequest, opts ...grpc.CallOption) (*ListNamespaceRevisionsReply, error)
+	GetNamespaceRevision(ctx context.Context, in *GetNamespaceRevisionRequest, opts ...grpc.CallOption) (*NamespaceRevisionItem, error)
+	DiffNamespaceRevisions(ctx context.Context, in *DiffNamespaceRevisionsRequest, opts ...grpc.CallOption) (*DiffNamespaceRevisionsReply, error)
+	RollbackNamespace(ctx context.Context, in *RollbackNamespaceRequest, opts ...grpc.CallOption) (*NamespaceRevisionItem, error)
+	TransferNamespaceOwnership(ctx context.Context, in *TransferNamespaceOwnershipRequest, opts ...grpc.CallOption) (*NamespaceRevisionItem, error)
 }
 
 type namespaceClient struct {
@@ -119,6 +131,66 @@ func (c *namespaceClient) SelectNamespace(ctx context.Context, in *SelectNamespa
 	return out, nil
 }
 
+func (c *namespaceClient) GetNamespaceStats(ctx context.Context, in *GetNamespaceStatsRequest, opts ...grpc.CallOption) (*GetNamespaceStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNamespaceStatsReply)
+	err := c.cc.Invoke(ctx, Namespace_GetNamespaceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceClient) ListNamespaceRevisions(ctx context.Context, in *ListNamespaceRevisionsRequest, opts ...grpc.CallOption) (*ListNamespaceRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespaceRevisionsReply)
+	err := c.cc.Invoke(ctx, Namespace_ListNamespaceRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceClient) GetNamespaceRevision(ctx context.Context, in *GetNamespaceRevisionRequest, opts ...grpc.CallOption) (*NamespaceRevisionItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceRevisionItem)
+	err := c.cc.Invoke(ctx, Namespace_GetNamespaceRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceClient) DiffNamespaceRevisions(ctx context.Context, in *DiffNamespaceRevisionsRequest, opts ...grpc.CallOption) (*DiffNamespaceRevisionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffNamespaceRevisionsReply)
+	err := c.cc.Invoke(ctx, Namespace_DiffNamespaceRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceClient) RollbackNamespace(ctx context.Context, in *RollbackNamespaceRequest, opts ...grpc.CallOption) (*NamespaceRevisionItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceRevisionItem)
+	err := c.cc.Invoke(ctx, Namespace_RollbackNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceClient) TransferNamespaceOwnership(ctx context.Context, in *TransferNamespaceOwnershipRequest, opts ...grpc.CallOption) (*NamespaceRevisionItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceRevisionItem)
+	err := c.cc.Invoke(ctx, Namespace_TransferNamespaceOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NamespaceServer is the server API for Namespace service.
 // All implementations must embed UnimplementedNamespaceServer
 // for forward compatibility.
@@ -130,6 +202,12 @@ type NamespaceServer interface {
 	GetNamespace(context.Context, *GetNamespaceRequest) (*NamespaceItem, error)
 	ListNamespace(context.Context, *ListNamespaceRequest) (*ListNamespaceReply, error)
 	SelectNamespace(context.Context, *SelectNamespaceRequest) (*SelectNamespaceReply, error)
+	GetNamespaceStats(context.Context, *GetNamespaceStatsRequest) (*GetNamespaceStatsReply, error)
+	ListNamespaceRevisions(context.Context, *ListNamespaceRevisionsRequest) (*ListNamespaceRevisionsReply, error)
+	GetNamespaceRevision(context.Context, *GetNamespaceRevisionRequest) (*NamespaceRevisionItem, error)
+	DiffNamespaceRevisions(context.Context, *DiffNamespaceRevisionsRequest) (*DiffNamespaceRevisionsReply, error)
+	RollbackNamespace(context.Context, *RollbackNamespaceRequest) (*NamespaceRevisionItem, error)
+	TransferNamespaceOwnership(context.Context, *TransferNamespaceOwnershipRequest) (*NamespaceRevisionItem, error)
 	mustEmbedUnimplementedNamespaceServer()
 }
 
@@ -161,6 +239,24 @@ func (UnimplementedNamespaceServer) ListNamespace(context.Context, *ListNamespac
 func (UnimplementedNamespaceServer) SelectNamespace(context.Context, *SelectNamespaceRequest) (*SelectNamespaceReply, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method SelectNamespace not implemented")
 }
+func (UnimplementedNamespaceServer) GetNamespaceStats(context.Context, *GetNamespaceStatsRequest) (*GetNamespaceStatsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceStats not implemented")
+}
+func (UnimplementedNamespaceServer) ListNamespaceRevisions(context.Context, *ListNamespaceRevisionsRequest) (*ListNamespaceRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceRevisions not implemented")
+}
+func (UnimplementedNamespaceServer) GetNamespaceRevision(context.Context, *GetNamespaceRevisionRequest) (*NamespaceRevisionItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceRevision not implemented")
+}
+func (UnimplementedNamespaceServer) DiffNamespaceRevisions(context.Context, *DiffNamespaceRevisionsRequest) (*DiffNamespaceRevisionsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffNamespaceRevisions not implemented")
+}
+func (UnimplementedNamespaceServer) RollbackNamespace(context.Context, *RollbackNamespaceRequest) (*NamespaceRevisionItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackNamespace not implemented")
+}
+func (UnimplementedNamespaceServer) TransferNamespaceOwnership(context.Context, *TransferNamespaceOwnershipRequest) (*NamespaceRevisionItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespaceOwnership not implemented")
+}
 func (UnimplementedNamespaceServer) mustEmbedUnimplementedNamespaceServer() {}
 func (UnimplementedNamespaceServer) testEmbeddedByValue()                   {}
 
@@ -308,6 +404,114 @@ func _Namespace_SelectNamespace_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Namespace_GetNamespaceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServer).GetNamespaceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Namespace_GetNamespaceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServer).GetNamespaceStats(ctx, req.(*GetNamespaceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Namespace_ListNamespaceRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServer).ListNamespaceRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Namespace_ListNamespaceRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServer).ListNamespaceRevisions(ctx, req.(*ListNamespaceRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Namespace_GetNamespaceRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServer).GetNamespaceRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Namespace_GetNamespaceRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServer).GetNamespaceRevision(ctx, req.(*GetNamespaceRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Namespace_DiffNamespaceRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffNamespaceRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServer).DiffNamespaceRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Namespace_DiffNamespaceRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServer).DiffNamespaceRevisions(ctx, req.(*DiffNamespaceRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Namespace_RollbackNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServer).RollbackNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Namespace_RollbackNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServer).RollbackNamespace(ctx, req.(*RollbackNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Namespace_TransferNamespaceOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferNamespaceOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServer).TransferNamespaceOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Namespace_TransferNamespaceOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServer).TransferNamespaceOwnership(ctx, req.(*TransferNamespaceOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Namespace_ServiceDesc is the grpc.ServiceDesc for Namespace service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -343,6 +547,30 @@ var Namespace_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SelectNamespace",
 			Handler:    _Namespace_SelectNamespace_Handler,
 		},
+		{
+			MethodName: "GetNamespaceStats",
+			Handler:    _Namespace_GetNamespaceStats_Handler,
+		},
+		{
+			MethodName: "ListNamespaceRevisions",
+			Handler:    _Namespace_ListNamespaceRevisions_Handler,
+		},
+		{
+			MethodName: "GetNamespaceRevision",
+			Handler:    _Namespace_GetNamespaceRevision_Handler,
+		},
+		{
+			MethodName: "DiffNamespaceRevisions",
+			Handler:    _Namespace_DiffNamespaceRevisions_Handler,
+		},
+		{
+			MethodName: "RollbackNamespace",
+			Handler:    _Namespace_RollbackNamespace_Handler,
+		},
+		{
+			MethodName: "TransferNamespaceOwnership",
+			Handler:    _Namespace_TransferNamespaceOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "api/v1/namespace.proto",
@@ -21,18 +21,30 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationNamespaceCreateNamespace = "/sovereign.api.v1.Namespace/CreateNamespace"
 const OperationNamespaceDeleteNamespace = "/sovereign.api.v1.Namespace/DeleteNamespace"
+const OperationNamespaceDiffNamespaceRevisions = "/sovereign.api.v1.Namespace/DiffNamespaceRevisions"
 const OperationNamespaceGetNamespace = "/sovereign.api.v1.Namespace/GetNamespace"
+const OperationNamespaceGetNamespaceRevision = "/sovereign.api.v1.Namespace/GetNamespaceRevision"
+const OperationNamespaceGetNamespaceStats = "/sovereign.api.v1.Namespace/GetNamespaceStats"
 const OperationNamespaceListNamespace = "/sovereign.api.v1.Namespace/ListNamespace"
+const OperationNamespaceListNamespaceRevisions = "/sovereign.api.v1.Namespace/ListNamespaceRevisions"
+const OperationNamespaceRollbackNamespace = "/sovereign.api.v1.Namespace/RollbackNamespace"
 const OperationNamespaceSelectNamespace = "/sovereign.api.v1.Namespace/SelectNamespace"
+const OperationNamespaceTransferNamespaceOwnership = "/sovereign.api.v1.Namespace/TransferNamespaceOwnership"
 const OperationNamespaceUpdateNamespace = "/sovereign.api.v1.Namespace/UpdateNamespace"
 const OperationNamespaceUpdateNamespaceStatus = "/sovereign.api.v1.Namespace/UpdateNamespaceStatus"
 
 type NamespaceHTTPServer interface {
 	CreateNamespace(context.Context, *CreateNamespaceRequest) (*CreateNamespaceReply, error)
 	DeleteNamespace(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceReply, error)
+	DiffNamespaceRevisions(context.Context, *DiffNamespaceRevisionsRequest) (*DiffNamespaceRevisionsReply, error)
 	GetNamespace(context.Context, *GetNamespaceRequest) (*NamespaceItem, error)
+	GetNamespaceRevision(context.Context, *GetNamespaceRevisionRequest) (*NamespaceRevisionItem, error)
+	GetNamespaceStats(context.Context, *GetNamespaceStatsRequest) (*GetNamespaceStatsReply, error)
 	ListNamespace(context.Context, *ListNamespaceRequest) (*ListNamespaceReply, error)
+	ListNamespaceRevisions(context.Context, *ListNamespaceRevisionsRequest) (*ListNamespaceRevisionsReply, error)
+	RollbackNamespace(context.Context, *RollbackNamespaceRequest) (*NamespaceRevisionItem, error)
 	SelectNamespace(context.Context, *SelectNamespaceRequest) (*SelectNamespaceReply, error)
+	TransferNamespaceOwnership(context.Context, *TransferNamespaceOwnershipRequest) (*NamespaceRevisionItem, error)
 	UpdateNamespace(context.Context, *UpdateNamespaceRequest) (*UpdateNamespaceReply, error)
 	UpdateNamespaceStatus(context.Context, *UpdateNamespaceStatusRequest) (*UpdateNamespaceStatusReply, error)
 }
@@ -46,6 +58,12 @@ func RegisterNamespaceHTTPServer(s *http.Server, srv NamespaceHTTPServer) {
 	r.GET("/v1/namespace/{uid}", _Namespace_GetNamespace0_HTTP_Handler(srv))
 	r.GET("/v1/namespaces", _Namespace_ListNamespace0_HTTP_Handler(srv))
 	r.GET("/v1/namespaces/select", _Namespace_SelectNamespace0_HTTP_Handler(srv))
+	r.GET("/v1/namespaces/stats", _Namespace_GetNamespaceStats0_HTTP_Handler(srv))
+	r.GET("/v1/namespace/{uid}/revisions", _Namespace_ListNamespaceRevisions0_HTTP_Handler(srv))
+	r.GET("/v1/namespace/{uid}/revision/{revision}", _Namespace_GetNamespaceRevision0_HTTP_Handler(srv))
+	r.GET("/v1/namespace/{uid}/revisions/diff", _Namespace_DiffNamespaceRevisions0_HTTP_Handler(srv))
+	r.POST("/v1/namespace/{uid}/rollback", _Namespace_RollbackNamespace0_HTTP_Handler(srv))
+	r.POST("/v1/namespace/{uid}/transfer", _Namespace_TransferNamespaceOwnership0_HTTP_Handler(srv))
 }
 
 func _Namespace_CreateNamespace0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
@@ -202,12 +220,153 @@ func _Namespace_SelectNamespace0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx
 	}
 }
 
+func _Namespace_GetNamespaceStats0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetNamespaceStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNamespaceGetNamespaceStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNamespaceStats(ctx, req.(*GetNamespaceStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetNamespaceStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Namespace_ListNamespaceRevisions0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListNamespaceRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNamespaceListNamespaceRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListNamespaceRevisions(ctx, req.(*ListNamespaceRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListNamespaceRevisionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Namespace_GetNamespaceRevision0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetNamespaceRevisionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNamespaceGetNamespaceRevision)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetNamespaceRevision(ctx, req.(*GetNamespaceRevisionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NamespaceRevisionItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Namespace_DiffNamespaceRevisions0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DiffNamespaceRevisionsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNamespaceDiffNamespaceRevisions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DiffNamespaceRevisions(ctx, req.(*DiffNamespaceRevisionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DiffNamespaceRevisionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Namespace_RollbackNamespace0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RollbackNamespaceRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNamespaceRollbackNamespace)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RollbackNamespace(ctx, req.(*RollbackNamespaceRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NamespaceRevisionItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Namespace_TransferNamespaceOwnership0_HTTP_Handler(srv NamespaceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in TransferNamespaceOwnershipRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationNamespaceTransferNamespaceOwnership)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.TransferNamespaceOwnership(ctx, req.(*TransferNamespaceOwnershipRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*NamespaceRevisionItem)
+		return ctx.Result(200, reply)
+	}
+}
+
 type NamespaceHTTPClient interface {
 	CreateNamespace(ctx context.Context, req *CreateNamespaceRequest, opts ...http.CallOption) (rsp *CreateNamespaceReply, err error)
 	DeleteNamespace(ctx context.Context, req *DeleteNamespaceRequest, opts ...http.CallOption) (rsp *DeleteNamespaceReply, err error)
+	DiffNamespaceRevisions(ctx context.Context, req *DiffNamespaceRevisionsRequest, opts ...http.CallOption) (rsp *DiffNamespaceRevisionsReply, err error)
 	GetNamespace(ctx context.Context, req *GetNamespaceRequest, opts ...http.CallOption) (rsp *NamespaceItem, err error)
+	GetNamespaceRevision(ctx context.Context, req *GetNamespaceRevisionRequest, opts ...http.CallOption) (rsp *NamespaceRevisionItem, err error)
+	GetNamespaceStats(ctx context.Context, req *GetNamespaceStatsRequest, opts ...http.CallOption) (rsp *GetNamespaceStatsReply, err error)
 	ListNamespace(ctx context.Context, req *ListNamespaceRequest, opts ...http.CallOption) (rsp *ListNamespaceReply, err error)
+	ListNamespaceRevisions(ctx context.Context, req *ListNamespaceRevisionsRequest, opts ...http.CallOption) (rsp *ListNamespaceRevisionsReply, err error)
+	RollbackNamespace(ctx context.Context, req *RollbackNamespaceRequest, opts ...http.CallOption) (rsp *NamespaceRevisionItem, err error)
 	SelectNamespace(ctx context.Context, req *SelectNamespaceRequest, opts ...http.CallOption) (rsp *SelectNamespaceReply, err error)
+	TransferNamespaceOwnership(ctx context.Context, req *TransferNamespaceOwnershipRequest, opts ...http.CallOption) (rsp *NamespaceRevisionItem, err error)
 	UpdateNamespace(ctx context.Context, req *UpdateNamespaceRequest, opts ...http.CallOption) (rsp *UpdateNamespaceReply, err error)
 	UpdateNamespaceStatus(ctx context.Context, req *UpdateNamespaceStatusRequest, opts ...http.CallOption) (rsp *UpdateNamespaceStatusReply, err error)
 }
@@ -246,6 +405,19 @@ func (c *NamespaceHTTPClientImpl) DeleteNamespace(ctx context.Context, in *Delet
 	return &out, nil
 }
 
+func (c *NamespaceHTTPClientImpl) DiffNamespaceRevisions(ctx context.Context, in *DiffNamespaceRevisionsRequest, opts ...http.CallOption) (*DiffNamespaceRevisionsReply, error) {
+	var out DiffNamespaceRevisionsReply
+	pattern := "/v1/namespace/{uid}/revisions/diff"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNamespaceDiffNamespaceRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NamespaceHTTPClientImpl) GetNamespace(ctx context.Context, in *GetNamespaceRequest, opts ...http.CallOption) (*NamespaceItem, error) {
 	var out NamespaceItem
 	pattern := "/v1/namespace/{uid}"
@@ -259,6 +431,32 @@ func (c *NamespaceHTTPClientImpl) GetNamespace(ctx context.Context, in *GetNames
 	return &out, nil
 }
 
+func (c *NamespaceHTTPClientImpl) GetNamespaceRevision(ctx context.Context, in *GetNamespaceRevisionRequest, opts ...http.CallOption) (*NamespaceRevisionItem, error) {
+	var out NamespaceRevisionItem
+	pattern := "/v1/namespace/{uid}/revision/{revision}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNamespaceGetNamespaceRevision))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NamespaceHTTPClientImpl) GetNamespaceStats(ctx context.Context, in *GetNamespaceStatsRequest, opts ...http.CallOption) (*GetNamespaceStatsReply, error) {
+	var out GetNamespaceStatsReply
+	pattern := "/v1/namespaces/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNamespaceGetNamespaceStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NamespaceHTTPClientImpl) ListNamespace(ctx context.Context, in *ListNamespaceRequest, opts ...http.CallOption) (*ListNamespaceReply, error) {
 	var out ListNamespaceReply
 	pattern := "/v1/namespaces"
@@ -272,6 +470,32 @@ func (c *NamespaceHTTPClientImpl) ListNamespace(ctx context.Context, in *ListNam
 	return &out, nil
 }
 
+func (c *NamespaceHTTPClientImpl) ListNamespaceRevisions(ctx context.Context, in *ListNamespaceRevisionsRequest, opts ...http.CallOption) (*ListNamespaceRevisionsReply, error) {
+	var out ListNamespaceRevisionsReply
+	pattern := "/v1/namespace/{uid}/revisions"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationNamespaceListNamespaceRevisions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *NamespaceHTTPClientImpl) RollbackNamespace(ctx context.Context, in *RollbackNamespaceRequest, opts ...http.CallOption) (*NamespaceRevisionItem, error) {
+	var out NamespaceRevisionItem
+	pattern := "/v1/namespace/{uid}/rollback"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNamespaceRollbackNamespace))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NamespaceHTTPClientImpl) SelectNamespace(ctx context.Context, in *SelectNamespaceRequest, opts ...http.CallOption) (*SelectNamespaceReply, error) {
 	var out SelectNamespaceReply
 	pattern := "/v1/namespaces/select"
@@ -285,6 +509,19 @@ func (c *NamespaceHTTPClientImpl) SelectNamespace(ctx context.Context, in *Selec
 	return &out, nil
 }
 
+func (c *NamespaceHTTPClientImpl) TransferNamespaceOwnership(ctx context.Context, in *TransferNamespaceOwnershipRequest, opts ...http.CallOption) (*NamespaceRevisionItem, error) {
+	var out NamespaceRevisionItem
+	pattern := "/v1/namespace/{uid}/transfer"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationNamespaceTransferNamespaceOwnership))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *NamespaceHTTPClientImpl) UpdateNamespace(ctx context.Context, in *UpdateNamespaceRequest, opts ...http.CallOption) (*UpdateNamespaceReply, error) {
 	var out UpdateNamespaceReply
 	pattern := "/v1/namespace/{uid}"
@@ -0,0 +1,283 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/v1/oauth2_provider.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	OAuth2Provider_CreateOAuth2Provider_FullMethodName       = "/sovereign.api.v1.OAuth2Provider/CreateOAuth2Provider"
+	OAuth2Provider_UpdateOAuth2Provider_FullMethodName       = "/sovereign.api.v1.OAuth2Provider/UpdateOAuth2Provider"
+	OAuth2Provider_UpdateOAuth2ProviderStatus_FullMethodName = "/sovereign.api.v1.OAuth2Provider/UpdateOAuth2ProviderStatus"
+	OAuth2Provider_DeleteOAuth2Provider_FullMethodName       = "/sovereign.api.v1.OAuth2Provider/DeleteOAuth2Provider"
+	OAuth2Provider_ListOAuth2Providers_FullMethodName        = "/sovereign.api.v1.OAuth2Provider/ListOAuth2Providers"
+)
+
+// OAuth2ProviderClient is the client API for OAuth2Provider service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// OAuth2Provider manages the OAuth2 providers stored in the database, the logins use the changes without a restart
+type OAuth2ProviderClient interface {
+	CreateOAuth2Provider(ctx context.Context, in *CreateOAuth2ProviderRequest, opts ...grpc.CallOption) (*OAuth2ProviderItem, error)
+	// UpdateOAuth2Provider replaces the config of the provider, the client secret is rotated when the config has one
+	UpdateOAuth2Provider(ctx context.Context, in *UpdateOAuth2ProviderRequest, opts ...grpc.CallOption) (*OAuth2ProviderItem, error)
+	// UpdateOAuth2ProviderStatus enables or disables the provider, the login routes of a disabled provider are not found
+	UpdateOAuth2ProviderStatus(ctx context.Context, in *UpdateOAuth2ProviderStatusRequest, opts ...grpc.CallOption) (*OAuth2ProviderItem, error)
+	DeleteOAuth2Provider(ctx context.Context, in *DeleteOAuth2ProviderRequest, opts ...grpc.CallOption) (*DeleteOAuth2ProviderReply, error)
+	// ListOAuth2Providers lists the providers of the config and of the OAuth2 provider API
+	ListOAuth2Providers(ctx context.Context, in *ListOAuth2ProvidersRequest, opts ...grpc.CallOption) (*ListOAuth2ProvidersReply, error)
+}
+
+type oAuth2ProviderClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewOAuth2ProviderClient(cc grpc.ClientConnInterface) OAuth2ProviderClient {
+	return &oAuth2ProviderClient{cc}
+}
+
+func (c *oAuth2ProviderClient) CreateOAuth2Provider(ctx context.Context, in *CreateOAuth2ProviderRequest, opts ...grpc.CallOption) (*OAuth2ProviderItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuth2ProviderItem)
+	err := c.cc.Invoke(ctx, OAuth2Provider_CreateOAuth2Provider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuth2ProviderClient) UpdateOAuth2Provider(ctx context.Context, in *UpdateOAuth2ProviderRequest, opts ...grpc.CallOption) (*OAuth2ProviderItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuth2ProviderItem)
+	err := c.cc.Invoke(ctx, OAuth2Provider_UpdateOAuth2Provider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuth2ProviderClient) UpdateOAuth2ProviderStatus(ctx context.Context, in *UpdateOAuth2ProviderStatusRequest, opts ...grpc.CallOption) (*OAuth2ProviderItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(OAuth2ProviderItem)
+	err := c.cc.Invoke(ctx, OAuth2Provider_UpdateOAuth2ProviderStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuth2ProviderClient) DeleteOAuth2Provider(ctx context.Context, in *DeleteOAuth2ProviderRequest, opts ...grpc.CallOption) (*DeleteOAuth2ProviderReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteOAuth2ProviderReply)
+	err := c.cc.Invoke(ctx, OAuth2Provider_DeleteOAuth2Provider_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *oAuth2ProviderClient) ListOAuth2Providers(ctx context.Context, in *ListOAuth2ProvidersRequest, opts ...grpc.CallOption) (*ListOAuth2ProvidersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListOAuth2ProvidersReply)
+	err := c.cc.Invoke(ctx, OAuth2Provider_ListOAuth2Providers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// OAuth2ProviderServer is the server API for OAuth2Provider service.
+// All implementations must embed UnimplementedOAuth2ProviderServer
+// for forward compatibility.
+//
+// OAuth2Provider manages the OAuth2 providers stored in the database, the logins use the changes without a restart
+type OAuth2ProviderServer interface {
+	CreateOAuth2Provider(context.Context, *CreateOAuth2ProviderRequest) (*OAuth2ProviderItem, error)
+	// UpdateOAuth2Provider replaces the config of the provider, the client secret is rotated when the config has one
+	UpdateOAuth2Provider(context.Context, *UpdateOAuth2ProviderRequest) (*OAuth2ProviderItem, error)
+	// UpdateOAuth2ProviderStatus enables or disables the provider, the login routes of a disabled provider are not found
+	UpdateOAuth2ProviderStatus(context.Context, *UpdateOAuth2ProviderStatusRequest) (*OAuth2ProviderItem, error)
+	DeleteOAuth2Provider(context.Context, *DeleteOAuth2ProviderRequest) (*DeleteOAuth2ProviderReply, error)
+	// ListOAuth2Providers lists the providers of the config and of the OAuth2 provider API
+	ListOAuth2Providers(context.Context, *ListOAuth2ProvidersRequest) (*ListOAuth2ProvidersReply, error)
+	mustEmbedUnimplementedOAuth2ProviderServer()
+}
+
+// UnimplementedOAuth2ProviderServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedOAuth2ProviderServer struct{}
+
+func (UnimplementedOAuth2ProviderServer) CreateOAuth2Provider(context.Context, *CreateOAuth2ProviderRequest) (*OAuth2ProviderItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOAuth2Provider not implemented")
+}
+func (UnimplementedOAuth2ProviderServer) UpdateOAuth2Provider(context.Context, *UpdateOAuth2ProviderRequest) (*OAuth2ProviderItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOAuth2Provider not implemented")
+}
+func (UnimplementedOAuth2ProviderServer) UpdateOAuth2ProviderStatus(context.Context, *UpdateOAuth2ProviderStatusRequest) (*OAuth2ProviderItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOAuth2ProviderStatus not implemented")
+}
+func (UnimplementedOAuth2ProviderServer) DeleteOAuth2Provider(context.Context, *DeleteOAuth2ProviderRequest) (*DeleteOAuth2ProviderReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteOAuth2Provider not implemented")
+}
+func (UnimplementedOAuth2ProviderServer) ListOAuth2Providers(context.Context, *ListOAuth2ProvidersRequest) (*ListOAuth2ProvidersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOAuth2Providers not implemented")
+}
+func (UnimplementedOAuth2ProviderServer) mustEmbedUnimplementedOAuth2ProviderServer() {}
+func (UnimplementedOAuth2ProviderServer) testEmbeddedByValue()                        {}
+
+// UnsafeOAuth2ProviderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to OAuth2ProviderServer will
+// result in compilation errors.
+type UnsafeOAuth2ProviderServer interface {
+	mustEmbedUnimplementedOAuth2ProviderServer()
+}
+
+func RegisterOAuth2ProviderServer(s grpc.ServiceRegistrar, srv OAuth2ProviderServer) {
+	// If the following call pancis, it indicates UnimplementedOAuth2ProviderServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&OAuth2Provider_ServiceDesc, srv)
+}
+
+func _OAuth2Provider_CreateOAuth2Provider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateOAuth2ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuth2ProviderServer).CreateOAuth2Provider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuth2Provider_CreateOAuth2Provider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuth2ProviderServer).CreateOAuth2Provider(ctx, req.(*CreateOAuth2ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuth2Provider_UpdateOAuth2Provider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOAuth2ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuth2ProviderServer).UpdateOAuth2Provider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuth2Provider_UpdateOAuth2Provider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuth2ProviderServer).UpdateOAuth2Provider(ctx, req.(*UpdateOAuth2ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuth2Provider_UpdateOAuth2ProviderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateOAuth2ProviderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuth2ProviderServer).UpdateOAuth2ProviderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuth2Provider_UpdateOAuth2ProviderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuth2ProviderServer).UpdateOAuth2ProviderStatus(ctx, req.(*UpdateOAuth2ProviderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuth2Provider_DeleteOAuth2Provider_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteOAuth2ProviderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuth2ProviderServer).DeleteOAuth2Provider(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuth2Provider_DeleteOAuth2Provider_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuth2ProviderServer).DeleteOAuth2Provider(ctx, req.(*DeleteOAuth2ProviderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OAuth2Provider_ListOAuth2Providers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOAuth2ProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OAuth2ProviderServer).ListOAuth2Providers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OAuth2Provider_ListOAuth2Providers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OAuth2ProviderServer).ListOAuth2Providers(ctx, req.(*ListOAuth2ProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// OAuth2Provider_ServiceDesc is the grpc.ServiceDesc for OAuth2Provider service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var OAuth2Provider_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sovereign.api.v1.OAuth2Provider",
+	HandlerType: (*OAuth2ProviderServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateOAuth2Provider",
+			Handler:    _OAuth2Provider_CreateOAuth2Provider_Handler,
+		},
+		{
+			MethodName: "UpdateOAuth2Provider",
+			Handler:    _OAuth2Provider_UpdateOAuth2Provider_Handler,
+		},
+		{
+			MethodName: "UpdateOAuth2ProviderStatus",
+			Handler:    _OAuth2Provider_UpdateOAuth2ProviderStatus_Handler,
+		},
+		{
+			MethodName: "DeleteOAuth2Provider",
+			Handler:    _OAuth2Provider_DeleteOAuth2Provider_Handler,
+		},
+		{
+			MethodName: "ListOAuth2Providers",
+			Handler:    _OAuth2Provider_ListOAuth2Providers_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/oauth2_provider.proto",
+}
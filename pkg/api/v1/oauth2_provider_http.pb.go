@@ -0,0 +1,240 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: api/v1/oauth2_provider.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationOAuth2ProviderCreateOAuth2Provider = "/sovereign.api.v1.OAuth2Provider/CreateOAuth2Provider"
+const OperationOAuth2ProviderDeleteOAuth2Provider = "/sovereign.api.v1.OAuth2Provider/DeleteOAuth2Provider"
+const OperationOAuth2ProviderListOAuth2Providers = "/sovereign.api.v1.OAuth2Provider/ListOAuth2Providers"
+const OperationOAuth2ProviderUpdateOAuth2Provider = "/sovereign.api.v1.OAuth2Provider/UpdateOAuth2Provider"
+const OperationOAuth2ProviderUpdateOAuth2ProviderStatus = "/sovereign.api.v1.OAuth2Provider/UpdateOAuth2ProviderStatus"
+
+type OAuth2ProviderHTTPServer interface {
+	CreateOAuth2Provider(context.Context, *CreateOAuth2ProviderRequest) (*OAuth2ProviderItem, error)
+	DeleteOAuth2Provider(context.Context, *DeleteOAuth2ProviderRequest) (*DeleteOAuth2ProviderReply, error)
+	// ListOAuth2Providers ListOAuth2Providers lists the providers of the config and of the OAuth2 provider API
+	ListOAuth2Providers(context.Context, *ListOAuth2ProvidersRequest) (*ListOAuth2ProvidersReply, error)
+	// UpdateOAuth2Provider UpdateOAuth2Provider replaces the config of the provider, the client secret is rotated when the config has one
+	UpdateOAuth2Provider(context.Context, *UpdateOAuth2ProviderRequest) (*OAuth2ProviderItem, error)
+	// UpdateOAuth2ProviderStatus UpdateOAuth2ProviderStatus enables or disables the provider, the login routes of a disabled provider are not found
+	UpdateOAuth2ProviderStatus(context.Context, *UpdateOAuth2ProviderStatusRequest) (*OAuth2ProviderItem, error)
+}
+
+func RegisterOAuth2ProviderHTTPServer(s *http.Server, srv OAuth2ProviderHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/oauth2/providers", _OAuth2Provider_CreateOAuth2Provider0_HTTP_Handler(srv))
+	r.PUT("/v1/oauth2/providers/{uid}", _OAuth2Provider_UpdateOAuth2Provider0_HTTP_Handler(srv))
+	r.PUT("/v1/oauth2/providers/{uid}/status", _OAuth2Provider_UpdateOAuth2ProviderStatus0_HTTP_Handler(srv))
+	r.DELETE("/v1/oauth2/providers/{uid}", _OAuth2Provider_DeleteOAuth2Provider0_HTTP_Handler(srv))
+	r.GET("/v1/oauth2/providers", _OAuth2Provider_ListOAuth2Providers0_HTTP_Handler(srv))
+}
+
+func _OAuth2Provider_CreateOAuth2Provider0_HTTP_Handler(srv OAuth2ProviderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateOAuth2ProviderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOAuth2ProviderCreateOAuth2Provider)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateOAuth2Provider(ctx, req.(*CreateOAuth2ProviderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OAuth2ProviderItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth2Provider_UpdateOAuth2Provider0_HTTP_Handler(srv OAuth2ProviderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateOAuth2ProviderRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOAuth2ProviderUpdateOAuth2Provider)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateOAuth2Provider(ctx, req.(*UpdateOAuth2ProviderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OAuth2ProviderItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth2Provider_UpdateOAuth2ProviderStatus0_HTTP_Handler(srv OAuth2ProviderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateOAuth2ProviderStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOAuth2ProviderUpdateOAuth2ProviderStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateOAuth2ProviderStatus(ctx, req.(*UpdateOAuth2ProviderStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*OAuth2ProviderItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth2Provider_DeleteOAuth2Provider0_HTTP_Handler(srv OAuth2ProviderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteOAuth2ProviderRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOAuth2ProviderDeleteOAuth2Provider)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteOAuth2Provider(ctx, req.(*DeleteOAuth2ProviderRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteOAuth2ProviderReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _OAuth2Provider_ListOAuth2Providers0_HTTP_Handler(srv OAuth2ProviderHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListOAuth2ProvidersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationOAuth2ProviderListOAuth2Providers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListOAuth2Providers(ctx, req.(*ListOAuth2ProvidersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListOAuth2ProvidersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type OAuth2ProviderHTTPClient interface {
+	CreateOAuth2Provider(ctx context.Context, req *CreateOAuth2ProviderRequest, opts ...http.CallOption) (rsp *OAuth2ProviderItem, err error)
+	DeleteOAuth2Provider(ctx context.Context, req *DeleteOAuth2ProviderRequest, opts ...http.CallOption) (rsp *DeleteOAuth2ProviderReply, err error)
+	ListOAuth2Providers(ctx context.Context, req *ListOAuth2ProvidersRequest, opts ...http.CallOption) (rsp *ListOAuth2ProvidersReply, err error)
+	UpdateOAuth2Provider(ctx context.Context, req *UpdateOAuth2ProviderRequest, opts ...http.CallOption) (rsp *OAuth2ProviderItem, err error)
+	UpdateOAuth2ProviderStatus(ctx context.Context, req *UpdateOAuth2ProviderStatusRequest, opts ...http.CallOption) (rsp *OAuth2ProviderItem, err error)
+}
+
+type OAuth2ProviderHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewOAuth2ProviderHTTPClient(client *http.Client) OAuth2ProviderHTTPClient {
+	return &OAuth2ProviderHTTPClientImpl{client}
+}
+
+func (c *OAuth2ProviderHTTPClientImpl) CreateOAuth2Provider(ctx context.Context, in *CreateOAuth2ProviderRequest, opts ...http.CallOption) (*OAuth2ProviderItem, error) {
+	var out OAuth2ProviderItem
+	pattern := "/v1/oauth2/providers"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOAuth2ProviderCreateOAuth2Provider))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OAuth2ProviderHTTPClientImpl) DeleteOAuth2Provider(ctx context.Context, in *DeleteOAuth2ProviderRequest, opts ...http.CallOption) (*DeleteOAuth2ProviderReply, error) {
+	var out DeleteOAuth2ProviderReply
+	pattern := "/v1/oauth2/providers/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOAuth2ProviderDeleteOAuth2Provider))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OAuth2ProviderHTTPClientImpl) ListOAuth2Providers(ctx context.Context, in *ListOAuth2ProvidersRequest, opts ...http.CallOption) (*ListOAuth2ProvidersReply, error) {
+	var out ListOAuth2ProvidersReply
+	pattern := "/v1/oauth2/providers"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationOAuth2ProviderListOAuth2Providers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OAuth2ProviderHTTPClientImpl) UpdateOAuth2Provider(ctx context.Context, in *UpdateOAuth2ProviderRequest, opts ...http.CallOption) (*OAuth2ProviderItem, error) {
+	var out OAuth2ProviderItem
+	pattern := "/v1/oauth2/providers/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOAuth2ProviderUpdateOAuth2Provider))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *OAuth2ProviderHTTPClientImpl) UpdateOAuth2ProviderStatus(ctx context.Context, in *UpdateOAuth2ProviderStatusRequest, opts ...http.CallOption) (*OAuth2ProviderItem, error) {
+	var out OAuth2ProviderItem
+	pattern := "/v1/oauth2/providers/{uid}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationOAuth2ProviderUpdateOAuth2ProviderStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
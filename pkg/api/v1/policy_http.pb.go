@@ -0,0 +1,236 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: api/v1/policy.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationPolicyCreatePolicy = "/sovereign.api.v1.Policy/CreatePolicy"
+const OperationPolicyDeletePolicy = "/sovereign.api.v1.Policy/DeletePolicy"
+const OperationPolicyExplainPolicy = "/sovereign.api.v1.Policy/ExplainPolicy"
+const OperationPolicyListPolicies = "/sovereign.api.v1.Policy/ListPolicies"
+const OperationPolicyUpdatePolicy = "/sovereign.api.v1.Policy/UpdatePolicy"
+
+type PolicyHTTPServer interface {
+	CreatePolicy(context.Context, *CreatePolicyRequest) (*PolicyItem, error)
+	DeletePolicy(context.Context, *DeletePolicyRequest) (*DeletePolicyReply, error)
+	// ExplainPolicy ExplainPolicy evaluates the permissions and policies for a request without calling it, and reports why it is allowed or denied
+	ExplainPolicy(context.Context, *ExplainPolicyRequest) (*ExplainPolicyReply, error)
+	// ListPolicies ListPolicies lists the policies of the config and of the policy API
+	ListPolicies(context.Context, *ListPoliciesRequest) (*ListPoliciesReply, error)
+	UpdatePolicy(context.Context, *UpdatePolicyRequest) (*PolicyItem, error)
+}
+
+func RegisterPolicyHTTPServer(s *http.Server, srv PolicyHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/policies", _Policy_CreatePolicy0_HTTP_Handler(srv))
+	r.PUT("/v1/policies/{uid}", _Policy_UpdatePolicy0_HTTP_Handler(srv))
+	r.DELETE("/v1/policies/{uid}", _Policy_DeletePolicy0_HTTP_Handler(srv))
+	r.GET("/v1/policies", _Policy_ListPolicies0_HTTP_Handler(srv))
+	r.POST("/v1/policies/explain", _Policy_ExplainPolicy0_HTTP_Handler(srv))
+}
+
+func _Policy_CreatePolicy0_HTTP_Handler(srv PolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreatePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPolicyCreatePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreatePolicy(ctx, req.(*CreatePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PolicyItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Policy_UpdatePolicy0_HTTP_Handler(srv PolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdatePolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPolicyUpdatePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdatePolicy(ctx, req.(*UpdatePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*PolicyItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Policy_DeletePolicy0_HTTP_Handler(srv PolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeletePolicyRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPolicyDeletePolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeletePolicy(ctx, req.(*DeletePolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeletePolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Policy_ListPolicies0_HTTP_Handler(srv PolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListPoliciesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPolicyListPolicies)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListPolicies(ctx, req.(*ListPoliciesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListPoliciesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Policy_ExplainPolicy0_HTTP_Handler(srv PolicyHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExplainPolicyRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationPolicyExplainPolicy)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExplainPolicy(ctx, req.(*ExplainPolicyRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExplainPolicyReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type PolicyHTTPClient interface {
+	CreatePolicy(ctx context.Context, req *CreatePolicyRequest, opts ...http.CallOption) (rsp *PolicyItem, err error)
+	DeletePolicy(ctx context.Context, req *DeletePolicyRequest, opts ...http.CallOption) (rsp *DeletePolicyReply, err error)
+	ExplainPolicy(ctx context.Context, req *ExplainPolicyRequest, opts ...http.CallOption) (rsp *ExplainPolicyReply, err error)
+	ListPolicies(ctx context.Context, req *ListPoliciesRequest, opts ...http.CallOption) (rsp *ListPoliciesReply, err error)
+	UpdatePolicy(ctx context.Context, req *UpdatePolicyRequest, opts ...http.CallOption) (rsp *PolicyItem, err error)
+}
+
+type PolicyHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewPolicyHTTPClient(client *http.Client) PolicyHTTPClient {
+	return &PolicyHTTPClientImpl{client}
+}
+
+func (c *PolicyHTTPClientImpl) CreatePolicy(ctx context.Context, in *CreatePolicyRequest, opts ...http.CallOption) (*PolicyItem, error) {
+	var out PolicyItem
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPolicyCreatePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PolicyHTTPClientImpl) DeletePolicy(ctx context.Context, in *DeletePolicyRequest, opts ...http.CallOption) (*DeletePolicyReply, error) {
+	var out DeletePolicyReply
+	pattern := "/v1/policies/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPolicyDeletePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PolicyHTTPClientImpl) ExplainPolicy(ctx context.Context, in *ExplainPolicyRequest, opts ...http.CallOption) (*ExplainPolicyReply, error) {
+	var out ExplainPolicyReply
+	pattern := "/v1/policies/explain"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPolicyExplainPolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PolicyHTTPClientImpl) ListPolicies(ctx context.Context, in *ListPoliciesRequest, opts ...http.CallOption) (*ListPoliciesReply, error) {
+	var out ListPoliciesReply
+	pattern := "/v1/policies"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationPolicyListPolicies))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *PolicyHTTPClientImpl) UpdatePolicy(ctx context.Context, in *UpdatePolicyRequest, opts ...http.CallOption) (*PolicyItem, error) {
+	var out PolicyItem
+	pattern := "/v1/policies/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationPolicyUpdatePolicy))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
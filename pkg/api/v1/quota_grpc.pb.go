@@ -0,0 +1,311 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/v1/quota.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	Quota_SetQuota_FullMethodName    = "/sovereign.api.v1.Quota/SetQuota"
+	Quota_DeleteQuota_FullMethodName = "/sovereign.api.v1.Quota/DeleteQuota"
+	Quota_GetQuota_FullMethodName    = "/sovereign.api.v1.Quota/GetQuota"
+	Quota_ListQuota_FullMethodName   = "/sovereign.api.v1.Quota/ListQuota"
+	Quota_Reserve_FullMethodName     = "/sovereign.api.v1.Quota/Reserve"
+	Quota_Release_FullMethodName     = "/sovereign.api.v1.Quota/Release"
+)
+
+// QuotaClient is the client API for Quota service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QuotaClient interface {
+	SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*QuotaItem, error)
+	DeleteQuota(ctx context.Context, in *DeleteQuotaRequest, opts ...grpc.CallOption) (*DeleteQuotaReply, error)
+	GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*QuotaItem, error)
+	ListQuota(ctx context.Context, in *ListQuotaRequest, opts ...grpc.CallOption) (*ListQuotaReply, error)
+	Reserve(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*QuotaItem, error)
+	Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*QuotaItem, error)
+}
+
+type quotaClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQuotaClient(cc grpc.ClientConnInterface) QuotaClient {
+	return &quotaClient{cc}
+}
+
+func (c *quotaClient) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...grpc.CallOption) (*QuotaItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaItem)
+	err := c.cc.Invoke(ctx, Quota_SetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaClient) DeleteQuota(ctx context.Context, in *DeleteQuotaRequest, opts ...grpc.CallOption) (*DeleteQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeleteQuotaReply)
+	err := c.cc.Invoke(ctx, Quota_DeleteQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaClient) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...grpc.CallOption) (*QuotaItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaItem)
+	err := c.cc.Invoke(ctx, Quota_GetQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaClient) ListQuota(ctx context.Context, in *ListQuotaRequest, opts ...grpc.CallOption) (*ListQuotaReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListQuotaReply)
+	err := c.cc.Invoke(ctx, Quota_ListQuota_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaClient) Reserve(ctx context.Context, in *ReserveRequest, opts ...grpc.CallOption) (*QuotaItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaItem)
+	err := c.cc.Invoke(ctx, Quota_Reserve_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *quotaClient) Release(ctx context.Context, in *ReleaseRequest, opts ...grpc.CallOption) (*QuotaItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(QuotaItem)
+	err := c.cc.Invoke(ctx, Quota_Release_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QuotaServer is the server API for Quota service.
+// All implementations must embed UnimplementedQuotaServer
+// for forward compatibility.
+type QuotaServer interface {
+	SetQuota(context.Context, *SetQuotaRequest) (*QuotaItem, error)
+	DeleteQuota(context.Context, *DeleteQuotaRequest) (*DeleteQuotaReply, error)
+	GetQuota(context.Context, *GetQuotaRequest) (*QuotaItem, error)
+	ListQuota(context.Context, *ListQuotaRequest) (*ListQuotaReply, error)
+	Reserve(context.Context, *ReserveRequest) (*QuotaItem, error)
+	Release(context.Context, *ReleaseRequest) (*QuotaItem, error)
+	mustEmbedUnimplementedQuotaServer()
+}
+
+// UnimplementedQuotaServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedQuotaServer struct{}
+
+func (UnimplementedQuotaServer) SetQuota(context.Context, *SetQuotaRequest) (*QuotaItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetQuota not implemented")
+}
+func (UnimplementedQuotaServer) DeleteQuota(context.Context, *DeleteQuotaRequest) (*DeleteQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteQuota not implemented")
+}
+func (UnimplementedQuotaServer) GetQuota(context.Context, *GetQuotaRequest) (*QuotaItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetQuota not implemented")
+}
+func (UnimplementedQuotaServer) ListQuota(context.Context, *ListQuotaRequest) (*ListQuotaReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListQuota not implemented")
+}
+func (UnimplementedQuotaServer) Reserve(context.Context, *ReserveRequest) (*QuotaItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reserve not implemented")
+}
+func (UnimplementedQuotaServer) Release(context.Context, *ReleaseRequest) (*QuotaItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Release not implemented")
+}
+func (UnimplementedQuotaServer) mustEmbedUnimplementedQuotaServer() {}
+func (UnimplementedQuotaServer) testEmbeddedByValue()               {}
+
+// UnsafeQuotaServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QuotaServer will
+// result in compilation errors.
+type UnsafeQuotaServer interface {
+	mustEmbedUnimplementedQuotaServer()
+}
+
+func RegisterQuotaServer(s grpc.ServiceRegistrar, srv QuotaServer) {
+	// If the following call pancis, it indicates UnimplementedQuotaServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&Quota_ServiceDesc, srv)
+}
+
+func _Quota_SetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServer).SetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quota_SetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServer).SetQuota(ctx, req.(*SetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quota_DeleteQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServer).DeleteQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quota_DeleteQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServer).DeleteQuota(ctx, req.(*DeleteQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quota_GetQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServer).GetQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quota_GetQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServer).GetQuota(ctx, req.(*GetQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quota_ListQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListQuotaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServer).ListQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quota_ListQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServer).ListQuota(ctx, req.(*ListQuotaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quota_Reserve_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServer).Reserve(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quota_Reserve_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServer).Reserve(ctx, req.(*ReserveRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Quota_Release_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QuotaServer).Release(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Quota_Release_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QuotaServer).Release(ctx, req.(*ReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Quota_ServiceDesc is the grpc.ServiceDesc for Quota service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Quota_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sovereign.api.v1.Quota",
+	HandlerType: (*QuotaServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetQuota",
+			Handler:    _Quota_SetQuota_Handler,
+		},
+		{
+			MethodName: "DeleteQuota",
+			Handler:    _Quota_DeleteQuota_Handler,
+		},
+		{
+			MethodName: "GetQuota",
+			Handler:    _Quota_GetQuota_Handler,
+		},
+		{
+			MethodName: "ListQuota",
+			Handler:    _Quota_ListQuota_Handler,
+		},
+		{
+			MethodName: "Reserve",
+			Handler:    _Quota_Reserve_Handler,
+		},
+		{
+			MethodName: "Release",
+			Handler:    _Quota_Release_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/quota.proto",
+}
@@ -0,0 +1,279 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: api/v1/quota.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationQuotaDeleteQuota = "/sovereign.api.v1.Quota/DeleteQuota"
+const OperationQuotaGetQuota = "/sovereign.api.v1.Quota/GetQuota"
+const OperationQuotaListQuota = "/sovereign.api.v1.Quota/ListQuota"
+const OperationQuotaRelease = "/sovereign.api.v1.Quota/Release"
+const OperationQuotaReserve = "/sovereign.api.v1.Quota/Reserve"
+const OperationQuotaSetQuota = "/sovereign.api.v1.Quota/SetQuota"
+
+type QuotaHTTPServer interface {
+	DeleteQuota(context.Context, *DeleteQuotaRequest) (*DeleteQuotaReply, error)
+	GetQuota(context.Context, *GetQuotaRequest) (*QuotaItem, error)
+	ListQuota(context.Context, *ListQuotaRequest) (*ListQuotaReply, error)
+	Release(context.Context, *ReleaseRequest) (*QuotaItem, error)
+	Reserve(context.Context, *ReserveRequest) (*QuotaItem, error)
+	SetQuota(context.Context, *SetQuotaRequest) (*QuotaItem, error)
+}
+
+func RegisterQuotaHTTPServer(s *http.Server, srv QuotaHTTPServer) {
+	r := s.Route("/")
+	r.PUT("/v1/quota/{resource}", _Quota_SetQuota0_HTTP_Handler(srv))
+	r.DELETE("/v1/quota/{resource}", _Quota_DeleteQuota0_HTTP_Handler(srv))
+	r.GET("/v1/quota/{resource}", _Quota_GetQuota0_HTTP_Handler(srv))
+	r.GET("/v1/quotas", _Quota_ListQuota0_HTTP_Handler(srv))
+	r.POST("/v1/quota/{resource}/reserve", _Quota_Reserve0_HTTP_Handler(srv))
+	r.POST("/v1/quota/{resource}/release", _Quota_Release0_HTTP_Handler(srv))
+}
+
+func _Quota_SetQuota0_HTTP_Handler(srv QuotaHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetQuotaRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuotaSetQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetQuota(ctx, req.(*SetQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QuotaItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Quota_DeleteQuota0_HTTP_Handler(srv QuotaHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteQuotaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuotaDeleteQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteQuota(ctx, req.(*DeleteQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Quota_GetQuota0_HTTP_Handler(srv QuotaHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetQuotaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuotaGetQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetQuota(ctx, req.(*GetQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QuotaItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Quota_ListQuota0_HTTP_Handler(srv QuotaHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListQuotaRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuotaListQuota)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListQuota(ctx, req.(*ListQuotaRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListQuotaReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Quota_Reserve0_HTTP_Handler(srv QuotaHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReserveRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuotaReserve)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Reserve(ctx, req.(*ReserveRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QuotaItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Quota_Release0_HTTP_Handler(srv QuotaHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ReleaseRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationQuotaRelease)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.Release(ctx, req.(*ReleaseRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*QuotaItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+type QuotaHTTPClient interface {
+	DeleteQuota(ctx context.Context, req *DeleteQuotaRequest, opts ...http.CallOption) (rsp *DeleteQuotaReply, err error)
+	GetQuota(ctx context.Context, req *GetQuotaRequest, opts ...http.CallOption) (rsp *QuotaItem, err error)
+	ListQuota(ctx context.Context, req *ListQuotaRequest, opts ...http.CallOption) (rsp *ListQuotaReply, err error)
+	Release(ctx context.Context, req *ReleaseRequest, opts ...http.CallOption) (rsp *QuotaItem, err error)
+	Reserve(ctx context.Context, req *ReserveRequest, opts ...http.CallOption) (rsp *QuotaItem, err error)
+	SetQuota(ctx context.Context, req *SetQuotaRequest, opts ...http.CallOption) (rsp *QuotaItem, err error)
+}
+
+type QuotaHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewQuotaHTTPClient(client *http.Client) QuotaHTTPClient {
+	return &QuotaHTTPClientImpl{client}
+}
+
+func (c *QuotaHTTPClientImpl) DeleteQuota(ctx context.Context, in *DeleteQuotaRequest, opts ...http.CallOption) (*DeleteQuotaReply, error) {
+	var out DeleteQuotaReply
+	pattern := "/v1/quota/{resource}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationQuotaDeleteQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *QuotaHTTPClientImpl) GetQuota(ctx context.Context, in *GetQuotaRequest, opts ...http.CallOption) (*QuotaItem, error) {
+	var out QuotaItem
+	pattern := "/v1/quota/{resource}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationQuotaGetQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *QuotaHTTPClientImpl) ListQuota(ctx context.Context, in *ListQuotaRequest, opts ...http.CallOption) (*ListQuotaReply, error) {
+	var out ListQuotaReply
+	pattern := "/v1/quotas"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationQuotaListQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *QuotaHTTPClientImpl) Release(ctx context.Context, in *ReleaseRequest, opts ...http.CallOption) (*QuotaItem, error) {
+	var out QuotaItem
+	pattern := "/v1/quota/{resource}/release"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationQuotaRelease))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *QuotaHTTPClientImpl) Reserve(ctx context.Context, in *ReserveRequest, opts ...http.CallOption) (*QuotaItem, error) {
+	var out QuotaItem
+	pattern := "/v1/quota/{resource}/reserve"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationQuotaReserve))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *QuotaHTTPClientImpl) SetQuota(ctx context.Context, in *SetQuotaRequest, opts ...http.CallOption) (*QuotaItem, error) {
+	var out QuotaItem
+	pattern := "/v1/quota/{resource}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationQuotaSetQuota))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
@@ -0,0 +1,394 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: api/v1/rbac.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationRBACCreateRole = "/sovereign.api.v1.RBAC/CreateRole"
+const OperationRBACCreateRoleBinding = "/sovereign.api.v1.RBAC/CreateRoleBinding"
+const OperationRBACDeleteRole = "/sovereign.api.v1.RBAC/DeleteRole"
+const OperationRBACDeleteRoleBinding = "/sovereign.api.v1.RBAC/DeleteRoleBinding"
+const OperationRBACGetRole = "/sovereign.api.v1.RBAC/GetRole"
+const OperationRBACListRoleBindings = "/sovereign.api.v1.RBAC/ListRoleBindings"
+const OperationRBACListRoles = "/sovereign.api.v1.RBAC/ListRoles"
+const OperationRBACSetRoleMFA = "/sovereign.api.v1.RBAC/SetRoleMFA"
+const OperationRBACUpdateRole = "/sovereign.api.v1.RBAC/UpdateRole"
+
+type RBACHTTPServer interface {
+	CreateRole(context.Context, *CreateRoleRequest) (*RoleItem, error)
+	// CreateRoleBinding CreateRoleBinding grants a role to a user in a namespace, or in every namespace when namespace is empty
+	CreateRoleBinding(context.Context, *CreateRoleBindingRequest) (*RoleBindingItem, error)
+	// DeleteRole DeleteRole deletes a role with its bindings, builtin roles can not be deleted
+	DeleteRole(context.Context, *DeleteRoleRequest) (*DeleteRoleReply, error)
+	DeleteRoleBinding(context.Context, *DeleteRoleBindingRequest) (*DeleteRoleBindingReply, error)
+	GetRole(context.Context, *GetRoleRequest) (*RoleItem, error)
+	ListRoleBindings(context.Context, *ListRoleBindingsRequest) (*ListRoleBindingsReply, error)
+	ListRoles(context.Context, *ListRolesRequest) (*ListRolesReply, error)
+	// SetRoleMFA SetRoleMFA sets whether the permissions of a role are only granted to the tokens of an MFA login, it applies to the builtin roles too
+	SetRoleMFA(context.Context, *SetRoleMFARequest) (*RoleItem, error)
+	// UpdateRole UpdateRole replaces the description and permissions of a role, builtin roles can not be changed
+	UpdateRole(context.Context, *UpdateRoleRequest) (*RoleItem, error)
+}
+
+func RegisterRBACHTTPServer(s *http.Server, srv RBACHTTPServer) {
+	r := s.Route("/")
+	r.POST("/v1/rbac/roles", _RBAC_CreateRole0_HTTP_Handler(srv))
+	r.PUT("/v1/rbac/roles/{uid}", _RBAC_UpdateRole0_HTTP_Handler(srv))
+	r.DELETE("/v1/rbac/roles/{uid}", _RBAC_DeleteRole0_HTTP_Handler(srv))
+	r.PUT("/v1/rbac/roles/{uid}/mfa", _RBAC_SetRoleMFA0_HTTP_Handler(srv))
+	r.GET("/v1/rbac/roles/{uid}", _RBAC_GetRole0_HTTP_Handler(srv))
+	r.GET("/v1/rbac/roles", _RBAC_ListRoles0_HTTP_Handler(srv))
+	r.POST("/v1/rbac/bindings", _RBAC_CreateRoleBinding0_HTTP_Handler(srv))
+	r.DELETE("/v1/rbac/bindings/{uid}", _RBAC_DeleteRoleBinding0_HTTP_Handler(srv))
+	r.GET("/v1/rbac/bindings", _RBAC_ListRoleBindings0_HTTP_Handler(srv))
+}
+
+func _RBAC_CreateRole0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACCreateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRole(ctx, req.(*CreateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_UpdateRole0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateRoleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACUpdateRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateRole(ctx, req.(*UpdateRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_DeleteRole0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACDeleteRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRole(ctx, req.(*DeleteRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteRoleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_SetRoleMFA0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SetRoleMFARequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACSetRoleMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SetRoleMFA(ctx, req.(*SetRoleMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_GetRole0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetRoleRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACGetRole)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetRole(ctx, req.(*GetRoleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_ListRoles0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACListRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoles(ctx, req.(*ListRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_CreateRoleBinding0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateRoleBindingRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACCreateRoleBinding)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateRoleBinding(ctx, req.(*CreateRoleBindingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RoleBindingItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_DeleteRoleBinding0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in DeleteRoleBindingRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACDeleteRoleBinding)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.DeleteRoleBinding(ctx, req.(*DeleteRoleBindingRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*DeleteRoleBindingReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _RBAC_ListRoleBindings0_HTTP_Handler(srv RBACHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListRoleBindingsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRBACListRoleBindings)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListRoleBindings(ctx, req.(*ListRoleBindingsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListRoleBindingsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type RBACHTTPClient interface {
+	CreateRole(ctx context.Context, req *CreateRoleRequest, opts ...http.CallOption) (rsp *RoleItem, err error)
+	CreateRoleBinding(ctx context.Context, req *CreateRoleBindingRequest, opts ...http.CallOption) (rsp *RoleBindingItem, err error)
+	DeleteRole(ctx context.Context, req *DeleteRoleRequest, opts ...http.CallOption) (rsp *DeleteRoleReply, err error)
+	DeleteRoleBinding(ctx context.Context, req *DeleteRoleBindingRequest, opts ...http.CallOption) (rsp *DeleteRoleBindingReply, err error)
+	GetRole(ctx context.Context, req *GetRoleRequest, opts ...http.CallOption) (rsp *RoleItem, err error)
+	ListRoleBindings(ctx context.Context, req *ListRoleBindingsRequest, opts ...http.CallOption) (rsp *ListRoleBindingsReply, err error)
+	ListRoles(ctx context.Context, req *ListRolesRequest, opts ...http.CallOption) (rsp *ListRolesReply, err error)
+	SetRoleMFA(ctx context.Context, req *SetRoleMFARequest, opts ...http.CallOption) (rsp *RoleItem, err error)
+	UpdateRole(ctx context.Context, req *UpdateRoleRequest, opts ...http.CallOption) (rsp *RoleItem, err error)
+}
+
+type RBACHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewRBACHTTPClient(client *http.Client) RBACHTTPClient {
+	return &RBACHTTPClientImpl{client}
+}
+
+func (c *RBACHTTPClientImpl) CreateRole(ctx context.Context, in *CreateRoleRequest, opts ...http.CallOption) (*RoleItem, error) {
+	var out RoleItem
+	pattern := "/v1/rbac/roles"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRBACCreateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) CreateRoleBinding(ctx context.Context, in *CreateRoleBindingRequest, opts ...http.CallOption) (*RoleBindingItem, error) {
+	var out RoleBindingItem
+	pattern := "/v1/rbac/bindings"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRBACCreateRoleBinding))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) DeleteRole(ctx context.Context, in *DeleteRoleRequest, opts ...http.CallOption) (*DeleteRoleReply, error) {
+	var out DeleteRoleReply
+	pattern := "/v1/rbac/roles/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACDeleteRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) DeleteRoleBinding(ctx context.Context, in *DeleteRoleBindingRequest, opts ...http.CallOption) (*DeleteRoleBindingReply, error) {
+	var out DeleteRoleBindingReply
+	pattern := "/v1/rbac/bindings/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACDeleteRoleBinding))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) GetRole(ctx context.Context, in *GetRoleRequest, opts ...http.CallOption) (*RoleItem, error) {
+	var out RoleItem
+	pattern := "/v1/rbac/roles/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACGetRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) ListRoleBindings(ctx context.Context, in *ListRoleBindingsRequest, opts ...http.CallOption) (*ListRoleBindingsReply, error) {
+	var out ListRoleBindingsReply
+	pattern := "/v1/rbac/bindings"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACListRoleBindings))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...http.CallOption) (*ListRolesReply, error) {
+	var out ListRolesReply
+	pattern := "/v1/rbac/roles"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRBACListRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) SetRoleMFA(ctx context.Context, in *SetRoleMFARequest, opts ...http.CallOption) (*RoleItem, error) {
+	var out RoleItem
+	pattern := "/v1/rbac/roles/{uid}/mfa"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRBACSetRoleMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *RBACHTTPClientImpl) UpdateRole(ctx context.Context, in *UpdateRoleRequest, opts ...http.CallOption) (*RoleItem, error) {
+	var out RoleItem
+	pattern := "/v1/rbac/roles/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRBACUpdateRole))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
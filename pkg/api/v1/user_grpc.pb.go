@@ -0,0 +1,439 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             v5.29.3
+// source: api/v1/user.proto
+
+package v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	User_ListUsers_FullMethodName               = "/sovereign.api.v1.User/ListUsers"
+	User_GetUser_FullMethodName                 = "/sovereign.api.v1.User/GetUser"
+	User_UpdateUser_FullMethodName              = "/sovereign.api.v1.User/UpdateUser"
+	User_UpdateUserStatus_FullMethodName        = "/sovereign.api.v1.User/UpdateUserStatus"
+	User_ListUserIdentities_FullMethodName      = "/sovereign.api.v1.User/ListUserIdentities"
+	User_UnlinkUserIdentity_FullMethodName      = "/sovereign.api.v1.User/UnlinkUserIdentity"
+	User_ListIdentityAuditEvents_FullMethodName = "/sovereign.api.v1.User/ListIdentityAuditEvents"
+	User_ListLoginAuditEvents_FullMethodName    = "/sovereign.api.v1.User/ListLoginAuditEvents"
+	User_ResetUserMFA_FullMethodName            = "/sovereign.api.v1.User/ResetUserMFA"
+)
+
+// UserClient is the client API for User service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// User manages the users signed up by a login, and the OAuth2 identities linked to them
+type UserClient interface {
+	ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersReply, error)
+	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserItem, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserItem, error)
+	// UpdateUserStatus enables or disables the user, a disabled user can not sign in and its tokens and API keys are rejected
+	UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UserItem, error)
+	ListUserIdentities(ctx context.Context, in *ListUserIdentitiesRequest, opts ...grpc.CallOption) (*ListUserIdentitiesReply, error)
+	// UnlinkUserIdentity removes the OAuth2 identity of the user, the last identity of a user without a password can not be removed
+	UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkUserIdentityReply, error)
+	// ListIdentityAuditEvents lists the links and unlinks of the identities of the user, newest first
+	ListIdentityAuditEvents(ctx context.Context, in *ListIdentityAuditEventsRequest, opts ...grpc.CallOption) (*ListIdentityAuditEventsReply, error)
+	// ListLoginAuditEvents lists the lockouts of the IPs and accounts after repeated failed logins, newest first
+	ListLoginAuditEvents(ctx context.Context, in *ListLoginAuditEventsRequest, opts ...grpc.CallOption) (*ListLoginAuditEventsReply, error)
+	// ResetUserMFA removes the TOTP secret and the recovery codes of a user who lost its authenticator, the user may enroll again
+	ResetUserMFA(ctx context.Context, in *ResetUserMFARequest, opts ...grpc.CallOption) (*ResetUserMFAReply, error)
+}
+
+type userClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewUserClient(cc grpc.ClientConnInterface) UserClient {
+	return &userClient{cc}
+}
+
+func (c *userClient) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...grpc.CallOption) (*ListUsersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUsersReply)
+	err := c.cc.Invoke(ctx, User_ListUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*UserItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserItem)
+	err := c.cc.Invoke(ctx, User_GetUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UserItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserItem)
+	err := c.cc.Invoke(ctx, User_UpdateUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...grpc.CallOption) (*UserItem, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UserItem)
+	err := c.cc.Invoke(ctx, User_UpdateUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListUserIdentities(ctx context.Context, in *ListUserIdentitiesRequest, opts ...grpc.CallOption) (*ListUserIdentitiesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListUserIdentitiesReply)
+	err := c.cc.Invoke(ctx, User_ListUserIdentities_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkUserIdentityReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlinkUserIdentityReply)
+	err := c.cc.Invoke(ctx, User_UnlinkUserIdentity_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListIdentityAuditEvents(ctx context.Context, in *ListIdentityAuditEventsRequest, opts ...grpc.CallOption) (*ListIdentityAuditEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListIdentityAuditEventsReply)
+	err := c.cc.Invoke(ctx, User_ListIdentityAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListLoginAuditEvents(ctx context.Context, in *ListLoginAuditEventsRequest, opts ...grpc.CallOption) (*ListLoginAuditEventsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginAuditEventsReply)
+	err := c.cc.Invoke(ctx, User_ListLoginAuditEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ResetUserMFA(ctx context.Context, in *ResetUserMFARequest, opts ...grpc.CallOption) (*ResetUserMFAReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetUserMFAReply)
+	err := c.cc.Invoke(ctx, User_ResetUserMFA_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// UserServer is the server API for User service.
+// All implementations must embed UnimplementedUserServer
+// for forward compatibility.
+//
+// User manages the users signed up by a login, and the OAuth2 identities linked to them
+type UserServer interface {
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error)
+	GetUser(context.Context, *GetUserRequest) (*UserItem, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserItem, error)
+	// UpdateUserStatus enables or disables the user, a disabled user can not sign in and its tokens and API keys are rejected
+	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UserItem, error)
+	ListUserIdentities(context.Context, *ListUserIdentitiesRequest) (*ListUserIdentitiesReply, error)
+	// UnlinkUserIdentity removes the OAuth2 identity of the user, the last identity of a user without a password can not be removed
+	UnlinkUserIdentity(context.Context, *UnlinkUserIdentityRequest) (*UnlinkUserIdentityReply, error)
+	// ListIdentityAuditEvents lists the links and unlinks of the identities of the user, newest first
+	ListIdentityAuditEvents(context.Context, *ListIdentityAuditEventsRequest) (*ListIdentityAuditEventsReply, error)
+	// ListLoginAuditEvents lists the lockouts of the IPs and accounts after repeated failed logins, newest first
+	ListLoginAuditEvents(context.Context, *ListLoginAuditEventsRequest) (*ListLoginAuditEventsReply, error)
+	// ResetUserMFA removes the TOTP secret and the recovery codes of a user who lost its authenticator, the user may enroll again
+	ResetUserMFA(context.Context, *ResetUserMFARequest) (*ResetUserMFAReply, error)
+	mustEmbedUnimplementedUserServer()
+}
+
+// UnimplementedUserServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedUserServer struct{}
+
+func (UnimplementedUserServer) ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUsers not implemented")
+}
+func (UnimplementedUserServer) GetUser(context.Context, *GetUserRequest) (*UserItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUser not implemented")
+}
+func (UnimplementedUserServer) UpdateUser(context.Context, *UpdateUserRequest) (*UserItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUser not implemented")
+}
+func (UnimplementedUserServer) UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UserItem, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserStatus not implemented")
+}
+func (UnimplementedUserServer) ListUserIdentities(context.Context, *ListUserIdentitiesRequest) (*ListUserIdentitiesReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListUserIdentities not implemented")
+}
+func (UnimplementedUserServer) UnlinkUserIdentity(context.Context, *UnlinkUserIdentityRequest) (*UnlinkUserIdentityReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkUserIdentity not implemented")
+}
+func (UnimplementedUserServer) ListIdentityAuditEvents(context.Context, *ListIdentityAuditEventsRequest) (*ListIdentityAuditEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListIdentityAuditEvents not implemented")
+}
+func (UnimplementedUserServer) ListLoginAuditEvents(context.Context, *ListLoginAuditEventsRequest) (*ListLoginAuditEventsReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListLoginAuditEvents not implemented")
+}
+func (UnimplementedUserServer) ResetUserMFA(context.Context, *ResetUserMFARequest) (*ResetUserMFAReply, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetUserMFA not implemented")
+}
+func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
+func (UnimplementedUserServer) testEmbeddedByValue()              {}
+
+// UnsafeUserServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to UserServer will
+// result in compilation errors.
+type UnsafeUserServer interface {
+	mustEmbedUnimplementedUserServer()
+}
+
+func RegisterUserServer(s grpc.ServiceRegistrar, srv UserServer) {
+	// If the following call pancis, it indicates UnimplementedUserServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&User_ServiceDesc, srv)
+}
+
+func _User_ListUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListUsers(ctx, req.(*ListUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateUserStatus(ctx, req.(*UpdateUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListUserIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListUserIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListUserIdentities_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListUserIdentities(ctx, req.(*ListUserIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnlinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnlinkUserIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnlinkUserIdentity_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnlinkUserIdentity(ctx, req.(*UnlinkUserIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListIdentityAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListIdentityAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListIdentityAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListIdentityAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListIdentityAuditEvents(ctx, req.(*ListIdentityAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListLoginAuditEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginAuditEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListLoginAuditEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListLoginAuditEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListLoginAuditEvents(ctx, req.(*ListLoginAuditEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ResetUserMFA_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserMFARequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ResetUserMFA(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ResetUserMFA_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ResetUserMFA(ctx, req.(*ResetUserMFARequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// User_ServiceDesc is the grpc.ServiceDesc for User service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var User_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "sovereign.api.v1.User",
+	HandlerType: (*UserServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListUsers",
+			Handler:    _User_ListUsers_Handler,
+		},
+		{
+			MethodName: "GetUser",
+			Handler:    _User_GetUser_Handler,
+		},
+		{
+			MethodName: "UpdateUser",
+			Handler:    _User_UpdateUser_Handler,
+		},
+		{
+			MethodName: "UpdateUserStatus",
+			Handler:    _User_UpdateUserStatus_Handler,
+		},
+		{
+			MethodName: "ListUserIdentities",
+			Handler:    _User_ListUserIdentities_Handler,
+		},
+		{
+			MethodName: "UnlinkUserIdentity",
+			Handler:    _User_UnlinkUserIdentity_Handler,
+		},
+		{
+			MethodName: "ListIdentityAuditEvents",
+			Handler:    _User_ListIdentityAuditEvents_Handler,
+		},
+		{
+			MethodName: "ListLoginAuditEvents",
+			Handler:    _User_ListLoginAuditEvents_Handler,
+		},
+		{
+			MethodName: "ResetUserMFA",
+			Handler:    _User_ResetUserMFA_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/user.proto",
+}
@@ -0,0 +1,395 @@
+// Code generated by protoc-gen-go-http. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-http v2.9.0
+// - protoc             v5.29.3
+// source: api/v1/user.proto
+
+package v1
+
+import (
+	context "context"
+	http "github.com/go-kratos/kratos/v2/transport/http"
+	binding "github.com/go-kratos/kratos/v2/transport/http/binding"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the kratos package it is being compiled against.
+var _ = new(context.Context)
+var _ = binding.EncodeURL
+
+const _ = http.SupportPackageIsVersion1
+
+const OperationUserGetUser = "/sovereign.api.v1.User/GetUser"
+const OperationUserListIdentityAuditEvents = "/sovereign.api.v1.User/ListIdentityAuditEvents"
+const OperationUserListLoginAuditEvents = "/sovereign.api.v1.User/ListLoginAuditEvents"
+const OperationUserListUserIdentities = "/sovereign.api.v1.User/ListUserIdentities"
+const OperationUserListUsers = "/sovereign.api.v1.User/ListUsers"
+const OperationUserResetUserMFA = "/sovereign.api.v1.User/ResetUserMFA"
+const OperationUserUnlinkUserIdentity = "/sovereign.api.v1.User/UnlinkUserIdentity"
+const OperationUserUpdateUser = "/sovereign.api.v1.User/UpdateUser"
+const OperationUserUpdateUserStatus = "/sovereign.api.v1.User/UpdateUserStatus"
+
+type UserHTTPServer interface {
+	GetUser(context.Context, *GetUserRequest) (*UserItem, error)
+	// ListIdentityAuditEvents ListIdentityAuditEvents lists the links and unlinks of the identities of the user, newest first
+	ListIdentityAuditEvents(context.Context, *ListIdentityAuditEventsRequest) (*ListIdentityAuditEventsReply, error)
+	// ListLoginAuditEvents ListLoginAuditEvents lists the lockouts of the IPs and accounts after repeated failed logins, newest first
+	ListLoginAuditEvents(context.Context, *ListLoginAuditEventsRequest) (*ListLoginAuditEventsReply, error)
+	ListUserIdentities(context.Context, *ListUserIdentitiesRequest) (*ListUserIdentitiesReply, error)
+	ListUsers(context.Context, *ListUsersRequest) (*ListUsersReply, error)
+	// ResetUserMFA ResetUserMFA removes the TOTP secret and the recovery codes of a user who lost its authenticator, the user may enroll again
+	ResetUserMFA(context.Context, *ResetUserMFARequest) (*ResetUserMFAReply, error)
+	// UnlinkUserIdentity UnlinkUserIdentity removes the OAuth2 identity of the user, the last identity of a user without a password can not be removed
+	UnlinkUserIdentity(context.Context, *UnlinkUserIdentityRequest) (*UnlinkUserIdentityReply, error)
+	UpdateUser(context.Context, *UpdateUserRequest) (*UserItem, error)
+	// UpdateUserStatus UpdateUserStatus enables or disables the user, a disabled user can not sign in and its tokens and API keys are rejected
+	UpdateUserStatus(context.Context, *UpdateUserStatusRequest) (*UserItem, error)
+}
+
+func RegisterUserHTTPServer(s *http.Server, srv UserHTTPServer) {
+	r := s.Route("/")
+	r.GET("/v1/users", _User_ListUsers0_HTTP_Handler(srv))
+	r.GET("/v1/users/{uid}", _User_GetUser0_HTTP_Handler(srv))
+	r.PUT("/v1/users/{uid}", _User_UpdateUser0_HTTP_Handler(srv))
+	r.PUT("/v1/users/{uid}/status", _User_UpdateUserStatus0_HTTP_Handler(srv))
+	r.GET("/v1/users/{uid}/identities", _User_ListUserIdentities0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{uid}/identities/{id}", _User_UnlinkUserIdentity0_HTTP_Handler(srv))
+	r.GET("/v1/users/{uid}/identity-audit-events", _User_ListIdentityAuditEvents0_HTTP_Handler(srv))
+	r.GET("/v1/login-audit-events", _User_ListLoginAuditEvents0_HTTP_Handler(srv))
+	r.DELETE("/v1/users/{uid}/mfa", _User_ResetUserMFA0_HTTP_Handler(srv))
+}
+
+func _User_ListUsers0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUsersRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUsers(ctx, req.(*ListUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_GetUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetUserRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserGetUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetUser(ctx, req.(*GetUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UpdateUser0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdateUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUser(ctx, req.(*UpdateUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UpdateUserStatus0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateUserStatusRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUpdateUserStatus)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateUserStatus(ctx, req.(*UpdateUserStatusRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UserItem)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListUserIdentities0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListUserIdentitiesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListUserIdentities)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListUserIdentities(ctx, req.(*ListUserIdentitiesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListUserIdentitiesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_UnlinkUserIdentity0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UnlinkUserIdentityRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserUnlinkUserIdentity)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UnlinkUserIdentity(ctx, req.(*UnlinkUserIdentityRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UnlinkUserIdentityReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListIdentityAuditEvents0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListIdentityAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListIdentityAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListIdentityAuditEvents(ctx, req.(*ListIdentityAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListIdentityAuditEventsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ListLoginAuditEvents0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLoginAuditEventsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserListLoginAuditEvents)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLoginAuditEvents(ctx, req.(*ListLoginAuditEventsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLoginAuditEventsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _User_ResetUserMFA0_HTTP_Handler(srv UserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetUserMFARequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationUserResetUserMFA)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetUserMFA(ctx, req.(*ResetUserMFARequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetUserMFAReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+type UserHTTPClient interface {
+	GetUser(ctx context.Context, req *GetUserRequest, opts ...http.CallOption) (rsp *UserItem, err error)
+	ListIdentityAuditEvents(ctx context.Context, req *ListIdentityAuditEventsRequest, opts ...http.CallOption) (rsp *ListIdentityAuditEventsReply, err error)
+	ListLoginAuditEvents(ctx context.Context, req *ListLoginAuditEventsRequest, opts ...http.CallOption) (rsp *ListLoginAuditEventsReply, err error)
+	ListUserIdentities(ctx context.Context, req *ListUserIdentitiesRequest, opts ...http.CallOption) (rsp *ListUserIdentitiesReply, err error)
+	ListUsers(ctx context.Context, req *ListUsersRequest, opts ...http.CallOption) (rsp *ListUsersReply, err error)
+	ResetUserMFA(ctx context.Context, req *ResetUserMFARequest, opts ...http.CallOption) (rsp *ResetUserMFAReply, err error)
+	UnlinkUserIdentity(ctx context.Context, req *UnlinkUserIdentityRequest, opts ...http.CallOption) (rsp *UnlinkUserIdentityReply, err error)
+	UpdateUser(ctx context.Context, req *UpdateUserRequest, opts ...http.CallOption) (rsp *UserItem, err error)
+	UpdateUserStatus(ctx context.Context, req *UpdateUserStatusRequest, opts ...http.CallOption) (rsp *UserItem, err error)
+}
+
+type UserHTTPClientImpl struct {
+	cc *http.Client
+}
+
+func NewUserHTTPClient(client *http.Client) UserHTTPClient {
+	return &UserHTTPClientImpl{client}
+}
+
+func (c *UserHTTPClientImpl) GetUser(ctx context.Context, in *GetUserRequest, opts ...http.CallOption) (*UserItem, error) {
+	var out UserItem
+	pattern := "/v1/users/{uid}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserGetUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListIdentityAuditEvents(ctx context.Context, in *ListIdentityAuditEventsRequest, opts ...http.CallOption) (*ListIdentityAuditEventsReply, error) {
+	var out ListIdentityAuditEventsReply
+	pattern := "/v1/users/{uid}/identity-audit-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListIdentityAuditEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListLoginAuditEvents(ctx context.Context, in *ListLoginAuditEventsRequest, opts ...http.CallOption) (*ListLoginAuditEventsReply, error) {
+	var out ListLoginAuditEventsReply
+	pattern := "/v1/login-audit-events"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListLoginAuditEvents))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListUserIdentities(ctx context.Context, in *ListUserIdentitiesRequest, opts ...http.CallOption) (*ListUserIdentitiesReply, error) {
+	var out ListUserIdentitiesReply
+	pattern := "/v1/users/{uid}/identities"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListUserIdentities))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ListUsers(ctx context.Context, in *ListUsersRequest, opts ...http.CallOption) (*ListUsersReply, error) {
+	var out ListUsersReply
+	pattern := "/v1/users"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserListUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) ResetUserMFA(ctx context.Context, in *ResetUserMFARequest, opts ...http.CallOption) (*ResetUserMFAReply, error) {
+	var out ResetUserMFAReply
+	pattern := "/v1/users/{uid}/mfa"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserResetUserMFA))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...http.CallOption) (*UnlinkUserIdentityReply, error) {
+	var out UnlinkUserIdentityReply
+	pattern := "/v1/users/{uid}/identities/{id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationUserUnlinkUserIdentity))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "DELETE", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...http.CallOption) (*UserItem, error) {
+	var out UserItem
+	pattern := "/v1/users/{uid}"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUpdateUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+func (c *UserHTTPClientImpl) UpdateUserStatus(ctx context.Context, in *UpdateUserStatusRequest, opts ...http.CallOption) (*UserItem, error) {
+	var out UserItem
+	pattern := "/v1/users/{uid}/status"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationUserUpdateUserStatus))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
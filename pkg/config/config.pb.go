@@ -117,7 +117,7 @@ func (x ORMConfig_Dialector) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ORMConfig_Dialector.Descriptor instead.
 func (ORMConfig_Dialector) EnumDescriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{3, 0}
+	return file_config_config_proto_rawDescGZIP(), []int{5, 0}
 }
 
 type ReportConfig_ReportType int32
@@ -166,7 +166,7 @@ func (x ReportConfig_ReportType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ReportConfig_ReportType.Descriptor instead.
 func (ReportConfig_ReportType) EnumDescriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{6, 0}
+	return file_config_config_proto_rawDescGZIP(), []int{8, 0}
 }
 
 type DomainConfig_Driver int32
@@ -218,7 +218,7 @@ func (x DomainConfig_Driver) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DomainConfig_Driver.Descriptor instead.
 func (DomainConfig_Driver) EnumDescriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{10, 0}
+	return file_config_config_proto_rawDescGZIP(), []int{12, 0}
 }
 
 type FileConfig_FileType int32
@@ -264,7 +264,7 @@ func (x FileConfig_FileType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use FileConfig_FileType.Descriptor instead.
 func (FileConfig_FileType) EnumDescriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{11, 0}
+	return file_config_config_proto_rawDescGZIP(), []int{13, 0}
 }
 
 type OAuth2_APP int32
@@ -274,6 +274,8 @@ const (
 	OAuth2_GITHUB  OAuth2_APP = 1
 	OAuth2_GITEE   OAuth2_APP = 2
 	OAuth2_FEISHU  OAuth2_APP = 3
+	OAuth2_OIDC    OAuth2_APP = 4
+	OAuth2_LDAP    OAuth2_APP = 5
 )
 
 // Enum value maps for OAuth2_APP.
@@ -283,12 +285,16 @@ var (
 		1: "GITHUB",
 		2: "GITEE",
 		3: "FEISHU",
+		4: "OIDC",
+		5: "LDAP",
 	}
 	OAuth2_APP_value = map[string]int32{
 		"UNKNOWN": 0,
 		"GITHUB":  1,
 		"GITEE":   2,
 		"FEISHU":  3,
+		"OIDC":    4,
+		"LDAP":    5,
 	}
 )
 
@@ -316,7 +322,158 @@ func (x OAuth2_APP) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use OAuth2_APP.Descriptor instead.
 func (OAuth2_APP) EnumDescriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{13, 0}
+	return file_config_config_proto_rawDescGZIP(), []int{15, 0}
+}
+
+// EmailFallback decides how a user without an email from the app signs in
+type OAuth2_EmailFallback int32
+
+const (
+	// REFUSE refuses the login until the user makes an email of the account available
+	OAuth2_REFUSE OAuth2_EmailFallback = 0
+	// NOREPLY signs the user in with the unverified noreply email of the app, e.g. <login>@users.noreply.github.com
+	OAuth2_NOREPLY OAuth2_EmailFallback = 1
+	// COMPLETE_PROFILE signs in like NOREPLY and adds complete_profile=true to the redirect,
+	// the user then sets a real email through /v1/auth/me/email
+	OAuth2_COMPLETE_PROFILE OAuth2_EmailFallback = 2
+)
+
+// Enum value maps for OAuth2_EmailFallback.
+var (
+	OAuth2_EmailFallback_name = map[int32]string{
+		0: "REFUSE",
+		1: "NOREPLY",
+		2: "COMPLETE_PROFILE",
+	}
+	OAuth2_EmailFallback_value = map[string]int32{
+		"REFUSE":           0,
+		"NOREPLY":          1,
+		"COMPLETE_PROFILE": 2,
+	}
+)
+
+func (x OAuth2_EmailFallback) Enum() *OAuth2_EmailFallback {
+	p := new(OAuth2_EmailFallback)
+	*p = x
+	return p
+}
+
+func (x OAuth2_EmailFallback) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OAuth2_EmailFallback) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_config_proto_enumTypes[6].Descriptor()
+}
+
+func (OAuth2_EmailFallback) Type() protoreflect.EnumType {
+	return &file_config_config_proto_enumTypes[6]
+}
+
+func (x OAuth2_EmailFallback) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OAuth2_EmailFallback.Descriptor instead.
+func (OAuth2_EmailFallback) EnumDescriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{15, 1}
+}
+
+// LinkPolicy decides how the first login of an identity finds the user of its email
+type OAuth2_LinkPolicy int32
+
+const (
+	// VERIFIED_EMAIL links the identity to the user of its email when both emails are verified
+	OAuth2_VERIFIED_EMAIL OAuth2_LinkPolicy = 0
+	// EXPLICIT never links by email, identities are linked by their signed-in user through /v1/auth/me/identities/link
+	OAuth2_EXPLICIT OAuth2_LinkPolicy = 1
+)
+
+// Enum value maps for OAuth2_LinkPolicy.
+var (
+	OAuth2_LinkPolicy_name = map[int32]string{
+		0: "VERIFIED_EMAIL",
+		1: "EXPLICIT",
+	}
+	OAuth2_LinkPolicy_value = map[string]int32{
+		"VERIFIED_EMAIL": 0,
+		"EXPLICIT":       1,
+	}
+)
+
+func (x OAuth2_LinkPolicy) Enum() *OAuth2_LinkPolicy {
+	p := new(OAuth2_LinkPolicy)
+	*p = x
+	return p
+}
+
+func (x OAuth2_LinkPolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (OAuth2_LinkPolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_config_proto_enumTypes[7].Descriptor()
+}
+
+func (OAuth2_LinkPolicy) Type() protoreflect.EnumType {
+	return &file_config_config_proto_enumTypes[7]
+}
+
+func (x OAuth2_LinkPolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use OAuth2_LinkPolicy.Descriptor instead.
+func (OAuth2_LinkPolicy) EnumDescriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{15, 2}
+}
+
+type LoginRateLimit_Store int32
+
+const (
+	// MEMORY counts in the memory of each replica
+	LoginRateLimit_MEMORY LoginRateLimit_Store = 0
+	// DATABASE counts in the database of the login config, shared by all replicas
+	LoginRateLimit_DATABASE LoginRateLimit_Store = 1
+)
+
+// Enum value maps for LoginRateLimit_Store.
+var (
+	LoginRateLimit_Store_name = map[int32]string{
+		0: "MEMORY",
+		1: "DATABASE",
+	}
+	LoginRateLimit_Store_value = map[string]int32{
+		"MEMORY":   0,
+		"DATABASE": 1,
+	}
+)
+
+func (x LoginRateLimit_Store) Enum() *LoginRateLimit_Store {
+	p := new(LoginRateLimit_Store)
+	*p = x
+	return p
+}
+
+func (x LoginRateLimit_Store) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (LoginRateLimit_Store) Descriptor() protoreflect.EnumDescriptor {
+	return file_config_config_proto_enumTypes[8].Descriptor()
+}
+
+func (LoginRateLimit_Store) Type() protoreflect.EnumType {
+	return &file_config_config_proto_enumTypes[8]
+}
+
+func (x LoginRateLimit_Store) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use LoginRateLimit_Store.Descriptor instead.
+func (LoginRateLimit_Store) EnumDescriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{17, 0}
 }
 
 type ClientConfig struct {
@@ -388,12 +545,20 @@ func (x *ClientConfig) GetNamespace() string {
 }
 
 type JWT struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
-	Expire        *durationpb.Duration   `protobuf:"bytes,2,opt,name=expire,proto3" json:"expire,omitempty"`
-	Issuer        string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Secret string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Expire *durationpb.Duration   `protobuf:"bytes,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	Issuer string                 `protobuf:"bytes,3,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// refreshExpire is the lifetime of a refresh token, each refresh rotates the token and restarts it, default 168h
+	RefreshExpire *durationpb.Duration `protobuf:"bytes,4,opt,name=refreshExpire,proto3" json:"refreshExpire,omitempty"`
+	// keys is the asymmetric key set of the issuer, tokens are signed with HS256 and the secret when it is empty
+	Keys []*JWTKey `protobuf:"bytes,5,rep,name=keys,proto3" json:"keys,omitempty"`
+	// signingKid selects the key signing new tokens, default the first key with a private key
+	SigningKid string `protobuf:"bytes,6,opt,name=signingKid,proto3" json:"signingKid,omitempty"`
+	// trustedIssuers lists the other issuers whose tokens are accepted, verified with the keys of their JWKS
+	TrustedIssuers []*JWTTrustedIssuer `protobuf:"bytes,7,rep,name=trustedIssuers,proto3" json:"trustedIssuers,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *JWT) Reset() {
@@ -447,6 +612,180 @@ func (x *JWT) GetIssuer() string {
 	return ""
 }
 
+func (x *JWT) GetRefreshExpire() *durationpb.Duration {
+	if x != nil {
+		return x.RefreshExpire
+	}
+	return nil
+}
+
+func (x *JWT) GetKeys() []*JWTKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
+func (x *JWT) GetSigningKid() string {
+	if x != nil {
+		return x.SigningKid
+	}
+	return ""
+}
+
+func (x *JWT) GetTrustedIssuers() []*JWTTrustedIssuer {
+	if x != nil {
+		return x.TrustedIssuers
+	}
+	return nil
+}
+
+// JWTKey is a key of the JWT key set, all keys are published in the JWKS.
+// Keep a retired key without its privateKeyFile until the tokens it signed expire, so rotation does not sign anyone out.
+type JWTKey struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// kid is sent in the token header, default the RFC 7638 thumbprint of the public key
+	Kid string `protobuf:"bytes,1,opt,name=kid,proto3" json:"kid,omitempty"`
+	// algorithm is RS256, ES256 or EdDSA, default derived from the key type
+	Algorithm string `protobuf:"bytes,2,opt,name=algorithm,proto3" json:"algorithm,omitempty"`
+	// privateKeyFile is a PEM file of a PKCS#8, PKCS#1 or SEC 1 private key, a key without it only verifies tokens
+	PrivateKeyFile string `protobuf:"bytes,3,opt,name=privateKeyFile,proto3" json:"privateKeyFile,omitempty"`
+	// publicKeyFile is a PEM file of a PKIX public key, read when there is no privateKeyFile
+	PublicKeyFile string `protobuf:"bytes,4,opt,name=publicKeyFile,proto3" json:"publicKeyFile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWTKey) Reset() {
+	*x = JWTKey{}
+	mi := &file_config_config_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTKey) ProtoMessage() {}
+
+func (x *JWTKey) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTKey.ProtoReflect.Descriptor instead.
+func (*JWTKey) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *JWTKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JWTKey) GetAlgorithm() string {
+	if x != nil {
+		return x.Algorithm
+	}
+	return ""
+}
+
+func (x *JWTKey) GetPrivateKeyFile() string {
+	if x != nil {
+		return x.PrivateKeyFile
+	}
+	return ""
+}
+
+func (x *JWTKey) GetPublicKeyFile() string {
+	if x != nil {
+		return x.PublicKeyFile
+	}
+	return ""
+}
+
+type JWTTrustedIssuer struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issuer must equal the iss claim of the tokens
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// jwksUrl is read again when a token is signed by an unknown kid, e.g. https://sovereign.example.com/.well-known/jwks.json
+	JwksUrl string `protobuf:"bytes,2,opt,name=jwksUrl,proto3" json:"jwksUrl,omitempty"`
+	// audience must be in the aud claim of the tokens, the tokens issued for other services are refused
+	Audience string `protobuf:"bytes,3,opt,name=audience,proto3" json:"audience,omitempty"`
+	// provider is the app of the identities linking the sub claim of the tokens to the users, default the issuer
+	Provider      string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JWTTrustedIssuer) Reset() {
+	*x = JWTTrustedIssuer{}
+	mi := &file_config_config_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JWTTrustedIssuer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JWTTrustedIssuer) ProtoMessage() {}
+
+func (x *JWTTrustedIssuer) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JWTTrustedIssuer.ProtoReflect.Descriptor instead.
+func (*JWTTrustedIssuer) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *JWTTrustedIssuer) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *JWTTrustedIssuer) GetJwksUrl() string {
+	if x != nil {
+		return x.JwksUrl
+	}
+	return ""
+}
+
+func (x *JWTTrustedIssuer) GetAudience() string {
+	if x != nil {
+		return x.Audience
+	}
+	return ""
+}
+
+func (x *JWTTrustedIssuer) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
+}
+
 type ClusterConfig struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
@@ -459,7 +798,7 @@ type ClusterConfig struct {
 
 func (x *ClusterConfig) Reset() {
 	*x = ClusterConfig{}
-	mi := &file_config_config_proto_msgTypes[2]
+	mi := &file_config_config_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -471,7 +810,7 @@ func (x *ClusterConfig) String() string {
 func (*ClusterConfig) ProtoMessage() {}
 
 func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[2]
+	mi := &file_config_config_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +823,7 @@ func (x *ClusterConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ClusterConfig.ProtoReflect.Descriptor instead.
 func (*ClusterConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{2}
+	return file_config_config_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *ClusterConfig) GetName() string {
@@ -527,7 +866,7 @@ type ORMConfig struct {
 
 func (x *ORMConfig) Reset() {
 	*x = ORMConfig{}
-	mi := &file_config_config_proto_msgTypes[3]
+	mi := &file_config_config_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -539,7 +878,7 @@ func (x *ORMConfig) String() string {
 func (*ORMConfig) ProtoMessage() {}
 
 func (x *ORMConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[3]
+	mi := &file_config_config_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -552,7 +891,7 @@ func (x *ORMConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ORMConfig.ProtoReflect.Descriptor instead.
 func (*ORMConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{3}
+	return file_config_config_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ORMConfig) GetDialector() ORMConfig_Dialector {
@@ -597,7 +936,7 @@ type MySQLOptions struct {
 
 func (x *MySQLOptions) Reset() {
 	*x = MySQLOptions{}
-	mi := &file_config_config_proto_msgTypes[4]
+	mi := &file_config_config_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -609,7 +948,7 @@ func (x *MySQLOptions) String() string {
 func (*MySQLOptions) ProtoMessage() {}
 
 func (x *MySQLOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[4]
+	mi := &file_config_config_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -622,7 +961,7 @@ func (x *MySQLOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MySQLOptions.ProtoReflect.Descriptor instead.
 func (*MySQLOptions) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{4}
+	return file_config_config_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *MySQLOptions) GetUsername() string {
@@ -676,7 +1015,7 @@ type SQLiteOptions struct {
 
 func (x *SQLiteOptions) Reset() {
 	*x = SQLiteOptions{}
-	mi := &file_config_config_proto_msgTypes[5]
+	mi := &file_config_config_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -688,7 +1027,7 @@ func (x *SQLiteOptions) String() string {
 func (*SQLiteOptions) ProtoMessage() {}
 
 func (x *SQLiteOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[5]
+	mi := &file_config_config_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -701,7 +1040,7 @@ func (x *SQLiteOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SQLiteOptions.ProtoReflect.Descriptor instead.
 func (*SQLiteOptions) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{5}
+	return file_config_config_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *SQLiteOptions) GetDsn() string {
@@ -722,7 +1061,7 @@ type ReportConfig struct {
 
 func (x *ReportConfig) Reset() {
 	*x = ReportConfig{}
-	mi := &file_config_config_proto_msgTypes[6]
+	mi := &file_config_config_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -734,7 +1073,7 @@ func (x *ReportConfig) String() string {
 func (*ReportConfig) ProtoMessage() {}
 
 func (x *ReportConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[6]
+	mi := &file_config_config_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -747,7 +1086,7 @@ func (x *ReportConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ReportConfig.ProtoReflect.Descriptor instead.
 func (*ReportConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{6}
+	return file_config_config_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *ReportConfig) GetReportType() ReportConfig_ReportType {
@@ -783,7 +1122,7 @@ type ETCDOptions struct {
 
 func (x *ETCDOptions) Reset() {
 	*x = ETCDOptions{}
-	mi := &file_config_config_proto_msgTypes[7]
+	mi := &file_config_config_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -795,7 +1134,7 @@ func (x *ETCDOptions) String() string {
 func (*ETCDOptions) ProtoMessage() {}
 
 func (x *ETCDOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[7]
+	mi := &file_config_config_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -808,7 +1147,7 @@ func (x *ETCDOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ETCDOptions.ProtoReflect.Descriptor instead.
 func (*ETCDOptions) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{7}
+	return file_config_config_proto_rawDescGZIP(), []int{9}
 }
 
 func (x *ETCDOptions) GetEndpoints() string {
@@ -848,7 +1187,7 @@ type KubernetesOptions struct {
 
 func (x *KubernetesOptions) Reset() {
 	*x = KubernetesOptions{}
-	mi := &file_config_config_proto_msgTypes[8]
+	mi := &file_config_config_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -860,7 +1199,7 @@ func (x *KubernetesOptions) String() string {
 func (*KubernetesOptions) ProtoMessage() {}
 
 func (x *KubernetesOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[8]
+	mi := &file_config_config_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,7 +1212,7 @@ func (x *KubernetesOptions) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use KubernetesOptions.ProtoReflect.Descriptor instead.
 func (*KubernetesOptions) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{8}
+	return file_config_config_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *KubernetesOptions) GetKubeConfig() string {
@@ -894,7 +1233,7 @@ type BasicAuthConfig struct {
 
 func (x *BasicAuthConfig) Reset() {
 	*x = BasicAuthConfig{}
-	mi := &file_config_config_proto_msgTypes[9]
+	mi := &file_config_config_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -906,7 +1245,7 @@ func (x *BasicAuthConfig) String() string {
 func (*BasicAuthConfig) ProtoMessage() {}
 
 func (x *BasicAuthConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[9]
+	mi := &file_config_config_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -919,7 +1258,7 @@ func (x *BasicAuthConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use BasicAuthConfig.ProtoReflect.Descriptor instead.
 func (*BasicAuthConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{9}
+	return file_config_config_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *BasicAuthConfig) GetUsername() string {
@@ -954,7 +1293,7 @@ type DomainConfig struct {
 
 func (x *DomainConfig) Reset() {
 	*x = DomainConfig{}
-	mi := &file_config_config_proto_msgTypes[10]
+	mi := &file_config_config_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -966,7 +1305,7 @@ func (x *DomainConfig) String() string {
 func (*DomainConfig) ProtoMessage() {}
 
 func (x *DomainConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[10]
+	mi := &file_config_config_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -979,7 +1318,7 @@ func (x *DomainConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DomainConfig.ProtoReflect.Descriptor instead.
 func (*DomainConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{10}
+	return file_config_config_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *DomainConfig) GetDriver() DomainConfig_Driver {
@@ -1015,7 +1354,7 @@ type FileConfig struct {
 
 func (x *FileConfig) Reset() {
 	*x = FileConfig{}
-	mi := &file_config_config_proto_msgTypes[11]
+	mi := &file_config_config_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1027,7 +1366,7 @@ func (x *FileConfig) String() string {
 func (*FileConfig) ProtoMessage() {}
 
 func (x *FileConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[11]
+	mi := &file_config_config_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1040,7 +1379,7 @@ func (x *FileConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use FileConfig.ProtoReflect.Descriptor instead.
 func (*FileConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{11}
+	return file_config_config_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *FileConfig) GetPath() string {
@@ -1083,7 +1422,7 @@ type OuterServerConfig struct {
 
 func (x *OuterServerConfig) Reset() {
 	*x = OuterServerConfig{}
-	mi := &file_config_config_proto_msgTypes[12]
+	mi := &file_config_config_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1095,7 +1434,7 @@ func (x *OuterServerConfig) String() string {
 func (*OuterServerConfig) ProtoMessage() {}
 
 func (x *OuterServerConfig) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[12]
+	mi := &file_config_config_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1108,7 +1447,7 @@ func (x *OuterServerConfig) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OuterServerConfig.ProtoReflect.Descriptor instead.
 func (*OuterServerConfig) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{12}
+	return file_config_config_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *OuterServerConfig) GetAddress() string {
@@ -1140,17 +1479,31 @@ func (x *OuterServerConfig) GetProtocol() Protocol {
 }
 
 type OAuth2 struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Enable        string                 `protobuf:"bytes,1,opt,name=enable,proto3" json:"enable,omitempty"`
-	RedirectUri   string                 `protobuf:"bytes,2,opt,name=redirectUri,proto3" json:"redirectUri,omitempty"`
-	Configs       []*OAuth2_Config       `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state       protoimpl.MessageState `protogen:"open.v1"`
+	Enable      string                 `protobuf:"bytes,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	RedirectUri string                 `protobuf:"bytes,2,opt,name=redirectUri,proto3" json:"redirectUri,omitempty"`
+	Configs     []*OAuth2_Config       `protobuf:"bytes,3,rep,name=configs,proto3" json:"configs,omitempty"`
+	// stateSecret signs the login state bound to the browser cookie, the jwt secret is used when it is empty
+	StateSecret string `protobuf:"bytes,4,opt,name=stateSecret,proto3" json:"stateSecret,omitempty"`
+	// stateExpire limits how long a login may take from the redirect to the callback, default 10m
+	StateExpire *durationpb.Duration `protobuf:"bytes,5,opt,name=stateExpire,proto3" json:"stateExpire,omitempty"`
+	// returnToAllowlist lists the URL prefixes a login may return to through the return_to parameter, e.g. https://moon.example.com/
+	ReturnToAllowlist []string `protobuf:"bytes,6,rep,name=returnToAllowlist,proto3" json:"returnToAllowlist,omitempty"`
+	// linkPolicy of the identities whose email belongs to an existing user, default VERIFIED_EMAIL
+	LinkPolicy OAuth2_LinkPolicy `protobuf:"varint,7,opt,name=linkPolicy,proto3,enum=sovereign.config.OAuth2_LinkPolicy" json:"linkPolicy,omitempty"`
+	// secretKey encrypts the client secrets of the providers managed by the OAuth2 provider API, the jwt secret is used when it is empty,
+	// the stored secrets can not be read after it changes
+	SecretKey string `protobuf:"bytes,8,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	// errorRedirectUri is where the browser is sent with the error and error_description query parameters when a login is refused,
+	// the return_to URL of the login is used first, the error is returned as the response when both are empty
+	ErrorRedirectUri string `protobuf:"bytes,9,opt,name=errorRedirectUri,proto3" json:"errorRedirectUri,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *OAuth2) Reset() {
 	*x = OAuth2{}
-	mi := &file_config_config_proto_msgTypes[13]
+	mi := &file_config_config_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1162,7 +1515,7 @@ func (x *OAuth2) String() string {
 func (*OAuth2) ProtoMessage() {}
 
 func (x *OAuth2) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[13]
+	mi := &file_config_config_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1175,7 +1528,7 @@ func (x *OAuth2) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuth2.ProtoReflect.Descriptor instead.
 func (*OAuth2) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{13}
+	return file_config_config_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *OAuth2) GetEnable() string {
@@ -1199,23 +1552,397 @@ func (x *OAuth2) GetConfigs() []*OAuth2_Config {
 	return nil
 }
 
+func (x *OAuth2) GetStateSecret() string {
+	if x != nil {
+		return x.StateSecret
+	}
+	return ""
+}
+
+func (x *OAuth2) GetStateExpire() *durationpb.Duration {
+	if x != nil {
+		return x.StateExpire
+	}
+	return nil
+}
+
+func (x *OAuth2) GetReturnToAllowlist() []string {
+	if x != nil {
+		return x.ReturnToAllowlist
+	}
+	return nil
+}
+
+func (x *OAuth2) GetLinkPolicy() OAuth2_LinkPolicy {
+	if x != nil {
+		return x.LinkPolicy
+	}
+	return OAuth2_VERIFIED_EMAIL
+}
+
+func (x *OAuth2) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *OAuth2) GetErrorRedirectUri() string {
+	if x != nil {
+		return x.ErrorRedirectUri
+	}
+	return ""
+}
+
+// LDAP signs users in against an LDAP directory or Active Directory with their directory password.
+// The service account binds and searches the user, the password is checked by binding as the found DN.
+type LDAP struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Enable string                 `protobuf:"bytes,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	// url of the directory, ldap://host:389 or ldaps://host:636
+	Url string `protobuf:"bytes,2,opt,name=url,proto3" json:"url,omitempty"`
+	// startTls upgrades an ldap:// connection before binding
+	StartTls           string `protobuf:"bytes,3,opt,name=startTls,proto3" json:"startTls,omitempty"`
+	InsecureSkipVerify string `protobuf:"bytes,4,opt,name=insecureSkipVerify,proto3" json:"insecureSkipVerify,omitempty"`
+	// caFile is a PEM file of the CAs trusted for LDAPS and StartTLS, the system pool is used when empty
+	CaFile       string `protobuf:"bytes,5,opt,name=caFile,proto3" json:"caFile,omitempty"`
+	BindDn       string `protobuf:"bytes,6,opt,name=bindDn,proto3" json:"bindDn,omitempty"`
+	BindPassword string `protobuf:"bytes,7,opt,name=bindPassword,proto3" json:"bindPassword,omitempty"`
+	BaseDn       string `protobuf:"bytes,8,opt,name=baseDn,proto3" json:"baseDn,omitempty"`
+	// userFilter finds the user signing in, {username} is replaced with the escaped username, default: (uid={username})
+	UserFilter string           `protobuf:"bytes,9,opt,name=userFilter,proto3" json:"userFilter,omitempty"`
+	Attributes *LDAP_Attributes `protobuf:"bytes,10,opt,name=attributes,proto3" json:"attributes,omitempty"`
+	Groups     *LDAP_Groups     `protobuf:"bytes,11,opt,name=groups,proto3" json:"groups,omitempty"`
+	// poolSize limits the idle connections bound as the service account, default 4
+	PoolSize int32 `protobuf:"varint,12,opt,name=poolSize,proto3" json:"poolSize,omitempty"`
+	// timeout of dialing and of each request, default 10s
+	Timeout       *durationpb.Duration `protobuf:"bytes,13,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAP) Reset() {
+	*x = LDAP{}
+	mi := &file_config_config_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAP) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAP) ProtoMessage() {}
+
+func (x *LDAP) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAP.ProtoReflect.Descriptor instead.
+func (*LDAP) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *LDAP) GetEnable() string {
+	if x != nil {
+		return x.Enable
+	}
+	return ""
+}
+
+func (x *LDAP) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *LDAP) GetStartTls() string {
+	if x != nil {
+		return x.StartTls
+	}
+	return ""
+}
+
+func (x *LDAP) GetInsecureSkipVerify() string {
+	if x != nil {
+		return x.InsecureSkipVerify
+	}
+	return ""
+}
+
+func (x *LDAP) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+func (x *LDAP) GetBindDn() string {
+	if x != nil {
+		return x.BindDn
+	}
+	return ""
+}
+
+func (x *LDAP) GetBindPassword() string {
+	if x != nil {
+		return x.BindPassword
+	}
+	return ""
+}
+
+func (x *LDAP) GetBaseDn() string {
+	if x != nil {
+		return x.BaseDn
+	}
+	return ""
+}
+
+func (x *LDAP) GetUserFilter() string {
+	if x != nil {
+		return x.UserFilter
+	}
+	return ""
+}
+
+func (x *LDAP) GetAttributes() *LDAP_Attributes {
+	if x != nil {
+		return x.Attributes
+	}
+	return nil
+}
+
+func (x *LDAP) GetGroups() *LDAP_Groups {
+	if x != nil {
+		return x.Groups
+	}
+	return nil
+}
+
+func (x *LDAP) GetPoolSize() int32 {
+	if x != nil {
+		return x.PoolSize
+	}
+	return 0
+}
+
+func (x *LDAP) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+// LoginRateLimit limits the logins by the client IP, the account and the provider, and locks an IP or an account out
+// for a while after repeated failed logins. It guards the OAuth2 login and callback routes and the password and LDAP logins.
+type LoginRateLimit struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Enable string                 `protobuf:"bytes,1,opt,name=enable,proto3" json:"enable,omitempty"`
+	Store  LoginRateLimit_Store   `protobuf:"varint,2,opt,name=store,proto3,enum=sovereign.config.LoginRateLimit_Store" json:"store,omitempty"`
+	// ip limits the logins of a client IP
+	Ip *LoginRateLimit_Rule `protobuf:"bytes,3,opt,name=ip,proto3" json:"ip,omitempty"`
+	// account limits the logins of a username, the OAuth2 logins have no account before the callback
+	Account *LoginRateLimit_Rule `protobuf:"bytes,4,opt,name=account,proto3" json:"account,omitempty"`
+	// provider limits the logins of a provider from all clients, such as github or ldap
+	Provider *LoginRateLimit_Rule    `protobuf:"bytes,5,opt,name=provider,proto3" json:"provider,omitempty"`
+	Lockout  *LoginRateLimit_Lockout `protobuf:"bytes,6,opt,name=lockout,proto3" json:"lockout,omitempty"`
+	// trustedProxies are the CIDRs or IPs of the proxies whose X-Forwarded-For and X-Real-IP headers are trusted for the client IP
+	TrustedProxies []string `protobuf:"bytes,7,rep,name=trustedProxies,proto3" json:"trustedProxies,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *LoginRateLimit) Reset() {
+	*x = LoginRateLimit{}
+	mi := &file_config_config_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRateLimit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRateLimit) ProtoMessage() {}
+
+func (x *LoginRateLimit) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRateLimit.ProtoReflect.Descriptor instead.
+func (*LoginRateLimit) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *LoginRateLimit) GetEnable() string {
+	if x != nil {
+		return x.Enable
+	}
+	return ""
+}
+
+func (x *LoginRateLimit) GetStore() LoginRateLimit_Store {
+	if x != nil {
+		return x.Store
+	}
+	return LoginRateLimit_MEMORY
+}
+
+func (x *LoginRateLimit) GetIp() *LoginRateLimit_Rule {
+	if x != nil {
+		return x.Ip
+	}
+	return nil
+}
+
+func (x *LoginRateLimit) GetAccount() *LoginRateLimit_Rule {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *LoginRateLimit) GetProvider() *LoginRateLimit_Rule {
+	if x != nil {
+		return x.Provider
+	}
+	return nil
+}
+
+func (x *LoginRateLimit) GetLockout() *LoginRateLimit_Lockout {
+	if x != nil {
+		return x.Lockout
+	}
+	return nil
+}
+
+func (x *LoginRateLimit) GetTrustedProxies() []string {
+	if x != nil {
+		return x.TrustedProxies
+	}
+	return nil
+}
+
+// MFA configures the TOTP second factor. A user who enabled it answers an MFA challenge after the password, LDAP or OAuth2 login
+// before the tokens are issued, and the tokens of such a login carry mfa in the amr claim.
+type MFA struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issuer is shown by the authenticator apps, default the jwt issuer
+	Issuer string `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	// secretKey encrypts the TOTP secrets of the users, the jwt secret is used when it is empty
+	SecretKey string `protobuf:"bytes,2,opt,name=secretKey,proto3" json:"secretKey,omitempty"`
+	// requiredOperations can only be called with a token of an MFA login, an item ending with * matches operations starting with it
+	RequiredOperations []string `protobuf:"bytes,3,rep,name=requiredOperations,proto3" json:"requiredOperations,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MFA) Reset() {
+	*x = MFA{}
+	mi := &file_config_config_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MFA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MFA) ProtoMessage() {}
+
+func (x *MFA) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MFA.ProtoReflect.Descriptor instead.
+func (*MFA) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *MFA) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *MFA) GetSecretKey() string {
+	if x != nil {
+		return x.SecretKey
+	}
+	return ""
+}
+
+func (x *MFA) GetRequiredOperations() []string {
+	if x != nil {
+		return x.RequiredOperations
+	}
+	return nil
+}
+
 type OAuth2_Config struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	App           OAuth2_APP             `protobuf:"varint,1,opt,name=app,proto3,enum=sovereign.config.OAuth2_APP" json:"app,omitempty"`
-	ClientId      string                 `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
-	ClientSecret  string                 `protobuf:"bytes,3,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
-	CallbackUri   string                 `protobuf:"bytes,4,opt,name=callbackUri,proto3" json:"callbackUri,omitempty"`
-	AuthUrl       string                 `protobuf:"bytes,5,opt,name=authUrl,proto3" json:"authUrl,omitempty"`
-	TokenUrl      string                 `protobuf:"bytes,6,opt,name=tokenUrl,proto3" json:"tokenUrl,omitempty"`
-	Scopes        []string               `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
-	LoginUrl      string                 `protobuf:"bytes,8,opt,name=loginUrl,proto3" json:"loginUrl,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	App          OAuth2_APP             `protobuf:"varint,1,opt,name=app,proto3,enum=sovereign.config.OAuth2_APP" json:"app,omitempty"`
+	ClientId     string                 `protobuf:"bytes,2,opt,name=clientId,proto3" json:"clientId,omitempty"`
+	ClientSecret string                 `protobuf:"bytes,3,opt,name=clientSecret,proto3" json:"clientSecret,omitempty"`
+	CallbackUri  string                 `protobuf:"bytes,4,opt,name=callbackUri,proto3" json:"callbackUri,omitempty"`
+	// authUrl and tokenUrl are read from the discovery document of OIDC apps when empty
+	AuthUrl  string             `protobuf:"bytes,5,opt,name=authUrl,proto3" json:"authUrl,omitempty"`
+	TokenUrl string             `protobuf:"bytes,6,opt,name=tokenUrl,proto3" json:"tokenUrl,omitempty"`
+	Scopes   []string           `protobuf:"bytes,7,rep,name=scopes,proto3" json:"scopes,omitempty"`
+	LoginUrl string             `protobuf:"bytes,8,opt,name=loginUrl,proto3" json:"loginUrl,omitempty"`
+	Oidc     *OAuth2_OIDCConfig `protobuf:"bytes,9,opt,name=oidc,proto3" json:"oidc,omitempty"`
+	// pkce sends an S256 code challenge with the login and its verifier with the token exchange, the IdP must support RFC 7636
+	Pkce bool `protobuf:"varint,10,opt,name=pkce,proto3" json:"pkce,omitempty"`
+	// trustEmail treats the emails of the app as verified, for IdPs which only hand out the emails they manage, e.g. a Feishu tenant
+	TrustEmail bool `protobuf:"varint,11,opt,name=trustEmail,proto3" json:"trustEmail,omitempty"`
+	// emailFallback signs in the users whose email the app does not hand out, GitHub and Gitee support it, default REFUSE
+	EmailFallback OAuth2_EmailFallback `protobuf:"varint,12,opt,name=emailFallback,proto3,enum=sovereign.config.OAuth2_EmailFallback" json:"emailFallback,omitempty"`
+	// apiUrl is the base URL of the REST API, e.g. https://ghe.example.com/api/v3 for GitHub Enterprise Server,
+	// default https://api.github.com for GitHub, https://gitee.com/api/v5 for Gitee and https://open.feishu.cn/open-apis for Feishu
+	ApiUrl string `protobuf:"bytes,13,opt,name=apiUrl,proto3" json:"apiUrl,omitempty"`
+	// userInfoUrl is the endpoint of the signed-in user, default the user endpoint of the app under apiUrl,
+	// OIDC apps read both from the discovery document
+	UserInfoUrl string `protobuf:"bytes,14,opt,name=userInfoUrl,proto3" json:"userInfoUrl,omitempty"`
+	// httpClient configures the requests to the app, including the token exchange and the OIDC discovery
+	HttpClient *OAuth2_HTTPClient `protobuf:"bytes,15,opt,name=httpClient,proto3" json:"httpClient,omitempty"`
+	// name identifies the instance of the app in its login route /oauth2/login/{name} and in the identities it links,
+	// names are unique ignoring case, default the app, e.g. GITHUB with the route /oauth2/login/github
+	Name string `protobuf:"bytes,16,opt,name=name,proto3" json:"name,omitempty"`
+	// admission restricts who may sign in with the provider, everyone may when empty
+	Admission     *OAuth2_Admission `protobuf:"bytes,17,opt,name=admission,proto3" json:"admission,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OAuth2_Config) Reset() {
 	*x = OAuth2_Config{}
-	mi := &file_config_config_proto_msgTypes[15]
+	mi := &file_config_config_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1227,7 +1954,7 @@ func (x *OAuth2_Config) String() string {
 func (*OAuth2_Config) ProtoMessage() {}
 
 func (x *OAuth2_Config) ProtoReflect() protoreflect.Message {
-	mi := &file_config_config_proto_msgTypes[15]
+	mi := &file_config_config_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1240,7 +1967,7 @@ func (x *OAuth2_Config) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OAuth2_Config.ProtoReflect.Descriptor instead.
 func (*OAuth2_Config) Descriptor() ([]byte, []int) {
-	return file_config_config_proto_rawDescGZIP(), []int{13, 0}
+	return file_config_config_proto_rawDescGZIP(), []int{15, 0}
 }
 
 func (x *OAuth2_Config) GetApp() OAuth2_APP {
@@ -1299,189 +2026,1052 @@ func (x *OAuth2_Config) GetLoginUrl() string {
 	return ""
 }
 
-var File_config_config_proto protoreflect.FileDescriptor
+func (x *OAuth2_Config) GetOidc() *OAuth2_OIDCConfig {
+	if x != nil {
+		return x.Oidc
+	}
+	return nil
+}
 
-var file_config_config_proto_rawDesc = []byte{
-	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e,
-	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a,
-	0x0a, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x76,
-	0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65,
-	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
-	0x22, 0x68, 0x0a, 0x03, 0x4a, 0x57, 0x54, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12,
-	0x31, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x22, 0xae, 0x01, 0x0a, 0x0d, 0x43,
-	0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x33,
-	0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67,
-	0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f,
-	0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xf6, 0x01, 0x0a, 0x09,
-	0x4f, 0x52, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x09, 0x64, 0x69, 0x61,
-	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73,
-	0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x4f, 0x52, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63,
-	0x74, 0x6f, 0x72, 0x52, 0x09, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x2e,
-	0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64,
-	0x65, 0x62, 0x75, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65,
-	0x6d, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x75,
-	0x73, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x22, 0x34,
-	0x0a, 0x09, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10, 0x0a, 0x0c, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x09, 0x0a,
-	0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53, 0x51, 0x4c, 0x49,
-	0x54, 0x45, 0x10, 0x02, 0x22, 0x99, 0x02, 0x0a, 0x0c, 0x4d, 0x79, 0x53, 0x51, 0x4c, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x12, 0x0a,
-	0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x6f, 0x73,
-	0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52,
-	0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73,
-	0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67,
-	0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x79, 0x53, 0x51, 0x4c, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
-	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01,
-	0x22, 0x21, 0x0a, 0x0d, 0x53, 0x51, 0x4c, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
-	0x64, 0x73, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72,
-	0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f,
-	0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54,
-	0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12,
-	0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x22, 0x3f, 0x0a,
-	0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17, 0x0a, 0x13, 0x52,
-	0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f,
-	0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x54, 0x43, 0x44, 0x10, 0x01, 0x12, 0x0e,
-	0x0a, 0x0a, 0x4b, 0x55, 0x42, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x45, 0x53, 0x10, 0x02, 0x22, 0xa0,
-	0x01, 0x0a, 0x0b, 0x45, 0x54, 0x43, 0x44, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1c,
-	0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65,
-	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75,
-	0x74, 0x22, 0x33, 0x0a, 0x11, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74, 0x65, 0x73, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62, 0x65, 0x43, 0x6f,
-	0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b, 0x75, 0x62, 0x65,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x63, 0x0a, 0x0f, 0x42, 0x61, 0x73, 0x69, 0x63, 0x41,
-	0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65,
-	0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72,
-	0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xca, 0x01, 0x0a, 0x0c,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x3d, 0x0a, 0x06,
-	0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x73,
-	0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x72, 0x69,
-	0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x76,
-	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x31, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12,
-	0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x4f, 0x52,
-	0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x54, 0x43, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a,
-	0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x03, 0x22, 0xe4, 0x01, 0x0a, 0x0a, 0x46, 0x69, 0x6c,
-	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x41, 0x0a, 0x08, 0x66,
-	0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
-	0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1a,
-	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a, 0x0f, 0x73, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x22,
-	0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08, 0x0a, 0x04, 0x59,
-	0x41, 0x4d, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e, 0x10, 0x01, 0x22,
-	0xb4, 0x01, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x43,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
-	0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d,
-	0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x36,
-	0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xbb, 0x03, 0x0a, 0x06, 0x4f, 0x41, 0x75, 0x74, 0x68,
-	0x32, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x72, 0x65, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x39, 0x0a, 0x07, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73,
-	0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
-	0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63,
-	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x1a, 0x84, 0x02, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x12, 0x2e, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c,
-	0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
-	0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e, 0x41, 0x50, 0x50, 0x52, 0x03, 0x61, 0x70,
-	0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65,
-	0x74, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x69,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b,
-	0x55, 0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f,
-	0x70, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x22, 0x35, 0x0a,
-	0x03, 0x41, 0x50, 0x50, 0x12, 0x0b, 0x0a, 0x07, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10,
-	0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49, 0x54, 0x48, 0x55, 0x42, 0x10, 0x01, 0x12, 0x09, 0x0a,
-	0x05, 0x47, 0x49, 0x54, 0x45, 0x45, 0x10, 0x02, 0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x49, 0x53,
-	0x48, 0x55, 0x10, 0x03, 0x2a, 0x34, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c,
-	0x12, 0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x4b,
-	0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43, 0x10, 0x01,
-	0x12, 0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66, 0x61,
-	0x6d, 0x69, 0x6c, 0x79, 0x2f, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2f, 0x70,
-	0x6b, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+func (x *OAuth2_Config) GetPkce() bool {
+	if x != nil {
+		return x.Pkce
+	}
+	return false
+}
+
+func (x *OAuth2_Config) GetTrustEmail() bool {
+	if x != nil {
+		return x.TrustEmail
+	}
+	return false
+}
+
+func (x *OAuth2_Config) GetEmailFallback() OAuth2_EmailFallback {
+	if x != nil {
+		return x.EmailFallback
+	}
+	return OAuth2_REFUSE
+}
+
+func (x *OAuth2_Config) GetApiUrl() string {
+	if x != nil {
+		return x.ApiUrl
+	}
+	return ""
+}
+
+func (x *OAuth2_Config) GetUserInfoUrl() string {
+	if x != nil {
+		return x.UserInfoUrl
+	}
+	return ""
+}
+
+func (x *OAuth2_Config) GetHttpClient() *OAuth2_HTTPClient {
+	if x != nil {
+		return x.HttpClient
+	}
+	return nil
+}
+
+func (x *OAuth2_Config) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuth2_Config) GetAdmission() *OAuth2_Admission {
+	if x != nil {
+		return x.Admission
+	}
+	return nil
+}
+
+// Admission restricts the logins of a provider, a refused login creates no user
+type OAuth2_Admission struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// emailDomains lists the domains of the verified emails which may sign in, e.g. example.com, checked on every login
+	EmailDomains []string `protobuf:"bytes,1,rep,name=emailDomains,proto3" json:"emailDomains,omitempty"`
+	// githubOrganizations admits the active members of the organizations, checked on every login and link through the API,
+	// which needs the read:org scope, a member of one of the githubTeams is admitted as well
+	GithubOrganizations []string `protobuf:"bytes,2,rep,name=githubOrganizations,proto3" json:"githubOrganizations,omitempty"`
+	// githubTeams admits the members of the teams, written as <org>/<team-slug>, checked like githubOrganizations
+	GithubTeams []string `protobuf:"bytes,3,rep,name=githubTeams,proto3" json:"githubTeams,omitempty"`
+	// feishuTenantKeys lists the Feishu tenants whose users may sign in, checked on every login and link
+	FeishuTenantKeys []string `protobuf:"bytes,4,rep,name=feishuTenantKeys,proto3" json:"feishuTenantKeys,omitempty"`
+	// inviteOnly requires an invitation code to sign up a new user, passed as the invitation query parameter of the login route,
+	// the users who already signed up sign in without one
+	InviteOnly    bool `protobuf:"varint,5,opt,name=inviteOnly,proto3" json:"inviteOnly,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth2_Admission) Reset() {
+	*x = OAuth2_Admission{}
+	mi := &file_config_config_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2_Admission) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2_Admission) ProtoMessage() {}
+
+func (x *OAuth2_Admission) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2_Admission.ProtoReflect.Descriptor instead.
+func (*OAuth2_Admission) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{15, 1}
+}
+
+func (x *OAuth2_Admission) GetEmailDomains() []string {
+	if x != nil {
+		return x.EmailDomains
+	}
+	return nil
+}
+
+func (x *OAuth2_Admission) GetGithubOrganizations() []string {
+	if x != nil {
+		return x.GithubOrganizations
+	}
+	return nil
+}
+
+func (x *OAuth2_Admission) GetGithubTeams() []string {
+	if x != nil {
+		return x.GithubTeams
+	}
+	return nil
+}
+
+func (x *OAuth2_Admission) GetFeishuTenantKeys() []string {
+	if x != nil {
+		return x.FeishuTenantKeys
+	}
+	return nil
+}
+
+func (x *OAuth2_Admission) GetInviteOnly() bool {
+	if x != nil {
+		return x.InviteOnly
+	}
+	return false
+}
+
+// HTTPClient configures the requests sent to an app
+type OAuth2_HTTPClient struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// timeout of each request, default 10s
+	Timeout *durationpb.Duration `protobuf:"bytes,1,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// proxy is the URL of the HTTP proxy, the proxy of the environment is used when empty
+	Proxy string `protobuf:"bytes,2,opt,name=proxy,proto3" json:"proxy,omitempty"`
+	// caFile is a PEM file of the CAs trusted for the app, the system pool is used when empty
+	CaFile        string `protobuf:"bytes,3,opt,name=caFile,proto3" json:"caFile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth2_HTTPClient) Reset() {
+	*x = OAuth2_HTTPClient{}
+	mi := &file_config_config_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2_HTTPClient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2_HTTPClient) ProtoMessage() {}
+
+func (x *OAuth2_HTTPClient) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2_HTTPClient.ProtoReflect.Descriptor instead.
+func (*OAuth2_HTTPClient) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{15, 2}
+}
+
+func (x *OAuth2_HTTPClient) GetTimeout() *durationpb.Duration {
+	if x != nil {
+		return x.Timeout
+	}
+	return nil
+}
+
+func (x *OAuth2_HTTPClient) GetProxy() string {
+	if x != nil {
+		return x.Proxy
+	}
+	return ""
+}
+
+func (x *OAuth2_HTTPClient) GetCaFile() string {
+	if x != nil {
+		return x.CaFile
+	}
+	return ""
+}
+
+// OIDCConfig configures a standard OpenID Connect identity provider such as Keycloak
+type OAuth2_OIDCConfig struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// issuer must match the iss of the discovery document and of the ID tokens, {issuer}/.well-known/openid-configuration is read on first login
+	Issuer string                    `protobuf:"bytes,1,opt,name=issuer,proto3" json:"issuer,omitempty"`
+	Claims *OAuth2_OIDCConfig_Claims `protobuf:"bytes,2,opt,name=claims,proto3" json:"claims,omitempty"`
+	// userInfo merges the claims of the userinfo endpoint into the ID token claims
+	UserInfo      bool `protobuf:"varint,3,opt,name=userInfo,proto3" json:"userInfo,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth2_OIDCConfig) Reset() {
+	*x = OAuth2_OIDCConfig{}
+	mi := &file_config_config_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2_OIDCConfig) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2_OIDCConfig) ProtoMessage() {}
+
+func (x *OAuth2_OIDCConfig) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2_OIDCConfig.ProtoReflect.Descriptor instead.
+func (*OAuth2_OIDCConfig) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{15, 3}
+}
+
+func (x *OAuth2_OIDCConfig) GetIssuer() string {
+	if x != nil {
+		return x.Issuer
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig) GetClaims() *OAuth2_OIDCConfig_Claims {
+	if x != nil {
+		return x.Claims
+	}
+	return nil
+}
+
+func (x *OAuth2_OIDCConfig) GetUserInfo() bool {
+	if x != nil {
+		return x.UserInfo
+	}
+	return false
+}
+
+// Claims names the ID token claims mapped onto the user, empty names use the standard claims
+type OAuth2_OIDCConfig_Claims struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// default: sub
+	OpenId string `protobuf:"bytes,1,opt,name=openId,proto3" json:"openId,omitempty"`
+	// default: preferred_username
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// default: name
+	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// default: email
+	Email string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	// default: picture
+	Avatar string `protobuf:"bytes,5,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Remark string `protobuf:"bytes,6,opt,name=remark,proto3" json:"remark,omitempty"`
+	// default: email_verified
+	EmailVerified string `protobuf:"bytes,7,opt,name=emailVerified,proto3" json:"emailVerified,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OAuth2_OIDCConfig_Claims) Reset() {
+	*x = OAuth2_OIDCConfig_Claims{}
+	mi := &file_config_config_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OAuth2_OIDCConfig_Claims) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OAuth2_OIDCConfig_Claims) ProtoMessage() {}
+
+func (x *OAuth2_OIDCConfig_Claims) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OAuth2_OIDCConfig_Claims.ProtoReflect.Descriptor instead.
+func (*OAuth2_OIDCConfig_Claims) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{15, 3, 0}
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetOpenId() string {
+	if x != nil {
+		return x.OpenId
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetAvatar() string {
+	if x != nil {
+		return x.Avatar
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *OAuth2_OIDCConfig_Claims) GetEmailVerified() string {
+	if x != nil {
+		return x.EmailVerified
+	}
+	return ""
+}
+
+// Attributes names the directory attributes mapped onto the user, empty names use the defaults
+type LDAP_Attributes struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// dn, objectGUID or any single valued attribute, objectGUID is formatted as a UUID, default: dn
+	OpenId string `protobuf:"bytes,1,opt,name=openId,proto3" json:"openId,omitempty"`
+	// default: uid, use sAMAccountName for Active Directory
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// default: displayName
+	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// default: mail
+	Email         string `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	Remark        string `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LDAP_Attributes) Reset() {
+	*x = LDAP_Attributes{}
+	mi := &file_config_config_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAP_Attributes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAP_Attributes) ProtoMessage() {}
+
+func (x *LDAP_Attributes) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAP_Attributes.ProtoReflect.Descriptor instead.
+func (*LDAP_Attributes) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{16, 0}
+}
+
+func (x *LDAP_Attributes) GetOpenId() string {
+	if x != nil {
+		return x.OpenId
+	}
+	return ""
+}
+
+func (x *LDAP_Attributes) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *LDAP_Attributes) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *LDAP_Attributes) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *LDAP_Attributes) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+// Groups configures how the groups of a user are read, groups are kept with the raw user
+type LDAP_Groups struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// baseDn enables the group search, empty reads the memberOf attribute of the user instead
+	BaseDn string `protobuf:"bytes,1,opt,name=baseDn,proto3" json:"baseDn,omitempty"`
+	// filter of the group search, {dn} and {username} are replaced with the escaped user DN and username, default: (member={dn})
+	Filter string `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	// default: cn
+	NameAttribute string `protobuf:"bytes,3,opt,name=nameAttribute,proto3" json:"nameAttribute,omitempty"`
+	// attribute of the user listing its group DNs when no baseDn is set, default: memberOf
+	MemberOfAttribute string `protobuf:"bytes,4,opt,name=memberOfAttribute,proto3" json:"memberOfAttribute,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *LDAP_Groups) Reset() {
+	*x = LDAP_Groups{}
+	mi := &file_config_config_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LDAP_Groups) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LDAP_Groups) ProtoMessage() {}
+
+func (x *LDAP_Groups) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LDAP_Groups.ProtoReflect.Descriptor instead.
+func (*LDAP_Groups) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{16, 1}
+}
+
+func (x *LDAP_Groups) GetBaseDn() string {
+	if x != nil {
+		return x.BaseDn
+	}
+	return ""
+}
+
+func (x *LDAP_Groups) GetFilter() string {
+	if x != nil {
+		return x.Filter
+	}
+	return ""
+}
+
+func (x *LDAP_Groups) GetNameAttribute() string {
+	if x != nil {
+		return x.NameAttribute
+	}
+	return ""
+}
+
+func (x *LDAP_Groups) GetMemberOfAttribute() string {
+	if x != nil {
+		return x.MemberOfAttribute
+	}
+	return ""
+}
+
+// Rule allows limit attempts of a key in the window, the window starts with the first attempt after the last one ended
+type LoginRateLimit_Rule struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// limit of the attempts in the window, 0 disables the rule
+	Limit int32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// default: 1m
+	Window        *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRateLimit_Rule) Reset() {
+	*x = LoginRateLimit_Rule{}
+	mi := &file_config_config_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRateLimit_Rule) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRateLimit_Rule) ProtoMessage() {}
+
+func (x *LoginRateLimit_Rule) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRateLimit_Rule.ProtoReflect.Descriptor instead.
+func (*LoginRateLimit_Rule) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{17, 0}
+}
+
+func (x *LoginRateLimit_Rule) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *LoginRateLimit_Rule) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+// Lockout locks an IP or an account out for the duration after maxFailures failed logins in the window
+type LoginRateLimit_Lockout struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// maxFailures of the window, 0 disables the lockout
+	MaxFailures int32 `protobuf:"varint,1,opt,name=maxFailures,proto3" json:"maxFailures,omitempty"`
+	// default: 15m
+	Window *durationpb.Duration `protobuf:"bytes,2,opt,name=window,proto3" json:"window,omitempty"`
+	// default: 15m
+	Duration      *durationpb.Duration `protobuf:"bytes,3,opt,name=duration,proto3" json:"duration,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LoginRateLimit_Lockout) Reset() {
+	*x = LoginRateLimit_Lockout{}
+	mi := &file_config_config_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LoginRateLimit_Lockout) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LoginRateLimit_Lockout) ProtoMessage() {}
+
+func (x *LoginRateLimit_Lockout) ProtoReflect() protoreflect.Message {
+	mi := &file_config_config_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LoginRateLimit_Lockout.ProtoReflect.Descriptor instead.
+func (*LoginRateLimit_Lockout) Descriptor() ([]byte, []int) {
+	return file_config_config_proto_rawDescGZIP(), []int{17, 1}
+}
+
+func (x *LoginRateLimit_Lockout) GetMaxFailures() int32 {
+	if x != nil {
+		return x.MaxFailures
+	}
+	return 0
+}
+
+func (x *LoginRateLimit_Lockout) GetWindow() *durationpb.Duration {
+	if x != nil {
+		return x.Window
+	}
+	return nil
+}
+
+func (x *LoginRateLimit_Lockout) GetDuration() *durationpb.Duration {
+	if x != nil {
+		return x.Duration
+	}
+	return nil
+}
+
+var File_config_config_proto protoreflect.FileDescriptor
+
+var file_config_config_proto_rawDesc = []byte{
+	0x0a, 0x13, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x10, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x1a, 0x19, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x61, 0x6e, 0x79, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x22, 0xbb, 0x01, 0x0a, 0x0c, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x07, 0x63, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x12, 0x1a,
+	0x0a, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x6a, 0x77, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x73, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65,
+	0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x06, 0x72, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0xc3, 0x02, 0x0a, 0x03, 0x4a, 0x57, 0x54, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x31, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x3f, 0x0a, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0d, 0x72,
+	0x65, 0x66, 0x72, 0x65, 0x73, 0x68, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x04,
+	0x6b, 0x65, 0x79, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x57,
+	0x54, 0x4b, 0x65, 0x79, 0x52, 0x04, 0x6b, 0x65, 0x79, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x73, 0x69,
+	0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x73, 0x69, 0x67, 0x6e, 0x69, 0x6e, 0x67, 0x4b, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x0e, 0x74, 0x72,
+	0x75, 0x73, 0x74, 0x65, 0x64, 0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63,
+	0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4a, 0x57, 0x54, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64,
+	0x49, 0x73, 0x73, 0x75, 0x65, 0x72, 0x52, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x49,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x73, 0x22, 0x86, 0x01, 0x0a, 0x06, 0x4a, 0x57, 0x54, 0x4b, 0x65,
+	0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x6b, 0x69, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68, 0x6d,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x67, 0x6f, 0x72, 0x69, 0x74, 0x68,
+	0x6d, 0x12, 0x26, 0x0a, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x46,
+	0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x72, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x63, 0x4b, 0x65, 0x79, 0x46, 0x69, 0x6c, 0x65, 0x22,
+	0x7c, 0x0a, 0x10, 0x4a, 0x57, 0x54, 0x54, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x49, 0x73, 0x73,
+	0x75, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x6a,
+	0x77, 0x6b, 0x73, 0x55, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6a, 0x77,
+	0x6b, 0x73, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x75, 0x64, 0x69, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x22, 0xae, 0x01,
+	0x0a, 0x0d, 0x43, 0x6c, 0x75, 0x73, 0x74, 0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63,
+	0x6f, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+	0x6f, 0x63, 0x6f, 0x6c, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xf6,
+	0x01, 0x0a, 0x09, 0x4f, 0x52, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x43, 0x0a, 0x09,
+	0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4f, 0x52, 0x4d, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x69, 0x61,
+	0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x52, 0x09, 0x64, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x6f,
+	0x72, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x62, 0x75, 0x67, 0x12, 0x28, 0x0a, 0x0f, 0x75, 0x73, 0x65, 0x53, 0x79,
+	0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0f, 0x75, 0x73, 0x65, 0x53, 0x79, 0x73, 0x74, 0x65, 0x6d, 0x4c, 0x6f, 0x67, 0x67, 0x65,
+	0x72, 0x22, 0x34, 0x0a, 0x09, 0x44, 0x69, 0x61, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x10,
+	0x0a, 0x0c, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00,
+	0x12, 0x09, 0x0a, 0x05, 0x4d, 0x59, 0x53, 0x51, 0x4c, 0x10, 0x01, 0x12, 0x0a, 0x0a, 0x06, 0x53,
+	0x51, 0x4c, 0x49, 0x54, 0x45, 0x10, 0x02, 0x22, 0x99, 0x02, 0x0a, 0x0c, 0x4d, 0x79, 0x53, 0x51,
+	0x4c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72,
+	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
+	0x12, 0x12, 0x0a, 0x04, 0x68, 0x6f, 0x73, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
+	0x68, 0x6f, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x05, 0x52, 0x04, 0x70, 0x6f, 0x72, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72,
+	0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4d, 0x79, 0x53, 0x51,
+	0x4c, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x0a, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65,
+	0x74, 0x65, 0x72, 0x73, 0x1a, 0x3d, 0x0a, 0x0f, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a,
+	0x02, 0x38, 0x01, 0x22, 0x21, 0x0a, 0x0d, 0x53, 0x51, 0x4c, 0x69, 0x74, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x64, 0x73, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x64, 0x73, 0x6e, 0x22, 0xe8, 0x01, 0x0a, 0x0c, 0x52, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x49, 0x0a, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x29, 0x2e, 0x73, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52,
+	0x65, 0x70, 0x6f, 0x72, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x52, 0x65, 0x70, 0x6f,
+	0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0a, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65,
+	0x22, 0x3f, 0x0a, 0x0a, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x17,
+	0x0a, 0x13, 0x52, 0x45, 0x50, 0x4f, 0x52, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e,
+	0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x54, 0x43, 0x44, 0x10,
+	0x01, 0x12, 0x0e, 0x0a, 0x0a, 0x4b, 0x55, 0x42, 0x45, 0x52, 0x4e, 0x45, 0x54, 0x45, 0x53, 0x10,
+	0x02, 0x22, 0xa0, 0x01, 0x0a, 0x0b, 0x45, 0x54, 0x43, 0x44, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x3b, 0x0a, 0x0b, 0x64, 0x69, 0x61, 0x6c, 0x54,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x64, 0x69, 0x61, 0x6c, 0x54, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x22, 0x33, 0x0a, 0x11, 0x4b, 0x75, 0x62, 0x65, 0x72, 0x6e, 0x65, 0x74,
+	0x65, 0x73, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x6b, 0x75, 0x62,
+	0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6b,
+	0x75, 0x62, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x22, 0x63, 0x0a, 0x0f, 0x42, 0x61, 0x73,
+	0x69, 0x63, 0x41, 0x75, 0x74, 0x68, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x1a, 0x0a, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x22, 0xca,
+	0x01, 0x0a, 0x0c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
+	0x3d, 0x0a, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x25, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+	0x44, 0x72, 0x69, 0x76, 0x65, 0x72, 0x52, 0x06, 0x64, 0x72, 0x69, 0x76, 0x65, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x41, 0x6e, 0x79, 0x52,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x31, 0x0a, 0x06, 0x44, 0x72, 0x69, 0x76,
+	0x65, 0x72, 0x12, 0x08, 0x0a, 0x04, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04,
+	0x47, 0x4f, 0x52, 0x4d, 0x10, 0x01, 0x12, 0x08, 0x0a, 0x04, 0x45, 0x54, 0x43, 0x44, 0x10, 0x02,
+	0x12, 0x09, 0x0a, 0x05, 0x4f, 0x55, 0x54, 0x45, 0x52, 0x10, 0x03, 0x22, 0xe4, 0x01, 0x0a, 0x0a,
+	0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61,
+	0x74, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x41,
+	0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x25, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x46, 0x69, 0x6c, 0x65, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x46,
+	0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x69, 0x6c, 0x65, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x43, 0x0a,
+	0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x76,
+	0x61, 0x6c, 0x22, 0x1e, 0x0a, 0x08, 0x46, 0x69, 0x6c, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x08,
+	0x0a, 0x04, 0x59, 0x41, 0x4d, 0x4c, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x4a, 0x53, 0x4f, 0x4e,
+	0x10, 0x01, 0x22, 0xb4, 0x01, 0x0a, 0x11, 0x4f, 0x75, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x36, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e,
+	0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x52,
+	0x08, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x22, 0xf4, 0x0e, 0x0a, 0x06, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x32, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x72, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x12, 0x39,
+	0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x07, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x3b, 0x0a, 0x0b, 0x73,
+	0x74, 0x61, 0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x45, 0x78, 0x70, 0x69, 0x72, 0x65, 0x12, 0x2c, 0x0a, 0x11, 0x72, 0x65, 0x74, 0x75,
+	0x72, 0x6e, 0x54, 0x6f, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x06, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x11, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x54, 0x6f, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x43, 0x0a, 0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x32, 0x2e, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x0a, 0x6c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x09, 0x73,
+	0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b, 0x65, 0x79, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x55, 0x72, 0x69, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x52, 0x65, 0x64, 0x69, 0x72, 0x65,
+	0x63, 0x74, 0x55, 0x72, 0x69, 0x1a, 0x94, 0x05, 0x0a, 0x06, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x12, 0x2e, 0x0a, 0x03, 0x61, 0x70, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e,
+	0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e, 0x41, 0x50, 0x50, 0x52, 0x03, 0x61, 0x70, 0x70,
+	0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x22, 0x0a, 0x0c,
+	0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x63, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55, 0x72, 0x69, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x55,
+	0x72, 0x69, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x75, 0x74, 0x68, 0x55, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x70,
+	0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x73, 0x63, 0x6f, 0x70, 0x65, 0x73,
+	0x12, 0x1a, 0x0a, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x6c, 0x6f, 0x67, 0x69, 0x6e, 0x55, 0x72, 0x6c, 0x12, 0x37, 0x0a, 0x04,
+	0x6f, 0x69, 0x64, 0x63, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x76,
+	0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41,
+	0x75, 0x74, 0x68, 0x32, 0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x04, 0x6f, 0x69, 0x64, 0x63, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6b, 0x63, 0x65, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x04, 0x70, 0x6b, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x72, 0x75,
+	0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x74,
+	0x72, 0x75, 0x73, 0x74, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x4c, 0x0a, 0x0d, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x26, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e,
+	0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e, 0x45, 0x6d, 0x61, 0x69, 0x6c,
+	0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x46,
+	0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63, 0x6b, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x70, 0x69, 0x55, 0x72,
+	0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x70, 0x69, 0x55, 0x72, 0x6c, 0x12,
+	0x20, 0x0a, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72, 0x6c, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x75, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x55, 0x72,
+	0x6c, 0x12, 0x43, 0x0a, 0x0a, 0x68, 0x74, 0x74, 0x70, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67,
+	0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e,
+	0x48, 0x54, 0x54, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x68, 0x74, 0x74, 0x70,
+	0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x40, 0x0a, 0x09, 0x61, 0x64,
+	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32, 0x2e, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x09, 0x61, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xcf, 0x01, 0x0a,
+	0x09, 0x41, 0x64, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x0a, 0x0c, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x12, 0x30,
+	0x0a, 0x13, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x13, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x4f, 0x72, 0x67, 0x61, 0x6e, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x20, 0x0a, 0x0b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x54, 0x65, 0x61, 0x6d, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x54, 0x65, 0x61,
+	0x6d, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x66, 0x65, 0x69, 0x73, 0x68, 0x75, 0x54, 0x65, 0x6e, 0x61,
+	0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x66, 0x65,
+	0x69, 0x73, 0x68, 0x75, 0x54, 0x65, 0x6e, 0x61, 0x6e, 0x74, 0x4b, 0x65, 0x79, 0x73, 0x12, 0x1e,
+	0x0a, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0a, 0x69, 0x6e, 0x76, 0x69, 0x74, 0x65, 0x4f, 0x6e, 0x6c, 0x79, 0x1a, 0x6f,
+	0x0a, 0x0a, 0x48, 0x54, 0x54, 0x50, 0x43, 0x6c, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x07,
+	0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x70, 0x72, 0x6f, 0x78, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x61, 0x46, 0x69, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x1a,
+	0xc3, 0x02, 0x0a, 0x0a, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x16,
+	0x0a, 0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x42, 0x0a, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69,
+	0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4f, 0x41, 0x75, 0x74, 0x68, 0x32,
+	0x2e, 0x4f, 0x49, 0x44, 0x43, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x43, 0x6c, 0x61, 0x69,
+	0x6d, 0x73, 0x52, 0x06, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x75, 0x73,
+	0x65, 0x72, 0x49, 0x6e, 0x66, 0x6f, 0x1a, 0xbc, 0x01, 0x0a, 0x06, 0x43, 0x6c, 0x61, 0x69, 0x6d,
+	0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1a, 0x0a,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x6d, 0x61,
+	0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x61, 0x76, 0x61, 0x74, 0x61, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72,
+	0x6b, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x12,
+	0x24, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x56, 0x65, 0x72,
+	0x69, 0x66, 0x69, 0x65, 0x64, 0x22, 0x49, 0x0a, 0x03, 0x41, 0x50, 0x50, 0x12, 0x0b, 0x0a, 0x07,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x47, 0x49, 0x54,
+	0x48, 0x55, 0x42, 0x10, 0x01, 0x12, 0x09, 0x0a, 0x05, 0x47, 0x49, 0x54, 0x45, 0x45, 0x10, 0x02,
+	0x12, 0x0a, 0x0a, 0x06, 0x46, 0x45, 0x49, 0x53, 0x48, 0x55, 0x10, 0x03, 0x12, 0x08, 0x0a, 0x04,
+	0x4f, 0x49, 0x44, 0x43, 0x10, 0x04, 0x12, 0x08, 0x0a, 0x04, 0x4c, 0x44, 0x41, 0x50, 0x10, 0x05,
+	0x22, 0x3e, 0x0a, 0x0d, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x46, 0x61, 0x6c, 0x6c, 0x62, 0x61, 0x63,
+	0x6b, 0x12, 0x0a, 0x0a, 0x06, 0x52, 0x45, 0x46, 0x55, 0x53, 0x45, 0x10, 0x00, 0x12, 0x0b, 0x0a,
+	0x07, 0x4e, 0x4f, 0x52, 0x45, 0x50, 0x4c, 0x59, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x43, 0x4f,
+	0x4d, 0x50, 0x4c, 0x45, 0x54, 0x45, 0x5f, 0x50, 0x52, 0x4f, 0x46, 0x49, 0x4c, 0x45, 0x10, 0x02,
+	0x22, 0x2e, 0x0a, 0x0a, 0x4c, 0x69, 0x6e, 0x6b, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x12,
+	0x0a, 0x0e, 0x56, 0x45, 0x52, 0x49, 0x46, 0x49, 0x45, 0x44, 0x5f, 0x45, 0x4d, 0x41, 0x49, 0x4c,
+	0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x58, 0x50, 0x4c, 0x49, 0x43, 0x49, 0x54, 0x10, 0x01,
+	0x22, 0xe7, 0x05, 0x0a, 0x04, 0x4c, 0x44, 0x41, 0x50, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x6e, 0x61,
+	0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x12, 0x10, 0x0a, 0x03, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03,
+	0x75, 0x72, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6c, 0x73, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x6c, 0x73, 0x12,
+	0x2e, 0x0a, 0x12, 0x69, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x69, 0x6e, 0x73,
+	0x65, 0x63, 0x75, 0x72, 0x65, 0x53, 0x6b, 0x69, 0x70, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x61, 0x46, 0x69, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x44,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x69, 0x6e, 0x64, 0x44, 0x6e, 0x12,
+	0x22, 0x0a, 0x0c, 0x62, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x62, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x73, 0x73, 0x77,
+	0x6f, 0x72, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6e, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x75,
+	0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x75, 0x73, 0x65, 0x72, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x41, 0x0a, 0x0a, 0x61,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4c, 0x44, 0x41, 0x50, 0x2e, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x73, 0x52, 0x0a, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x35,
+	0x0a, 0x06, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x2e, 0x4c, 0x44, 0x41, 0x50, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x06, 0x67,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x6f, 0x6f, 0x6c, 0x53, 0x69, 0x7a,
+	0x65, 0x12, 0x33, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x1a, 0x82, 0x01, 0x0a, 0x0a, 0x41, 0x74, 0x74, 0x72, 0x69,
+	0x62, 0x75, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6f, 0x70, 0x65, 0x6e, 0x49, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x6e, 0x69, 0x63, 0x6b, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x1a, 0x8c, 0x01, 0x0a, 0x06,
+	0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6e,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x61, 0x73, 0x65, 0x44, 0x6e, 0x12, 0x16,
+	0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x24, 0x0a, 0x0d, 0x6e, 0x61, 0x6d, 0x65, 0x41, 0x74,
+	0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6e,
+	0x61, 0x6d, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x12, 0x2c, 0x0a, 0x11,
+	0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f, 0x66, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x4f,
+	0x66, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x22, 0x99, 0x05, 0x0a, 0x0e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x12, 0x3c, 0x0a, 0x05, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x26, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e,
+	0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74,
+	0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x12, 0x35, 0x0a, 0x02, 0x69, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x25, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x52, 0x02, 0x69, 0x70, 0x12, 0x3f, 0x0a, 0x07, 0x61, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e, 0x73, 0x6f,
+	0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x4c,
+	0x6f, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e, 0x52, 0x75,
+	0x6c, 0x65, 0x52, 0x07, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x08, 0x70,
+	0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x2e,
+	0x52, 0x75, 0x6c, 0x65, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x12, 0x42,
+	0x0a, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x28, 0x2e, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2e, 0x63, 0x6f, 0x6e, 0x66,
+	0x69, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x69, 0x6e, 0x52, 0x61, 0x74, 0x65, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x2e, 0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x6c, 0x6f, 0x63, 0x6b, 0x6f,
+	0x75, 0x74, 0x12, 0x26, 0x0a, 0x0e, 0x74, 0x72, 0x75, 0x73, 0x74, 0x65, 0x64, 0x50, 0x72, 0x6f,
+	0x78, 0x69, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x74, 0x72, 0x75, 0x73,
+	0x74, 0x65, 0x64, 0x50, 0x72, 0x6f, 0x78, 0x69, 0x65, 0x73, 0x1a, 0x4f, 0x0a, 0x04, 0x52, 0x75,
+	0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x05, 0x52, 0x05, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x1a, 0x95, 0x01, 0x0a, 0x07,
+	0x4c, 0x6f, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x12, 0x20, 0x0a, 0x0b, 0x6d, 0x61, 0x78, 0x46, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0b, 0x6d, 0x61,
+	0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x31, 0x0a, 0x06, 0x77, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x44, 0x75, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x35, 0x0a, 0x08,
+	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x08, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0x21, 0x0a, 0x05, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x0a, 0x0a, 0x06,
+	0x4d, 0x45, 0x4d, 0x4f, 0x52, 0x59, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x44, 0x41, 0x54, 0x41,
+	0x42, 0x41, 0x53, 0x45, 0x10, 0x01, 0x22, 0x6b, 0x0a, 0x03, 0x4d, 0x46, 0x41, 0x12, 0x16, 0x0a,
+	0x06, 0x69, 0x73, 0x73, 0x75, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x72, 0x12, 0x1c, 0x0a, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x4b,
+	0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74,
+	0x4b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4f,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x12, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x2a, 0x34, 0x0a, 0x08, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x63, 0x6f, 0x6c, 0x12,
+	0x14, 0x0a, 0x10, 0x50, 0x52, 0x4f, 0x54, 0x4f, 0x43, 0x4f, 0x4c, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x08, 0x0a, 0x04, 0x47, 0x52, 0x50, 0x43, 0x10, 0x01, 0x12,
+	0x08, 0x0a, 0x04, 0x48, 0x54, 0x54, 0x50, 0x10, 0x02, 0x42, 0x34, 0x5a, 0x32, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x69, 0x64, 0x65, 0x2d, 0x66, 0x61, 0x6d,
+	0x69, 0x6c, 0x79, 0x2f, 0x73, 0x6f, 0x76, 0x65, 0x72, 0x65, 0x69, 0x67, 0x6e, 0x2f, 0x70, 0x6b,
+	0x67, 0x2f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x3b, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1496,59 +3086,97 @@ func file_config_config_proto_rawDescGZIP() []byte {
 	return file_config_config_proto_rawDescData
 }
 
-var file_config_config_proto_enumTypes = make([]protoimpl.EnumInfo, 6)
-var file_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
+var file_config_config_proto_enumTypes = make([]protoimpl.EnumInfo, 9)
+var file_config_config_proto_msgTypes = make([]protoimpl.MessageInfo, 29)
 var file_config_config_proto_goTypes = []any{
-	(Protocol)(0),                // 0: sovereign.config.Protocol
-	(ORMConfig_Dialector)(0),     // 1: sovereign.config.ORMConfig.Dialector
-	(ReportConfig_ReportType)(0), // 2: sovereign.config.ReportConfig.ReportType
-	(DomainConfig_Driver)(0),     // 3: sovereign.config.DomainConfig.Driver
-	(FileConfig_FileType)(0),     // 4: sovereign.config.FileConfig.FileType
-	(OAuth2_APP)(0),              // 5: sovereign.config.OAuth2.APP
-	(*ClientConfig)(nil),         // 6: sovereign.config.ClientConfig
-	(*JWT)(nil),                  // 7: sovereign.config.JWT
-	(*ClusterConfig)(nil),        // 8: sovereign.config.ClusterConfig
-	(*ORMConfig)(nil),            // 9: sovereign.config.ORMConfig
-	(*MySQLOptions)(nil),         // 10: sovereign.config.MySQLOptions
-	(*SQLiteOptions)(nil),        // 11: sovereign.config.SQLiteOptions
-	(*ReportConfig)(nil),         // 12: sovereign.config.ReportConfig
-	(*ETCDOptions)(nil),          // 13: sovereign.config.ETCDOptions
-	(*KubernetesOptions)(nil),    // 14: sovereign.config.KubernetesOptions
-	(*BasicAuthConfig)(nil),      // 15: sovereign.config.BasicAuthConfig
-	(*DomainConfig)(nil),         // 16: sovereign.config.DomainConfig
-	(*FileConfig)(nil),           // 17: sovereign.config.FileConfig
-	(*OuterServerConfig)(nil),    // 18: sovereign.config.OuterServerConfig
-	(*OAuth2)(nil),               // 19: sovereign.config.OAuth2
-	nil,                          // 20: sovereign.config.MySQLOptions.ParametersEntry
-	(*OAuth2_Config)(nil),        // 21: sovereign.config.OAuth2.Config
-	(*durationpb.Duration)(nil),  // 22: google.protobuf.Duration
-	(*anypb.Any)(nil),            // 23: google.protobuf.Any
+	(Protocol)(0),                    // 0: sovereign.config.Protocol
+	(ORMConfig_Dialector)(0),         // 1: sovereign.config.ORMConfig.Dialector
+	(ReportConfig_ReportType)(0),     // 2: sovereign.config.ReportConfig.ReportType
+	(DomainConfig_Driver)(0),         // 3: sovereign.config.DomainConfig.Driver
+	(FileConfig_FileType)(0),         // 4: sovereign.config.FileConfig.FileType
+	(OAuth2_APP)(0),                  // 5: sovereign.config.OAuth2.APP
+	(OAuth2_EmailFallback)(0),        // 6: sovereign.config.OAuth2.EmailFallback
+	(OAuth2_LinkPolicy)(0),           // 7: sovereign.config.OAuth2.LinkPolicy
+	(LoginRateLimit_Store)(0),        // 8: sovereign.config.LoginRateLimit.Store
+	(*ClientConfig)(nil),             // 9: sovereign.config.ClientConfig
+	(*JWT)(nil),                      // 10: sovereign.config.JWT
+	(*JWTKey)(nil),                   // 11: sovereign.config.JWTKey
+	(*JWTTrustedIssuer)(nil),         // 12: sovereign.config.JWTTrustedIssuer
+	(*ClusterConfig)(nil),            // 13: sovereign.config.ClusterConfig
+	(*ORMConfig)(nil),                // 14: sovereign.config.ORMConfig
+	(*MySQLOptions)(nil),             // 15: sovereign.config.MySQLOptions
+	(*SQLiteOptions)(nil),            // 16: sovereign.config.SQLiteOptions
+	(*ReportConfig)(nil),             // 17: sovereign.config.ReportConfig
+	(*ETCDOptions)(nil),              // 18: sovereign.config.ETCDOptions
+	(*KubernetesOptions)(nil),        // 19: sovereign.config.KubernetesOptions
+	(*BasicAuthConfig)(nil),          // 20: sovereign.config.BasicAuthConfig
+	(*DomainConfig)(nil),             // 21: sovereign.config.DomainConfig
+	(*FileConfig)(nil),               // 22: sovereign.config.FileConfig
+	(*OuterServerConfig)(nil),        // 23: sovereign.config.OuterServerConfig
+	(*OAuth2)(nil),                   // 24: sovereign.config.OAuth2
+	(*LDAP)(nil),                     // 25: sovereign.config.LDAP
+	(*LoginRateLimit)(nil),           // 26: sovereign.config.LoginRateLimit
+	(*MFA)(nil),                      // 27: sovereign.config.MFA
+	nil,                              // 28: sovereign.config.MySQLOptions.ParametersEntry
+	(*OAuth2_Config)(nil),            // 29: sovereign.config.OAuth2.Config
+	(*OAuth2_Admission)(nil),         // 30: sovereign.config.OAuth2.Admission
+	(*OAuth2_HTTPClient)(nil),        // 31: sovereign.config.OAuth2.HTTPClient
+	(*OAuth2_OIDCConfig)(nil),        // 32: sovereign.config.OAuth2.OIDCConfig
+	(*OAuth2_OIDCConfig_Claims)(nil), // 33: sovereign.config.OAuth2.OIDCConfig.Claims
+	(*LDAP_Attributes)(nil),          // 34: sovereign.config.LDAP.Attributes
+	(*LDAP_Groups)(nil),              // 35: sovereign.config.LDAP.Groups
+	(*LoginRateLimit_Rule)(nil),      // 36: sovereign.config.LoginRateLimit.Rule
+	(*LoginRateLimit_Lockout)(nil),   // 37: sovereign.config.LoginRateLimit.Lockout
+	(*durationpb.Duration)(nil),      // 38: google.protobuf.Duration
+	(*anypb.Any)(nil),                // 39: google.protobuf.Any
 }
 var file_config_config_proto_depIdxs = []int32{
-	8,  // 0: sovereign.config.ClientConfig.cluster:type_name -> sovereign.config.ClusterConfig
-	12, // 1: sovereign.config.ClientConfig.report:type_name -> sovereign.config.ReportConfig
-	22, // 2: sovereign.config.JWT.expire:type_name -> google.protobuf.Duration
-	22, // 3: sovereign.config.ClusterConfig.timeout:type_name -> google.protobuf.Duration
-	0,  // 4: sovereign.config.ClusterConfig.protocol:type_name -> sovereign.config.Protocol
-	1,  // 5: sovereign.config.ORMConfig.dialector:type_name -> sovereign.config.ORMConfig.Dialector
-	23, // 6: sovereign.config.ORMConfig.options:type_name -> google.protobuf.Any
-	20, // 7: sovereign.config.MySQLOptions.parameters:type_name -> sovereign.config.MySQLOptions.ParametersEntry
-	2,  // 8: sovereign.config.ReportConfig.reportType:type_name -> sovereign.config.ReportConfig.ReportType
-	23, // 9: sovereign.config.ReportConfig.options:type_name -> google.protobuf.Any
-	22, // 10: sovereign.config.ETCDOptions.dialTimeout:type_name -> google.protobuf.Duration
-	3,  // 11: sovereign.config.DomainConfig.driver:type_name -> sovereign.config.DomainConfig.Driver
-	23, // 12: sovereign.config.DomainConfig.options:type_name -> google.protobuf.Any
-	4,  // 13: sovereign.config.FileConfig.fileType:type_name -> sovereign.config.FileConfig.FileType
-	22, // 14: sovereign.config.FileConfig.storageInterval:type_name -> google.protobuf.Duration
-	22, // 15: sovereign.config.OuterServerConfig.timeout:type_name -> google.protobuf.Duration
-	0,  // 16: sovereign.config.OuterServerConfig.protocol:type_name -> sovereign.config.Protocol
-	21, // 17: sovereign.config.OAuth2.configs:type_name -> sovereign.config.OAuth2.Config
-	5,  // 18: sovereign.config.OAuth2.Config.app:type_name -> sovereign.config.OAuth2.APP
-	19, // [19:19] is the sub-list for method output_type
-	19, // [19:19] is the sub-list for method input_type
-	19, // [19:19] is the sub-list for extension type_name
-	19, // [19:19] is the sub-list for extension extendee
-	0,  // [0:19] is the sub-list for field type_name
+	13, // 0: sovereign.config.ClientConfig.cluster:type_name -> sovereign.config.ClusterConfig
+	17, // 1: sovereign.config.ClientConfig.report:type_name -> sovereign.config.ReportConfig
+	38, // 2: sovereign.config.JWT.expire:type_name -> google.protobuf.Duration
+	38, // 3: sovereign.config.JWT.refreshExpire:type_name -> google.protobuf.Duration
+	11, // 4: sovereign.config.JWT.keys:type_name -> sovereign.config.JWTKey
+	12, // 5: sovereign.config.JWT.trustedIssuers:type_name -> sovereign.config.JWTTrustedIssuer
+	38, // 6: sovereign.config.ClusterConfig.timeout:type_name -> google.protobuf.Duration
+	0,  // 7: sovereign.config.ClusterConfig.protocol:type_name -> sovereign.config.Protocol
+	1,  // 8: sovereign.config.ORMConfig.dialector:type_name -> sovereign.config.ORMConfig.Dialector
+	39, // 9: sovereign.config.ORMConfig.options:type_name -> google.protobuf.Any
+	28, // 10: sovereign.config.MySQLOptions.parameters:type_name -> sovereign.config.MySQLOptions.ParametersEntry
+	2,  // 11: sovereign.config.ReportConfig.reportType:type_name -> sovereign.config.ReportConfig.ReportType
+	39, // 12: sovereign.config.ReportConfig.options:type_name -> google.protobuf.Any
+	38, // 13: sovereign.config.ETCDOptions.dialTimeout:type_name -> google.protobuf.Duration
+	3,  // 14: sovereign.config.DomainConfig.driver:type_name -> sovereign.config.DomainConfig.Driver
+	39, // 15: sovereign.config.DomainConfig.options:type_name -> google.protobuf.Any
+	4,  // 16: sovereign.config.FileConfig.fileType:type_name -> sovereign.config.FileConfig.FileType
+	38, // 17: sovereign.config.FileConfig.storageInterval:type_name -> google.protobuf.Duration
+	38, // 18: sovereign.config.OuterServerConfig.timeout:type_name -> google.protobuf.Duration
+	0,  // 19: sovereign.config.OuterServerConfig.protocol:type_name -> sovereign.config.Protocol
+	29, // 20: sovereign.config.OAuth2.configs:type_name -> sovereign.config.OAuth2.Config
+	38, // 21: sovereign.config.OAuth2.stateExpire:type_name -> google.protobuf.Duration
+	7,  // 22: sovereign.config.OAuth2.linkPolicy:type_name -> sovereign.config.OAuth2.LinkPolicy
+	34, // 23: sovereign.config.LDAP.attributes:type_name -> sovereign.config.LDAP.Attributes
+	35, // 24: sovereign.config.LDAP.groups:type_name -> sovereign.config.LDAP.Groups
+	38, // 25: sovereign.config.LDAP.timeout:type_name -> google.protobuf.Duration
+	8,  // 26: sovereign.config.LoginRateLimit.store:type_name -> sovereign.config.LoginRateLimit.Store
+	36, // 27: sovereign.config.LoginRateLimit.ip:type_name -> sovereign.config.LoginRateLimit.Rule
+	36, // 28: sovereign.config.LoginRateLimit.account:type_name -> sovereign.config.LoginRateLimit.Rule
+	36, // 29: sovereign.config.LoginRateLimit.provider:type_name -> sovereign.config.LoginRateLimit.Rule
+	37, // 30: sovereign.config.LoginRateLimit.lockout:type_name -> sovereign.config.LoginRateLimit.Lockout
+	5,  // 31: sovereign.config.OAuth2.Config.app:type_name -> sovereign.config.OAuth2.APP
+	32, // 32: sovereign.config.OAuth2.Config.oidc:type_name -> sovereign.config.OAuth2.OIDCConfig
+	6,  // 33: sovereign.config.OAuth2.Config.emailFallback:type_name -> sovereign.config.OAuth2.EmailFallback
+	31, // 34: sovereign.config.OAuth2.Config.httpClient:type_name -> sovereign.config.OAuth2.HTTPClient
+	30, // 35: sovereign.config.OAuth2.Config.admission:type_name -> sovereign.config.OAuth2.Admission
+	38, // 36: sovereign.config.OAuth2.HTTPClient.timeout:type_name -> google.protobuf.Duration
+	33, // 37: sovereign.config.OAuth2.OIDCConfig.claims:type_name -> sovereign.config.OAuth2.OIDCConfig.Claims
+	38, // 38: sovereign.config.LoginRateLimit.Rule.window:type_name -> google.protobuf.Duration
+	38, // 39: sovereign.config.LoginRateLimit.Lockout.window:type_name -> google.protobuf.Duration
+	38, // 40: sovereign.config.LoginRateLimit.Lockout.duration:type_name -> google.protobuf.Duration
+	41, // [41:41] is the sub-list for method output_type
+	41, // [41:41] is the sub-list for method input_type
+	41, // [41:41] is the sub-list for extension type_name
+	41, // [41:41] is the sub-list for extension extendee
+	0,  // [0:41] is the sub-list for field type_name
 }
 
 func init() { file_config_config_proto_init() }
@@ -1561,8 +3189,8 @@ func file_config_config_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_config_config_proto_rawDesc,
-			NumEnums:      6,
-			NumMessages:   16,
+			NumEnums:      9,
+			NumMessages:   29,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -0,0 +1,66 @@
+package authv1
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+const (
+	// APIKeyPrefix starts every API key, which tells an API key from a JWT in the Authorization header
+	APIKeyPrefix = "sov_"
+	// apiKeyDisplayLength is the length of the key prefix kept to tell keys apart
+	apiKeyDisplayLength = len(APIKeyPrefix) + 8
+)
+
+type (
+	// APIKeyInfo 请求所使用的 API key 及其限制
+	APIKeyInfo struct {
+		UID        snowflake.ID
+		Scopes     []string
+		Namespaces []string
+	}
+
+	apiKeyInfoKey struct{}
+)
+
+// NewAPIKey 生成 API key，只有 hash 会被保存，prefix 用于区分不同的 key
+func NewAPIKey() (key string, prefix string, hash string) {
+	key = APIKeyPrefix + newRandomToken()
+	return key, key[:apiKeyDisplayLength], HashAPIKey(key)
+}
+
+// HashAPIKey 计算 API key 的哈希
+func HashAPIKey(key string) string {
+	return HashRefreshToken(key)
+}
+
+// IsAPIKey 判断 bearer token 是否为 API key
+func IsAPIKey(token string) bool {
+	return strings.HasPrefix(token, APIKeyPrefix)
+}
+
+// AllowOperation reports whether the scopes of the key allow the operation, a scope ending with * matches operations starting with it.
+func (i *APIKeyInfo) AllowOperation(operation string) bool {
+	if len(i.Scopes) == 0 {
+		return true
+	}
+	return MatchPermission(i.Scopes, operation)
+}
+
+// AllowNamespace reports whether the key may use the namespace.
+func (i *APIKeyInfo) AllowNamespace(namespace string) bool {
+	return len(i.Namespaces) == 0 || slices.Contains(i.Namespaces, namespace)
+}
+
+func WithAPIKeyInfo(ctx context.Context, info *APIKeyInfo) context.Context {
+	return context.WithValue(ctx, apiKeyInfoKey{}, info)
+}
+
+// GetAPIKeyInfo returns the API key of the request, ok is false when the request is signed in with a JWT.
+func GetAPIKeyInfo(ctx context.Context) (*APIKeyInfo, bool) {
+	info, ok := ctx.Value(apiKeyInfoKey{}).(*APIKeyInfo)
+	return info, ok
+}
@@ -7,4 +7,65 @@ import (
 
 type Repository interface {
 	Login(ctx context.Context, req *LoginRequest) (*LoginResponse, error)
+	GetUser(ctx context.Context, req *GetUserRequest) (*UserModel, error)
+	RefreshToken(ctx context.Context, req *RefreshTokenRequest) (*TokenModel, error)
+	RevokeToken(ctx context.Context, req *RevokeTokenRequest) (*RevokeTokenResponse, error)
+	IsTokenRevoked(ctx context.Context, req *IsTokenRevokedRequest) (*IsTokenRevokedResponse, error)
+	PasswordLogin(ctx context.Context, req *PasswordLoginRequest) (*TokenModel, error)
+	ChangePassword(ctx context.Context, req *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	BootstrapAdmin(ctx context.Context, req *BootstrapAdminRequest) (*UserModel, error)
+	CreateAPIKey(ctx context.Context, req *CreateAPIKeyRequest) (*CreateAPIKeyResponse, error)
+	ListAPIKeys(ctx context.Context, req *ListAPIKeysRequest) (*ListAPIKeysResponse, error)
+	RevokeAPIKey(ctx context.Context, req *RevokeAPIKeyRequest) (*RevokeAPIKeyResponse, error)
+	VerifyAPIKey(ctx context.Context, req *VerifyAPIKeyRequest) (*APIKeyModel, error)
+	CreateRole(ctx context.Context, req *CreateRoleRequest) (*RoleModel, error)
+	UpdateRole(ctx context.Context, req *UpdateRoleRequest) (*RoleModel, error)
+	DeleteRole(ctx context.Context, req *DeleteRoleRequest) (*DeleteRoleResponse, error)
+	GetRole(ctx context.Context, req *GetRoleRequest) (*RoleModel, error)
+	ListRoles(ctx context.Context, req *ListRolesRequest) (*ListRolesResponse, error)
+	EnsureBuiltinRoles(ctx context.Context, req *EnsureBuiltinRolesRequest) (*EnsureBuiltinRolesResponse, error)
+	CreateRoleBinding(ctx context.Context, req *CreateRoleBindingRequest) (*RoleBindingModel, error)
+	DeleteRoleBinding(ctx context.Context, req *DeleteRoleBindingRequest) (*DeleteRoleBindingResponse, error)
+	ListRoleBindings(ctx context.Context, req *ListRoleBindingsRequest) (*ListRoleBindingsResponse, error)
+	GetUserPermissions(ctx context.Context, req *GetUserPermissionsRequest) (*GetUserPermissionsResponse, error)
+	CreatePolicy(ctx context.Context, req *CreatePolicyRequest) (*PolicyModel, error)
+	UpdatePolicy(ctx context.Context, req *UpdatePolicyRequest) (*PolicyModel, error)
+	GetPolicy(ctx context.Context, req *GetPolicyRequest) (*PolicyModel, error)
+	DeletePolicy(ctx context.Context, req *DeletePolicyRequest) (*DeletePolicyResponse, error)
+	ListPolicies(ctx context.Context, req *ListPoliciesRequest) (*ListPoliciesResponse, error)
+	ListUsers(ctx context.Context, req *ListUsersRequest) (*ListUsersResponse, error)
+	UpdateUser(ctx context.Context, req *UpdateUserRequest) (*UserModel, error)
+	UpdateUserStatus(ctx context.Context, req *UpdateUserStatusRequest) (*UserModel, error)
+	UpdateUserEmail(ctx context.Context, req *UpdateUserEmailRequest) (*UserModel, error)
+	ListUserIdentities(ctx context.Context, req *ListUserIdentitiesRequest) (*ListUserIdentitiesResponse, error)
+	UnlinkUserIdentity(ctx context.Context, req *UnlinkUserIdentityRequest) (*UnlinkUserIdentityResponse, error)
+	GetUserPreferences(ctx context.Context, req *GetUserPreferencesRequest) (*UserPreferencesModel, error)
+	SaveUserPreferences(ctx context.Context, req *SaveUserPreferencesRequest) (*UserPreferencesModel, error)
+	LinkIdentity(ctx context.Context, req *LinkIdentityRequest) (*IdentityModel, error)
+	ListIdentityAuditEvents(ctx context.Context, req *ListIdentityAuditEventsRequest) (*ListIdentityAuditEventsResponse, error)
+	CreateOAuth2Provider(ctx context.Context, req *CreateOAuth2ProviderRequest) (*OAuth2ProviderModel, error)
+	UpdateOAuth2Provider(ctx context.Context, req *UpdateOAuth2ProviderRequest) (*OAuth2ProviderModel, error)
+	UpdateOAuth2ProviderStatus(ctx context.Context, req *UpdateOAuth2ProviderStatusRequest) (*OAuth2ProviderModel, error)
+	DeleteOAuth2Provider(ctx context.Context, req *DeleteOAuth2ProviderRequest) (*DeleteOAuth2ProviderResponse, error)
+	GetOAuth2Provider(ctx context.Context, req *GetOAuth2ProviderRequest) (*OAuth2ProviderModel, error)
+	ListOAuth2Providers(ctx context.Context, req *ListOAuth2ProvidersRequest) (*ListOAuth2ProvidersResponse, error)
+	CreateInvitation(ctx context.Context, req *CreateInvitationRequest) (*CreateInvitationResponse, error)
+	ListInvitations(ctx context.Context, req *ListInvitationsRequest) (*ListInvitationsResponse, error)
+	DeleteInvitation(ctx context.Context, req *DeleteInvitationRequest) (*DeleteInvitationResponse, error)
+	HitLoginLimit(ctx context.Context, req *HitLoginLimitRequest) (*LoginLimitModel, error)
+	LockLoginLimit(ctx context.Context, req *LockLoginLimitRequest) (*LoginLimitModel, error)
+	GetLoginLimit(ctx context.Context, req *GetLoginLimitRequest) (*LoginLimitModel, error)
+	ResetLoginLimit(ctx context.Context, req *ResetLoginLimitRequest) (*ResetLoginLimitResponse, error)
+	CreateLoginAuditEvent(ctx context.Context, req *CreateLoginAuditEventRequest) (*LoginAuditEventModel, error)
+	ListLoginAuditEvents(ctx context.Context, req *ListLoginAuditEventsRequest) (*ListLoginAuditEventsResponse, error)
+	SetRoleMFA(ctx context.Context, req *SetRoleMFARequest) (*RoleModel, error)
+	GetUserMFA(ctx context.Context, req *GetUserMFARequest) (*UserMFAModel, error)
+	SaveUserMFA(ctx context.Context, req *SaveUserMFARequest) (*UserMFAModel, error)
+	EnableUserMFA(ctx context.Context, req *EnableUserMFARequest) (*UserMFAModel, error)
+	DeleteUserMFA(ctx context.Context, req *DeleteUserMFARequest) (*DeleteUserMFAResponse, error)
+	SaveMFARecoveryCodes(ctx context.Context, req *SaveMFARecoveryCodesRequest) (*UserMFAModel, error)
+	UseMFACode(ctx context.Context, req *UseMFACodeRequest) (*UseMFACodeResponse, error)
+	GetMFAChallenge(ctx context.Context, req *GetMFAChallengeRequest) (*MFAChallengeModel, error)
+	FailMFAChallenge(ctx context.Context, req *FailMFAChallengeRequest) (*MFAChallengeModel, error)
+	CompleteMFAChallenge(ctx context.Context, req *CompleteMFAChallengeRequest) (*TokenModel, error)
 }
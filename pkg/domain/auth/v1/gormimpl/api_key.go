@@ -0,0 +1,160 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// apiKeyLastUsedInterval limits how often the last use of a key is written, a key is used by every request of its client.
+const apiKeyLastUsedInterval = time.Minute
+
+// CreateAPIKey implements [authv1.Repository].
+func (g *gormRepository) CreateAPIKey(ctx context.Context, req *authv1.CreateAPIKeyRequest) (*authv1.CreateAPIKeyResponse, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(req.GetUid())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("user %d not found", req.GetUid())
+		}
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	key, prefix, hash := authv1.NewAPIKey()
+	apiKeyDO := &model.APIKey{
+		UID:        g.node.Generate(),
+		UserUID:    userDO.UID,
+		Name:       req.GetName(),
+		Prefix:     prefix,
+		TokenHash:  hash,
+		Scopes:     req.GetScopes(),
+		Namespaces: req.GetNamespaces(),
+	}
+	if req.GetExpiresAt() > 0 {
+		expiresAt := time.Unix(req.GetExpiresAt(), 0)
+		apiKeyDO.ExpiresAt = &expiresAt
+	}
+	if err := query.APIKey.WithContext(ctx).Create(apiKeyDO); err != nil {
+		klog.Context(ctx).Debugw("msg", "create api key failed", "error", err, "userUID", userDO.UID)
+		return nil, merr.ErrorInternal("create api key failed").WithCause(err)
+	}
+	apiKeyDO.User = userDO
+	return &authv1.CreateAPIKeyResponse{ApiKey: convertAPIKeyModel(apiKeyDO), Key: key}, nil
+}
+
+// ListAPIKeys implements [authv1.Repository].
+func (g *gormRepository) ListAPIKeys(ctx context.Context, req *authv1.ListAPIKeysRequest) (*authv1.ListAPIKeysResponse, error) {
+	apiKeyMutation := query.APIKey
+	wrappers := apiKeyMutation.WithContext(ctx).Where(apiKeyMutation.UserUID.Eq(req.GetUid()))
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count api keys failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(cursorCondition(apiKeyMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(cursorOrder(apiKeyMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	apiKeyDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list api keys failed").WithCause(err)
+	}
+	apiKeyDOs, prevCursor, nextCursor := authv1.PageWindow(apiKeyDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(apiKeyDO *model.APIKey) uint32 {
+		return apiKeyDO.ID
+	})
+	items := make([]*authv1.APIKeyModel, 0, len(apiKeyDOs))
+	for _, apiKeyDO := range apiKeyDOs {
+		items = append(items, convertAPIKeyModel(apiKeyDO))
+	}
+	return &authv1.ListAPIKeysResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// RevokeAPIKey implements [authv1.Repository].
+func (g *gormRepository) RevokeAPIKey(ctx context.Context, req *authv1.RevokeAPIKeyRequest) (*authv1.RevokeAPIKeyResponse, error) {
+	apiKeyMutation := query.APIKey
+	result, err := apiKeyMutation.WithContext(ctx).
+		Where(apiKeyMutation.UID.Eq(req.GetApiKeyUID()), apiKeyMutation.UserUID.Eq(req.GetUid())).
+		Delete()
+	if err != nil {
+		return nil, merr.ErrorInternal("revoke api key failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, merr.ErrorNotFound("api key %d not found", req.GetApiKeyUID())
+	}
+	return &authv1.RevokeAPIKeyResponse{}, nil
+}
+
+// VerifyAPIKey implements [authv1.Repository].
+func (g *gormRepository) VerifyAPIKey(ctx context.Context, req *authv1.VerifyAPIKeyRequest) (*authv1.APIKeyModel, error) {
+	apiKeyMutation := query.APIKey
+	apiKeyDO, err := apiKeyMutation.WithContext(ctx).
+		Preload(apiKeyMutation.User).
+		Where(apiKeyMutation.TokenHash.Eq(authv1.HashAPIKey(req.GetKey()))).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorUnauthorized("api key is invalid")
+		}
+		return nil, merr.ErrorInternal("get api key failed").WithCause(err)
+	}
+	now := time.Now()
+	if apiKeyDO.ExpiresAt != nil && now.After(*apiKeyDO.ExpiresAt) {
+		return nil, merr.ErrorUnauthorized("api key is expired")
+	}
+	if apiKeyDO.User == nil {
+		return nil, merr.ErrorUnauthorized("user of the api key is not found")
+	}
+	if err := checkUserEnabled(apiKeyDO.User); err != nil {
+		return nil, err
+	}
+	if apiKeyDO.LastUsedAt == nil || now.Sub(*apiKeyDO.LastUsedAt) >= apiKeyLastUsedInterval {
+		if _, err := apiKeyMutation.WithContext(ctx).Where(apiKeyMutation.ID.Eq(apiKeyDO.ID)).UpdateSimple(apiKeyMutation.LastUsedAt.Value(now)); err != nil {
+			klog.Context(ctx).Warnw("msg", "update api key last used failed", "error", err, "apiKeyUID", apiKeyDO.UID)
+		}
+		apiKeyDO.LastUsedAt = &now
+	}
+	return convertAPIKeyModel(apiKeyDO), nil
+}
+
+func convertAPIKeyModel(apiKeyDO *model.APIKey) *authv1.APIKeyModel {
+	apiKey := &authv1.APIKeyModel{
+		Uid:        apiKeyDO.UID.Int64(),
+		UserUID:    apiKeyDO.UserUID.Int64(),
+		Name:       apiKeyDO.Name,
+		Prefix:     apiKeyDO.Prefix,
+		Scopes:     apiKeyDO.Scopes,
+		Namespaces: apiKeyDO.Namespaces,
+		CreatedAt:  apiKeyDO.CreatedAt.Unix(),
+	}
+	if apiKeyDO.User != nil {
+		apiKey.Username = apiKeyDO.User.Email
+	}
+	if apiKeyDO.ExpiresAt != nil {
+		apiKey.ExpiresAt = apiKeyDO.ExpiresAt.Unix()
+	}
+	if apiKeyDO.LastUsedAt != nil {
+		apiKey.LastUsedAt = apiKeyDO.LastUsedAt.Unix()
+	}
+	return apiKey
+}
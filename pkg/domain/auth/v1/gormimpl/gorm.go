@@ -5,6 +5,8 @@ import (
 	"context"
 	"errors"
 	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/aide-family/magicbox/hello"
 	"github.com/aide-family/magicbox/pointer"
@@ -21,6 +23,7 @@ import (
 	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
 	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
 	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/enum"
 	"github.com/aide-family/sovereign/pkg/merr"
 )
 
@@ -63,10 +66,6 @@ func (g *gormRepository) Login(ctx context.Context, req *authv1.LoginRequest) (*
 		klog.Context(ctx).Debugw("msg", "user is nil")
 		return nil, merr.ErrorInvalidArgument("user is nil")
 	}
-	if pointer.IsNil(oauthConfig) {
-		klog.Context(ctx).Debugw("msg", "oauthConfig is nil")
-		return nil, merr.ErrorInvalidArgument("oauthConfig is nil")
-	}
 	if email := user.GetEmail(); strutil.IsEmpty(email) {
 		klog.Context(ctx).Debugw("msg", "email is empty")
 		return nil, merr.ErrorInvalidArgument("email is empty")
@@ -78,23 +77,34 @@ func (g *gormRepository) Login(ctx context.Context, req *authv1.LoginRequest) (*
 		return nil, err
 	}
 
-	// 2. check if user exists
-	userDO, err := g.findOrCreateUser(ctx, user)
+	// 2. find the user of the identity, its first login links it by email according to the link policy
+	userDO, err := g.findLinkedUser(ctx, oauth2UserDO)
 	if err != nil {
 		return nil, err
 	}
+	if pointer.IsNil(userDO) {
+		if userDO, err = g.linkUserByEmail(ctx, req, oauth2UserDO); err != nil {
+			return nil, err
+		}
+	} else if err := g.markEmailVerified(ctx, userDO, user); err != nil {
+		return nil, err
+	}
 
-	// bind user and oauth2 user
-	if err := g.bindUserAndOAuth2User(ctx, userDO, oauth2UserDO); err != nil {
+	if err := checkUserEnabled(userDO); err != nil {
 		return nil, err
 	}
 
-	// generate token
-	token, err := g.generateToken(ctx, userDO)
+	// generate token, or the MFA challenge of the users who enabled MFA
+	token, err := g.issueLoginToken(ctx, userDO, req.GetAmr())
 	if err != nil {
 		return nil, err
 	}
 
+	// logins without a browser redirect, such as LDAP, only need the token
+	if pointer.IsNil(oauthConfig) {
+		return &authv1.LoginResponse{Token: token, User: convertUserModel(userDO)}, nil
+	}
+
 	// build redirect url
 	redirectURL, err := g.buildRedirectURL(token, oauthConfig.GetRedirectURL())
 	if err != nil {
@@ -102,17 +112,72 @@ func (g *gormRepository) Login(ctx context.Context, req *authv1.LoginRequest) (*
 	}
 	return &authv1.LoginResponse{
 		RedirectURL: redirectURL,
+		Token:       token,
+		User:        convertUserModel(userDO),
 	}, nil
 }
 
+// GetUser implements [authv1.Repository].
+func (g *gormRepository) GetUser(ctx context.Context, req *authv1.GetUserRequest) (*authv1.UserModel, error) {
+	if strutil.IsNotEmpty(req.GetApp()) {
+		return g.getIdentityUser(ctx, req.GetApp(), req.GetOpenID())
+	}
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(req.GetUid())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("user %d not found", req.GetUid())
+		}
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	return convertUserModel(userDO), nil
+}
+
+// getIdentityUser returns the user the identity is linked to, an unknown identity and an identity not linked yet are not found.
+func (g *gormRepository) getIdentityUser(ctx context.Context, app, openID string) (*authv1.UserModel, error) {
+	oauth2Mutation := query.OAuth2User
+	oauth2UserDO, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.APP.Eq(app), oauth2Mutation.OpenID.Eq(openID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("identity %s of %s not found", openID, app)
+		}
+		return nil, merr.ErrorInternal("get oauth2 user failed").WithCause(err)
+	}
+	userDO, err := g.findLinkedUser(ctx, oauth2UserDO)
+	if err != nil {
+		return nil, err
+	}
+	if userDO == nil {
+		return nil, merr.ErrorNotFound("identity %s of %s is not linked to a user", openID, app)
+	}
+	return convertUserModel(userDO), nil
+}
+
+func convertUserModel(userDO *model.User) *authv1.UserModel {
+	return &authv1.UserModel{
+		Id:            userDO.ID,
+		Uid:           userDO.UID.Int64(),
+		Name:          userDO.Name,
+		Nickname:      userDO.Nickname,
+		Email:         userDO.Email,
+		Avatar:        userDO.Avatar,
+		Remark:        userDO.Remark,
+		Status:        int32(userDO.Status),
+		CreatedAt:     userDO.CreatedAt.Unix(),
+		UpdatedAt:     userDO.UpdatedAt.Unix(),
+		EmailVerified: userDO.EmailVerified,
+	}
+}
+
 func (g *gormRepository) findOrCreateOAuth2User(ctx context.Context, user *authv1.User) (*model.OAuth2User, error) {
 	oauth2Mutation := query.OAuth2User
-	oauth2UserDO, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.OpenID.Eq(user.GetOpenID())).First()
+	oauth2UserDO, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.APP.Eq(user.GetApp()), oauth2Mutation.OpenID.Eq(user.GetOpenID())).First()
 	if err != nil {
 		if !errors.Is(err, gorm.ErrRecordNotFound) {
-			klog.Context(ctx).Debugw("msg", "get oauth2 user failed", "error", err, "openID", user.GetOpenID())
+			klog.Context(ctx).Debugw("msg", "get oauth2 user failed", "error", err, "app", user.GetApp(), "openID", user.GetOpenID())
 			return nil, merr.ErrorInternal("get oauth2 user failed").WithCause(err)
 		}
+		// the identity is not linked to any user until its uid is replaced by the uid of a user
 		oauth2UserDO = &model.OAuth2User{
 			OpenID: user.GetOpenID(),
 			Name:   user.GetName(),
@@ -124,13 +189,31 @@ func (g *gormRepository) findOrCreateOAuth2User(ctx context.Context, user *authv
 		}
 		if err := oauth2Mutation.WithContext(ctx).Create(oauth2UserDO); err != nil {
 			klog.Context(ctx).Debugw("msg", "create oauth2 user failed", "error", err, "oauth2UserUID", oauth2UserDO.UID)
-			return nil, merr.ErrorInternal("create oauth2 user failed").WithCause(err).WithCause(err)
+			return nil, merr.ErrorInternal("create oauth2 user failed").WithCause(err)
 		}
 	}
 	return oauth2UserDO, nil
 }
 
-func (g *gormRepository) findOrCreateUser(ctx context.Context, user *authv1.User) (*model.User, error) {
+// findLinkedUser returns the user the identity is linked to, nil before the identity is linked.
+func (g *gormRepository) findLinkedUser(ctx context.Context, oauth2UserDO *model.OAuth2User) (*model.User, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(int64(oauth2UserDO.UID))).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		klog.Context(ctx).Debugw("msg", "get user failed", "error", err, "userUID", oauth2UserDO.UID)
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	return userDO, nil
+}
+
+// linkUserByEmail links the first login of an identity to the user of its email, or to a new user when no user has the email.
+// An existing user is only linked under the verified email policy when both the identity and the user verified the email,
+// so an account with the same unverified email at any app cannot take the user over.
+func (g *gormRepository) linkUserByEmail(ctx context.Context, req *authv1.LoginRequest, oauth2UserDO *model.OAuth2User) (*model.User, error) {
+	user, policy := req.GetUser(), req.GetLinkPolicy()
 	userMutation := query.User
 	userDO, err := userMutation.WithContext(ctx).Where(userMutation.Email.Eq(user.GetEmail())).First()
 	if err != nil {
@@ -138,49 +221,88 @@ func (g *gormRepository) findOrCreateUser(ctx context.Context, user *authv1.User
 			klog.Context(ctx).Debugw("msg", "get user failed", "error", err, "email", user.GetEmail())
 			return nil, merr.ErrorInternal("get user failed").WithCause(err)
 		}
-		userDO = &model.User{
-			Email:    user.GetEmail(),
-			Name:     user.GetName(),
-			Avatar:   user.GetAvatar(),
-			Remark:   user.GetRemark(),
-			Nickname: user.GetNickname(),
-			UID:      g.node.Generate(),
+		return g.createLinkedUser(ctx, req, oauth2UserDO)
+	}
+	if policy != authv1.LinkPolicy_LINK_POLICY_VERIFIED_EMAIL || !user.GetEmailVerified() || !userDO.EmailVerified {
+		klog.Context(ctx).Infow("msg", "identity not linked by email", "app", user.GetApp(), "openID", user.GetOpenID(), "userUID", userDO.UID, "policy", policy, "emailVerified", user.GetEmailVerified())
+		return nil, merr.ErrorForbidden("email %s belongs to an existing user, sign in to it and link the %s account there", user.GetEmail(), user.GetApp())
+	}
+	if err := checkUserEnabled(userDO); err != nil {
+		return nil, err
+	}
+	err = query.Use(g.db).Transaction(func(tx *query.Query) error {
+		return g.linkIdentity(ctx, tx, userDO, oauth2UserDO, user, userDO.UID, linkReasonVerifiedEmail)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return userDO, nil
+}
+
+// createLinkedUser signs up a new user with the identity, using up the invitation of the login when the provider is invite-only.
+func (g *gormRepository) createLinkedUser(ctx context.Context, req *authv1.LoginRequest, oauth2UserDO *model.OAuth2User) (*model.User, error) {
+	user := req.GetUser()
+	userDO := &model.User{
+		Email:         user.GetEmail(),
+		EmailVerified: user.GetEmailVerified(),
+		Name:          user.GetName(),
+		Avatar:        user.GetAvatar(),
+		Remark:        user.GetRemark(),
+		Nickname:      user.GetNickname(),
+		Status:        uint8(enum.GlobalStatus_ENABLED),
+		UID:           g.node.Generate(),
+	}
+	err := query.Use(g.db).Transaction(func(tx *query.Query) error {
+		if req.GetInviteOnly() {
+			if err := g.useInvitation(ctx, tx, req.GetInvitationCode(), user.GetApp()); err != nil {
+				return err
+			}
 		}
-		if err := userMutation.WithContext(ctx).Create(userDO); err != nil {
+		if err := tx.User.WithContext(ctx).Create(userDO); err != nil {
 			klog.Context(ctx).Debugw("msg", "create user failed", "error", err, "userUID", userDO.UID)
-			return nil, merr.ErrorInternal("create user failed").WithCause(err)
+			return merr.ErrorInternal("create user failed").WithCause(err)
 		}
+		return g.linkIdentity(ctx, tx, userDO, oauth2UserDO, user, userDO.UID, linkReasonSignup)
+	})
+	if err != nil {
+		return nil, err
 	}
 	return userDO, nil
 }
 
-func (g *gormRepository) bindUserAndOAuth2User(ctx context.Context, user *model.User, oauth2User *model.OAuth2User) error {
-	if int64(oauth2User.UID) == int64(user.UID) {
+// markEmailVerified marks the email of the user verified when an identity linked to it verified the same email.
+func (g *gormRepository) markEmailVerified(ctx context.Context, userDO *model.User, user *authv1.User) error {
+	if userDO.EmailVerified || !user.GetEmailVerified() || !strings.EqualFold(userDO.Email, user.GetEmail()) {
 		return nil
 	}
-	oauth2Mutation := query.OAuth2User
-	if _, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.UID.Eq(int64(oauth2User.UID))).Update(oauth2Mutation.UID, int64(user.UID)); err != nil {
-		klog.Context(ctx).Debugw("msg", "update oauth2 user failed", "error", err, "oauth2UserUID", oauth2User.UID, "userUID", user.UID)
-		return merr.ErrorInternal("update oauth2 user failed").WithCause(err)
+	userMutation := query.User
+	if _, err := userMutation.WithContext(ctx).Where(userMutation.ID.Eq(userDO.ID)).Update(userMutation.EmailVerified, true); err != nil {
+		return merr.ErrorInternal("update user failed").WithCause(err)
 	}
+	userDO.EmailVerified = true
 	return nil
 }
 
-func (g *gormRepository) generateToken(ctx context.Context, user *model.User) (string, error) {
-	claims := authv1.NewJwtClaims(g.jwtConfig, authv1.BaseInfo{
-		UID:      user.UID,
-		Username: user.Email,
-	})
-	return claims.GenerateToken()
-}
-
-func (g *gormRepository) buildRedirectURL(token, redirectURL string) (string, error) {
+// buildRedirectURL carries the MFA challenge in the query, and the tokens in the fragment,
+// which the browser neither sends to the servers of the redirect nor puts in the Referer header.
+func (g *gormRepository) buildRedirectURL(token *authv1.TokenModel, redirectURL string) (string, error) {
 	urlObj, err := url.Parse(redirectURL)
 	if err != nil {
 		return "", merr.ErrorInvalidArgument("invalid redirect URL").WithCause(err)
 	}
-	query := urlObj.Query()
-	query.Set("token", token)
-	urlObj.RawQuery = query.Encode()
+	if challenge := token.GetMfaChallenge(); challenge != "" {
+		query := urlObj.Query()
+		query.Set("mfa_challenge", challenge)
+		query.Set("mfa_challenge_expires_at", strconv.FormatInt(token.GetMfaChallengeExpiresAt(), 10))
+		urlObj.RawQuery = query.Encode()
+		return urlObj.String(), nil
+	}
+	fragment := url.Values{}
+	fragment.Set("token", token.GetToken())
+	fragment.Set("refresh_token", token.GetRefreshToken())
+	urlObj.RawFragment = fragment.Encode()
+	if urlObj.Fragment, err = url.PathUnescape(urlObj.RawFragment); err != nil {
+		return "", merr.ErrorInternal("invalid redirect URL fragment").WithCause(err)
+	}
 	return urlObj.String(), nil
 }
@@ -0,0 +1,145 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// CreateInvitation implements [authv1.Repository].
+func (g *gormRepository) CreateInvitation(ctx context.Context, req *authv1.CreateInvitationRequest) (*authv1.CreateInvitationResponse, error) {
+	code, prefix, hash := authv1.NewInvitationCode()
+	invitationDO := &model.Invitation{
+		UID:        g.node.Generate(),
+		Provider:   req.GetProvider(),
+		Prefix:     prefix,
+		CodeHash:   hash,
+		MaxUses:    req.GetMaxUses(),
+		CreatorUID: snowflake.ParseInt64(req.GetCreatorUID()),
+		Remark:     req.GetRemark(),
+	}
+	if req.GetExpiresAt() > 0 {
+		expiresAt := time.Unix(req.GetExpiresAt(), 0)
+		invitationDO.ExpiresAt = &expiresAt
+	}
+	if err := query.Invitation.WithContext(ctx).Create(invitationDO); err != nil {
+		klog.Context(ctx).Debugw("msg", "create invitation failed", "error", err, "provider", req.GetProvider())
+		return nil, merr.ErrorInternal("create invitation failed").WithCause(err)
+	}
+	return &authv1.CreateInvitationResponse{Invitation: convertInvitationModel(invitationDO), Code: code}, nil
+}
+
+// ListInvitations implements [authv1.Repository].
+func (g *gormRepository) ListInvitations(ctx context.Context, req *authv1.ListInvitationsRequest) (*authv1.ListInvitationsResponse, error) {
+	invitationMutation := query.Invitation
+	wrappers := invitationMutation.WithContext(ctx)
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count invitations failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(cursorCondition(invitationMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(cursorOrder(invitationMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	invitationDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list invitations failed").WithCause(err)
+	}
+	invitationDOs, prevCursor, nextCursor := authv1.PageWindow(invitationDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(invitationDO *model.Invitation) uint32 {
+		return invitationDO.ID
+	})
+	items := make([]*authv1.InvitationModel, 0, len(invitationDOs))
+	for _, invitationDO := range invitationDOs {
+		items = append(items, convertInvitationModel(invitationDO))
+	}
+	return &authv1.ListInvitationsResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// DeleteInvitation implements [authv1.Repository].
+func (g *gormRepository) DeleteInvitation(ctx context.Context, req *authv1.DeleteInvitationRequest) (*authv1.DeleteInvitationResponse, error) {
+	invitationMutation := query.Invitation
+	result, err := invitationMutation.WithContext(ctx).Where(invitationMutation.UID.Eq(req.GetUid())).Delete()
+	if err != nil {
+		return nil, merr.ErrorInternal("delete invitation failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, merr.ErrorNotFound("invitation %d not found", req.GetUid())
+	}
+	return &authv1.DeleteInvitationResponse{}, nil
+}
+
+// useInvitation uses up the invitation of the code for a sign-up with the provider, the use is counted in the transaction
+// of the sign-up, so that an invitation is not used by more sign-ups than it allows.
+func (g *gormRepository) useInvitation(ctx context.Context, tx *query.Query, code, provider string) error {
+	if code == "" {
+		return merr.ErrorForbidden("signing up with %s requires an invitation", provider)
+	}
+	invitationMutation := tx.Invitation
+	invitationDO, err := invitationMutation.WithContext(ctx).Where(invitationMutation.CodeHash.Eq(authv1.HashInvitationCode(code))).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return merr.ErrorForbidden("invitation is invalid")
+		}
+		return merr.ErrorInternal("get invitation failed").WithCause(err)
+	}
+	if invitationDO.Provider != "" && !strings.EqualFold(invitationDO.Provider, provider) {
+		return merr.ErrorForbidden("invitation is not for %s", provider)
+	}
+	if invitationDO.ExpiresAt != nil && time.Now().After(*invitationDO.ExpiresAt) {
+		return merr.ErrorForbidden("invitation is expired")
+	}
+	result, err := invitationMutation.WithContext(ctx).
+		Where(invitationMutation.ID.Eq(invitationDO.ID)).
+		Where(invitationMutation.WithContext(ctx).Where(invitationMutation.MaxUses.Eq(0)).Or(invitationMutation.UsedCount.LtCol(invitationMutation.MaxUses))).
+		UpdateSimple(invitationMutation.UsedCount.Add(1))
+	if err != nil {
+		return merr.ErrorInternal("use invitation failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		return merr.ErrorForbidden("invitation is used up")
+	}
+	klog.Context(ctx).Infow("msg", "invitation used", "invitationUID", invitationDO.UID, "provider", provider)
+	return nil
+}
+
+func convertInvitationModel(invitationDO *model.Invitation) *authv1.InvitationModel {
+	invitation := &authv1.InvitationModel{
+		Uid:        invitationDO.UID.Int64(),
+		Provider:   invitationDO.Provider,
+		Prefix:     invitationDO.Prefix,
+		MaxUses:    invitationDO.MaxUses,
+		UsedCount:  invitationDO.UsedCount,
+		CreatorUID: invitationDO.CreatorUID.Int64(),
+		Remark:     invitationDO.Remark,
+		CreatedAt:  invitationDO.CreatedAt.Unix(),
+	}
+	if invitationDO.ExpiresAt != nil {
+		invitation.ExpiresAt = invitationDO.ExpiresAt.Unix()
+	}
+	return invitation
+}
@@ -0,0 +1,194 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// HitLoginLimit implements [authv1.Repository].
+// Every step is a single statement, so that the hits of the replicas sharing the database are all counted.
+func (g *gormRepository) HitLoginLimit(ctx context.Context, req *authv1.HitLoginLimitRequest) (*authv1.LoginLimitModel, error) {
+	limitMutation := query.LoginLimit
+	now := time.Now()
+	windowEnd := now.Add(time.Duration(req.GetWindow()) * time.Second)
+	// the window of the key ended, the hit starts a new one
+	result, err := limitMutation.WithContext(ctx).
+		Where(limitMutation.Key.Eq(req.GetKey()), limitMutation.WindowEnd.Lte(now)).
+		UpdateSimple(limitMutation.Hits.Value(1), limitMutation.WindowEnd.Value(windowEnd))
+	if err != nil {
+		return nil, merr.ErrorInternal("hit login limit failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		result, err = limitMutation.WithContext(ctx).
+			Where(limitMutation.Key.Eq(req.GetKey()), limitMutation.WindowEnd.Gt(now)).
+			UpdateSimple(limitMutation.Hits.Add(1))
+		if err != nil {
+			return nil, merr.ErrorInternal("hit login limit failed").WithCause(err)
+		}
+	}
+	if result.RowsAffected == 0 {
+		if err := g.createLoginLimit(ctx, req.GetKey(), windowEnd, now); err != nil {
+			return nil, err
+		}
+	}
+	return g.getLoginLimit(ctx, req.GetKey())
+}
+
+// createLoginLimit creates the first hit of the key, a replica creating it at the same time counts its hit on the created row,
+// the keys whose window and lockout ended are removed first.
+func (g *gormRepository) createLoginLimit(ctx context.Context, key string, windowEnd, now time.Time) error {
+	limitMutation := query.LoginLimit
+	_, err := limitMutation.WithContext(ctx).
+		Where(limitMutation.WindowEnd.Lt(now)).
+		Where(limitMutation.WithContext(ctx).Where(limitMutation.LockedUntil.IsNull()).Or(limitMutation.LockedUntil.Lt(now))).
+		Delete()
+	if err != nil {
+		return merr.ErrorInternal("delete expired login limits failed").WithCause(err)
+	}
+	limitDO := &model.LoginLimit{Key: key, Hits: 1, WindowEnd: windowEnd}
+	result := g.db.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(limitDO)
+	if result.Error != nil {
+		return merr.ErrorInternal("create login limit failed").WithCause(result.Error)
+	}
+	if result.RowsAffected > 0 {
+		return nil
+	}
+	if _, err := limitMutation.WithContext(ctx).Where(limitMutation.Key.Eq(key)).UpdateSimple(limitMutation.Hits.Add(1)); err != nil {
+		return merr.ErrorInternal("hit login limit failed").WithCause(err)
+	}
+	return nil
+}
+
+// LockLoginLimit implements [authv1.Repository].
+func (g *gormRepository) LockLoginLimit(ctx context.Context, req *authv1.LockLoginLimitRequest) (*authv1.LoginLimitModel, error) {
+	limitMutation := query.LoginLimit
+	lockedUntil := time.Unix(req.GetLockedUntil(), 0)
+	limitDO := &model.LoginLimit{Key: req.GetKey(), WindowEnd: time.Now(), LockedUntil: &lockedUntil}
+	err := limitMutation.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: limitMutation.Key.ColumnName().String()}},
+		DoUpdates: clause.AssignmentColumns([]string{limitMutation.LockedUntil.ColumnName().String()}),
+	}).Create(limitDO)
+	if err != nil {
+		return nil, merr.ErrorInternal("lock login limit failed").WithCause(err)
+	}
+	return g.getLoginLimit(ctx, req.GetKey())
+}
+
+// GetLoginLimit implements [authv1.Repository], a key which was never hit has no hits and no lockout.
+func (g *gormRepository) GetLoginLimit(ctx context.Context, req *authv1.GetLoginLimitRequest) (*authv1.LoginLimitModel, error) {
+	return g.getLoginLimit(ctx, req.GetKey())
+}
+
+// ResetLoginLimit implements [authv1.Repository].
+func (g *gormRepository) ResetLoginLimit(ctx context.Context, req *authv1.ResetLoginLimitRequest) (*authv1.ResetLoginLimitResponse, error) {
+	limitMutation := query.LoginLimit
+	if _, err := limitMutation.WithContext(ctx).Where(limitMutation.Key.Eq(req.GetKey())).UpdateSimple(limitMutation.Hits.Value(0)); err != nil {
+		return nil, merr.ErrorInternal("reset login limit failed").WithCause(err)
+	}
+	return &authv1.ResetLoginLimitResponse{}, nil
+}
+
+func (g *gormRepository) getLoginLimit(ctx context.Context, key string) (*authv1.LoginLimitModel, error) {
+	limitMutation := query.LoginLimit
+	limitDO, err := limitMutation.WithContext(ctx).Where(limitMutation.Key.Eq(key)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &authv1.LoginLimitModel{Key: key}, nil
+		}
+		return nil, merr.ErrorInternal("get login limit failed").WithCause(err)
+	}
+	limit := &authv1.LoginLimitModel{
+		Key:       limitDO.Key,
+		Count:     limitDO.Hits,
+		WindowEnd: limitDO.WindowEnd.Unix(),
+	}
+	if limitDO.LockedUntil != nil {
+		limit.LockedUntil = limitDO.LockedUntil.Unix()
+	}
+	return limit, nil
+}
+
+// CreateLoginAuditEvent implements [authv1.Repository].
+func (g *gormRepository) CreateLoginAuditEvent(ctx context.Context, req *authv1.CreateLoginAuditEventRequest) (*authv1.LoginAuditEventModel, error) {
+	event := req.GetEvent()
+	eventDO := &model.LoginAuditEvent{
+		Action:      event.GetAction(),
+		Kind:        event.GetKind(),
+		Subject:     event.GetSubject(),
+		Provider:    event.GetProvider(),
+		IP:          event.GetIp(),
+		Failures:    event.GetFailures(),
+		LockedUntil: time.Unix(event.GetLockedUntil(), 0),
+	}
+	if err := query.LoginAuditEvent.WithContext(ctx).Create(eventDO); err != nil {
+		return nil, merr.ErrorInternal("create login audit event failed").WithCause(err)
+	}
+	return convertLoginAuditEventModel(eventDO), nil
+}
+
+// ListLoginAuditEvents implements [authv1.Repository].
+func (g *gormRepository) ListLoginAuditEvents(ctx context.Context, req *authv1.ListLoginAuditEventsRequest) (*authv1.ListLoginAuditEventsResponse, error) {
+	eventMutation := query.LoginAuditEvent
+	wrappers := eventMutation.WithContext(ctx)
+	if subject := req.GetSubject(); subject != "" {
+		wrappers = wrappers.Where(eventMutation.Subject.Eq(subject))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count login audit events failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(cursorCondition(eventMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(cursorOrder(eventMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	eventDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list login audit events failed").WithCause(err)
+	}
+	eventDOs, prevCursor, nextCursor := authv1.PageWindow(eventDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(eventDO *model.LoginAuditEvent) uint32 {
+		return eventDO.ID
+	})
+	items := make([]*authv1.LoginAuditEventModel, 0, len(eventDOs))
+	for _, eventDO := range eventDOs {
+		items = append(items, convertLoginAuditEventModel(eventDO))
+	}
+	return &authv1.ListLoginAuditEventsResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func convertLoginAuditEventModel(eventDO *model.LoginAuditEvent) *authv1.LoginAuditEventModel {
+	return &authv1.LoginAuditEventModel{
+		Id:          eventDO.ID,
+		Action:      eventDO.Action,
+		Kind:        eventDO.Kind,
+		Subject:     eventDO.Subject,
+		Provider:    eventDO.Provider,
+		Ip:          eventDO.IP,
+		Failures:    eventDO.Failures,
+		LockedUntil: eventDO.LockedUntil.Unix(),
+		CreatedAt:   eventDO.CreatedAt.Unix(),
+	}
+}
@@ -0,0 +1,300 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// SetRoleMFA implements [authv1.Repository].
+func (g *gormRepository) SetRoleMFA(ctx context.Context, req *authv1.SetRoleMFARequest) (*authv1.RoleModel, error) {
+	roleDO, err := g.getRole(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	roleMutation := query.Role
+	if _, err := roleMutation.WithContext(ctx).Where(roleMutation.ID.Eq(roleDO.ID)).Update(roleMutation.RequireMFA, req.GetRequireMfa()); err != nil {
+		return nil, merr.ErrorInternal("update role failed").WithCause(err)
+	}
+	if roleDO, err = g.getRole(ctx, req.GetUid()); err != nil {
+		return nil, err
+	}
+	return convertRoleModel(roleDO), nil
+}
+
+// GetUserMFA implements [authv1.Repository], a user who never enrolled has an empty secret.
+func (g *gormRepository) GetUserMFA(ctx context.Context, req *authv1.GetUserMFARequest) (*authv1.UserMFAModel, error) {
+	return g.getUserMFA(ctx, req.GetUserUID())
+}
+
+// SaveUserMFA implements [authv1.Repository].
+func (g *gormRepository) SaveUserMFA(ctx context.Context, req *authv1.SaveUserMFARequest) (*authv1.UserMFAModel, error) {
+	mfaMutation := query.UserMFA
+	mfaDO, err := mfaMutation.WithContext(ctx).Where(mfaMutation.UserUID.Eq(req.GetUserUID())).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorInternal("get user MFA failed").WithCause(err)
+		}
+		mfaDO = &model.UserMFA{UserUID: snowflake.ParseInt64(req.GetUserUID())}
+	}
+	if mfaDO.Enabled {
+		return nil, merr.ErrorParams("MFA is already enabled, disable it before enrolling again")
+	}
+	mfaDO.Secret = req.GetSecret()
+	mfaDO.LastStep = 0
+	if err := mfaMutation.WithContext(ctx).Save(mfaDO); err != nil {
+		return nil, merr.ErrorInternal("save user MFA failed").WithCause(err)
+	}
+	return g.getUserMFA(ctx, req.GetUserUID())
+}
+
+// EnableUserMFA implements [authv1.Repository].
+func (g *gormRepository) EnableUserMFA(ctx context.Context, req *authv1.EnableUserMFARequest) (*authv1.UserMFAModel, error) {
+	err := query.Use(g.db).Transaction(func(tx *query.Query) error {
+		mfaMutation := tx.UserMFA
+		result, err := mfaMutation.WithContext(ctx).
+			Where(mfaMutation.UserUID.Eq(req.GetUserUID()), mfaMutation.Enabled.Is(false), mfaMutation.Secret.Neq("")).
+			UpdateSimple(mfaMutation.Enabled.Value(true), mfaMutation.LastStep.Value(req.GetStep()), mfaMutation.EnabledAt.Value(time.Now()))
+		if err != nil {
+			return merr.ErrorInternal("enable user MFA failed").WithCause(err)
+		}
+		if result.RowsAffected == 0 {
+			return merr.ErrorParams("there is no MFA enrollment to confirm")
+		}
+		return g.replaceRecoveryCodes(ctx, tx, req.GetUserUID(), req.GetRecoveryCodeHashes())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g.getUserMFA(ctx, req.GetUserUID())
+}
+
+// DeleteUserMFA implements [authv1.Repository], the recovery codes and the waiting challenges of the user are removed too.
+func (g *gormRepository) DeleteUserMFA(ctx context.Context, req *authv1.DeleteUserMFARequest) (*authv1.DeleteUserMFAResponse, error) {
+	err := query.Use(g.db).Transaction(func(tx *query.Query) error {
+		if _, err := tx.UserMFA.WithContext(ctx).Where(tx.UserMFA.UserUID.Eq(req.GetUserUID())).Delete(); err != nil {
+			return err
+		}
+		if _, err := tx.MFARecoveryCode.WithContext(ctx).Where(tx.MFARecoveryCode.UserUID.Eq(req.GetUserUID())).Delete(); err != nil {
+			return err
+		}
+		_, err := tx.MFAChallenge.WithContext(ctx).Where(tx.MFAChallenge.UserUID.Eq(req.GetUserUID())).Delete()
+		return err
+	})
+	if err != nil {
+		return nil, merr.ErrorInternal("delete user MFA failed").WithCause(err)
+	}
+	return &authv1.DeleteUserMFAResponse{}, nil
+}
+
+// SaveMFARecoveryCodes implements [authv1.Repository].
+func (g *gormRepository) SaveMFARecoveryCodes(ctx context.Context, req *authv1.SaveMFARecoveryCodesRequest) (*authv1.UserMFAModel, error) {
+	err := query.Use(g.db).Transaction(func(tx *query.Query) error {
+		return g.replaceRecoveryCodes(ctx, tx, req.GetUserUID(), req.GetRecoveryCodeHashes())
+	})
+	if err != nil {
+		return nil, err
+	}
+	return g.getUserMFA(ctx, req.GetUserUID())
+}
+
+// UseMFACode implements [authv1.Repository].
+// Both checks are a single conditional update, so that a code used by two requests at the same time is accepted once.
+func (g *gormRepository) UseMFACode(ctx context.Context, req *authv1.UseMFACodeRequest) (*authv1.UseMFACodeResponse, error) {
+	if codeHash := req.GetRecoveryCodeHash(); codeHash != "" {
+		codeMutation := query.MFARecoveryCode
+		result, err := codeMutation.WithContext(ctx).
+			Where(codeMutation.UserUID.Eq(req.GetUserUID()), codeMutation.CodeHash.Eq(codeHash), codeMutation.UsedAt.IsNull()).
+			UpdateSimple(codeMutation.UsedAt.Value(time.Now()))
+		if err != nil {
+			return nil, merr.ErrorInternal("use recovery code failed").WithCause(err)
+		}
+		return &authv1.UseMFACodeResponse{Accepted: result.RowsAffected > 0}, nil
+	}
+	mfaMutation := query.UserMFA
+	result, err := mfaMutation.WithContext(ctx).
+		Where(mfaMutation.UserUID.Eq(req.GetUserUID()), mfaMutation.Enabled.Is(true), mfaMutation.LastStep.Lt(req.GetStep())).
+		UpdateSimple(mfaMutation.LastStep.Value(req.GetStep()))
+	if err != nil {
+		return nil, merr.ErrorInternal("use TOTP code failed").WithCause(err)
+	}
+	return &authv1.UseMFACodeResponse{Accepted: result.RowsAffected > 0}, nil
+}
+
+// GetMFAChallenge implements [authv1.Repository].
+func (g *gormRepository) GetMFAChallenge(ctx context.Context, req *authv1.GetMFAChallengeRequest) (*authv1.MFAChallengeModel, error) {
+	challengeDO, err := g.findMFAChallenge(ctx, query.Use(g.db), req.GetChallenge())
+	if err != nil {
+		return nil, err
+	}
+	return convertMFAChallengeModel(challengeDO), nil
+}
+
+// FailMFAChallenge implements [authv1.Repository].
+func (g *gormRepository) FailMFAChallenge(ctx context.Context, req *authv1.FailMFAChallengeRequest) (*authv1.MFAChallengeModel, error) {
+	mutation := query.Use(g.db)
+	challengeDO, err := g.findMFAChallenge(ctx, mutation, req.GetChallenge())
+	if err != nil {
+		return nil, err
+	}
+	challengeMutation := mutation.MFAChallenge
+	if _, err := challengeMutation.WithContext(ctx).Where(challengeMutation.ID.Eq(challengeDO.ID)).UpdateSimple(challengeMutation.Attempts.Add(1)); err != nil {
+		return nil, merr.ErrorInternal("fail MFA challenge failed").WithCause(err)
+	}
+	if challengeDO, err = g.findMFAChallenge(ctx, mutation, req.GetChallenge()); err != nil {
+		return nil, err
+	}
+	if challengeDO.Attempts >= authv1.MFAChallengeMaxAttempts {
+		if _, err := challengeMutation.WithContext(ctx).Where(challengeMutation.ID.Eq(challengeDO.ID)).Delete(); err != nil {
+			return nil, merr.ErrorInternal("delete MFA challenge failed").WithCause(err)
+		}
+	}
+	return convertMFAChallengeModel(challengeDO), nil
+}
+
+// CompleteMFAChallenge implements [authv1.Repository].
+func (g *gormRepository) CompleteMFAChallenge(ctx context.Context, req *authv1.CompleteMFAChallengeRequest) (*authv1.TokenModel, error) {
+	var token *authv1.TokenModel
+	err := query.Use(g.db).Transaction(func(tx *query.Query) error {
+		challengeDO, err := g.findMFAChallenge(ctx, tx, req.GetChallenge())
+		if err != nil {
+			return err
+		}
+		result, err := tx.MFAChallenge.WithContext(ctx).Where(tx.MFAChallenge.ID.Eq(challengeDO.ID)).Delete()
+		if err != nil {
+			return merr.ErrorInternal("delete MFA challenge failed").WithCause(err)
+		}
+		if result.RowsAffected == 0 {
+			return merr.ErrorUnauthorized("MFA challenge is already used, please login again")
+		}
+		userDO, err := tx.User.WithContext(ctx).Where(tx.User.UID.Eq(challengeDO.UserUID.Int64())).First()
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return merr.ErrorUnauthorized("user of the MFA challenge is not found")
+			}
+			return merr.ErrorInternal("get user failed").WithCause(err)
+		}
+		if err := checkUserEnabled(userDO); err != nil {
+			return err
+		}
+		amr := append(slices.Clone(challengeDO.AMR), req.GetMethod(), authv1.AMRMFA)
+		token, err = g.issueToken(ctx, tx, userDO, g.node.Generate(), amr)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// issueLoginToken issues the tokens of a login, every login starts a new refresh token family,
+// the login of a user who enabled MFA gets an MFA challenge instead.
+func (g *gormRepository) issueLoginToken(ctx context.Context, userDO *model.User, amr []string) (*authv1.TokenModel, error) {
+	mfaMutation := query.UserMFA
+	count, err := mfaMutation.WithContext(ctx).Where(mfaMutation.UserUID.Eq(userDO.UID.Int64()), mfaMutation.Enabled.Is(true)).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("get user MFA failed").WithCause(err)
+	}
+	if count == 0 {
+		return g.issueToken(ctx, query.Use(g.db), userDO, g.node.Generate(), amr)
+	}
+
+	challengeMutation := query.MFAChallenge
+	if _, err := challengeMutation.WithContext(ctx).Where(challengeMutation.ExpiresAt.Lt(time.Now())).Delete(); err != nil {
+		klog.Context(ctx).Warnw("msg", "delete expired MFA challenges failed", "error", err)
+	}
+	challenge, challengeHash := authv1.NewRefreshToken()
+	challengeDO := &model.MFAChallenge{
+		ChallengeHash: challengeHash,
+		UserUID:       userDO.UID,
+		AMR:           amr,
+		ExpiresAt:     time.Now().Add(authv1.MFAChallengeExpire),
+	}
+	if err := challengeMutation.WithContext(ctx).Create(challengeDO); err != nil {
+		return nil, merr.ErrorInternal("create MFA challenge failed").WithCause(err)
+	}
+	return &authv1.TokenModel{
+		MfaChallenge:          challenge,
+		MfaChallengeExpiresAt: challengeDO.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (g *gormRepository) findMFAChallenge(ctx context.Context, mutation *query.Query, challenge string) (*model.MFAChallenge, error) {
+	if challenge == "" {
+		return nil, merr.ErrorUnauthorized("MFA challenge is required")
+	}
+	challengeMutation := mutation.MFAChallenge
+	challengeDO, err := challengeMutation.WithContext(ctx).
+		Where(challengeMutation.ChallengeHash.Eq(authv1.HashRefreshToken(challenge)), challengeMutation.ExpiresAt.Gt(time.Now())).
+		First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorUnauthorized("MFA challenge is invalid or expired, please login again")
+		}
+		return nil, merr.ErrorInternal("get MFA challenge failed").WithCause(err)
+	}
+	return challengeDO, nil
+}
+
+func (g *gormRepository) replaceRecoveryCodes(ctx context.Context, tx *query.Query, userUID int64, codeHashes []string) error {
+	codeMutation := tx.MFARecoveryCode
+	if _, err := codeMutation.WithContext(ctx).Where(codeMutation.UserUID.Eq(userUID)).Delete(); err != nil {
+		return merr.ErrorInternal("delete recovery codes failed").WithCause(err)
+	}
+	codeDOs := make([]*model.MFARecoveryCode, 0, len(codeHashes))
+	for _, codeHash := range codeHashes {
+		codeDOs = append(codeDOs, &model.MFARecoveryCode{UserUID: snowflake.ParseInt64(userUID), CodeHash: codeHash})
+	}
+	if len(codeDOs) == 0 {
+		return nil
+	}
+	if err := codeMutation.WithContext(ctx).Create(codeDOs...); err != nil {
+		return merr.ErrorInternal("create recovery codes failed").WithCause(err)
+	}
+	return nil
+}
+
+func (g *gormRepository) getUserMFA(ctx context.Context, userUID int64) (*authv1.UserMFAModel, error) {
+	mfaMutation := query.UserMFA
+	mfaDO, err := mfaMutation.WithContext(ctx).Where(mfaMutation.UserUID.Eq(userUID)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &authv1.UserMFAModel{UserUID: userUID}, nil
+		}
+		return nil, merr.ErrorInternal("get user MFA failed").WithCause(err)
+	}
+	codeMutation := query.MFARecoveryCode
+	count, err := codeMutation.WithContext(ctx).Where(codeMutation.UserUID.Eq(userUID), codeMutation.UsedAt.IsNull()).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count recovery codes failed").WithCause(err)
+	}
+	mfa := &authv1.UserMFAModel{
+		UserUID:       userUID,
+		Secret:        mfaDO.Secret,
+		Enabled:       mfaDO.Enabled,
+		RecoveryCodes: int32(count),
+	}
+	if mfaDO.EnabledAt != nil {
+		mfa.EnabledAt = mfaDO.EnabledAt.Unix()
+	}
+	return mfa, nil
+}
+
+func convertMFAChallengeModel(challengeDO *model.MFAChallenge) *authv1.MFAChallengeModel {
+	return &authv1.MFAChallengeModel{
+		UserUID:   challengeDO.UserUID.Int64(),
+		Amr:       challengeDO.AMR,
+		Attempts:  challengeDO.Attempts,
+		ExpiresAt: challengeDO.ExpiresAt.Unix(),
+	}
+}
@@ -12,6 +12,21 @@ func Models() []any {
 	return []any{
 		&User{},
 		&OAuth2User{},
+		&RefreshToken{},
+		&RevokedToken{},
+		&APIKey{},
+		&Role{},
+		&RoleBinding{},
+		&Policy{},
+		&UserPreference{},
+		&IdentityAuditEvent{},
+		&OAuth2Provider{},
+		&Invitation{},
+		&LoginLimit{},
+		&LoginAuditEvent{},
+		&UserMFA{},
+		&MFARecoveryCode{},
+		&MFAChallenge{},
 	}
 }
 
@@ -28,6 +43,10 @@ type User struct {
 	Avatar   string `gorm:"column:avatar;type:varchar(100);not null"`
 	Remark   string `gorm:"column:remark;type:varchar(100);not null"`
 	Status   uint8  `gorm:"column:status;type:tinyint;not null;default:0"`
+	// PasswordHash is the bcrypt hash of the local password, empty for users who only sign in with OAuth2
+	PasswordHash string `gorm:"column:password_hash;type:varchar(255);not null;default:''"`
+	// EmailVerified is set when an app verified the email, only a verified email links the first login of an identity to the user
+	EmailVerified bool `gorm:"column:email_verified;not null;default:false"`
 }
 
 func (User) TableName() string {
@@ -51,3 +70,253 @@ type OAuth2User struct {
 func (OAuth2User) TableName() string {
 	return "user_oauth2s"
 }
+
+// RefreshToken is a refresh token issued by a login, tokens rotated from the same login share the FamilyUID.
+type RefreshToken struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	TokenHash string       `gorm:"column:token_hash;type:varchar(64);not null;uniqueIndex"`
+	UserUID   snowflake.ID `gorm:"column:user_uid;not null;index"`
+	FamilyUID snowflake.ID `gorm:"column:family_uid;not null;index"`
+	ExpiresAt time.Time    `gorm:"column:expires_at;type:datetime;not null;index"`
+	RevokedAt *time.Time   `gorm:"column:revoked_at;type:datetime"`
+	AMR       []string     `gorm:"column:amr;type:json;serializer:json"`
+}
+
+func (RefreshToken) TableName() string {
+	return "user_refresh_tokens"
+}
+
+// RevokedToken is an access token revoked before it expires, the row can be removed once ExpiresAt has passed.
+type RevokedToken struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	TokenID   string       `gorm:"column:token_id;type:varchar(64);not null;uniqueIndex"`
+	UserUID   snowflake.ID `gorm:"column:user_uid;not null;index"`
+	ExpiresAt time.Time    `gorm:"column:expires_at;type:datetime;not null;index"`
+}
+
+func (RevokedToken) TableName() string {
+	return "user_revoked_tokens"
+}
+
+// APIKey is a personal access token of a user, only the hash of the key is stored.
+type APIKey struct {
+	ID         uint32         `gorm:"column:id;primaryKey;autoIncrement"`
+	UID        snowflake.ID   `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt  time.Time      `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt  time.Time      `gorm:"column:updated_at;type:datetime;not null;"`
+	DeletedAt  gorm.DeletedAt `gorm:"column:deleted_at;type:datetime;index"`
+	UserUID    snowflake.ID   `gorm:"column:user_uid;not null;index"`
+	Name       string         `gorm:"column:name;type:varchar(100);not null"`
+	Prefix     string         `gorm:"column:prefix;type:varchar(16);not null"`
+	TokenHash  string         `gorm:"column:token_hash;type:varchar(64);not null;uniqueIndex"`
+	Scopes     []string       `gorm:"column:scopes;type:json;serializer:json"`
+	Namespaces []string       `gorm:"column:namespaces;type:json;serializer:json"`
+	ExpiresAt  *time.Time     `gorm:"column:expires_at;type:datetime"`
+	LastUsedAt *time.Time     `gorm:"column:last_used_at;type:datetime"`
+	User       *User          `gorm:"foreignKey:UserUID;references:UID"`
+}
+
+func (APIKey) TableName() string {
+	return "user_api_keys"
+}
+
+// Role is a named set of permissions, a permission is an operation name or an operation prefix ending with *.
+type Role struct {
+	ID          uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	UID         snowflake.ID `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt   time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt   time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	Name        string       `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	Description string       `gorm:"column:description;type:varchar(255);not null;default:''"`
+	Permissions []string     `gorm:"column:permissions;type:json;serializer:json"`
+	Builtin     bool         `gorm:"column:builtin;not null;default:false"`
+	RequireMFA  bool         `gorm:"column:require_mfa;not null;default:false"`
+}
+
+func (Role) TableName() string {
+	return "roles"
+}
+
+// RoleBinding grants a role to a user in a namespace, or in every namespace when Namespace is empty.
+type RoleBinding struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	UID       snowflake.ID `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UserUID   snowflake.ID `gorm:"column:user_uid;not null;uniqueIndex:idx__role_binding__user_uid__role_uid__namespace"`
+	RoleUID   snowflake.ID `gorm:"column:role_uid;not null;uniqueIndex:idx__role_binding__user_uid__role_uid__namespace;index"`
+	Namespace string       `gorm:"column:namespace;type:varchar(100);not null;default:'';uniqueIndex:idx__role_binding__user_uid__role_uid__namespace"`
+	User      *User        `gorm:"foreignKey:UserUID;references:UID"`
+	Role      *Role        `gorm:"foreignKey:RoleUID;references:UID"`
+}
+
+func (RoleBinding) TableName() string {
+	return "role_bindings"
+}
+
+// Policy is a CEL expression which must be true for the operations it matches, or the request is denied.
+type Policy struct {
+	ID          uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	UID         snowflake.ID `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt   time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt   time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	Name        string       `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	Description string       `gorm:"column:description;type:varchar(255);not null;default:''"`
+	Operations  []string     `gorm:"column:operations;type:json;serializer:json"`
+	Expression  string       `gorm:"column:expression;type:text;not null"`
+	Message     string       `gorm:"column:message;type:varchar(255);not null;default:''"`
+}
+
+func (Policy) TableName() string {
+	return "policies"
+}
+
+// UserPreference is the preferences of a user, a user without a row has not set any.
+type UserPreference struct {
+	ID               uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt        time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt        time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	UserUID          snowflake.ID `gorm:"column:user_uid;not null;uniqueIndex"`
+	DefaultNamespace string       `gorm:"column:default_namespace;type:varchar(100);not null;default:''"`
+	Locale           string       `gorm:"column:locale;type:varchar(35);not null;default:''"`
+	Timezone         string       `gorm:"column:timezone;type:varchar(64);not null;default:''"`
+}
+
+func (UserPreference) TableName() string {
+	return "user_preferences"
+}
+
+// IdentityAuditEvent records a link or unlink of an identity, ActorUID is the user who did it.
+type IdentityAuditEvent struct {
+	ID            uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt     time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UserUID       snowflake.ID `gorm:"column:user_uid;not null;index"`
+	ActorUID      snowflake.ID `gorm:"column:actor_uid;not null"`
+	Action        string       `gorm:"column:action;type:varchar(16);not null"`
+	Reason        string       `gorm:"column:reason;type:varchar(32);not null;default:''"`
+	APP           string       `gorm:"column:app;type:varchar(100);not null"`
+	OpenID        string       `gorm:"column:open_id;type:varchar(100);not null"`
+	Email         string       `gorm:"column:email;type:varchar(100);not null;default:''"`
+	EmailVerified bool         `gorm:"column:email_verified;not null;default:false"`
+}
+
+func (IdentityAuditEvent) TableName() string {
+	return "identity_audit_events"
+}
+
+// OAuth2Provider is an OAuth2 provider managed by the API, Config is its protojson config without the client secret,
+// ClientSecret is encrypted by the caller.
+type OAuth2Provider struct {
+	ID           uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	UID          snowflake.ID `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt    time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt    time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	Name         string       `gorm:"column:name;type:varchar(64);not null;uniqueIndex"`
+	APP          string       `gorm:"column:app;type:varchar(32);not null"`
+	Config       string       `gorm:"column:config;type:text;not null"`
+	ClientSecret string       `gorm:"column:client_secret;type:text;not null"`
+	Status       uint8        `gorm:"column:status;type:tinyint;not null;default:0"`
+}
+
+func (OAuth2Provider) TableName() string {
+	return "oauth2_providers"
+}
+
+// Invitation signs up users with the invite-only providers, only the hash of the code is stored.
+type Invitation struct {
+	ID         uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	UID        snowflake.ID `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt  time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt  time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	Provider   string       `gorm:"column:provider;type:varchar(64);not null"`
+	Prefix     string       `gorm:"column:prefix;type:varchar(16);not null"`
+	CodeHash   string       `gorm:"column:code_hash;type:varchar(64);not null;uniqueIndex"`
+	MaxUses    int32        `gorm:"column:max_uses;not null;default:0"`
+	UsedCount  int32        `gorm:"column:used_count;not null;default:0"`
+	ExpiresAt  *time.Time   `gorm:"column:expires_at;type:datetime"`
+	CreatorUID snowflake.ID `gorm:"column:creator_uid;not null"`
+	Remark     string       `gorm:"column:remark;type:varchar(255);not null"`
+}
+
+func (Invitation) TableName() string {
+	return "invitations"
+}
+
+// LoginLimit counts the login attempts or failures of a key in its window and keeps the lockout of the key,
+// it is the login rate limit store shared by the replicas.
+type LoginLimit struct {
+	ID          uint32     `gorm:"column:id;primaryKey;autoIncrement"`
+	UpdatedAt   time.Time  `gorm:"column:updated_at;type:datetime;not null;"`
+	Key         string     `gorm:"column:limit_key;type:varchar(255);not null;uniqueIndex"`
+	Hits        int64      `gorm:"column:hits;not null;default:0"`
+	WindowEnd   time.Time  `gorm:"column:window_end;type:datetime;not null;index"`
+	LockedUntil *time.Time `gorm:"column:locked_until;type:datetime"`
+}
+
+func (LoginLimit) TableName() string {
+	return "login_limits"
+}
+
+// LoginAuditEvent records a lockout of an IP or an account after repeated failed logins.
+type LoginAuditEvent struct {
+	ID          uint32    `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt   time.Time `gorm:"column:created_at;type:datetime;not null;"`
+	Action      string    `gorm:"column:action;type:varchar(16);not null"`
+	Kind        string    `gorm:"column:kind;type:varchar(16);not null"`
+	Subject     string    `gorm:"column:subject;type:varchar(255);not null;index"`
+	Provider    string    `gorm:"column:provider;type:varchar(64);not null;default:''"`
+	IP          string    `gorm:"column:ip;type:varchar(64);not null;default:''"`
+	Failures    int64     `gorm:"column:failures;not null;default:0"`
+	LockedUntil time.Time `gorm:"column:locked_until;type:datetime;not null"`
+}
+
+func (LoginAuditEvent) TableName() string {
+	return "login_audit_events"
+}
+
+// UserMFA is the TOTP second factor of a user, the secret is encrypted by the biz layer,
+// LastStep is the time step of the last used code so that a code can not be used twice.
+type UserMFA struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+	UserUID   snowflake.ID `gorm:"column:user_uid;not null;uniqueIndex"`
+	Secret    string       `gorm:"column:secret;type:text;not null"`
+	Enabled   bool         `gorm:"column:enabled;not null;default:false"`
+	LastStep  int64        `gorm:"column:last_step;not null;default:0"`
+	EnabledAt *time.Time   `gorm:"column:enabled_at;type:datetime"`
+}
+
+func (UserMFA) TableName() string {
+	return "user_mfas"
+}
+
+// MFARecoveryCode is a recovery code of a user, only the hash of the code is stored.
+type MFARecoveryCode struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UserUID   snowflake.ID `gorm:"column:user_uid;not null;index"`
+	CodeHash  string       `gorm:"column:code_hash;type:varchar(64);not null;index"`
+	UsedAt    *time.Time   `gorm:"column:used_at;type:datetime"`
+}
+
+func (MFARecoveryCode) TableName() string {
+	return "mfa_recovery_codes"
+}
+
+// MFAChallenge is a login waiting for the second factor, only the hash of the challenge is stored.
+type MFAChallenge struct {
+	ID            uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	CreatedAt     time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	ChallengeHash string       `gorm:"column:challenge_hash;type:varchar(64);not null;uniqueIndex"`
+	UserUID       snowflake.ID `gorm:"column:user_uid;not null;index"`
+	AMR           []string     `gorm:"column:amr;type:json;serializer:json"`
+	Attempts      int32        `gorm:"column:attempts;not null;default:0"`
+	ExpiresAt     time.Time    `gorm:"column:expires_at;type:datetime;not null;index"`
+}
+
+func (MFAChallenge) TableName() string {
+	return "mfa_challenges"
+}
@@ -0,0 +1,161 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aide-family/magicbox/strutil"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/enum"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// CreateOAuth2Provider implements [authv1.Repository].
+func (g *gormRepository) CreateOAuth2Provider(ctx context.Context, req *authv1.CreateOAuth2ProviderRequest) (*authv1.OAuth2ProviderModel, error) {
+	providerMutation := query.OAuth2Provider
+	count, err := providerMutation.WithContext(ctx).Where(providerMutation.Name.Eq(req.GetName())).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("get oauth2 provider failed").WithCause(err)
+	}
+	if count > 0 {
+		return nil, merr.ErrorParams("oauth2 provider %s already exists", req.GetName())
+	}
+	providerDO := &model.OAuth2Provider{
+		UID:          g.node.Generate(),
+		Name:         req.GetName(),
+		APP:          req.GetApp(),
+		Config:       req.GetConfig(),
+		ClientSecret: req.GetClientSecret(),
+		Status:       uint8(enum.GlobalStatus_ENABLED),
+	}
+	if err := providerMutation.WithContext(ctx).Create(providerDO); err != nil {
+		klog.Context(ctx).Debugw("msg", "create oauth2 provider failed", "error", err, "name", req.GetName())
+		return nil, merr.ErrorInternal("create oauth2 provider failed").WithCause(err)
+	}
+	return convertOAuth2ProviderModel(providerDO), nil
+}
+
+// UpdateOAuth2Provider implements [authv1.Repository].
+func (g *gormRepository) UpdateOAuth2Provider(ctx context.Context, req *authv1.UpdateOAuth2ProviderRequest) (*authv1.OAuth2ProviderModel, error) {
+	providerDO, err := g.getOAuth2Provider(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	providerDO.APP = req.GetApp()
+	providerDO.Config = req.GetConfig()
+	if clientSecret := req.GetClientSecret(); strutil.IsNotEmpty(clientSecret) {
+		providerDO.ClientSecret = clientSecret
+	}
+	if err := query.OAuth2Provider.WithContext(ctx).Save(providerDO); err != nil {
+		return nil, merr.ErrorInternal("update oauth2 provider failed").WithCause(err)
+	}
+	return convertOAuth2ProviderModel(providerDO), nil
+}
+
+// UpdateOAuth2ProviderStatus implements [authv1.Repository].
+func (g *gormRepository) UpdateOAuth2ProviderStatus(ctx context.Context, req *authv1.UpdateOAuth2ProviderStatusRequest) (*authv1.OAuth2ProviderModel, error) {
+	providerDO, err := g.getOAuth2Provider(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	providerDO.Status = uint8(req.GetStatus())
+	providerMutation := query.OAuth2Provider
+	if _, err := providerMutation.WithContext(ctx).Where(providerMutation.ID.Eq(providerDO.ID)).Update(providerMutation.Status, providerDO.Status); err != nil {
+		return nil, merr.ErrorInternal("update oauth2 provider status failed").WithCause(err)
+	}
+	klog.Context(ctx).Infow("msg", "oauth2 provider status updated", "uid", providerDO.UID, "name", providerDO.Name, "status", enum.GlobalStatus(providerDO.Status))
+	return convertOAuth2ProviderModel(providerDO), nil
+}
+
+// DeleteOAuth2Provider implements [authv1.Repository].
+func (g *gormRepository) DeleteOAuth2Provider(ctx context.Context, req *authv1.DeleteOAuth2ProviderRequest) (*authv1.DeleteOAuth2ProviderResponse, error) {
+	providerMutation := query.OAuth2Provider
+	result, err := providerMutation.WithContext(ctx).Where(providerMutation.UID.Eq(req.GetUid())).Delete()
+	if err != nil {
+		return nil, merr.ErrorInternal("delete oauth2 provider failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, merr.ErrorNotFound("oauth2 provider %d not found", req.GetUid())
+	}
+	return &authv1.DeleteOAuth2ProviderResponse{}, nil
+}
+
+// GetOAuth2Provider implements [authv1.Repository].
+func (g *gormRepository) GetOAuth2Provider(ctx context.Context, req *authv1.GetOAuth2ProviderRequest) (*authv1.OAuth2ProviderModel, error) {
+	providerDO, err := g.getOAuth2Provider(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	return convertOAuth2ProviderModel(providerDO), nil
+}
+
+// ListOAuth2Providers implements [authv1.Repository].
+func (g *gormRepository) ListOAuth2Providers(ctx context.Context, req *authv1.ListOAuth2ProvidersRequest) (*authv1.ListOAuth2ProvidersResponse, error) {
+	providerMutation := query.OAuth2Provider
+	wrappers := providerMutation.WithContext(ctx)
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count oauth2 providers failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(ascCursorCondition(providerMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(ascCursorOrder(providerMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	providerDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list oauth2 providers failed").WithCause(err)
+	}
+	providerDOs, prevCursor, nextCursor := authv1.PageWindow(providerDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(providerDO *model.OAuth2Provider) uint32 {
+		return providerDO.ID
+	})
+	items := make([]*authv1.OAuth2ProviderModel, 0, len(providerDOs))
+	for _, providerDO := range providerDOs {
+		items = append(items, convertOAuth2ProviderModel(providerDO))
+	}
+	return &authv1.ListOAuth2ProvidersResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func (g *gormRepository) getOAuth2Provider(ctx context.Context, uid int64) (*model.OAuth2Provider, error) {
+	providerMutation := query.OAuth2Provider
+	providerDO, err := providerMutation.WithContext(ctx).Where(providerMutation.UID.Eq(uid)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("oauth2 provider %d not found", uid)
+		}
+		return nil, merr.ErrorInternal("get oauth2 provider failed").WithCause(err)
+	}
+	return providerDO, nil
+}
+
+func convertOAuth2ProviderModel(providerDO *model.OAuth2Provider) *authv1.OAuth2ProviderModel {
+	return &authv1.OAuth2ProviderModel{
+		Uid:          providerDO.UID.Int64(),
+		Name:         providerDO.Name,
+		App:          providerDO.APP,
+		Config:       providerDO.Config,
+		ClientSecret: providerDO.ClientSecret,
+		Status:       int32(providerDO.Status),
+		CreatedAt:    providerDO.CreatedAt.Unix(),
+		UpdatedAt:    providerDO.UpdatedAt.Unix(),
+	}
+}
@@ -0,0 +1,40 @@
+package gormimpl
+
+import (
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+// cursorCondition selects the rows after the cursor in the paging direction of a list ordered by id, newest first.
+func cursorCondition(id field.Uint32, cursor *authv1.PageCursor) gen.Condition {
+	if cursor.GetBackward() {
+		return id.Gt(cursor.GetId())
+	}
+	return id.Lt(cursor.GetId())
+}
+
+// cursorOrder orders a list newest first, a backward page is read oldest first and reversed by authv1.PageWindow.
+func cursorOrder(id field.Uint32, cursor *authv1.PageCursor) field.Expr {
+	if cursor.GetBackward() {
+		return id.Asc()
+	}
+	return id.Desc()
+}
+
+// ascCursorCondition selects the rows after the cursor in the paging direction of a list ordered by id, oldest first.
+func ascCursorCondition(id field.Uint32, cursor *authv1.PageCursor) gen.Condition {
+	if cursor.GetBackward() {
+		return id.Lt(cursor.GetId())
+	}
+	return id.Gt(cursor.GetId())
+}
+
+// ascCursorOrder orders a list oldest first, a backward page is read newest first and reversed by authv1.PageWindow.
+func ascCursorOrder(id field.Uint32, cursor *authv1.PageCursor) field.Expr {
+	if cursor.GetBackward() {
+		return id.Desc()
+	}
+	return id.Asc()
+}
@@ -0,0 +1,127 @@
+package gormimpl_test
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"testing"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+// cursorPage is a page of a list of the auth domain, items name its rows.
+type cursorPage struct {
+	items      []string
+	prevCursor *authv1.PageCursor
+	nextCursor *authv1.PageCursor
+}
+
+// walkPages walks a list of 5 rows with the page size 2 forward to the last page and back to the first page.
+func walkPages(t *testing.T, list func(cursor *authv1.PageCursor) cursorPage, want [][]string) {
+	t.Helper()
+	checkPage := func(page cursorPage, want []string, hasPrev, hasNext bool) {
+		t.Helper()
+		if !slices.Equal(page.items, want) {
+			t.Fatalf("want page %v, got %v", want, page.items)
+		}
+		if (page.prevCursor != nil) != hasPrev || (page.nextCursor != nil) != hasNext {
+			t.Fatalf("page %v: want prev %v next %v, got prev %v next %v", want, hasPrev, hasNext, page.prevCursor, page.nextCursor)
+		}
+	}
+	first := list(nil)
+	checkPage(first, want[0], false, true)
+	middle := list(first.nextCursor)
+	checkPage(middle, want[1], true, true)
+	last := list(middle.nextCursor)
+	checkPage(last, want[2], true, false)
+
+	back := list(last.prevCursor)
+	checkPage(back, want[1], true, true)
+	back = list(back.prevCursor)
+	checkPage(back, want[0], false, true)
+}
+
+func TestListUsersCursor(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		name := fmt.Sprintf("user-%d", i)
+		if _, err := repo.BootstrapAdmin(ctx, &authv1.BootstrapAdminRequest{Username: name, Email: name + "@example.com", Password: "correct horse battery"}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	walkPages(t, func(cursor *authv1.PageCursor) cursorPage {
+		t.Helper()
+		resp, err := repo.ListUsers(ctx, &authv1.ListUsersRequest{PageSize: 2, Keyword: "user-", Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetTotal() != 5 {
+			t.Fatalf("want the total of all pages, got %d", resp.GetTotal())
+		}
+		page := cursorPage{prevCursor: resp.GetPrevCursor(), nextCursor: resp.GetNextCursor()}
+		for _, user := range resp.GetItems() {
+			page.items = append(page.items, user.GetName())
+		}
+		return page
+	}, [][]string{{"user-5", "user-4"}, {"user-3", "user-2"}, {"user-1"}})
+
+	// the offset page keeps working, it has a previous page after the first one
+	resp, err := repo.ListUsers(ctx, &authv1.ListUsersRequest{Page: 2, PageSize: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.GetItems()) != 2 || resp.GetItems()[0].GetName() != "user-3" || resp.GetPrevCursor() == nil || resp.GetNextCursor() == nil {
+		t.Fatalf("want the second offset page with both cursors, got %v", resp)
+	}
+}
+
+func TestListLoginAuditEventsCursor(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		for _, subject := range []string{fmt.Sprintf("10.0.0.%d", i), "alice"} {
+			event := &authv1.LoginAuditEventModel{Action: "lockout", Kind: "ip", Subject: subject, Provider: "password", Ip: fmt.Sprintf("10.0.0.%d", i), Failures: int64(i)}
+			if _, err := repo.CreateLoginAuditEvent(ctx, &authv1.CreateLoginAuditEventRequest{Event: event}); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+	walkPages(t, func(cursor *authv1.PageCursor) cursorPage {
+		t.Helper()
+		resp, err := repo.ListLoginAuditEvents(ctx, &authv1.ListLoginAuditEventsRequest{Subject: "alice", PageSize: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		page := cursorPage{prevCursor: resp.GetPrevCursor(), nextCursor: resp.GetNextCursor()}
+		for _, event := range resp.GetItems() {
+			page.items = append(page.items, event.GetIp())
+		}
+		return page
+	}, [][]string{{"10.0.0.5", "10.0.0.4"}, {"10.0.0.3", "10.0.0.2"}, {"10.0.0.1"}})
+}
+
+func TestListRolesCursor(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		if _, err := repo.CreateRole(ctx, &authv1.CreateRoleRequest{Name: fmt.Sprintf("role-%d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	walkPages(t, func(cursor *authv1.PageCursor) cursorPage {
+		t.Helper()
+		resp, err := repo.ListRoles(ctx, &authv1.ListRolesRequest{PageSize: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetTotal() != 5 {
+			t.Fatalf("want the total of all pages, got %d", resp.GetTotal())
+		}
+		page := cursorPage{prevCursor: resp.GetPrevCursor(), nextCursor: resp.GetNextCursor()}
+		for _, role := range resp.GetItems() {
+			page.items = append(page.items, role.GetName())
+		}
+		return page
+	}, [][]string{{"role-1", "role-2"}, {"role-3", "role-4"}, {"role-5"}})
+}
@@ -0,0 +1,122 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/enum"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// PasswordLogin implements [authv1.Repository].
+func (g *gormRepository) PasswordLogin(ctx context.Context, req *authv1.PasswordLoginRequest) (*authv1.TokenModel, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).
+		Where(userMutation.WithContext(ctx).Where(userMutation.Name.Eq(req.GetUsername())).Or(userMutation.Email.Eq(req.GetUsername()))).
+		First()
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	var passwordHash string
+	if userDO != nil {
+		passwordHash = userDO.PasswordHash
+	}
+	ok, err := authv1.ComparePassword(passwordHash, req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		klog.Context(ctx).Debugw("msg", "password login failed", "username", req.GetUsername())
+		return nil, merr.ErrorUnauthorized("username or password is incorrect")
+	}
+	if err := checkUserEnabled(userDO); err != nil {
+		return nil, err
+	}
+	return g.issueLoginToken(ctx, userDO, []string{authv1.AMRPassword})
+}
+
+// ChangePassword implements [authv1.Repository].
+func (g *gormRepository) ChangePassword(ctx context.Context, req *authv1.ChangePasswordRequest) (*authv1.ChangePasswordResponse, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(req.GetUid())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("user %d not found", req.GetUid())
+		}
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	// users signed up with OAuth2 set their first password without an old one
+	if userDO.PasswordHash != "" {
+		ok, err := authv1.ComparePassword(userDO.PasswordHash, req.GetOldPassword())
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, merr.ErrorParams("old password is incorrect")
+		}
+	}
+	passwordHash, err := authv1.HashPassword(req.GetNewPassword())
+	if err != nil {
+		return nil, err
+	}
+	if _, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(req.GetUid())).Update(userMutation.PasswordHash, passwordHash); err != nil {
+		return nil, merr.ErrorInternal("change password failed").WithCause(err)
+	}
+	return &authv1.ChangePasswordResponse{}, nil
+}
+
+// BootstrapAdmin implements [authv1.Repository].
+func (g *gormRepository) BootstrapAdmin(ctx context.Context, req *authv1.BootstrapAdminRequest) (*authv1.UserModel, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.Name.Eq(req.GetUsername())).First()
+	if err == nil {
+		return g.existingAdmin(ctx, userDO)
+	}
+	if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	passwordHash, err := authv1.HashPassword(req.GetPassword())
+	if err != nil {
+		return nil, err
+	}
+	userDO = &model.User{
+		UID:          g.node.Generate(),
+		Name:         req.GetUsername(),
+		Nickname:     req.GetUsername(),
+		Email:        req.GetEmail(),
+		Status:       uint8(enum.GlobalStatus_ENABLED),
+		PasswordHash: passwordHash,
+		// the email of the admin is set by the operator
+		EmailVerified: true,
+	}
+	if err := userMutation.WithContext(ctx).Create(userDO); err != nil {
+		return nil, merr.ErrorInternal("create admin %s failed", req.GetUsername()).WithCause(err)
+	}
+	klog.Context(ctx).Infow("msg", "bootstrap admin created", "username", userDO.Name, "uid", userDO.UID)
+	return convertUserModel(userDO), nil
+}
+
+// existingAdmin accepts the user of the admin name as the admin only when it is a local account,
+// a user signed up by an OAuth2 or LDAP login could otherwise take the name and become the admin.
+func (g *gormRepository) existingAdmin(ctx context.Context, userDO *model.User) (*authv1.UserModel, error) {
+	if userDO.PasswordHash == "" {
+		klog.Context(ctx).Warnw("msg", "bootstrap admin name belongs to a federated user", "username", userDO.Name, "uid", userDO.UID)
+		return nil, merr.ErrorForbidden("user %s has no password, it is not the bootstrap admin", userDO.Name)
+	}
+	oauth2Mutation := query.OAuth2User
+	identities, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.UID.Eq(int64(userDO.UID))).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count user identities failed").WithCause(err)
+	}
+	if identities > 0 {
+		klog.Context(ctx).Warnw("msg", "bootstrap admin name belongs to a federated user", "username", userDO.Name, "uid", userDO.UID)
+		return nil, merr.ErrorForbidden("user %s has linked identities, it is not the bootstrap admin", userDO.Name)
+	}
+	return convertUserModel(userDO), nil
+}
@@ -0,0 +1,45 @@
+package gormimpl_test
+
+import (
+	"context"
+	"testing"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func TestBootstrapAdmin(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	bootstrap := func(username string) (*authv1.UserModel, error) {
+		return repo.BootstrapAdmin(ctx, &authv1.BootstrapAdminRequest{Username: username, Email: username + "@localhost", Password: "correct horse battery"})
+	}
+
+	created, err := bootstrap("admin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := bootstrap("admin")
+	if err != nil {
+		t.Fatalf("want the local admin accepted on the next start, got %v", err)
+	}
+	if again.GetUid() != created.GetUid() {
+		t.Fatalf("want the existing admin, got %d", again.GetUid())
+	}
+
+	// a GitHub user signed up with the name of the admin before the admin was bootstrapped
+	if _, err := repo.Login(ctx, &authv1.LoginRequest{User: &authv1.User{App: "github", OpenID: "1", Name: "root", Email: "root@example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bootstrap("root"); !merr.IsForbidden(err) {
+		t.Fatalf("want the name of a federated user refused, got %v", err)
+	}
+
+	// a local admin who linked an identity is refused too
+	if _, err := repo.LinkIdentity(ctx, &authv1.LinkIdentityRequest{UserUID: created.GetUid(), User: &authv1.User{App: "github", OpenID: "2", Name: "admin", Email: "admin@example.com"}}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := bootstrap("admin"); !merr.IsForbidden(err) {
+		t.Fatalf("want the admin with a linked identity refused, got %v", err)
+	}
+}
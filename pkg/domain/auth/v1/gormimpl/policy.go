@@ -0,0 +1,139 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// CreatePolicy implements [authv1.Repository].
+func (g *gormRepository) CreatePolicy(ctx context.Context, req *authv1.CreatePolicyRequest) (*authv1.PolicyModel, error) {
+	policyMutation := query.Policy
+	count, err := policyMutation.WithContext(ctx).Where(policyMutation.Name.Eq(req.GetName())).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("get policy failed").WithCause(err)
+	}
+	if count > 0 {
+		return nil, merr.ErrorParams("policy %s already exists", req.GetName())
+	}
+	policyDO := &model.Policy{
+		UID:         g.node.Generate(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Operations:  req.GetOperations(),
+		Expression:  req.GetExpression(),
+		Message:     req.GetMessage(),
+	}
+	if err := policyMutation.WithContext(ctx).Create(policyDO); err != nil {
+		klog.Context(ctx).Debugw("msg", "create policy failed", "error", err, "name", req.GetName())
+		return nil, merr.ErrorInternal("create policy failed").WithCause(err)
+	}
+	return convertPolicyModel(policyDO), nil
+}
+
+// UpdatePolicy implements [authv1.Repository].
+func (g *gormRepository) UpdatePolicy(ctx context.Context, req *authv1.UpdatePolicyRequest) (*authv1.PolicyModel, error) {
+	policyMutation := query.Policy
+	policyDO, err := policyMutation.WithContext(ctx).Where(policyMutation.UID.Eq(req.GetUid())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("policy %d not found", req.GetUid())
+		}
+		return nil, merr.ErrorInternal("get policy failed").WithCause(err)
+	}
+	policyDO.Description = req.GetDescription()
+	policyDO.Operations = req.GetOperations()
+	policyDO.Expression = req.GetExpression()
+	policyDO.Message = req.GetMessage()
+	if err := policyMutation.WithContext(ctx).Save(policyDO); err != nil {
+		return nil, merr.ErrorInternal("update policy failed").WithCause(err)
+	}
+	return convertPolicyModel(policyDO), nil
+}
+
+// GetPolicy implements [authv1.Repository].
+func (g *gormRepository) GetPolicy(ctx context.Context, req *authv1.GetPolicyRequest) (*authv1.PolicyModel, error) {
+	policyMutation := query.Policy
+	policyDO, err := policyMutation.WithContext(ctx).Where(policyMutation.UID.Eq(req.GetUid())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("policy %d not found", req.GetUid())
+		}
+		return nil, merr.ErrorInternal("get policy failed").WithCause(err)
+	}
+	return convertPolicyModel(policyDO), nil
+}
+
+// DeletePolicy implements [authv1.Repository].
+func (g *gormRepository) DeletePolicy(ctx context.Context, req *authv1.DeletePolicyRequest) (*authv1.DeletePolicyResponse, error) {
+	policyMutation := query.Policy
+	result, err := policyMutation.WithContext(ctx).Where(policyMutation.UID.Eq(req.GetUid())).Delete()
+	if err != nil {
+		return nil, merr.ErrorInternal("delete policy failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, merr.ErrorNotFound("policy %d not found", req.GetUid())
+	}
+	return &authv1.DeletePolicyResponse{}, nil
+}
+
+// ListPolicies implements [authv1.Repository].
+func (g *gormRepository) ListPolicies(ctx context.Context, req *authv1.ListPoliciesRequest) (*authv1.ListPoliciesResponse, error) {
+	policyMutation := query.Policy
+	wrappers := policyMutation.WithContext(ctx)
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count policies failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(ascCursorCondition(policyMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(ascCursorOrder(policyMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	policyDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list policies failed").WithCause(err)
+	}
+	policyDOs, prevCursor, nextCursor := authv1.PageWindow(policyDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(policyDO *model.Policy) uint32 {
+		return policyDO.ID
+	})
+	items := make([]*authv1.PolicyModel, 0, len(policyDOs))
+	for _, policyDO := range policyDOs {
+		items = append(items, convertPolicyModel(policyDO))
+	}
+	return &authv1.ListPoliciesResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func convertPolicyModel(policyDO *model.Policy) *authv1.PolicyModel {
+	return &authv1.PolicyModel{
+		Uid:         policyDO.UID.Int64(),
+		Name:        policyDO.Name,
+		Description: policyDO.Description,
+		Operations:  policyDO.Operations,
+		Expression:  policyDO.Expression,
+		Message:     policyDO.Message,
+		CreatedAt:   policyDO.CreatedAt.Unix(),
+		UpdatedAt:   policyDO.UpdatedAt.Unix(),
+	}
+}
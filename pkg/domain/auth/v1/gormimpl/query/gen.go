@@ -16,39 +16,114 @@ import (
 )
 
 var (
-	Q          = new(Query)
-	OAuth2User *oAuth2User
-	User       *user
+	Q                  = new(Query)
+	APIKey             *aPIKey
+	IdentityAuditEvent *identityAuditEvent
+	Invitation         *invitation
+	LoginAuditEvent    *loginAuditEvent
+	LoginLimit         *loginLimit
+	MFAChallenge       *mFAChallenge
+	MFARecoveryCode    *mFARecoveryCode
+	OAuth2Provider     *oAuth2Provider
+	OAuth2User         *oAuth2User
+	Policy             *policy
+	RefreshToken       *refreshToken
+	RevokedToken       *revokedToken
+	Role               *role
+	RoleBinding        *roleBinding
+	User               *user
+	UserMFA            *userMFA
+	UserPreference     *userPreference
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
+	APIKey = &Q.APIKey
+	IdentityAuditEvent = &Q.IdentityAuditEvent
+	Invitation = &Q.Invitation
+	LoginAuditEvent = &Q.LoginAuditEvent
+	LoginLimit = &Q.LoginLimit
+	MFAChallenge = &Q.MFAChallenge
+	MFARecoveryCode = &Q.MFARecoveryCode
+	OAuth2Provider = &Q.OAuth2Provider
 	OAuth2User = &Q.OAuth2User
+	Policy = &Q.Policy
+	RefreshToken = &Q.RefreshToken
+	RevokedToken = &Q.RevokedToken
+	Role = &Q.Role
+	RoleBinding = &Q.RoleBinding
 	User = &Q.User
+	UserMFA = &Q.UserMFA
+	UserPreference = &Q.UserPreference
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:         db,
-		OAuth2User: newOAuth2User(db, opts...),
-		User:       newUser(db, opts...),
+		db:                 db,
+		APIKey:             newAPIKey(db, opts...),
+		IdentityAuditEvent: newIdentityAuditEvent(db, opts...),
+		Invitation:         newInvitation(db, opts...),
+		LoginAuditEvent:    newLoginAuditEvent(db, opts...),
+		LoginLimit:         newLoginLimit(db, opts...),
+		MFAChallenge:       newMFAChallenge(db, opts...),
+		MFARecoveryCode:    newMFARecoveryCode(db, opts...),
+		OAuth2Provider:     newOAuth2Provider(db, opts...),
+		OAuth2User:         newOAuth2User(db, opts...),
+		Policy:             newPolicy(db, opts...),
+		RefreshToken:       newRefreshToken(db, opts...),
+		RevokedToken:       newRevokedToken(db, opts...),
+		Role:               newRole(db, opts...),
+		RoleBinding:        newRoleBinding(db, opts...),
+		User:               newUser(db, opts...),
+		UserMFA:            newUserMFA(db, opts...),
+		UserPreference:     newUserPreference(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	OAuth2User oAuth2User
-	User       user
+	APIKey             aPIKey
+	IdentityAuditEvent identityAuditEvent
+	Invitation         invitation
+	LoginAuditEvent    loginAuditEvent
+	LoginLimit         loginLimit
+	MFAChallenge       mFAChallenge
+	MFARecoveryCode    mFARecoveryCode
+	OAuth2Provider     oAuth2Provider
+	OAuth2User         oAuth2User
+	Policy             policy
+	RefreshToken       refreshToken
+	RevokedToken       revokedToken
+	Role               role
+	RoleBinding        roleBinding
+	User               user
+	UserMFA            userMFA
+	UserPreference     userPreference
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:         db,
-		OAuth2User: q.OAuth2User.clone(db),
-		User:       q.User.clone(db),
+		db:                 db,
+		APIKey:             q.APIKey.clone(db),
+		IdentityAuditEvent: q.IdentityAuditEvent.clone(db),
+		Invitation:         q.Invitation.clone(db),
+		LoginAuditEvent:    q.LoginAuditEvent.clone(db),
+		LoginLimit:         q.LoginLimit.clone(db),
+		MFAChallenge:       q.MFAChallenge.clone(db),
+		MFARecoveryCode:    q.MFARecoveryCode.clone(db),
+		OAuth2Provider:     q.OAuth2Provider.clone(db),
+		OAuth2User:         q.OAuth2User.clone(db),
+		Policy:             q.Policy.clone(db),
+		RefreshToken:       q.RefreshToken.clone(db),
+		RevokedToken:       q.RevokedToken.clone(db),
+		Role:               q.Role.clone(db),
+		RoleBinding:        q.RoleBinding.clone(db),
+		User:               q.User.clone(db),
+		UserMFA:            q.UserMFA.clone(db),
+		UserPreference:     q.UserPreference.clone(db),
 	}
 }
 
@@ -62,21 +137,66 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:         db,
-		OAuth2User: q.OAuth2User.replaceDB(db),
-		User:       q.User.replaceDB(db),
+		db:                 db,
+		APIKey:             q.APIKey.replaceDB(db),
+		IdentityAuditEvent: q.IdentityAuditEvent.replaceDB(db),
+		Invitation:         q.Invitation.replaceDB(db),
+		LoginAuditEvent:    q.LoginAuditEvent.replaceDB(db),
+		LoginLimit:         q.LoginLimit.replaceDB(db),
+		MFAChallenge:       q.MFAChallenge.replaceDB(db),
+		MFARecoveryCode:    q.MFARecoveryCode.replaceDB(db),
+		OAuth2Provider:     q.OAuth2Provider.replaceDB(db),
+		OAuth2User:         q.OAuth2User.replaceDB(db),
+		Policy:             q.Policy.replaceDB(db),
+		RefreshToken:       q.RefreshToken.replaceDB(db),
+		RevokedToken:       q.RevokedToken.replaceDB(db),
+		Role:               q.Role.replaceDB(db),
+		RoleBinding:        q.RoleBinding.replaceDB(db),
+		User:               q.User.replaceDB(db),
+		UserMFA:            q.UserMFA.replaceDB(db),
+		UserPreference:     q.UserPreference.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	OAuth2User IOAuth2UserDo
-	User       IUserDo
+	APIKey             IAPIKeyDo
+	IdentityAuditEvent IIdentityAuditEventDo
+	Invitation         IInvitationDo
+	LoginAuditEvent    ILoginAuditEventDo
+	LoginLimit         ILoginLimitDo
+	MFAChallenge       IMFAChallengeDo
+	MFARecoveryCode    IMFARecoveryCodeDo
+	OAuth2Provider     IOAuth2ProviderDo
+	OAuth2User         IOAuth2UserDo
+	Policy             IPolicyDo
+	RefreshToken       IRefreshTokenDo
+	RevokedToken       IRevokedTokenDo
+	Role               IRoleDo
+	RoleBinding        IRoleBindingDo
+	User               IUserDo
+	UserMFA            IUserMFADo
+	UserPreference     IUserPreferenceDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		OAuth2User: q.OAuth2User.WithContext(ctx),
-		User:       q.User.WithContext(ctx),
+		APIKey:             q.APIKey.WithContext(ctx),
+		IdentityAuditEvent: q.IdentityAuditEvent.WithContext(ctx),
+		Invitation:         q.Invitation.WithContext(ctx),
+		LoginAuditEvent:    q.LoginAuditEvent.WithContext(ctx),
+		LoginLimit:         q.LoginLimit.WithContext(ctx),
+		MFAChallenge:       q.MFAChallenge.WithContext(ctx),
+		MFARecoveryCode:    q.MFARecoveryCode.WithContext(ctx),
+		OAuth2Provider:     q.OAuth2Provider.WithContext(ctx),
+		OAuth2User:         q.OAuth2User.WithContext(ctx),
+		Policy:             q.Policy.WithContext(ctx),
+		RefreshToken:       q.RefreshToken.WithContext(ctx),
+		RevokedToken:       q.RevokedToken.WithContext(ctx),
+		Role:               q.Role.WithContext(ctx),
+		RoleBinding:        q.RoleBinding.WithContext(ctx),
+		User:               q.User.WithContext(ctx),
+		UserMFA:            q.UserMFA.WithContext(ctx),
+		UserPreference:     q.UserPreference.WithContext(ctx),
 	}
 }
 
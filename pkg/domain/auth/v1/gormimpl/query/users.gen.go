@@ -38,6 +38,8 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 	_user.Avatar = field.NewString(tableName, "avatar")
 	_user.Remark = field.NewString(tableName, "remark")
 	_user.Status = field.NewUint8(tableName, "status")
+	_user.PasswordHash = field.NewString(tableName, "password_hash")
+	_user.EmailVerified = field.NewBool(tableName, "email_verified")
 
 	_user.fillFieldMap()
 
@@ -47,18 +49,20 @@ func newUser(db *gorm.DB, opts ...gen.DOOption) user {
 type user struct {
 	userDo
 
-	ALL       field.Asterisk
-	ID        field.Uint32
-	UID       field.Int64
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	DeletedAt field.Field
-	Name      field.String
-	Nickname  field.String
-	Email     field.String
-	Avatar    field.String
-	Remark    field.String
-	Status    field.Uint8
+	ALL           field.Asterisk
+	ID            field.Uint32
+	UID           field.Int64
+	CreatedAt     field.Time
+	UpdatedAt     field.Time
+	DeletedAt     field.Field
+	Name          field.String
+	Nickname      field.String
+	Email         field.String
+	Avatar        field.String
+	Remark        field.String
+	Status        field.Uint8
+	PasswordHash  field.String
+	EmailVerified field.Bool
 
 	fieldMap map[string]field.Expr
 }
@@ -86,6 +90,8 @@ func (u *user) updateTableName(table string) *user {
 	u.Avatar = field.NewString(table, "avatar")
 	u.Remark = field.NewString(table, "remark")
 	u.Status = field.NewUint8(table, "status")
+	u.PasswordHash = field.NewString(table, "password_hash")
+	u.EmailVerified = field.NewBool(table, "email_verified")
 
 	u.fillFieldMap()
 
@@ -102,7 +108,7 @@ func (u *user) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (u *user) fillFieldMap() {
-	u.fieldMap = make(map[string]field.Expr, 11)
+	u.fieldMap = make(map[string]field.Expr, 13)
 	u.fieldMap["id"] = u.ID
 	u.fieldMap["uid"] = u.UID
 	u.fieldMap["created_at"] = u.CreatedAt
@@ -114,6 +120,8 @@ func (u *user) fillFieldMap() {
 	u.fieldMap["avatar"] = u.Avatar
 	u.fieldMap["remark"] = u.Remark
 	u.fieldMap["status"] = u.Status
+	u.fieldMap["password_hash"] = u.PasswordHash
+	u.fieldMap["email_verified"] = u.EmailVerified
 }
 
 func (u user) clone(db *gorm.DB) user {
@@ -0,0 +1,325 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"github.com/aide-family/magicbox/strutil"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// CreateRole implements [authv1.Repository].
+func (g *gormRepository) CreateRole(ctx context.Context, req *authv1.CreateRoleRequest) (*authv1.RoleModel, error) {
+	roleMutation := query.Role
+	count, err := roleMutation.WithContext(ctx).Where(roleMutation.Name.Eq(req.GetName())).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("get role failed").WithCause(err)
+	}
+	if count > 0 {
+		return nil, merr.ErrorParams("role %s already exists", req.GetName())
+	}
+	roleDO := &model.Role{
+		UID:         g.node.Generate(),
+		Name:        req.GetName(),
+		Description: req.GetDescription(),
+		Permissions: req.GetPermissions(),
+	}
+	if err := roleMutation.WithContext(ctx).Create(roleDO); err != nil {
+		klog.Context(ctx).Debugw("msg", "create role failed", "error", err, "name", req.GetName())
+		return nil, merr.ErrorInternal("create role failed").WithCause(err)
+	}
+	return convertRoleModel(roleDO), nil
+}
+
+// UpdateRole implements [authv1.Repository].
+func (g *gormRepository) UpdateRole(ctx context.Context, req *authv1.UpdateRoleRequest) (*authv1.RoleModel, error) {
+	roleDO, err := g.getRole(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	if roleDO.Builtin {
+		return nil, merr.ErrorForbidden("builtin role %s can not be changed", roleDO.Name)
+	}
+	roleDO.Description = req.GetDescription()
+	roleDO.Permissions = req.GetPermissions()
+	if err := query.Role.WithContext(ctx).Save(roleDO); err != nil {
+		return nil, merr.ErrorInternal("update role failed").WithCause(err)
+	}
+	return convertRoleModel(roleDO), nil
+}
+
+// DeleteRole implements [authv1.Repository].
+func (g *gormRepository) DeleteRole(ctx context.Context, req *authv1.DeleteRoleRequest) (*authv1.DeleteRoleResponse, error) {
+	roleDO, err := g.getRole(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	if roleDO.Builtin {
+		return nil, merr.ErrorForbidden("builtin role %s can not be deleted", roleDO.Name)
+	}
+	err = query.Use(g.db).Transaction(func(tx *query.Query) error {
+		if _, err := tx.RoleBinding.WithContext(ctx).Where(tx.RoleBinding.RoleUID.Eq(roleDO.UID.Int64())).Delete(); err != nil {
+			return err
+		}
+		_, err := tx.Role.WithContext(ctx).Where(tx.Role.ID.Eq(roleDO.ID)).Delete()
+		return err
+	})
+	if err != nil {
+		return nil, merr.ErrorInternal("delete role failed").WithCause(err)
+	}
+	return &authv1.DeleteRoleResponse{}, nil
+}
+
+// GetRole implements [authv1.Repository].
+func (g *gormRepository) GetRole(ctx context.Context, req *authv1.GetRoleRequest) (*authv1.RoleModel, error) {
+	roleDO, err := g.getRole(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	return convertRoleModel(roleDO), nil
+}
+
+// ListRoles implements [authv1.Repository].
+func (g *gormRepository) ListRoles(ctx context.Context, req *authv1.ListRolesRequest) (*authv1.ListRolesResponse, error) {
+	roleMutation := query.Role
+	wrappers := roleMutation.WithContext(ctx)
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count roles failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(ascCursorCondition(roleMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(ascCursorOrder(roleMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	roleDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list roles failed").WithCause(err)
+	}
+	roleDOs, prevCursor, nextCursor := authv1.PageWindow(roleDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(roleDO *model.Role) uint32 {
+		return roleDO.ID
+	})
+	items := make([]*authv1.RoleModel, 0, len(roleDOs))
+	for _, roleDO := range roleDOs {
+		items = append(items, convertRoleModel(roleDO))
+	}
+	return &authv1.ListRolesResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// EnsureBuiltinRoles implements [authv1.Repository].
+func (g *gormRepository) EnsureBuiltinRoles(ctx context.Context, req *authv1.EnsureBuiltinRolesRequest) (*authv1.EnsureBuiltinRolesResponse, error) {
+	roleMutation := query.Role
+	items := make([]*authv1.RoleModel, 0, len(req.GetRoles()))
+	for _, role := range req.GetRoles() {
+		roleDO, err := roleMutation.WithContext(ctx).Where(roleMutation.Name.Eq(role.GetName())).First()
+		if err != nil {
+			if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, merr.ErrorInternal("get role failed").WithCause(err)
+			}
+			roleDO = &model.Role{UID: g.node.Generate(), Name: role.GetName()}
+		}
+		roleDO.Description = role.GetDescription()
+		roleDO.Permissions = role.GetPermissions()
+		roleDO.Builtin = true
+		if err := roleMutation.WithContext(ctx).Save(roleDO); err != nil {
+			return nil, merr.ErrorInternal("save builtin role %s failed", role.GetName()).WithCause(err)
+		}
+		items = append(items, convertRoleModel(roleDO))
+	}
+	return &authv1.EnsureBuiltinRolesResponse{Items: items}, nil
+}
+
+// CreateRoleBinding implements [authv1.Repository].
+func (g *gormRepository) CreateRoleBinding(ctx context.Context, req *authv1.CreateRoleBindingRequest) (*authv1.RoleBindingModel, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(req.GetUserUID())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("user %d not found", req.GetUserUID())
+		}
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	roleDO, err := g.getRole(ctx, req.GetRoleUID())
+	if err != nil {
+		return nil, err
+	}
+	bindingMutation := query.RoleBinding
+	bindingDO, err := bindingMutation.WithContext(ctx).Where(
+		bindingMutation.UserUID.Eq(req.GetUserUID()),
+		bindingMutation.RoleUID.Eq(req.GetRoleUID()),
+		bindingMutation.Namespace.Eq(req.GetNamespace()),
+	).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorInternal("get role binding failed").WithCause(err)
+		}
+		bindingDO = &model.RoleBinding{
+			UID:       g.node.Generate(),
+			UserUID:   userDO.UID,
+			RoleUID:   roleDO.UID,
+			Namespace: req.GetNamespace(),
+		}
+		if err := bindingMutation.WithContext(ctx).Create(bindingDO); err != nil {
+			klog.Context(ctx).Debugw("msg", "create role binding failed", "error", err, "userUID", userDO.UID, "roleUID", roleDO.UID)
+			return nil, merr.ErrorInternal("create role binding failed").WithCause(err)
+		}
+	}
+	bindingDO.User, bindingDO.Role = userDO, roleDO
+	return convertRoleBindingModel(bindingDO), nil
+}
+
+// DeleteRoleBinding implements [authv1.Repository].
+func (g *gormRepository) DeleteRoleBinding(ctx context.Context, req *authv1.DeleteRoleBindingRequest) (*authv1.DeleteRoleBindingResponse, error) {
+	bindingMutation := query.RoleBinding
+	result, err := bindingMutation.WithContext(ctx).Where(bindingMutation.UID.Eq(req.GetUid())).Delete()
+	if err != nil {
+		return nil, merr.ErrorInternal("delete role binding failed").WithCause(err)
+	}
+	if result.RowsAffected == 0 {
+		return nil, merr.ErrorNotFound("role binding %d not found", req.GetUid())
+	}
+	return &authv1.DeleteRoleBindingResponse{}, nil
+}
+
+// ListRoleBindings implements [authv1.Repository].
+func (g *gormRepository) ListRoleBindings(ctx context.Context, req *authv1.ListRoleBindingsRequest) (*authv1.ListRoleBindingsResponse, error) {
+	bindingMutation := query.RoleBinding
+	wrapper := bindingMutation.WithContext(ctx).Preload(bindingMutation.User, bindingMutation.Role)
+	if req.GetUserUID() > 0 {
+		wrapper = wrapper.Where(bindingMutation.UserUID.Eq(req.GetUserUID()))
+	}
+	if req.GetRoleUID() > 0 {
+		wrapper = wrapper.Where(bindingMutation.RoleUID.Eq(req.GetRoleUID()))
+	}
+	if strutil.IsNotEmpty(req.GetNamespace()) {
+		wrapper = wrapper.Where(bindingMutation.Namespace.Eq(req.GetNamespace()))
+	}
+	total, err := wrapper.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count role bindings failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrapper = wrapper.Where(ascCursorCondition(bindingMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrapper = wrapper.Offset(offset)
+	}
+	wrapper = wrapper.Order(ascCursorOrder(bindingMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrapper = wrapper.Limit(int(req.GetPageSize()) + 1)
+	}
+	bindingDOs, err := wrapper.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list role bindings failed").WithCause(err)
+	}
+	bindingDOs, prevCursor, nextCursor := authv1.PageWindow(bindingDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(bindingDO *model.RoleBinding) uint32 {
+		return bindingDO.ID
+	})
+	items := make([]*authv1.RoleBindingModel, 0, len(bindingDOs))
+	for _, bindingDO := range bindingDOs {
+		items = append(items, convertRoleBindingModel(bindingDO))
+	}
+	return &authv1.ListRoleBindingsResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetUserPermissions implements [authv1.Repository].
+func (g *gormRepository) GetUserPermissions(ctx context.Context, req *authv1.GetUserPermissionsRequest) (*authv1.GetUserPermissionsResponse, error) {
+	bindingMutation := query.RoleBinding
+	namespaces := []string{""}
+	if strutil.IsNotEmpty(req.GetNamespace()) {
+		namespaces = append(namespaces, req.GetNamespace())
+	}
+	bindingDOs, err := bindingMutation.WithContext(ctx).
+		Preload(bindingMutation.Role).
+		Where(bindingMutation.UserUID.Eq(req.GetUserUID()), bindingMutation.Namespace.In(namespaces...)).
+		Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("get user permissions failed").WithCause(err)
+	}
+	permissions, roles := make([]string, 0, len(bindingDOs)), make([]string, 0, len(bindingDOs))
+	for _, bindingDO := range bindingDOs {
+		if bindingDO.Role == nil || (bindingDO.Role.RequireMFA && !req.GetMfa()) {
+			continue
+		}
+		if !slices.Contains(roles, bindingDO.Role.Name) {
+			roles = append(roles, bindingDO.Role.Name)
+		}
+		for _, permission := range bindingDO.Role.Permissions {
+			if !slices.Contains(permissions, permission) {
+				permissions = append(permissions, permission)
+			}
+		}
+	}
+	return &authv1.GetUserPermissionsResponse{Permissions: permissions, Roles: roles}, nil
+}
+
+func (g *gormRepository) getRole(ctx context.Context, uid int64) (*model.Role, error) {
+	roleMutation := query.Role
+	roleDO, err := roleMutation.WithContext(ctx).Where(roleMutation.UID.Eq(uid)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("role %d not found", uid)
+		}
+		return nil, merr.ErrorInternal("get role failed").WithCause(err)
+	}
+	return roleDO, nil
+}
+
+func convertRoleModel(roleDO *model.Role) *authv1.RoleModel {
+	return &authv1.RoleModel{
+		Uid:         roleDO.UID.Int64(),
+		Name:        roleDO.Name,
+		Description: roleDO.Description,
+		Permissions: roleDO.Permissions,
+		Builtin:     roleDO.Builtin,
+		RequireMfa:  roleDO.RequireMFA,
+		CreatedAt:   roleDO.CreatedAt.Unix(),
+		UpdatedAt:   roleDO.UpdatedAt.Unix(),
+	}
+}
+
+func convertRoleBindingModel(bindingDO *model.RoleBinding) *authv1.RoleBindingModel {
+	binding := &authv1.RoleBindingModel{
+		Uid:       bindingDO.UID.Int64(),
+		UserUID:   bindingDO.UserUID.Int64(),
+		Namespace: bindingDO.Namespace,
+		CreatedAt: bindingDO.CreatedAt.Unix(),
+	}
+	if bindingDO.User != nil {
+		binding.Username = bindingDO.User.Email
+	}
+	if bindingDO.Role != nil {
+		binding.Role = convertRoleModel(bindingDO.Role)
+	}
+	return binding
+}
@@ -0,0 +1,168 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// RefreshToken implements [authv1.Repository].
+// The refresh token is rotated on every use, presenting a rotated token again revokes every token of its login.
+func (g *gormRepository) RefreshToken(ctx context.Context, req *authv1.RefreshTokenRequest) (*authv1.TokenModel, error) {
+	mutation := query.Use(g.db)
+	tokenDO, err := g.findRefreshToken(ctx, mutation, req.GetRefreshToken())
+	if err != nil {
+		return nil, err
+	}
+	if tokenDO.RevokedAt != nil {
+		klog.Context(ctx).Warnw("msg", "rotated refresh token is reused, revoke the login", "userUID", tokenDO.UserUID, "familyUID", tokenDO.FamilyUID)
+		if err := g.revokeRefreshTokenFamily(ctx, mutation, tokenDO.FamilyUID); err != nil {
+			return nil, err
+		}
+		return nil, merr.ErrorUnauthorized("refresh token is revoked, please login again")
+	}
+	if time.Now().After(tokenDO.ExpiresAt) {
+		return nil, merr.ErrorUnauthorized("refresh token is expired, please login again")
+	}
+	userDO, err := mutation.User.WithContext(ctx).Where(mutation.User.UID.Eq(tokenDO.UserUID.Int64())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorUnauthorized("user of the refresh token is not found")
+		}
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	if err := checkUserEnabled(userDO); err != nil {
+		return nil, err
+	}
+
+	var token *authv1.TokenModel
+	err = mutation.Transaction(func(tx *query.Query) error {
+		refreshTokenMutation := tx.RefreshToken
+		result, err := refreshTokenMutation.WithContext(ctx).
+			Where(refreshTokenMutation.ID.Eq(tokenDO.ID), refreshTokenMutation.RevokedAt.IsNull()).
+			Update(refreshTokenMutation.RevokedAt, time.Now())
+		if err != nil {
+			return merr.ErrorInternal("rotate refresh token failed").WithCause(err)
+		}
+		if result.RowsAffected == 0 {
+			return merr.ErrorUnauthorized("refresh token is already used, please login again")
+		}
+		token, err = g.issueToken(ctx, tx, userDO, tokenDO.FamilyUID, tokenDO.AMR)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return token, nil
+}
+
+// RevokeToken implements [authv1.Repository].
+func (g *gormRepository) RevokeToken(ctx context.Context, req *authv1.RevokeTokenRequest) (*authv1.RevokeTokenResponse, error) {
+	mutation := query.Use(g.db)
+	if refreshToken := req.GetRefreshToken(); refreshToken != "" {
+		tokenDO, err := g.findRefreshToken(ctx, mutation, refreshToken)
+		if err != nil {
+			return nil, err
+		}
+		if req.GetUid() > 0 && tokenDO.UserUID.Int64() != req.GetUid() {
+			return nil, merr.ErrorForbidden("refresh token does not belong to the current user")
+		}
+		if err := g.revokeRefreshTokenFamily(ctx, mutation, tokenDO.FamilyUID); err != nil {
+			return nil, err
+		}
+	}
+	if tokenID := req.GetTokenID(); tokenID != "" {
+		revokedTokenMutation := mutation.RevokedToken
+		revokedTokenDO := &model.RevokedToken{
+			TokenID:   tokenID,
+			UserUID:   snowflake.ParseInt64(req.GetUid()),
+			ExpiresAt: time.Unix(req.GetExpiresAt(), 0),
+		}
+		if err := revokedTokenMutation.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(revokedTokenDO); err != nil {
+			return nil, merr.ErrorInternal("revoke token failed").WithCause(err)
+		}
+		// the revocation list only needs the tokens which are not expired yet
+		if _, err := revokedTokenMutation.WithContext(ctx).Where(revokedTokenMutation.ExpiresAt.Lt(time.Now())).Delete(); err != nil {
+			klog.Context(ctx).Warnw("msg", "delete expired revoked tokens failed", "error", err)
+		}
+	}
+	return &authv1.RevokeTokenResponse{}, nil
+}
+
+// IsTokenRevoked implements [authv1.Repository].
+func (g *gormRepository) IsTokenRevoked(ctx context.Context, req *authv1.IsTokenRevokedRequest) (*authv1.IsTokenRevokedResponse, error) {
+	revokedTokenMutation := query.Use(g.db).RevokedToken
+	count, err := revokedTokenMutation.WithContext(ctx).Where(revokedTokenMutation.TokenID.Eq(req.GetTokenID())).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("check token revocation failed").WithCause(err)
+	}
+	return &authv1.IsTokenRevokedResponse{Revoked: count > 0}, nil
+}
+
+// issueToken signs an access token and stores the hash of a new refresh token of the login familyUID,
+// the refresh token keeps the amr of the login for the tokens it is rotated to.
+func (g *gormRepository) issueToken(ctx context.Context, tx *query.Query, user *model.User, familyUID snowflake.ID, amr []string) (*authv1.TokenModel, error) {
+	claims := authv1.NewJwtClaims(g.jwtConfig, authv1.BaseInfo{
+		UID:      user.UID,
+		Username: user.Email,
+		AMR:      amr,
+	})
+	accessToken, err := claims.GenerateToken()
+	if err != nil {
+		return nil, merr.ErrorInternal("generate token failed").WithCause(err)
+	}
+	refreshToken, refreshTokenHash := authv1.NewRefreshToken()
+	refreshTokenDO := &model.RefreshToken{
+		TokenHash: refreshTokenHash,
+		UserUID:   user.UID,
+		FamilyUID: familyUID,
+		ExpiresAt: time.Now().Add(authv1.RefreshExpire(g.jwtConfig)),
+		AMR:       amr,
+	}
+	if err := tx.RefreshToken.WithContext(ctx).Create(refreshTokenDO); err != nil {
+		klog.Context(ctx).Debugw("msg", "create refresh token failed", "error", err, "userUID", user.UID)
+		return nil, merr.ErrorInternal("create refresh token failed").WithCause(err)
+	}
+	return &authv1.TokenModel{
+		Token:            accessToken,
+		ExpiresAt:        claims.ExpiresAt.Unix(),
+		RefreshToken:     refreshToken,
+		RefreshExpiresAt: refreshTokenDO.ExpiresAt.Unix(),
+	}, nil
+}
+
+func (g *gormRepository) findRefreshToken(ctx context.Context, mutation *query.Query, refreshToken string) (*model.RefreshToken, error) {
+	if refreshToken == "" {
+		return nil, merr.ErrorUnauthorized("refresh token is required")
+	}
+	refreshTokenMutation := mutation.RefreshToken
+	tokenDO, err := refreshTokenMutation.WithContext(ctx).Where(refreshTokenMutation.TokenHash.Eq(authv1.HashRefreshToken(refreshToken))).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorUnauthorized("refresh token is invalid")
+		}
+		return nil, merr.ErrorInternal("get refresh token failed").WithCause(err)
+	}
+	return tokenDO, nil
+}
+
+func (g *gormRepository) revokeRefreshTokenFamily(ctx context.Context, mutation *query.Query, familyUID snowflake.ID) error {
+	refreshTokenMutation := mutation.RefreshToken
+	_, err := refreshTokenMutation.WithContext(ctx).
+		Where(refreshTokenMutation.FamilyUID.Eq(familyUID.Int64()), refreshTokenMutation.RevokedAt.IsNull()).
+		Update(refreshTokenMutation.RevokedAt, time.Now())
+	if err != nil {
+		return merr.ErrorInternal("revoke refresh token failed").WithCause(err)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package gormimpl_test
+
+import (
+	"context"
+	"net/url"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func newGormRepository(t *testing.T) authv1.Repository {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "sovereign.db")
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{DisableForeignKeyConstraintWhenMigrating: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(model.Models()...); err != nil {
+		t.Fatal(err)
+	}
+	sqliteOptions, err := anypb.New(&config.SQLiteOptions{Dsn: dsn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err := anypb.New(&config.ORMConfig{Dialector: config.ORMConfig_SQLITE, Options: sqliteOptions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwtConfig := &config.JWT{Secret: "secret", Expire: durationpb.New(time.Hour), Issuer: "sovereign-test"}
+	repo, closer, err := gormimpl.NewGormRepository(&config.DomainConfig{Driver: config.DomainConfig_GORM, Options: options}, jwtConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+	})
+	return repo
+}
+
+// passwordLogin creates the local user alice and signs her in.
+func passwordLogin(t *testing.T, repo authv1.Repository) *authv1.TokenModel {
+	t.Helper()
+	ctx := context.Background()
+	if _, err := repo.BootstrapAdmin(ctx, &authv1.BootstrapAdminRequest{Username: "alice", Email: "alice@example.com", Password: "correct horse battery"}); err != nil {
+		t.Fatal(err)
+	}
+	token, err := repo.PasswordLogin(ctx, &authv1.PasswordLoginRequest{Username: "alice", Password: "correct horse battery"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return token
+}
+
+func refresh(repo authv1.Repository, refreshToken string) (*authv1.TokenModel, error) {
+	return repo.RefreshToken(context.Background(), &authv1.RefreshTokenRequest{RefreshToken: refreshToken})
+}
+
+func TestRefreshTokenRotation(t *testing.T) {
+	repo := newGormRepository(t)
+	first := passwordLogin(t, repo)
+	second, err := refresh(repo, first.GetRefreshToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.GetRefreshToken() == "" || second.GetRefreshToken() == first.GetRefreshToken() {
+		t.Fatalf("want a new refresh token, got %q", second.GetRefreshToken())
+	}
+	if second.GetToken() == "" {
+		t.Fatal("want a new access token")
+	}
+	third, err := refresh(repo, second.GetRefreshToken())
+	if err != nil {
+		t.Fatalf("want the rotated refresh token accepted, got %v", err)
+	}
+	if third.GetRefreshToken() == second.GetRefreshToken() {
+		t.Fatal("want the refresh token rotated again")
+	}
+	if _, err := refresh(repo, "unknown"); !merr.IsUnauthorized(err) {
+		t.Fatalf("want an unknown refresh token refused, got %v", err)
+	}
+}
+
+func TestRefreshTokenReuseRevokesFamily(t *testing.T) {
+	repo := newGormRepository(t)
+	first := passwordLogin(t, repo)
+	second, err := refresh(repo, first.GetRefreshToken())
+	if err != nil {
+		t.Fatal(err)
+	}
+	other, err := repo.PasswordLogin(context.Background(), &authv1.PasswordLoginRequest{Username: "alice", Password: "correct horse battery"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := refresh(repo, first.GetRefreshToken()); !merr.IsUnauthorized(err) {
+		t.Fatalf("want a replayed refresh token refused, got %v", err)
+	}
+	if _, err := refresh(repo, second.GetRefreshToken()); !merr.IsUnauthorized(err) {
+		t.Fatalf("want the refresh tokens of the replayed login revoked, got %v", err)
+	}
+	if _, err := refresh(repo, other.GetRefreshToken()); err != nil {
+		t.Fatalf("want the other login of the user kept, got %v", err)
+	}
+}
+
+func TestRevokeToken(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	token := passwordLogin(t, repo)
+
+	revoked, err := repo.IsTokenRevoked(ctx, &authv1.IsTokenRevokedRequest{TokenID: "jti-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revoked.GetRevoked() {
+		t.Fatal("want a token which was never revoked accepted")
+	}
+	revoke := &authv1.RevokeTokenRequest{TokenID: "jti-1", ExpiresAt: time.Now().Add(time.Hour).Unix(), RefreshToken: token.GetRefreshToken()}
+	for range 2 {
+		if _, err := repo.RevokeToken(ctx, revoke); err != nil {
+			t.Fatalf("want revoking a token twice to succeed, got %v", err)
+		}
+	}
+	revoked, err = repo.IsTokenRevoked(ctx, &authv1.IsTokenRevokedRequest{TokenID: "jti-1"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !revoked.GetRevoked() {
+		t.Fatal("want the revoked jti rejected")
+	}
+	if revoked, err := repo.IsTokenRevoked(ctx, &authv1.IsTokenRevokedRequest{TokenID: "jti-2"}); err != nil || revoked.GetRevoked() {
+		t.Fatalf("want other tokens accepted, got %v %v", revoked.GetRevoked(), err)
+	}
+	if _, err := refresh(repo, token.GetRefreshToken()); !merr.IsUnauthorized(err) {
+		t.Fatalf("want the refresh token of the logout revoked, got %v", err)
+	}
+}
+
+func TestLoginRedirectTokensInFragment(t *testing.T) {
+	repo := newGormRepository(t)
+	login, err := repo.Login(context.Background(), &authv1.LoginRequest{
+		User:        &authv1.User{App: "github", OpenID: "1", Name: "alice", Email: "alice@example.com"},
+		OauthConfig: &authv1.OAuth2Config{RedirectURL: "https://app.example.com/callback?tab=home"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	redirectURL, err := url.Parse(login.GetRedirectURL())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if query := redirectURL.Query(); query.Get("tab") != "home" || query.Has("token") || query.Has("refresh_token") {
+		t.Fatalf("want the tokens kept out of the query, got %q", redirectURL.RawQuery)
+	}
+	fragment, err := url.ParseQuery(redirectURL.Fragment)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fragment.Get("token") != login.GetToken().GetToken() || fragment.Get("refresh_token") != login.GetToken().GetRefreshToken() {
+		t.Fatalf("want the tokens in the fragment, got %q", redirectURL.Fragment)
+	}
+}
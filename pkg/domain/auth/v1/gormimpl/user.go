@@ -0,0 +1,400 @@
+package gormimpl
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/auth/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/enum"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	identityActionLink   = "link"
+	identityActionUnlink = "unlink"
+
+	// linkReasonSignup links the identity to the user created by its first login
+	linkReasonSignup = "signup"
+	// linkReasonVerifiedEmail links the identity to the user of its verified email
+	linkReasonVerifiedEmail = "verified_email"
+	// linkReasonExplicit links the identity to the signed-in user who asked for it
+	linkReasonExplicit = "explicit"
+)
+
+// checkUserEnabled rejects the users disabled by the user management API, users created before the status was set count as enabled.
+func checkUserEnabled(userDO *model.User) error {
+	if enum.GlobalStatus(userDO.Status) == enum.GlobalStatus_DISABLED {
+		return merr.ErrorForbidden("user %s is disabled", userDO.Name)
+	}
+	return nil
+}
+
+func (g *gormRepository) getUser(ctx context.Context, uid int64) (*model.User, error) {
+	userMutation := query.User
+	userDO, err := userMutation.WithContext(ctx).Where(userMutation.UID.Eq(uid)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("user %d not found", uid)
+		}
+		return nil, merr.ErrorInternal("get user failed").WithCause(err)
+	}
+	return userDO, nil
+}
+
+// ListUsers implements [authv1.Repository].
+func (g *gormRepository) ListUsers(ctx context.Context, req *authv1.ListUsersRequest) (*authv1.ListUsersResponse, error) {
+	userMutation := query.User
+	wrappers := userMutation.WithContext(ctx)
+	if keyword := req.GetKeyword(); keyword != "" {
+		like := "%" + keyword + "%"
+		wrappers = wrappers.Where(userMutation.WithContext(ctx).Where(userMutation.Name.Like(like)).Or(userMutation.Nickname.Like(like)).Or(userMutation.Email.Like(like)))
+	}
+	if status := enum.GlobalStatus(req.GetStatus()); status == enum.GlobalStatus_ENABLED {
+		// users created before the status was set are enabled too
+		wrappers = wrappers.Where(userMutation.Status.In(uint8(enum.GlobalStatus_GlobalStatus_UNKNOWN), uint8(enum.GlobalStatus_ENABLED)))
+	} else if status > enum.GlobalStatus_GlobalStatus_UNKNOWN {
+		wrappers = wrappers.Where(userMutation.Status.Eq(uint8(status)))
+	}
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count users failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(cursorCondition(userMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(cursorOrder(userMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	userDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list users failed").WithCause(err)
+	}
+	userDOs, prevCursor, nextCursor := authv1.PageWindow(userDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(userDO *model.User) uint32 {
+		return userDO.ID
+	})
+	items := make([]*authv1.UserModel, 0, len(userDOs))
+	for _, userDO := range userDOs {
+		items = append(items, convertUserModel(userDO))
+	}
+	return &authv1.ListUsersResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// UpdateUser implements [authv1.Repository].
+func (g *gormRepository) UpdateUser(ctx context.Context, req *authv1.UpdateUserRequest) (*authv1.UserModel, error) {
+	userDO, err := g.getUser(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	userDO.Nickname = req.GetNickname()
+	userDO.Avatar = req.GetAvatar()
+	userDO.Remark = req.GetRemark()
+	userDO.UpdatedAt = time.Now()
+	userMutation := query.User
+	if _, err := userMutation.WithContext(ctx).Where(userMutation.ID.Eq(userDO.ID)).UpdateSimple(
+		userMutation.Nickname.Value(userDO.Nickname),
+		userMutation.Avatar.Value(userDO.Avatar),
+		userMutation.Remark.Value(userDO.Remark),
+		userMutation.UpdatedAt.Value(userDO.UpdatedAt),
+	); err != nil {
+		return nil, merr.ErrorInternal("update user failed").WithCause(err)
+	}
+	return convertUserModel(userDO), nil
+}
+
+// UpdateUserStatus implements [authv1.Repository].
+func (g *gormRepository) UpdateUserStatus(ctx context.Context, req *authv1.UpdateUserStatusRequest) (*authv1.UserModel, error) {
+	userDO, err := g.getUser(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	userDO.Status = uint8(req.GetStatus())
+	userMutation := query.User
+	if _, err := userMutation.WithContext(ctx).Where(userMutation.ID.Eq(userDO.ID)).Update(userMutation.Status, userDO.Status); err != nil {
+		return nil, merr.ErrorInternal("update user status failed").WithCause(err)
+	}
+	klog.Context(ctx).Infow("msg", "user status updated", "uid", userDO.UID, "status", enum.GlobalStatus(userDO.Status))
+	return convertUserModel(userDO), nil
+}
+
+// UpdateUserEmail implements [authv1.Repository].
+func (g *gormRepository) UpdateUserEmail(ctx context.Context, req *authv1.UpdateUserEmailRequest) (*authv1.UserModel, error) {
+	userDO, err := g.getUser(ctx, req.GetUid())
+	if err != nil {
+		return nil, err
+	}
+	userMutation := query.User
+	count, err := userMutation.WithContext(ctx).Where(userMutation.Email.Eq(req.GetEmail()), userMutation.ID.Neq(userDO.ID)).Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("check user email failed").WithCause(err)
+	}
+	if count > 0 {
+		return nil, merr.ErrorParams("email %s belongs to another user", req.GetEmail())
+	}
+	userDO.Email = req.GetEmail()
+	userDO.EmailVerified = false
+	userDO.UpdatedAt = time.Now()
+	if _, err := userMutation.WithContext(ctx).Where(userMutation.ID.Eq(userDO.ID)).UpdateSimple(
+		userMutation.Email.Value(userDO.Email),
+		userMutation.EmailVerified.Value(userDO.EmailVerified),
+		userMutation.UpdatedAt.Value(userDO.UpdatedAt),
+	); err != nil {
+		return nil, merr.ErrorInternal("update user email failed").WithCause(err)
+	}
+	return convertUserModel(userDO), nil
+}
+
+// ListUserIdentities implements [authv1.Repository].
+func (g *gormRepository) ListUserIdentities(ctx context.Context, req *authv1.ListUserIdentitiesRequest) (*authv1.ListUserIdentitiesResponse, error) {
+	if _, err := g.getUser(ctx, req.GetUserUID()); err != nil {
+		return nil, err
+	}
+	oauth2Mutation := query.OAuth2User
+	oauth2UserDOs, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.UID.Eq(req.GetUserUID())).Order(oauth2Mutation.ID).Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list user identities failed").WithCause(err)
+	}
+	items := make([]*authv1.IdentityModel, 0, len(oauth2UserDOs))
+	for _, oauth2UserDO := range oauth2UserDOs {
+		items = append(items, convertIdentityModel(oauth2UserDO))
+	}
+	return &authv1.ListUserIdentitiesResponse{Items: items}, nil
+}
+
+// UnlinkUserIdentity implements [authv1.Repository].
+func (g *gormRepository) UnlinkUserIdentity(ctx context.Context, req *authv1.UnlinkUserIdentityRequest) (*authv1.UnlinkUserIdentityResponse, error) {
+	userDO, err := g.getUser(ctx, req.GetUserUID())
+	if err != nil {
+		return nil, err
+	}
+	err = query.Use(g.db).Transaction(func(tx *query.Query) error {
+		oauth2Mutation := tx.OAuth2User
+		identities, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.UID.Eq(req.GetUserUID())).Find()
+		if err != nil {
+			return merr.ErrorInternal("list user identities failed").WithCause(err)
+		}
+		var found *model.OAuth2User
+		for _, identity := range identities {
+			if identity.ID == req.GetId() {
+				found = identity
+			}
+		}
+		if found == nil {
+			return merr.ErrorNotFound("identity %d of user %d not found", req.GetId(), req.GetUserUID())
+		}
+		if len(identities) == 1 && userDO.PasswordHash == "" {
+			return merr.ErrorParams("identity %d is the only way user %s signs in, set a password first", req.GetId(), userDO.Name)
+		}
+		if _, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.ID.Eq(req.GetId())).Delete(); err != nil {
+			return merr.ErrorInternal("unlink user identity failed").WithCause(err)
+		}
+		event := &model.IdentityAuditEvent{
+			UserUID:  userDO.UID,
+			ActorUID: snowflake.ParseInt64(req.GetOperatorUID()),
+			Action:   identityActionUnlink,
+			APP:      found.APP,
+			OpenID:   found.OpenID,
+			Email:    found.Email,
+		}
+		if err := tx.IdentityAuditEvent.WithContext(ctx).Create(event); err != nil {
+			return merr.ErrorInternal("create identity audit event failed").WithCause(err)
+		}
+		klog.Context(ctx).Infow("msg", "identity unlinked", "userUID", userDO.UID, "app", found.APP, "openID", found.OpenID, "actorUID", event.ActorUID)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &authv1.UnlinkUserIdentityResponse{}, nil
+}
+
+// LinkIdentity implements [authv1.Repository].
+func (g *gormRepository) LinkIdentity(ctx context.Context, req *authv1.LinkIdentityRequest) (*authv1.IdentityModel, error) {
+	user := req.GetUser()
+	if user.GetOpenID() == "" {
+		return nil, merr.ErrorInvalidArgument("user is nil")
+	}
+	userDO, err := g.getUser(ctx, req.GetUserUID())
+	if err != nil {
+		return nil, err
+	}
+	if err := checkUserEnabled(userDO); err != nil {
+		return nil, err
+	}
+	oauth2UserDO, err := g.findOrCreateOAuth2User(ctx, user)
+	if err != nil {
+		return nil, err
+	}
+	linkedDO, err := g.findLinkedUser(ctx, oauth2UserDO)
+	if err != nil {
+		return nil, err
+	}
+	if linkedDO != nil {
+		if linkedDO.UID == userDO.UID {
+			return convertIdentityModel(oauth2UserDO), nil
+		}
+		return nil, merr.ErrorForbidden("the %s account %s is linked to another user, unlink it there first", user.GetApp(), user.GetOpenID())
+	}
+	err = query.Use(g.db).Transaction(func(tx *query.Query) error {
+		return g.linkIdentity(ctx, tx, userDO, oauth2UserDO, user, userDO.UID, linkReasonExplicit)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return convertIdentityModel(oauth2UserDO), nil
+}
+
+// linkIdentity links the identity to the user and records the audit event of the link.
+func (g *gormRepository) linkIdentity(ctx context.Context, tx *query.Query, userDO *model.User, oauth2UserDO *model.OAuth2User, user *authv1.User, actorUID snowflake.ID, reason string) error {
+	oauth2Mutation := tx.OAuth2User
+	if _, err := oauth2Mutation.WithContext(ctx).Where(oauth2Mutation.ID.Eq(oauth2UserDO.ID)).Update(oauth2Mutation.UID, int64(userDO.UID)); err != nil {
+		klog.Context(ctx).Debugw("msg", "update oauth2 user failed", "error", err, "oauth2UserID", oauth2UserDO.ID, "userUID", userDO.UID)
+		return merr.ErrorInternal("update oauth2 user failed").WithCause(err)
+	}
+	oauth2UserDO.UID = userDO.UID
+	event := &model.IdentityAuditEvent{
+		UserUID:       userDO.UID,
+		ActorUID:      actorUID,
+		Action:        identityActionLink,
+		Reason:        reason,
+		APP:           oauth2UserDO.APP,
+		OpenID:        oauth2UserDO.OpenID,
+		Email:         user.GetEmail(),
+		EmailVerified: user.GetEmailVerified(),
+	}
+	if err := tx.IdentityAuditEvent.WithContext(ctx).Create(event); err != nil {
+		return merr.ErrorInternal("create identity audit event failed").WithCause(err)
+	}
+	klog.Context(ctx).Infow("msg", "identity linked", "userUID", userDO.UID, "app", oauth2UserDO.APP, "openID", oauth2UserDO.OpenID, "reason", reason, "emailVerified", event.EmailVerified)
+	return nil
+}
+
+// ListIdentityAuditEvents implements [authv1.Repository].
+func (g *gormRepository) ListIdentityAuditEvents(ctx context.Context, req *authv1.ListIdentityAuditEventsRequest) (*authv1.ListIdentityAuditEventsResponse, error) {
+	eventMutation := query.IdentityAuditEvent
+	wrappers := eventMutation.WithContext(ctx).Where(eventMutation.UserUID.Eq(req.GetUserUID()))
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternal("count identity audit events failed").WithCause(err)
+	}
+	cursor := req.GetCursor()
+	var offset int
+	if cursor != nil {
+		wrappers = wrappers.Where(cursorCondition(eventMutation.ID, cursor))
+	} else if req.GetPage() > 1 && req.GetPageSize() > 0 {
+		offset = int((req.GetPage() - 1) * req.GetPageSize())
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(cursorOrder(eventMutation.ID, cursor))
+	if req.GetPageSize() > 0 {
+		wrappers = wrappers.Limit(int(req.GetPageSize()) + 1)
+	}
+	eventDOs, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternal("list identity audit events failed").WithCause(err)
+	}
+	eventDOs, prevCursor, nextCursor := authv1.PageWindow(eventDOs, int(req.GetPageSize()), cursor, cursor != nil || offset > 0, func(eventDO *model.IdentityAuditEvent) uint32 {
+		return eventDO.ID
+	})
+	items := make([]*authv1.IdentityAuditEventModel, 0, len(eventDOs))
+	for _, eventDO := range eventDOs {
+		items = append(items, &authv1.IdentityAuditEventModel{
+			Id:            eventDO.ID,
+			UserUID:       eventDO.UserUID.Int64(),
+			ActorUID:      eventDO.ActorUID.Int64(),
+			Action:        eventDO.Action,
+			Reason:        eventDO.Reason,
+			App:           eventDO.APP,
+			OpenID:        eventDO.OpenID,
+			Email:         eventDO.Email,
+			EmailVerified: eventDO.EmailVerified,
+			CreatedAt:     eventDO.CreatedAt.Unix(),
+		})
+	}
+	return &authv1.ListIdentityAuditEventsResponse{
+		Items:      items,
+		Total:      total,
+		Page:       req.GetPage(),
+		PageSize:   req.GetPageSize(),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+func convertIdentityModel(oauth2UserDO *model.OAuth2User) *authv1.IdentityModel {
+	return &authv1.IdentityModel{
+		Id:        oauth2UserDO.ID,
+		UserUID:   oauth2UserDO.UID.Int64(),
+		App:       oauth2UserDO.APP,
+		OpenID:    oauth2UserDO.OpenID,
+		Name:      oauth2UserDO.Name,
+		Email:     oauth2UserDO.Email,
+		Avatar:    oauth2UserDO.Avatar,
+		CreatedAt: oauth2UserDO.CreatedAt.Unix(),
+		UpdatedAt: oauth2UserDO.UpdatedAt.Unix(),
+	}
+}
+
+// GetUserPreferences implements [authv1.Repository].
+func (g *gormRepository) GetUserPreferences(ctx context.Context, req *authv1.GetUserPreferencesRequest) (*authv1.UserPreferencesModel, error) {
+	preferenceMutation := query.UserPreference
+	preferenceDO, err := preferenceMutation.WithContext(ctx).Where(preferenceMutation.UserUID.Eq(req.GetUserUID())).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &authv1.UserPreferencesModel{UserUID: req.GetUserUID()}, nil
+		}
+		return nil, merr.ErrorInternal("get user preferences failed").WithCause(err)
+	}
+	return convertUserPreferencesModel(preferenceDO), nil
+}
+
+// SaveUserPreferences implements [authv1.Repository].
+func (g *gormRepository) SaveUserPreferences(ctx context.Context, req *authv1.SaveUserPreferencesRequest) (*authv1.UserPreferencesModel, error) {
+	if _, err := g.getUser(ctx, req.GetUserUID()); err != nil {
+		return nil, err
+	}
+	preferenceMutation := query.UserPreference
+	preferenceDO, err := preferenceMutation.WithContext(ctx).Where(preferenceMutation.UserUID.Eq(req.GetUserUID())).First()
+	if err != nil {
+		if !errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorInternal("get user preferences failed").WithCause(err)
+		}
+		preferenceDO = &model.UserPreference{UserUID: snowflake.ParseInt64(req.GetUserUID())}
+	}
+	preferenceDO.DefaultNamespace = req.GetDefaultNamespace()
+	preferenceDO.Locale = req.GetLocale()
+	preferenceDO.Timezone = req.GetTimezone()
+	if err := preferenceMutation.WithContext(ctx).Save(preferenceDO); err != nil {
+		return nil, merr.ErrorInternal("save user preferences failed").WithCause(err)
+	}
+	return convertUserPreferencesModel(preferenceDO), nil
+}
+
+func convertUserPreferencesModel(preferenceDO *model.UserPreference) *authv1.UserPreferencesModel {
+	return &authv1.UserPreferencesModel{
+		UserUID:          preferenceDO.UserUID.Int64(),
+		DefaultNamespace: preferenceDO.DefaultNamespace,
+		Locale:           preferenceDO.Locale,
+		Timezone:         preferenceDO.Timezone,
+		UpdatedAt:        preferenceDO.UpdatedAt.Unix(),
+	}
+}
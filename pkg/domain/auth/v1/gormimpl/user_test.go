@@ -0,0 +1,77 @@
+package gormimpl_test
+
+import (
+	"context"
+	"testing"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func TestGetIdentityUser(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	login, err := repo.Login(ctx, &authv1.LoginRequest{User: &authv1.User{App: "remote-sso", OpenID: "alice@remote", Name: "alice", Email: "alice@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	user, err := repo.GetUser(ctx, &authv1.GetUserRequest{App: "remote-sso", OpenID: "alice@remote"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if user.GetUid() != login.GetUser().GetUid() {
+		t.Fatalf("want the user linked to the identity, got %d", user.GetUid())
+	}
+	if _, err := repo.GetUser(ctx, &authv1.GetUserRequest{App: "other-sso", OpenID: "alice@remote"}); !merr.IsNotFound(err) {
+		t.Fatalf("want the identity of another app not found, got %v", err)
+	}
+	if _, err := repo.GetUser(ctx, &authv1.GetUserRequest{App: "remote-sso", OpenID: "mallory@remote"}); !merr.IsNotFound(err) {
+		t.Fatalf("want an unknown identity not found, got %v", err)
+	}
+}
+
+func TestUserPreferences(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	login, err := repo.Login(ctx, &authv1.LoginRequest{User: &authv1.User{App: "sso", OpenID: "alice", Name: "alice", Email: "alice@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	alice := login.GetUser().GetUid()
+
+	preferences, err := repo.GetUserPreferences(ctx, &authv1.GetUserPreferencesRequest{UserUID: alice})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if preferences.GetUserUID() != alice || preferences.GetDefaultNamespace() != "" || preferences.GetUpdatedAt() != 0 {
+		t.Fatalf("want empty preferences before they are saved, got %v", preferences)
+	}
+
+	tests := []struct {
+		name string
+		req  *authv1.SaveUserPreferencesRequest
+	}{
+		{name: "create", req: &authv1.SaveUserPreferencesRequest{UserUID: alice, DefaultNamespace: "team-a", Locale: "en", Timezone: "Europe/Paris"}},
+		{name: "update", req: &authv1.SaveUserPreferencesRequest{UserUID: alice, DefaultNamespace: "team-b", Timezone: "UTC"}},
+		{name: "clear", req: &authv1.SaveUserPreferencesRequest{UserUID: alice}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := repo.SaveUserPreferences(ctx, tt.req); err != nil {
+				t.Fatal(err)
+			}
+			got, err := repo.GetUserPreferences(ctx, &authv1.GetUserPreferencesRequest{UserUID: alice})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got.GetDefaultNamespace() != tt.req.GetDefaultNamespace() || got.GetLocale() != tt.req.GetLocale() || got.GetTimezone() != tt.req.GetTimezone() {
+				t.Fatalf("want the saved preferences %v, got %v", tt.req, got)
+			}
+		})
+	}
+
+	if _, err := repo.SaveUserPreferences(ctx, &authv1.SaveUserPreferencesRequest{UserUID: alice + 1, DefaultNamespace: "team-a"}); !merr.IsNotFound(err) {
+		t.Fatalf("want the preferences of an unknown user rejected, got %v", err)
+	}
+}
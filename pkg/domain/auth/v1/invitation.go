@@ -0,0 +1,19 @@
+package authv1
+
+const (
+	// InvitationCodePrefix starts every invitation code
+	InvitationCodePrefix = "inv_"
+	// invitationDisplayLength is the length of the code prefix kept to tell invitations apart
+	invitationDisplayLength = len(InvitationCodePrefix) + 8
+)
+
+// NewInvitationCode 生成邀请码，只有 hash 会被保存，prefix 用于区分不同的邀请码
+func NewInvitationCode() (code string, prefix string, hash string) {
+	code = InvitationCodePrefix + newRandomToken()
+	return code, code[:invitationDisplayLength], HashInvitationCode(code)
+}
+
+// HashInvitationCode 计算邀请码的哈希
+func HashInvitationCode(code string) string {
+	return HashRefreshToken(code)
+}
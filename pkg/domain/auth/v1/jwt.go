@@ -2,7 +2,10 @@ package authv1
 
 import (
 	"context"
-	"encoding/json"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"time"
 
 	"github.com/aide-family/magicbox/strutil"
@@ -18,10 +21,13 @@ type (
 	BaseInfo struct {
 		UID      snowflake.ID `json:"uid"`
 		Username string       `json:"username"`
+		// AMR lists the methods which authenticated the user, the API keys have none
+		AMR []string `json:"amr,omitempty"`
 	}
 
 	JwtClaims struct {
-		signKey string
+		keySet    *KeySet
+		keySetErr error
 		BaseInfo
 		jwtv5.RegisteredClaims
 	}
@@ -29,28 +35,70 @@ type (
 	baseInfoKey struct{}
 )
 
-// NewJwtClaims new jwt claims
+const defaultRefreshExpire = 7 * 24 * time.Hour
+
+// NewJwtClaims new jwt claims, each token gets a random jti so that it can be revoked before it expires
 func NewJwtClaims(c *config.JWT, base BaseInfo) *JwtClaims {
-	expire, issuer := c.GetExpire().AsDuration(), c.GetIssuer()
+	expire, issuer := c.GetExpire().AsDuration(), Issuer(c)
 	if expire <= 0 {
 		expire = 10 * time.Minute
 	}
-	if strutil.IsEmpty(issuer) {
-		issuer = "moon"
-	}
+	keySet, err := LoadKeySet(c)
 	return &JwtClaims{
-		signKey:  c.GetSecret(),
-		BaseInfo: base,
+		keySet:    keySet,
+		keySetErr: err,
+		BaseInfo:  base,
 		RegisteredClaims: jwtv5.RegisteredClaims{
+			ID:        newRandomToken(),
 			ExpiresAt: jwtv5.NewNumericDate(time.Now().Add(expire)),
 			Issuer:    issuer,
 		},
 	}
 }
 
-// GenerateToken generate token
+// GenerateToken generate token, signed by the signing key of the key set of the jwt config
 func (l *JwtClaims) GenerateToken() (string, error) {
-	return jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, l).SignedString([]byte(l.signKey))
+	if l.keySetErr != nil {
+		return "", l.keySetErr
+	}
+	if l.keySet == nil {
+		return "", merr.ErrorInternal("jwt claims are not created by NewJwtClaims")
+	}
+	return l.keySet.Sign(l)
+}
+
+// Issuer 令牌的签发者，未配置时为默认值
+func Issuer(c *config.JWT) string {
+	if issuer := c.GetIssuer(); strutil.IsNotEmpty(issuer) {
+		return issuer
+	}
+	return defaultIssuer
+}
+
+// RefreshExpire 刷新令牌的有效期
+func RefreshExpire(c *config.JWT) time.Duration {
+	if expire := c.GetRefreshExpire().AsDuration(); expire > 0 {
+		return expire
+	}
+	return defaultRefreshExpire
+}
+
+// NewRefreshToken 生成刷新令牌，只有令牌的哈希会被保存
+func NewRefreshToken() (token string, hash string) {
+	token = newRandomToken()
+	return token, HashRefreshToken(token)
+}
+
+// HashRefreshToken 计算刷新令牌的哈希
+func HashRefreshToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func newRandomToken() string {
+	token := make([]byte, 32)
+	_, _ = rand.Read(token)
+	return base64.RawURLEncoding.EncodeToString(token)
 }
 
 // GetClaimsFromContext 从context中获取已解析的JWT claims
@@ -66,29 +114,6 @@ func GetClaimsFromContext(ctx context.Context) (*JwtClaims, error) {
 	return jwtClaims, nil
 }
 
-// ParseClaimsFromToken 从JWT token字符串中解析出claims
-func ParseClaimsFromToken(secret string, token string) (*JwtClaims, error) {
-	claims, err := jwtv5.Parse(token, func(token *jwtv5.Token) (interface{}, error) {
-		return []byte(secret), nil
-	})
-	if err != nil {
-		return nil, err
-	}
-	if !claims.Valid {
-		return nil, merr.ErrorUnauthorized("token is invalid")
-	}
-
-	claimsBs, err := json.Marshal(claims.Claims)
-	if err != nil {
-		return nil, err
-	}
-	var jwtClaims JwtClaims
-	if err := json.Unmarshal(claimsBs, &jwtClaims); err != nil {
-		return nil, err
-	}
-	return &jwtClaims, nil
-}
-
 func WithBaseInfo(ctx context.Context, baseInfo BaseInfo) context.Context {
 	return context.WithValue(ctx, baseInfoKey{}, baseInfo)
 }
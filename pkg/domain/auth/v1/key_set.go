@@ -0,0 +1,417 @@
+package authv1
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"net/http"
+	"os"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/aide-family/magicbox/pointer"
+	"github.com/aide-family/magicbox/strutil"
+	"github.com/go-jose/go-jose/v4"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	AlgorithmHS256 = "HS256"
+	AlgorithmRS256 = "RS256"
+	AlgorithmES256 = "ES256"
+	AlgorithmEdDSA = "EdDSA"
+
+	defaultIssuer = "moon"
+	// remoteJWKSRefreshInterval limits how often the JWKS of a trusted issuer is read again for an unknown kid
+	remoteJWKSRefreshInterval = time.Minute
+	remoteJWKSTimeout         = 10 * time.Second
+)
+
+var (
+	signingMethods = map[string]jwtv5.SigningMethod{
+		AlgorithmRS256: jwtv5.SigningMethodRS256,
+		AlgorithmES256: jwtv5.SigningMethodES256,
+		AlgorithmEdDSA: jwtv5.SigningMethodEdDSA,
+	}
+
+	// keySets caches the key set of each jwt config, a reloaded config loads the key files again
+	keySets sync.Map
+)
+
+type (
+	// KeySet signs the access tokens of the issuer and verifies the tokens of the issuer and its trusted issuers.
+	// Without keys the tokens are signed and verified with HS256 and the jwt secret.
+	KeySet struct {
+		issuer  string
+		secret  []byte
+		signing *signingKey
+		keys    map[string]*jose.JSONWebKey
+		methods []string
+		trusted map[string]*remoteKeySet
+	}
+
+	signingKey struct {
+		kid    string
+		method jwtv5.SigningMethod
+		key    crypto.Signer
+	}
+
+	keySetResult struct {
+		keySet *KeySet
+		err    error
+	}
+
+	// remoteKeySet is the JWKS of a trusted issuer, read again when a token is signed by a kid it does not have.
+	// Its tokens must be issued for the audience, their subjects are the identities of the provider.
+	remoteKeySet struct {
+		url      string
+		audience string
+		provider string
+		client   *http.Client
+
+		mu        sync.Mutex
+		keys      jose.JSONWebKeySet
+		fetchedAt time.Time
+	}
+)
+
+// LoadKeySet returns the key set of the jwt config, the key files are read once per config.
+func LoadKeySet(c *config.JWT) (*KeySet, error) {
+	if result, ok := keySets.Load(c); ok {
+		return result.(*keySetResult).keySet, result.(*keySetResult).err
+	}
+	keySet, err := NewKeySet(c)
+	result, _ := keySets.LoadOrStore(c, &keySetResult{keySet: keySet, err: err})
+	return result.(*keySetResult).keySet, result.(*keySetResult).err
+}
+
+// NewKeySet reads the keys of the jwt config.
+func NewKeySet(c *config.JWT) (*KeySet, error) {
+	issuer := Issuer(c)
+	keySet := &KeySet{
+		issuer:  issuer,
+		secret:  []byte(c.GetSecret()),
+		keys:    make(map[string]*jose.JSONWebKey, len(c.GetKeys())),
+		trusted: make(map[string]*remoteKeySet, len(c.GetTrustedIssuers())),
+	}
+	methods := make(map[string]struct{})
+	for _, keyConfig := range c.GetKeys() {
+		key, signer, err := loadKey(keyConfig)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := keySet.keys[key.KeyID]; ok {
+			return nil, merr.ErrorInternal("jwt key %s is duplicated", key.KeyID)
+		}
+		keySet.keys[key.KeyID] = key
+		methods[key.Algorithm] = struct{}{}
+		if pointer.IsNil(signer) {
+			continue
+		}
+		if keySet.signing == nil && strutil.IsEmpty(c.GetSigningKid()) || key.KeyID == c.GetSigningKid() {
+			keySet.signing = &signingKey{kid: key.KeyID, method: signingMethods[key.Algorithm], key: signer}
+		}
+	}
+	if len(keySet.keys) > 0 {
+		if strutil.IsNotEmpty(c.GetSigningKid()) && (keySet.signing == nil || keySet.signing.kid != c.GetSigningKid()) {
+			return nil, merr.ErrorInternal("jwt signing key %s has no private key", c.GetSigningKid())
+		}
+	} else if len(keySet.secret) > 0 {
+		methods[AlgorithmHS256] = struct{}{}
+	}
+	for _, trustedIssuer := range c.GetTrustedIssuers() {
+		if strutil.IsEmpty(trustedIssuer.GetIssuer()) || strutil.IsEmpty(trustedIssuer.GetJwksUrl()) || strutil.IsEmpty(trustedIssuer.GetAudience()) {
+			return nil, merr.ErrorInternal("jwt trusted issuer requires issuer, jwksUrl and audience")
+		}
+		if trustedIssuer.GetIssuer() == issuer {
+			return nil, merr.ErrorInternal("jwt trusted issuer %s is the issuer itself", issuer)
+		}
+		provider := trustedIssuer.GetProvider()
+		if strutil.IsEmpty(provider) {
+			provider = trustedIssuer.GetIssuer()
+		}
+		keySet.trusted[trustedIssuer.GetIssuer()] = &remoteKeySet{
+			url:      trustedIssuer.GetJwksUrl(),
+			audience: trustedIssuer.GetAudience(),
+			provider: provider,
+			client:   &http.Client{Timeout: remoteJWKSTimeout},
+		}
+		for algorithm := range signingMethods {
+			methods[algorithm] = struct{}{}
+		}
+	}
+	for method := range methods {
+		keySet.methods = append(keySet.methods, method)
+	}
+	return keySet, nil
+}
+
+// Issuer returns the iss claim of the tokens the key set signs.
+func (k *KeySet) Issuer() string {
+	return k.issuer
+}
+
+// Methods returns the signing algorithms the key set accepts.
+func (k *KeySet) Methods() []string {
+	return k.methods
+}
+
+// TrustedProvider returns the identity provider of the subjects of a trusted issuer, false for the issuer itself and unknown issuers.
+func (k *KeySet) TrustedProvider(issuer string) (string, bool) {
+	remote, ok := k.trusted[issuer]
+	if !ok {
+		return "", false
+	}
+	return remote.provider, true
+}
+
+// Sign signs the claims with the signing key and sends its kid in the header.
+func (k *KeySet) Sign(claims jwtv5.Claims) (string, error) {
+	if k.signing == nil {
+		if len(k.keys) > 0 {
+			return "", merr.ErrorInternal("jwt key set has no signing key")
+		}
+		if len(k.secret) == 0 {
+			return "", merr.ErrorInternal("jwt secret is required")
+		}
+		return jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, claims).SignedString(k.secret)
+	}
+	token := jwtv5.NewWithClaims(k.signing.method, claims)
+	token.Header["kid"] = k.signing.kid
+	return token.SignedString(k.signing.key)
+}
+
+// Keyfunc returns the key verifying the token, chosen by its issuer and kid, the algorithm of the token must be the one of the key.
+// The token of a trusted issuer must be issued for its audience.
+func (k *KeySet) Keyfunc(token *jwtv5.Token) (any, error) {
+	issuer, err := token.Claims.GetIssuer()
+	if err != nil {
+		return nil, err
+	}
+	kid, _ := token.Header["kid"].(string)
+	if issuer != k.issuer {
+		remote, ok := k.trusted[issuer]
+		if !ok {
+			return nil, fmt.Errorf("token issuer %q is not trusted", issuer)
+		}
+		audience, err := token.Claims.GetAudience()
+		if err != nil {
+			return nil, err
+		}
+		if !slices.Contains(audience, remote.audience) {
+			return nil, fmt.Errorf("token of issuer %q is not issued for %q", issuer, remote.audience)
+		}
+		key, err := remote.key(kid)
+		if err != nil {
+			return nil, err
+		}
+		return verifyKey(token, key)
+	}
+	if len(k.keys) == 0 {
+		if token.Method.Alg() != AlgorithmHS256 || len(k.secret) == 0 {
+			return nil, fmt.Errorf("token signing method %s is not accepted", token.Method.Alg())
+		}
+		return k.secret, nil
+	}
+	key, ok := k.keys[kid]
+	if !ok {
+		return nil, fmt.Errorf("token kid %q is unknown", kid)
+	}
+	return verifyKey(token, key)
+}
+
+// JWKS returns the public keys of the key set, the HS256 secret is never published.
+func (k *KeySet) JWKS() *jose.JSONWebKeySet {
+	jwks := &jose.JSONWebKeySet{Keys: make([]jose.JSONWebKey, 0, len(k.keys))}
+	for _, key := range k.keys {
+		jwks.Keys = append(jwks.Keys, *key)
+	}
+	return jwks
+}
+
+func verifyKey(token *jwtv5.Token, key *jose.JSONWebKey) (any, error) {
+	algorithm := key.Algorithm
+	if strutil.IsEmpty(algorithm) {
+		algorithm, _ = keyAlgorithm(key.Key)
+	}
+	if token.Method.Alg() != algorithm {
+		return nil, fmt.Errorf("token signing method %s does not match key %s", token.Method.Alg(), key.KeyID)
+	}
+	return key.Key, nil
+}
+
+func (r *remoteKeySet) key(kid string) (*jose.JSONWebKey, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if key := findKey(&r.keys, kid); key != nil {
+		return key, nil
+	}
+	if time.Since(r.fetchedAt) < remoteJWKSRefreshInterval {
+		return nil, fmt.Errorf("token kid %q is unknown", kid)
+	}
+	keys, err := r.fetch()
+	// a failed read waits for the interval too, so that a down issuer is not asked for every token
+	r.fetchedAt = time.Now()
+	if err != nil {
+		return nil, err
+	}
+	r.keys = *keys
+	if key := findKey(&r.keys, kid); key != nil {
+		return key, nil
+	}
+	return nil, fmt.Errorf("token kid %q is unknown", kid)
+}
+
+func (r *remoteKeySet) fetch() (*jose.JSONWebKeySet, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), remoteJWKSTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, r.url, nil)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("get jwks %s failed: %w", r.url, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("get jwks %s failed: %s", r.url, resp.Status)
+	}
+	var keys jose.JSONWebKeySet
+	if err := json.NewDecoder(resp.Body).Decode(&keys); err != nil {
+		return nil, fmt.Errorf("decode jwks %s failed: %w", r.url, err)
+	}
+	return &keys, nil
+}
+
+// findKey finds the key by kid, a token without kid matches the only key of the set.
+func findKey(keys *jose.JSONWebKeySet, kid string) *jose.JSONWebKey {
+	if strutil.IsEmpty(kid) {
+		if len(keys.Keys) == 1 {
+			return &keys.Keys[0]
+		}
+		return nil
+	}
+	if found := keys.Key(kid); len(found) > 0 {
+		return &found[0]
+	}
+	return nil
+}
+
+// loadKey reads the key files, signer is nil for a key that only verifies tokens.
+func loadKey(c *config.JWTKey) (*jose.JSONWebKey, crypto.Signer, error) {
+	var (
+		publicKey crypto.PublicKey
+		signer    crypto.Signer
+	)
+	switch {
+	case strutil.IsNotEmpty(c.GetPrivateKeyFile()):
+		block, err := readPEM(c.GetPrivateKeyFile())
+		if err != nil {
+			return nil, nil, err
+		}
+		if signer, err = parsePrivateKey(block); err != nil {
+			return nil, nil, merr.ErrorInternal("parse jwt private key %s failed", c.GetPrivateKeyFile()).WithCause(err)
+		}
+		publicKey = signer.Public()
+	case strutil.IsNotEmpty(c.GetPublicKeyFile()):
+		block, err := readPEM(c.GetPublicKeyFile())
+		if err != nil {
+			return nil, nil, err
+		}
+		if publicKey, err = parsePublicKey(block); err != nil {
+			return nil, nil, merr.ErrorInternal("parse jwt public key %s failed", c.GetPublicKeyFile()).WithCause(err)
+		}
+	default:
+		return nil, nil, merr.ErrorInternal("jwt key %s requires privateKeyFile or publicKeyFile", c.GetKid())
+	}
+	algorithm, err := keyAlgorithm(publicKey)
+	if err != nil {
+		return nil, nil, err
+	}
+	if strutil.IsNotEmpty(c.GetAlgorithm()) && c.GetAlgorithm() != algorithm {
+		return nil, nil, merr.ErrorInternal("jwt key %s is a %s key, not %s", c.GetKid(), algorithm, c.GetAlgorithm())
+	}
+	key := &jose.JSONWebKey{Key: publicKey, KeyID: c.GetKid(), Algorithm: algorithm, Use: "sig"}
+	if strutil.IsEmpty(key.KeyID) {
+		thumbprint, err := key.Thumbprint(crypto.SHA256)
+		if err != nil {
+			return nil, nil, merr.ErrorInternal("jwt key thumbprint failed").WithCause(err)
+		}
+		key.KeyID = base64.RawURLEncoding.EncodeToString(thumbprint)
+	}
+	return key, signer, nil
+}
+
+// keyAlgorithm returns the only algorithm the key is used with.
+func keyAlgorithm(publicKey crypto.PublicKey) (string, error) {
+	switch key := publicKey.(type) {
+	case *rsa.PublicKey:
+		return AlgorithmRS256, nil
+	case *ecdsa.PublicKey:
+		if key.Curve != elliptic.P256() {
+			return "", merr.ErrorInternal("jwt ecdsa key must use the P-256 curve")
+		}
+		return AlgorithmES256, nil
+	case ed25519.PublicKey:
+		return AlgorithmEdDSA, nil
+	default:
+		return "", merr.ErrorInternal("jwt key type %T is not supported", publicKey)
+	}
+}
+
+func readPEM(file string) (*pem.Block, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, merr.ErrorInternal("read jwt key file %s failed", file).WithCause(err)
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, merr.ErrorInternal("jwt key file %s is not a pem file", file)
+	}
+	return block, nil
+}
+
+func parsePrivateKey(block *pem.Block) (crypto.Signer, error) {
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		return x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "EC PRIVATE KEY":
+		return x509.ParseECPrivateKey(block.Bytes)
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	signer, ok := key.(crypto.Signer)
+	if !ok {
+		return nil, fmt.Errorf("private key type %T is not supported", key)
+	}
+	return signer, nil
+}
+
+func parsePublicKey(block *pem.Block) (crypto.PublicKey, error) {
+	switch block.Type {
+	case "CERTIFICATE":
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		return cert.PublicKey, nil
+	case "RSA PUBLIC KEY":
+		return x509.ParsePKCS1PublicKey(block.Bytes)
+	}
+	return x509.ParsePKIXPublicKey(block.Bytes)
+}
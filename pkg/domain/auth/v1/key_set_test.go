@@ -0,0 +1,263 @@
+package authv1_test
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+func writeKeyFiles(t *testing.T, name string, key crypto.Signer) (privateKeyFile, publicKeyFile string) {
+	t.Helper()
+	privateDER, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("MarshalPKCS8PrivateKey failed: %v", err)
+	}
+	publicDER, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		t.Fatalf("MarshalPKIXPublicKey failed: %v", err)
+	}
+	dir := t.TempDir()
+	privateKeyFile, publicKeyFile = filepath.Join(dir, name+".pem"), filepath.Join(dir, name+".pub.pem")
+	if err := os.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: privateDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(publicKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: publicDER}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	return privateKeyFile, publicKeyFile
+}
+
+func newTestKeys(t *testing.T) map[string]crypto.Signer {
+	t.Helper()
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, edKey, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return map[string]crypto.Signer{authv1.AlgorithmRS256: rsaKey, authv1.AlgorithmES256: ecKey, authv1.AlgorithmEdDSA: edKey}
+}
+
+func parseToken(keySet *authv1.KeySet, token string) (*authv1.JwtClaims, error) {
+	claims := &authv1.JwtClaims{}
+	_, err := jwtv5.ParseWithClaims(token, claims, keySet.Keyfunc, jwtv5.WithValidMethods(keySet.Methods()))
+	return claims, err
+}
+
+func TestKeySetSignAndVerify(t *testing.T) {
+	for algorithm, key := range newTestKeys(t) {
+		t.Run(algorithm, func(t *testing.T) {
+			privateKeyFile, _ := writeKeyFiles(t, algorithm, key)
+			c := &config.JWT{Issuer: "sovereign-test", Keys: []*config.JWTKey{{PrivateKeyFile: privateKeyFile}}}
+			keySet, err := authv1.LoadKeySet(c)
+			if err != nil {
+				t.Fatalf("LoadKeySet failed: %v", err)
+			}
+			token, err := authv1.NewJwtClaims(c, authv1.BaseInfo{Username: "alice"}).GenerateToken()
+			if err != nil {
+				t.Fatalf("GenerateToken failed: %v", err)
+			}
+			parsed, _, err := jwtv5.NewParser().ParseUnverified(token, &authv1.JwtClaims{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if parsed.Method.Alg() != algorithm || parsed.Header["kid"] != keySet.JWKS().Keys[0].KeyID {
+				t.Fatalf("unexpected header %v", parsed.Header)
+			}
+			claims, err := parseToken(keySet, token)
+			if err != nil {
+				t.Fatalf("parse token failed: %v", err)
+			}
+			if claims.Username != "alice" {
+				t.Fatalf("unexpected username %s", claims.Username)
+			}
+			// the public key set is all a verifying service needs
+			jwks, err := json.Marshal(keySet.JWKS())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(keySet.JWKS().Keys) != 1 || !keySet.JWKS().Keys[0].IsPublic() {
+				t.Fatalf("jwks is not a single public key: %s", jwks)
+			}
+		})
+	}
+}
+
+func TestKeySetRotation(t *testing.T) {
+	keys := newTestKeys(t)
+	oldPrivateKeyFile, oldPublicKeyFile := writeKeyFiles(t, "old", keys[authv1.AlgorithmRS256])
+	newPrivateKeyFile, _ := writeKeyFiles(t, "new", keys[authv1.AlgorithmES256])
+
+	before := &config.JWT{Issuer: "sovereign-test", Keys: []*config.JWTKey{{Kid: "old", PrivateKeyFile: oldPrivateKeyFile}}}
+	oldToken, err := authv1.NewJwtClaims(before, authv1.BaseInfo{Username: "alice"}).GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+
+	after := &config.JWT{
+		Issuer: "sovereign-test",
+		Keys: []*config.JWTKey{
+			{Kid: "old", PublicKeyFile: oldPublicKeyFile},
+			{Kid: "new", PrivateKeyFile: newPrivateKeyFile},
+		},
+		SigningKid: "new",
+	}
+	keySet, err := authv1.LoadKeySet(after)
+	if err != nil {
+		t.Fatalf("LoadKeySet failed: %v", err)
+	}
+	newToken, err := authv1.NewJwtClaims(after, authv1.BaseInfo{Username: "bob"}).GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+	for _, token := range []string{oldToken, newToken} {
+		if _, err := parseToken(keySet, token); err != nil {
+			t.Fatalf("parse token failed: %v", err)
+		}
+	}
+
+	retired := &config.JWT{Issuer: "sovereign-test", Keys: []*config.JWTKey{{Kid: "new", PrivateKeyFile: newPrivateKeyFile}}}
+	retiredKeySet, err := authv1.LoadKeySet(retired)
+	if err != nil {
+		t.Fatalf("LoadKeySet failed: %v", err)
+	}
+	if _, err := parseToken(retiredKeySet, oldToken); err == nil {
+		t.Fatal("token of a removed key is accepted")
+	}
+
+	if _, err := authv1.LoadKeySet(&config.JWT{Keys: []*config.JWTKey{{Kid: "old", PublicKeyFile: oldPublicKeyFile}}, SigningKid: "old"}); err == nil {
+		t.Fatal("signing key without private key is accepted")
+	}
+}
+
+func TestKeySetTrustedIssuer(t *testing.T) {
+	privateKeyFile, _ := writeKeyFiles(t, "remote", newTestKeys(t)[authv1.AlgorithmEdDSA])
+	remote := &config.JWT{Issuer: "sovereign-remote", Keys: []*config.JWTKey{{PrivateKeyFile: privateKeyFile}}}
+	remoteKeySet, err := authv1.LoadKeySet(remote)
+	if err != nil {
+		t.Fatalf("LoadKeySet failed: %v", err)
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(remoteKeySet.JWKS())
+	}))
+	defer srv.Close()
+
+	local := &config.JWT{
+		Secret:         "xxx",
+		Issuer:         "sovereign-test",
+		TrustedIssuers: []*config.JWTTrustedIssuer{{Issuer: "sovereign-remote", JwksUrl: srv.URL, Audience: "sovereign-test"}},
+	}
+	keySet, err := authv1.LoadKeySet(local)
+	if err != nil {
+		t.Fatalf("LoadKeySet failed: %v", err)
+	}
+	if provider, ok := keySet.TrustedProvider("sovereign-remote"); !ok || provider != "sovereign-remote" {
+		t.Fatalf("want the issuer as the default provider, got %q %v", provider, ok)
+	}
+	if _, ok := keySet.TrustedProvider("sovereign-test"); ok {
+		t.Fatal("the issuer itself is a trusted issuer")
+	}
+	remoteToken := func(audience ...string) string {
+		token, err := remoteKeySet.Sign(&authv1.JwtClaims{
+			BaseInfo: authv1.BaseInfo{UID: 1, Username: "alice"},
+			RegisteredClaims: jwtv5.RegisteredClaims{
+				Issuer:    "sovereign-remote",
+				Subject:   "alice",
+				Audience:  audience,
+				ExpiresAt: jwtv5.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Sign failed: %v", err)
+		}
+		return token
+	}
+	if _, err := parseToken(keySet, remoteToken("other", "sovereign-test")); err != nil {
+		t.Fatalf("parse remote token failed: %v", err)
+	}
+	if _, err := parseToken(keySet, remoteToken()); err == nil {
+		t.Fatal("remote token without audience is accepted")
+	}
+	if _, err := parseToken(keySet, remoteToken("other")); err == nil {
+		t.Fatal("remote token for another audience is accepted")
+	}
+	localToken, err := authv1.NewJwtClaims(local, authv1.BaseInfo{Username: "bob"}).GenerateToken()
+	if err != nil {
+		t.Fatalf("GenerateToken failed: %v", err)
+	}
+	if _, err := parseToken(keySet, localToken); err != nil {
+		t.Fatalf("parse HS256 token failed: %v", err)
+	}
+
+	// a token of the remote issuer signed with the secret must not pass as an HS256 token
+	forged, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, &authv1.JwtClaims{
+		RegisteredClaims: jwtv5.RegisteredClaims{Issuer: "sovereign-remote"},
+	}).SignedString([]byte("xxx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseToken(keySet, forged); err == nil {
+		t.Fatal("HS256 token of a trusted issuer is accepted")
+	}
+	unknown, err := jwtv5.NewWithClaims(jwtv5.SigningMethodHS256, &authv1.JwtClaims{
+		RegisteredClaims: jwtv5.RegisteredClaims{Issuer: "somebody"},
+	}).SignedString([]byte("xxx"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := parseToken(keySet, unknown); err == nil {
+		t.Fatal("token of an unknown issuer is accepted")
+	}
+}
+
+func TestKeySetTrustedIssuerConfig(t *testing.T) {
+	tests := []struct {
+		name    string
+		trusted *config.JWTTrustedIssuer
+	}{
+		{name: "without audience", trusted: &config.JWTTrustedIssuer{Issuer: "sovereign-remote", JwksUrl: "https://remote.example.com/jwks.json"}},
+		{name: "without jwksUrl", trusted: &config.JWTTrustedIssuer{Issuer: "sovereign-remote", Audience: "sovereign-test"}},
+		{name: "the issuer itself", trusted: &config.JWTTrustedIssuer{Issuer: "sovereign-test", JwksUrl: "https://remote.example.com/jwks.json", Audience: "sovereign-test"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := authv1.NewKeySet(&config.JWT{Secret: "xxx", Issuer: "sovereign-test", TrustedIssuers: []*config.JWTTrustedIssuer{tt.trusted}}); err == nil {
+				t.Fatal("want the trusted issuer refused")
+			}
+		})
+	}
+	keySet, err := authv1.NewKeySet(&config.JWT{
+		Secret:         "xxx",
+		Issuer:         "sovereign-test",
+		TrustedIssuers: []*config.JWTTrustedIssuer{{Issuer: "sovereign-remote", JwksUrl: "https://remote.example.com/jwks.json", Audience: "sovereign-test", Provider: "remote-sso"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if provider, _ := keySet.TrustedProvider("sovereign-remote"); provider != "remote-sso" {
+		t.Fatalf("want the configured provider, got %q", provider)
+	}
+}
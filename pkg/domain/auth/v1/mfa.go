@@ -0,0 +1,129 @@
+package authv1
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The amr values of the tokens, see RFC 8176.
+const (
+	// AMRPassword 密码登录，包括 LDAP 登录
+	AMRPassword = "pwd"
+	// AMRFederated OAuth2 登录
+	AMRFederated = "fed"
+	// AMROTP TOTP 验证码
+	AMROTP = "otp"
+	// AMRRecoveryCode 恢复码，不是 RFC 8176 注册的值
+	AMRRecoveryCode = "rc"
+	// AMRMFA 登录完成了第二因素认证
+	AMRMFA = "mfa"
+)
+
+const (
+	totpPeriod = 30
+	totpDigits = 6
+	// totpSkew accepts the codes of the steps next to the current one, for the clocks of the phones which drift
+	totpSkew = 1
+	// totpSecretSize is the 160 bits recommended by RFC 4226
+	totpSecretSize = 20
+	// RecoveryCodeCount is the number of the recovery codes of a user
+	RecoveryCodeCount = 10
+	// MFAChallengeExpire is how long a login waits for the second factor
+	MFAChallengeExpire = 5 * time.Minute
+	// MFAChallengeMaxAttempts is the number of the wrong codes which use the challenge up
+	MFAChallengeMaxAttempts = 5
+)
+
+var totpEncoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// MFA reports whether the token was issued by a login which passed the second factor.
+func (b BaseInfo) MFA() bool {
+	return slices.Contains(b.AMR, AMRMFA)
+}
+
+// NewTOTPSecret 生成 base32 编码的 TOTP 密钥
+func NewTOTPSecret() string {
+	secret := make([]byte, totpSecretSize)
+	_, _ = rand.Read(secret)
+	return totpEncoding.EncodeToString(secret)
+}
+
+// TOTPProvisioningURI returns the otpauth URI of the secret, which the authenticator apps read from a QR code.
+func TOTPProvisioningURI(issuer, account, secret string) string {
+	query := url.Values{}
+	query.Set("secret", secret)
+	query.Set("issuer", issuer)
+	query.Set("algorithm", "SHA1")
+	query.Set("digits", strconv.Itoa(totpDigits))
+	query.Set("period", strconv.Itoa(totpPeriod))
+	return "otpauth://totp/" + url.PathEscape(issuer+":"+account) + "?" + query.Encode()
+}
+
+// IsTOTPCode 判断 code 是否为 TOTP 验证码的格式，其他格式按恢复码处理
+func IsTOTPCode(code string) bool {
+	if len(code) != totpDigits {
+		return false
+	}
+	for _, c := range code {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// VerifyTOTP checks the code against the steps around now, and returns the step of the code,
+// the caller keeps the last used step so that a code can not be used twice.
+func VerifyTOTP(secret, code string, now time.Time) (int64, bool) {
+	key, err := totpEncoding.DecodeString(strings.ToUpper(strings.TrimRight(secret, "=")))
+	if err != nil || !IsTOTPCode(code) {
+		return 0, false
+	}
+	current := now.Unix() / totpPeriod
+	for step := current - totpSkew; step <= current+totpSkew; step++ {
+		if subtle.ConstantTimeCompare([]byte(totpCode(key, step)), []byte(code)) == 1 {
+			return step, true
+		}
+	}
+	return 0, false
+}
+
+// totpCode is the HOTP value of the step, RFC 4226 section 5.3.
+func totpCode(key []byte, step int64) string {
+	mac := hmac.New(sha1.New, key)
+	_ = binary.Write(mac, binary.BigEndian, step)
+	sum := mac.Sum(nil)
+	offset := sum[len(sum)-1] & 0x0f
+	value := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+	return fmt.Sprintf("%0*d", totpDigits, value%1000000)
+}
+
+// NewRecoveryCodes 生成恢复码，只有哈希会被保存
+func NewRecoveryCodes() (codes []string, hashes []string) {
+	codes, hashes = make([]string, 0, RecoveryCodeCount), make([]string, 0, RecoveryCodeCount)
+	for range RecoveryCodeCount {
+		raw := make([]byte, 5)
+		_, _ = rand.Read(raw)
+		code := hex.EncodeToString(raw)
+		codes = append(codes, code[:5]+"-"+code[5:])
+		hashes = append(hashes, HashRecoveryCode(code))
+	}
+	return codes, hashes
+}
+
+// HashRecoveryCode 计算恢复码的哈希，忽略大小写和分隔符
+func HashRecoveryCode(code string) string {
+	code = strings.ToLower(strings.NewReplacer("-", "", " ", "").Replace(code))
+	return HashRefreshToken(code)
+}
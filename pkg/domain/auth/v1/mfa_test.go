@@ -0,0 +1,70 @@
+package authv1_test
+
+import (
+	"encoding/base32"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+)
+
+// the SHA1 vectors of RFC 6238 appendix B, truncated to 6 digits
+func TestVerifyTOTP(t *testing.T) {
+	secret := base32.StdEncoding.EncodeToString([]byte("12345678901234567890"))
+	tests := []struct {
+		unix int64
+		code string
+	}{
+		{unix: 59, code: "287082"},
+		{unix: 1111111109, code: "081804"},
+		{unix: 1234567890, code: "005924"},
+		{unix: 2000000000, code: "279037"},
+	}
+	for _, tt := range tests {
+		step, ok := authv1.VerifyTOTP(secret, tt.code, time.Unix(tt.unix, 0))
+		if !ok || step != tt.unix/30 {
+			t.Fatalf("code %s at %d: got step %d, %v", tt.code, tt.unix, step, ok)
+		}
+	}
+	// the code of the previous step is accepted for the clocks which drift, older codes are not
+	if _, ok := authv1.VerifyTOTP(secret, "287082", time.Unix(89, 0)); !ok {
+		t.Fatal("want the code of the previous step accepted")
+	}
+	if _, ok := authv1.VerifyTOTP(secret, "287082", time.Unix(120, 0)); ok {
+		t.Fatal("want the code of two steps ago refused")
+	}
+	if _, ok := authv1.VerifyTOTP(secret, "287083", time.Unix(59, 0)); ok {
+		t.Fatal("want a wrong code refused")
+	}
+}
+
+func TestTOTPProvisioningURI(t *testing.T) {
+	secret := authv1.NewTOTPSecret()
+	uri, err := url.Parse(authv1.TOTPProvisioningURI("moon", "admin@example.com", secret))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uri.Scheme != "otpauth" || uri.Host != "totp" || uri.Path != "/moon:admin@example.com" {
+		t.Fatalf("got provisioning URI %s", uri)
+	}
+	if query := uri.Query(); query.Get("secret") != secret || query.Get("issuer") != "moon" || query.Get("digits") != "6" {
+		t.Fatalf("got provisioning URI query %v", query)
+	}
+}
+
+func TestRecoveryCodes(t *testing.T) {
+	codes, hashes := authv1.NewRecoveryCodes()
+	if len(codes) != authv1.RecoveryCodeCount || len(hashes) != authv1.RecoveryCodeCount {
+		t.Fatalf("got %d codes and %d hashes", len(codes), len(hashes))
+	}
+	for i, code := range codes {
+		if authv1.IsTOTPCode(code) {
+			t.Fatalf("recovery code %s looks like a TOTP code", code)
+		}
+		if authv1.HashRecoveryCode(strings.ToUpper(strings.ReplaceAll(code, "-", ""))) != hashes[i] {
+			t.Fatalf("want the hash of %s to ignore case and separators", code)
+		}
+	}
+}
@@ -0,0 +1,34 @@
+package authv1
+
+import "slices"
+
+// PageWindow trims rows fetched with one extra look-ahead row and returns the cursors around the page.
+// Rows fetched backward are reversed into display order, hasPrevious reports whether rows exist before a forward page.
+func PageWindow[T any](rows []T, pageSize int, cursor *PageCursor, hasPrevious bool, idOf func(T) uint32) ([]T, *PageCursor, *PageCursor) {
+	if pageSize <= 0 {
+		return rows, nil, nil
+	}
+	hasMore := len(rows) > pageSize
+	if hasMore {
+		rows = rows[:pageSize]
+	}
+	backward := cursor.GetBackward()
+	if backward {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, nil, nil
+	}
+	hasPrev, hasNext := hasPrevious, hasMore
+	if backward {
+		hasPrev, hasNext = hasMore, true
+	}
+	var prev, next *PageCursor
+	if hasPrev {
+		prev = &PageCursor{Id: idOf(rows[0]), Backward: true}
+	}
+	if hasNext {
+		next = &PageCursor{Id: idOf(rows[len(rows)-1])}
+	}
+	return rows, prev, next
+}
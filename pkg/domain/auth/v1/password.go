@@ -0,0 +1,50 @@
+package authv1
+
+import (
+	"errors"
+	"unicode/utf8"
+
+	"golang.org/x/crypto/bcrypt"
+
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	minPasswordLength = 8
+	// bcrypt only reads the first 72 bytes of a password
+	maxPasswordLength = 72
+)
+
+// dummyPasswordHash is compared when the account does not exist, so that a missing account takes as long as a wrong password.
+var dummyPasswordHash, _ = bcrypt.GenerateFromPassword([]byte("sovereign-dummy-password"), bcrypt.DefaultCost)
+
+// HashPassword 校验密码强度并计算 bcrypt 哈希
+func HashPassword(password string) (string, error) {
+	if utf8.RuneCountInString(password) < minPasswordLength {
+		return "", merr.ErrorParams("password must have at least %d characters", minPasswordLength)
+	}
+	if len(password) > maxPasswordLength {
+		return "", merr.ErrorParams("password must not exceed %d bytes", maxPasswordLength)
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	if err != nil {
+		return "", merr.ErrorInternal("hash password failed").WithCause(err)
+	}
+	return string(hash), nil
+}
+
+// ComparePassword 比较密码与哈希，hash 为空时按账号不存在处理
+func ComparePassword(hash, password string) (bool, error) {
+	if hash == "" {
+		_ = bcrypt.CompareHashAndPassword(dummyPasswordHash, []byte(password))
+		return false, nil
+	}
+	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
+	if err == nil {
+		return true, nil
+	}
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return false, nil
+	}
+	return false, merr.ErrorInternal("compare password failed").WithCause(err)
+}
@@ -0,0 +1,19 @@
+package authv1
+
+import "strings"
+
+// MatchPermission reports whether a permission grants the operation, a permission ending with * matches operations starting with it.
+func MatchPermission(permissions []string, operation string) bool {
+	for _, permission := range permissions {
+		if prefix, ok := strings.CutSuffix(permission, "*"); ok {
+			if strings.HasPrefix(operation, prefix) {
+				return true
+			}
+			continue
+		}
+		if permission == operation {
+			return true
+		}
+	}
+	return false
+}
@@ -6,9 +6,12 @@ import (
 	"context"
 	"fmt"
 	"io"
+	"maps"
 	"os"
 	"path/filepath"
+	"slices"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -48,24 +51,33 @@ func NewFileRepository(c *config.DomainConfig) (namespacev1.Repository, func() e
 	}
 
 	tmpFilepath := filepath.Join(fileConfig.Path, fmt.Sprintf("%s.tmp", fileConfig.Filename))
+	revisionsFilename := revisionsFilename(fileConfig.Filename)
+	revisionsTmpFilepath := filepath.Join(fileConfig.Path, fmt.Sprintf("%s.tmp", revisionsFilename))
+	revisionsFilepath := filepath.Join(fileConfig.Path, revisionsFilename)
 	filepath := filepath.Join(fileConfig.Path, fileConfig.Filename)
 	node, err := snowflake.NewNode(hello.NodeID())
 	if err != nil {
 		return nil, nil, err
 	}
 	f := &fileRepository{
-		repoConfig:      c,
-		fileConfig:      fileConfig,
-		tmpFilepath:     tmpFilepath,
-		filepath:        filepath,
-		stopChan:        make(chan struct{}),
-		storageInterval: fileConfig.StorageInterval.AsDuration(),
-		node:            node,
-		namespaces:      make([]*model.NamespaceModel, 0),
+		repoConfig:           c,
+		fileConfig:           fileConfig,
+		tmpFilepath:          tmpFilepath,
+		filepath:             filepath,
+		revisionsTmpFilepath: revisionsTmpFilepath,
+		revisionsFilepath:    revisionsFilepath,
+		stopChan:             make(chan struct{}),
+		storageInterval:      fileConfig.StorageInterval.AsDuration(),
+		node:                 node,
+		namespaces:           make([]*model.NamespaceModel, 0),
+		revisions:            make([]*model.NamespaceRevisionModel, 0),
 	}
 	if err := f.load(); err != nil {
 		return nil, nil, err
 	}
+	if err := f.loadRevisions(); err != nil {
+		return nil, nil, err
+	}
 	f.watch()
 	return f, func() error {
 		close(f.stopChan)
@@ -74,13 +86,19 @@ func NewFileRepository(c *config.DomainConfig) (namespacev1.Repository, func() e
 }
 
 type fileRepository struct {
-	repoConfig      *config.DomainConfig
-	fileConfig      *config.FileConfig
-	tmpFilepath     string
-	filepath        string
-	mu              sync.RWMutex
-	namespaces      []*model.NamespaceModel
-	nextID          uint32
+	repoConfig  *config.DomainConfig
+	fileConfig  *config.FileConfig
+	tmpFilepath string
+	filepath    string
+	mu          sync.RWMutex
+	namespaces  []*model.NamespaceModel
+	nextID      uint32
+
+	revisionsTmpFilepath string
+	revisionsFilepath    string
+	revisions            []*model.NamespaceRevisionModel
+	nextRevisionID       uint32
+
 	stopChan        chan struct{}
 	storageInterval time.Duration
 	changed         bool
@@ -151,9 +169,119 @@ func (f *fileRepository) save() error {
 		return err
 	}
 	klog.Debugw("msg", "save namespaces to file", "filepath", f.filepath)
+	return f.saveRevisions()
+}
+
+// revisionsFilename derives the revisions file from the namespaces file, e.g. namespaces.yaml -> namespaces.revisions.yaml.
+func revisionsFilename(filename string) string {
+	ext := filepath.Ext(filename)
+	return strings.TrimSuffix(filename, ext) + ".revisions" + ext
+}
+
+func (f *fileRepository) loadRevisions() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if _, err := os.Stat(f.revisionsFilepath); os.IsNotExist(err) {
+		return nil
+	}
+
+	file, err := os.Open(f.revisionsFilepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var revisions []*model.NamespaceRevisionModel
+	if err := yaml.NewDecoder(file).Decode(&revisions); err != nil {
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	for _, revision := range revisions {
+		f.nextRevisionID = max(f.nextRevisionID, revision.ID)
+	}
+	f.revisions = revisions
+	return nil
+}
+
+// saveRevisions writes the revisions file, the caller must hold the lock.
+func (f *fileRepository) saveRevisions() error {
+	file, err := os.Create(f.revisionsTmpFilepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := yaml.NewEncoder(file).Encode(f.revisions); err != nil {
+		return err
+	}
+	if err := os.Rename(f.revisionsTmpFilepath, f.revisionsFilepath); err != nil {
+		return err
+	}
+	klog.Debugw("msg", "save namespace revisions to file", "filepath", f.revisionsFilepath)
 	return nil
 }
 
+// appendRevision snapshots the namespace as the next revision, the caller must hold the lock.
+func (f *fileRepository) appendRevision(namespace *model.NamespaceModel, action namespacev1.RevisionAction, sourceRevision int32, operator int64) *model.NamespaceRevisionModel {
+	var revision int32
+	for _, item := range f.revisions {
+		if item.NamespaceUID == namespace.UID {
+			revision = max(revision, item.Revision)
+		}
+	}
+	f.nextRevisionID++
+	revisionItem := &model.NamespaceRevisionModel{
+		ID:             f.nextRevisionID,
+		UID:            f.node.Generate().Int64(),
+		NamespaceUID:   namespace.UID,
+		Revision:       revision + 1,
+		Name:           namespace.Name,
+		Metadata:       maps.Clone(namespace.Metadata),
+		Status:         namespace.Status,
+		Action:         int32(action),
+		SourceRevision: sourceRevision,
+		Creator:        operatorOf(operator),
+		CreatedAt:      time.Now().Unix(),
+		DisplayName:    namespace.DisplayName,
+		Description:    namespace.Description,
+		Owners:         slices.Clone(namespace.Owners),
+		Icon:           namespace.Icon,
+	}
+	f.revisions = append(f.revisions, revisionItem)
+	return revisionItem
+}
+
+// findNamespace returns the namespace by uid, the caller must hold the lock.
+func (f *fileRepository) findNamespace(uid int64) *model.NamespaceModel {
+	for _, namespace := range f.namespaces {
+		if namespace.UID == uid {
+			return namespace
+		}
+	}
+	return nil
+}
+
+// findRevision returns the revision of the namespace, the caller must hold the lock.
+func (f *fileRepository) findRevision(namespaceUID int64, revision int32) *model.NamespaceRevisionModel {
+	for _, item := range f.revisions {
+		if item.NamespaceUID == namespaceUID && item.Revision == revision {
+			return item
+		}
+	}
+	return nil
+}
+
+// operatorOf falls back to the system operator when the change is not made by a signed-in user.
+func operatorOf(operator int64) int64 {
+	if operator <= 0 {
+		return 1
+	}
+	return operator
+}
+
 func (f *fileRepository) watch() {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
@@ -191,17 +319,22 @@ func (f *fileRepository) CreateNamespace(ctx context.Context, req *namespacev1.C
 	f.nextID++
 	nextID := f.nextID
 	namespaceItem := &model.NamespaceModel{
-		ID:        nextID,
-		UID:       f.node.Generate().Int64(),
-		Name:      req.Name,
-		Metadata:  req.Metadata,
-		Status:    req.Status,
-		CreatedAt: time.Now().Unix(),
-		UpdatedAt: time.Now().Unix(),
-		Creator:   f.node.Generate().Int64(),
-		DeletedAt: 0,
+		ID:          nextID,
+		UID:         f.node.Generate().Int64(),
+		Name:        req.Name,
+		Metadata:    req.Metadata,
+		Status:      req.Status,
+		CreatedAt:   time.Now().Unix(),
+		UpdatedAt:   time.Now().Unix(),
+		Creator:     operatorOf(req.Operator),
+		DeletedAt:   0,
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Owners:      req.Owners,
+		Icon:        req.Icon,
 	}
 	f.namespaces = append(f.namespaces, namespaceItem)
+	f.appendRevision(namespaceItem, namespacev1.RevisionAction_REVISION_ACTION_CREATE, 0, req.Operator)
 	return convertNamespaceModel(namespaceItem), nil
 }
 
@@ -239,79 +372,100 @@ func (f *fileRepository) GetNamespaceByName(ctx context.Context, req *namespacev
 	return nil, merr.ErrorNotFound("namespace %s not found", req.Name)
 }
 
-func desc[T cmp.Ordered](i, j T) bool {
-	return i > j
+// sortKey is the comparable sort key of a namespace, a field uses either num or str.
+type sortKey struct {
+	num int64
+	str string
 }
 
-func asc[T cmp.Ordered](i, j T) bool {
-	return i < j
-}
-
-func (f *fileRepository) getSortLessFunc(orderBy namespacev1.Field, order namespacev1.Order) func(i, j int) bool {
+func namespaceSortKey(namespace *model.NamespaceModel, orderBy namespacev1.Field) sortKey {
 	switch orderBy {
 	case namespacev1.Field_ID:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].ID, f.namespaces[j].ID)
-			}
-			return asc(f.namespaces[i].ID, f.namespaces[j].ID)
-		}
+		return sortKey{num: int64(namespace.ID)}
 	case namespacev1.Field_NAME:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].Name, f.namespaces[j].Name)
-			}
-			return asc(f.namespaces[i].Name, f.namespaces[j].Name)
-		}
+		return sortKey{str: namespace.Name}
 	case namespacev1.Field_STATUS:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].Status, f.namespaces[j].Status)
-			}
-			return asc(f.namespaces[i].Status, f.namespaces[j].Status)
-		}
+		return sortKey{num: int64(namespace.Status)}
 	case namespacev1.Field_CREATED_AT:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].CreatedAt, f.namespaces[j].CreatedAt)
-			}
-			return asc(f.namespaces[i].CreatedAt, f.namespaces[j].CreatedAt)
-		}
+		return sortKey{num: namespace.CreatedAt}
 	case namespacev1.Field_UPDATED_AT:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].UpdatedAt, f.namespaces[j].UpdatedAt)
-			}
-			return asc(f.namespaces[i].UpdatedAt, f.namespaces[j].UpdatedAt)
-		}
-	case namespacev1.Field_DELETED_AT:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].DeletedAt, f.namespaces[j].DeletedAt)
-			}
-			return asc(f.namespaces[i].DeletedAt, f.namespaces[j].DeletedAt)
-		}
+		return sortKey{num: namespace.UpdatedAt}
 	case namespacev1.Field_CREATOR:
-		return func(i, j int) bool {
-			if order == namespacev1.Order_DESC {
-				return desc(f.namespaces[i].Creator, f.namespaces[j].Creator)
-			}
-			return asc(f.namespaces[i].Creator, f.namespaces[j].Creator)
-		}
+		return sortKey{num: namespace.Creator}
 	default:
-		return func(i, j int) bool {
-			return false
+		return sortKey{num: namespace.UID}
+	}
+}
+
+// compareSortKey compares two rows by sort key and then by uid, desc reverses the result.
+func compareSortKey(a sortKey, aUID int64, b sortKey, bUID int64, desc bool) int {
+	c := cmp.Or(cmp.Compare(a.num, b.num), cmp.Compare(a.str, b.str), cmp.Compare(aUID, bUID))
+	if desc {
+		return -c
+	}
+	return c
+}
+
+func namespaceCursor(namespace *model.NamespaceModel, orderBy namespacev1.Field) *namespacev1.PageCursor {
+	key := namespaceSortKey(namespace, orderBy)
+	value := key.str
+	if orderBy != namespacev1.Field_NAME {
+		value = strconv.FormatInt(key.num, 10)
+	}
+	return &namespacev1.PageCursor{Value: value, Uid: namespace.UID}
+}
+
+func parseCursorKey(orderBy namespacev1.Field, cursor *namespacev1.PageCursor) (sortKey, error) {
+	if orderBy == namespacev1.Field_NAME {
+		return sortKey{str: cursor.GetValue()}, nil
+	}
+	if orderBy == namespacev1.Field_UID {
+		return sortKey{num: cursor.GetUid()}, nil
+	}
+	num, err := strconv.ParseInt(cursor.GetValue(), 10, 64)
+	if err != nil {
+		return sortKey{}, merr.ErrorParams("invalid page cursor")
+	}
+	return sortKey{num: num}, nil
+}
+
+// pageNamespaces sorts the namespaces and returns the rows of one page with one extra look-ahead row,
+// rows of a backward page are returned nearest to the cursor first.
+func pageNamespaces(namespaces []*model.NamespaceModel, orderBy namespacev1.Field, desc bool, cursor *namespacev1.PageCursor, offset, pageSize int) ([]*model.NamespaceModel, error) {
+	slices.SortFunc(namespaces, func(a, b *model.NamespaceModel) int {
+		return compareSortKey(namespaceSortKey(a, orderBy), a.UID, namespaceSortKey(b, orderBy), b.UID, desc)
+	})
+	if pointer.IsNotNil(cursor) {
+		key, err := parseCursorKey(orderBy, cursor)
+		if err != nil {
+			return nil, err
 		}
+		index, _ := slices.BinarySearchFunc(namespaces, key, func(namespace *model.NamespaceModel, key sortKey) int {
+			return compareSortKey(namespaceSortKey(namespace, orderBy), namespace.UID, key, cursor.GetUid(), desc)
+		})
+		if cursor.GetBackward() {
+			namespaces = slices.Clone(namespaces[:index])
+			slices.Reverse(namespaces)
+		} else {
+			if index < len(namespaces) && namespaces[index].UID == cursor.GetUid() {
+				index++
+			}
+			namespaces = namespaces[index:]
+		}
+	} else {
+		namespaces = namespaces[min(offset, len(namespaces)):]
+	}
+	if pageSize > 0 {
+		namespaces = namespaces[:min(pageSize+1, len(namespaces))]
 	}
+	return namespaces, nil
 }
 
 // ListNamespace implements [namespacev1.Repository].
 func (f *fileRepository) ListNamespace(ctx context.Context, req *namespacev1.ListNamespaceRequest) (*namespacev1.ListNamespaceResponse, error) {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	namespaces := make([]*namespacev1.NamespaceModel, 0, len(f.namespaces))
-	sortFunc := f.getSortLessFunc(req.OrderBy, req.Order)
-	sort.Slice(f.namespaces, sortFunc)
+	filtered := make([]*model.NamespaceModel, 0, len(f.namespaces))
 	for _, namespace := range f.namespaces {
 		if req.Status > enum.GlobalStatus_GlobalStatus_UNKNOWN && namespace.Status != req.Status {
 			continue
@@ -319,21 +473,31 @@ func (f *fileRepository) ListNamespace(ctx context.Context, req *namespacev1.Lis
 		if req.Keyword != "" && !strings.Contains(namespace.Name, req.Keyword) {
 			continue
 		}
-		namespaces = append(namespaces, convertNamespaceModel(namespace))
+		filtered = append(filtered, namespace)
 	}
-	if req.Page > 0 && req.PageSize > 0 {
-		start := (req.Page - 1) * req.PageSize
-		end := start + req.PageSize
-		if end > int32(len(namespaces)) {
-			end = int32(len(namespaces))
-		}
-		namespaces = namespaces[start:end]
+	orderBy := namespacev1.CursorField(req.OrderBy)
+	var offset int
+	if pointer.IsNil(req.Cursor) && req.Page > 1 && req.PageSize > 0 {
+		offset = int((req.Page - 1) * req.PageSize)
+	}
+	rows, err := pageNamespaces(filtered, orderBy, req.Order == namespacev1.Order_DESC, req.Cursor, offset, int(req.PageSize))
+	if err != nil {
+		return nil, err
+	}
+	rows, prevCursor, nextCursor := namespacev1.PageWindow(rows, int(req.PageSize), req.Cursor, pointer.IsNotNil(req.Cursor) || offset > 0, func(namespace *model.NamespaceModel) *namespacev1.PageCursor {
+		return namespaceCursor(namespace, orderBy)
+	})
+	namespaces := make([]*namespacev1.NamespaceModel, 0, len(rows))
+	for _, namespace := range rows {
+		namespaces = append(namespaces, convertNamespaceModel(namespace))
 	}
 	return &namespacev1.ListNamespaceResponse{
 		Namespaces: namespaces,
-		Total:      int64(len(namespaces)),
+		Total:      int64(len(filtered)),
 		Page:       req.Page,
 		PageSize:   req.PageSize,
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -341,20 +505,7 @@ func (f *fileRepository) ListNamespace(ctx context.Context, req *namespacev1.Lis
 func (f *fileRepository) SelectNamespace(ctx context.Context, req *namespacev1.SelectNamespaceRequest) (*namespacev1.SelectNamespaceResponse, error) {
 	f.mu.RLock()
 	defer f.mu.RUnlock()
-	namespaces := make([]*namespacev1.NamespaceItemSelect, 0, len(f.namespaces))
-	sortFunc := f.getSortLessFunc(namespacev1.Field_UID, req.Order)
-	sort.Slice(f.namespaces, sortFunc)
-	count := 0
-	lessFunc := func(i, j int64) bool {
-		switch req.Order {
-		case namespacev1.Order_DESC:
-			return i > j
-		case namespacev1.Order_ASC:
-			return i < j
-		default:
-			return false
-		}
-	}
+	filtered := make([]*model.NamespaceModel, 0, len(f.namespaces))
 	for _, namespace := range f.namespaces {
 		if req.Status > enum.GlobalStatus_GlobalStatus_UNKNOWN && namespace.Status != req.Status {
 			continue
@@ -362,30 +513,242 @@ func (f *fileRepository) SelectNamespace(ctx context.Context, req *namespacev1.S
 		if req.Keyword != "" && !strings.Contains(namespace.Name, req.Keyword) {
 			continue
 		}
-		if lessFunc(namespace.UID, req.LastUID) {
-			continue
-		}
-		count++
+		filtered = append(filtered, namespace)
+	}
+	cursor := req.Cursor
+	if pointer.IsNil(cursor) && req.LastUID > 0 {
+		cursor = &namespacev1.PageCursor{Uid: req.LastUID}
+	}
+	rows, err := pageNamespaces(filtered, namespacev1.Field_UID, req.Order == namespacev1.Order_DESC, cursor, 0, int(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	rows, prevCursor, nextCursor := namespacev1.PageWindow(rows, int(req.Limit), cursor, pointer.IsNotNil(cursor), func(namespace *model.NamespaceModel) *namespacev1.PageCursor {
+		return namespaceCursor(namespace, namespacev1.Field_UID)
+	})
+	namespaces := make([]*namespacev1.NamespaceItemSelect, 0, len(rows))
+	for _, namespace := range rows {
 		namespaces = append(namespaces, convertNamespaceItemSelect(namespace))
-		if count >= int(req.Limit) {
-			break
-		}
 	}
-
+	var lastUID int64
+	if len(rows) > 0 {
+		lastUID = rows[len(rows)-1].UID
+	}
 	return &namespacev1.SelectNamespaceResponse{
-		Items:   namespaces[:req.Limit],
-		Total:   int64(len(namespaces)),
-		LastUID: namespaces[len(namespaces)-1].Value,
-		HasMore: count == int(req.Limit),
+		Items:      namespaces,
+		Total:      int64(len(namespaces)),
+		LastUID:    lastUID,
+		HasMore:    pointer.IsNotNil(nextCursor),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
 	}, nil
 }
 
 // UpdateNamespace implements [namespacev1.Repository].
 func (f *fileRepository) UpdateNamespace(ctx context.Context, req *namespacev1.UpdateNamespaceRequest) (*namespacev1.ResultInfo, error) {
-	panic("unimplemented")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	namespace := f.findNamespace(req.Uid)
+	if namespace == nil {
+		return nil, merr.ErrorNotFound("namespace %d not found", req.Uid)
+	}
+	f.changed = true
+	namespace.Name = req.Name
+	namespace.Metadata = req.Metadata
+	namespace.DisplayName = req.DisplayName
+	namespace.Description = req.Description
+	namespace.Owners = req.Owners
+	namespace.Icon = req.Icon
+	namespace.UpdatedAt = time.Now().Unix()
+	f.appendRevision(namespace, namespacev1.RevisionAction_REVISION_ACTION_UPDATE, 0, req.Operator)
+	return &namespacev1.ResultInfo{RowsAffected: 1, Error: ""}, nil
 }
 
 // UpdateNamespaceStatus implements [namespacev1.Repository].
 func (f *fileRepository) UpdateNamespaceStatus(ctx context.Context, req *namespacev1.UpdateNamespaceStatusRequest) (*namespacev1.ResultInfo, error) {
-	panic("unimplemented")
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	namespace := f.findNamespace(req.Uid)
+	if namespace == nil {
+		return nil, merr.ErrorNotFound("namespace %d not found", req.Uid)
+	}
+	f.changed = true
+	namespace.Status = req.Status
+	namespace.UpdatedAt = time.Now().Unix()
+	f.appendRevision(namespace, namespacev1.RevisionAction_REVISION_ACTION_UPDATE_STATUS, 0, req.Operator)
+	return &namespacev1.ResultInfo{RowsAffected: 1, Error: ""}, nil
+}
+
+// ListNamespaceRevisions implements [namespacev1.Repository].
+func (f *fileRepository) ListNamespaceRevisions(ctx context.Context, req *namespacev1.ListNamespaceRevisionsRequest) (*namespacev1.ListNamespaceRevisionsResponse, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	rows := make([]*model.NamespaceRevisionModel, 0)
+	for _, revision := range f.revisions {
+		if revision.NamespaceUID == req.NamespaceUid {
+			rows = append(rows, revision)
+		}
+	}
+	total := int64(len(rows))
+	// rows are read newest first, or oldest first after a backward cursor
+	backward := req.Cursor.GetBackward()
+	slices.SortFunc(rows, func(a, b *model.NamespaceRevisionModel) int {
+		if backward {
+			return cmp.Compare(a.Revision, b.Revision)
+		}
+		return cmp.Compare(b.Revision, a.Revision)
+	})
+	var offset int
+	if pointer.IsNotNil(req.Cursor) {
+		cursorRevision, err := namespacev1.ParseRevisionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		index := slices.IndexFunc(rows, func(revision *model.NamespaceRevisionModel) bool {
+			if backward {
+				return revision.Revision > cursorRevision
+			}
+			return revision.Revision < cursorRevision
+		})
+		if index < 0 {
+			index = len(rows)
+		}
+		rows = rows[index:]
+	} else if req.Page > 1 && req.PageSize > 0 {
+		offset = min(int((req.Page-1)*req.PageSize), len(rows))
+		rows = rows[offset:]
+	}
+	if req.PageSize > 0 {
+		rows = rows[:min(int(req.PageSize)+1, len(rows))]
+	}
+	rows, prevCursor, nextCursor := namespacev1.PageWindow(rows, int(req.PageSize), req.Cursor, pointer.IsNotNil(req.Cursor) || offset > 0, func(revision *model.NamespaceRevisionModel) *namespacev1.PageCursor {
+		return namespacev1.RevisionCursor(revision.Revision)
+	})
+	revisions := make([]*namespacev1.NamespaceRevisionModel, 0, len(rows))
+	for _, revision := range rows {
+		revisions = append(revisions, convertNamespaceRevisionModel(revision))
+	}
+	return &namespacev1.ListNamespaceRevisionsResponse{
+		Revisions:  revisions,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetNamespaceRevision implements [namespacev1.Repository].
+func (f *fileRepository) GetNamespaceRevision(ctx context.Context, req *namespacev1.GetNamespaceRevisionRequest) (*namespacev1.NamespaceRevisionModel, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	revision := f.findRevision(req.NamespaceUid, req.Revision)
+	if revision == nil {
+		return nil, merr.ErrorNotFound("namespace %d revision %d not found", req.NamespaceUid, req.Revision)
+	}
+	return convertNamespaceRevisionModel(revision), nil
+}
+
+// RollbackNamespace implements [namespacev1.Repository].
+func (f *fileRepository) RollbackNamespace(ctx context.Context, req *namespacev1.RollbackNamespaceRequest) (*namespacev1.NamespaceRevisionModel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	namespace := f.findNamespace(req.NamespaceUid)
+	if namespace == nil {
+		return nil, merr.ErrorNotFound("namespace %d not found", req.NamespaceUid)
+	}
+	source := f.findRevision(req.NamespaceUid, req.Revision)
+	if source == nil {
+		return nil, merr.ErrorNotFound("namespace %d revision %d not found", req.NamespaceUid, req.Revision)
+	}
+	f.changed = true
+	namespace.Name = source.Name
+	namespace.Metadata = maps.Clone(source.Metadata)
+	namespace.Status = source.Status
+	namespace.DisplayName = source.DisplayName
+	namespace.Description = source.Description
+	namespace.Owners = slices.Clone(source.Owners)
+	namespace.Icon = source.Icon
+	namespace.UpdatedAt = time.Now().Unix()
+	revision := f.appendRevision(namespace, namespacev1.RevisionAction_REVISION_ACTION_ROLLBACK, source.Revision, req.Operator)
+	return convertNamespaceRevisionModel(revision), nil
+}
+
+// TransferNamespaceOwnership implements [namespacev1.Repository].
+func (f *fileRepository) TransferNamespaceOwnership(ctx context.Context, req *namespacev1.TransferNamespaceOwnershipRequest) (*namespacev1.NamespaceRevisionModel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	namespace := f.findNamespace(req.Uid)
+	if namespace == nil {
+		return nil, merr.ErrorNotFound("namespace %d not found", req.Uid)
+	}
+	owners, err := namespacev1.TransferOwners(namespace.Owners, req.PreviousOwner, req.NewOwner)
+	if err != nil {
+		return nil, err
+	}
+	f.changed = true
+	namespace.Owners = owners
+	namespace.UpdatedAt = time.Now().Unix()
+	revision := f.appendRevision(namespace, namespacev1.RevisionAction_REVISION_ACTION_TRANSFER_OWNERSHIP, 0, req.Operator)
+	return convertNamespaceRevisionModel(revision), nil
+}
+
+// GetNamespaceStats implements [namespacev1.Repository].
+func (f *fileRepository) GetNamespaceStats(ctx context.Context, req *namespacev1.GetNamespaceStatsRequest) (*namespacev1.NamespaceStats, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	statusCounts := make(map[enum.GlobalStatus]int64)
+	dailyCounts := make(map[string]int64)
+	creatorCounts := make(map[int64]int64)
+	largestMetadata := make([]*namespacev1.NamespaceMetadataSize, 0, len(f.namespaces))
+	for _, namespace := range f.namespaces {
+		if namespace.DeletedAt != 0 {
+			continue
+		}
+		statusCounts[namespace.Status]++
+		creatorCounts[namespace.Creator]++
+		if namespace.CreatedAt >= req.StartAt && namespace.CreatedAt < req.EndAt {
+			dailyCounts[time.Unix(namespace.CreatedAt, 0).UTC().Format(time.DateOnly)]++
+		}
+		largestMetadata = append(largestMetadata, &namespacev1.NamespaceMetadataSize{
+			Uid:  namespace.UID,
+			Name: namespace.Name,
+			Size: int64(len(namespace.Metadata)),
+		})
+	}
+
+	stats := &namespacev1.NamespaceStats{
+		StatusCounts:   make([]*namespacev1.NamespaceStatusCount, 0, len(statusCounts)),
+		DailyCreations: make([]*namespacev1.NamespaceDailyCount, 0, len(dailyCounts)),
+		TopCreators:    make([]*namespacev1.NamespaceCreatorCount, 0, len(creatorCounts)),
+	}
+	for status, count := range statusCounts {
+		stats.Total += count
+		stats.StatusCounts = append(stats.StatusCounts, &namespacev1.NamespaceStatusCount{Status: status, Count: count})
+	}
+	sort.Slice(stats.StatusCounts, func(i, j int) bool {
+		return stats.StatusCounts[i].Status < stats.StatusCounts[j].Status
+	})
+	for date, count := range dailyCounts {
+		stats.DailyCreations = append(stats.DailyCreations, &namespacev1.NamespaceDailyCount{Date: date, Count: count})
+	}
+	sort.Slice(stats.DailyCreations, func(i, j int) bool {
+		return stats.DailyCreations[i].Date < stats.DailyCreations[j].Date
+	})
+	for creator, count := range creatorCounts {
+		stats.TopCreators = append(stats.TopCreators, &namespacev1.NamespaceCreatorCount{Creator: creator, Count: count})
+	}
+	sort.Slice(stats.TopCreators, func(i, j int) bool {
+		if stats.TopCreators[i].Count != stats.TopCreators[j].Count {
+			return stats.TopCreators[i].Count > stats.TopCreators[j].Count
+		}
+		return stats.TopCreators[i].Creator < stats.TopCreators[j].Creator
+	})
+	sort.SliceStable(largestMetadata, func(i, j int) bool {
+		return largestMetadata[i].Size > largestMetadata[j].Size
+	})
+	topN := int(req.TopN)
+	stats.TopCreators = stats.TopCreators[:min(topN, len(stats.TopCreators))]
+	stats.LargestMetadata = largestMetadata[:min(topN, len(largestMetadata))]
+	return stats, nil
 }
@@ -0,0 +1,292 @@
+package fileimpl_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"go.yaml.in/yaml/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	namespacev1 "github.com/aide-family/sovereign/pkg/domain/namespace/v1"
+	"github.com/aide-family/sovereign/pkg/domain/namespace/v1/fileimpl"
+	"github.com/aide-family/sovereign/pkg/domain/namespace/v1/fileimpl/model"
+	"github.com/aide-family/sovereign/pkg/enum"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func newFileRepository(t *testing.T) namespacev1.Repository {
+	t.Helper()
+	return openFileRepository(t, t.TempDir())
+}
+
+// openFileRepository opens the repository of the namespaces file in dir, which may be written in advance.
+func openFileRepository(t *testing.T, dir string) namespacev1.Repository {
+	t.Helper()
+	options, err := anypb.New(&config.FileConfig{Path: dir, Filename: "namespaces.yaml", StorageInterval: durationpb.New(time.Minute)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, closer, err := fileimpl.NewFileRepository(&config.DomainConfig{Driver: config.DomainConfig_FILE, Options: options})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+	})
+	return repo
+}
+
+func TestListNamespaceCursor(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		if _, err := repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{Name: fmt.Sprintf("ns-%d", i), Status: enum.GlobalStatus_ENABLED}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name    string
+		orderBy namespacev1.Field
+		order   namespacev1.Order
+		want    [][]string
+	}{
+		{name: "name asc", orderBy: namespacev1.Field_NAME, order: namespacev1.Order_ASC, want: [][]string{{"ns-1", "ns-2"}, {"ns-3", "ns-4"}, {"ns-5"}}},
+		{name: "name desc", orderBy: namespacev1.Field_NAME, order: namespacev1.Order_DESC, want: [][]string{{"ns-5", "ns-4"}, {"ns-3", "ns-2"}, {"ns-1"}}},
+		{name: "created at ties broken by uid", orderBy: namespacev1.Field_CREATED_AT, order: namespacev1.Order_ASC, want: [][]string{{"ns-1", "ns-2"}, {"ns-3", "ns-4"}, {"ns-5"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := func(cursor *namespacev1.PageCursor) *namespacev1.ListNamespaceResponse {
+				t.Helper()
+				resp, err := repo.ListNamespace(ctx, &namespacev1.ListNamespaceRequest{PageSize: 2, OrderBy: tt.orderBy, Order: tt.order, Cursor: cursor})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return resp
+			}
+			checkPage := func(resp *namespacev1.ListNamespaceResponse, want []string, hasPrev, hasNext bool) {
+				t.Helper()
+				names := make([]string, 0, len(resp.GetNamespaces()))
+				for _, namespace := range resp.GetNamespaces() {
+					names = append(names, namespace.GetName())
+				}
+				if !slices.Equal(names, want) {
+					t.Fatalf("want page %v, got %v", want, names)
+				}
+				if (resp.GetPrevCursor() != nil) != hasPrev || (resp.GetNextCursor() != nil) != hasNext {
+					t.Fatalf("page %v: want prev %v next %v, got prev %v next %v", want, hasPrev, hasNext, resp.GetPrevCursor(), resp.GetNextCursor())
+				}
+			}
+
+			first := list(nil)
+			checkPage(first, tt.want[0], false, true)
+			middle := list(first.GetNextCursor())
+			checkPage(middle, tt.want[1], true, true)
+			last := list(middle.GetNextCursor())
+			checkPage(last, tt.want[2], true, false)
+
+			back := list(last.GetPrevCursor())
+			checkPage(back, tt.want[1], true, true)
+			back = list(back.GetPrevCursor())
+			checkPage(back, tt.want[0], false, true)
+		})
+	}
+}
+
+func TestListNamespaceRevisionsCursor(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	namespace, err := repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{Name: "ns", Status: enum.GlobalStatus_ENABLED})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 2; i <= 5; i++ {
+		if _, err := repo.UpdateNamespace(ctx, &namespacev1.UpdateNamespaceRequest{Uid: namespace.GetUid(), Name: "ns", Description: fmt.Sprintf("revision %d", i)}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list := func(cursor *namespacev1.PageCursor) *namespacev1.ListNamespaceRevisionsResponse {
+		t.Helper()
+		resp, err := repo.ListNamespaceRevisions(ctx, &namespacev1.ListNamespaceRevisionsRequest{NamespaceUid: namespace.GetUid(), PageSize: 2, Cursor: cursor})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.GetTotal() != 5 {
+			t.Fatalf("want the total of all pages, got %d", resp.GetTotal())
+		}
+		return resp
+	}
+	checkPage := func(resp *namespacev1.ListNamespaceRevisionsResponse, want []int32, hasPrev, hasNext bool) {
+		t.Helper()
+		revisions := make([]int32, 0, len(resp.GetRevisions()))
+		for _, revision := range resp.GetRevisions() {
+			revisions = append(revisions, revision.GetRevision())
+		}
+		if !slices.Equal(revisions, want) {
+			t.Fatalf("want page %v, got %v", want, revisions)
+		}
+		if (resp.GetPrevCursor() != nil) != hasPrev || (resp.GetNextCursor() != nil) != hasNext {
+			t.Fatalf("page %v: want prev %v next %v, got prev %v next %v", want, hasPrev, hasNext, resp.GetPrevCursor(), resp.GetNextCursor())
+		}
+	}
+
+	first := list(nil)
+	checkPage(first, []int32{5, 4}, false, true)
+	middle := list(first.GetNextCursor())
+	checkPage(middle, []int32{3, 2}, true, true)
+	last := list(middle.GetNextCursor())
+	checkPage(last, []int32{1}, true, false)
+	back := list(last.GetPrevCursor())
+	checkPage(back, []int32{3, 2}, true, true)
+	back = list(back.GetPrevCursor())
+	checkPage(back, []int32{5, 4}, false, true)
+}
+
+func TestGetNamespaceStats(t *testing.T) {
+	dir := t.TempDir()
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	namespaces := []*model.NamespaceModel{
+		{ID: 1, UID: 101, Name: "ns-1", Status: enum.GlobalStatus_ENABLED, Creator: 7, CreatedAt: day.Add(-time.Hour).Unix(), Metadata: map[string]string{"a": "1"}},
+		{ID: 2, UID: 102, Name: "ns-2", Status: enum.GlobalStatus_ENABLED, Creator: 7, CreatedAt: day.Add(12 * time.Hour).Unix(), Metadata: map[string]string{"a": "1", "b": "2", "c": "3"}},
+		{ID: 3, UID: 103, Name: "ns-3", Status: enum.GlobalStatus_DISABLED, Creator: 8, CreatedAt: day.Add(23 * time.Hour).Unix()},
+		{ID: 4, UID: 104, Name: "ns-4", Status: enum.GlobalStatus_ENABLED, Creator: 9, CreatedAt: day.Add(49 * time.Hour).Unix(), Metadata: map[string]string{"a": "1", "b": "2"}},
+		{ID: 5, UID: 105, Name: "ns-5", Status: enum.GlobalStatus_ENABLED, Creator: 9, CreatedAt: day.Add(50 * time.Hour).Unix(), DeletedAt: day.Add(51 * time.Hour).Unix()},
+	}
+	content, err := yaml.Marshal(namespaces)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "namespaces.yaml"), content, 0o600); err != nil {
+		t.Fatal(err)
+	}
+	repo := openFileRepository(t, dir)
+
+	stats, err := repo.GetNamespaceStats(context.Background(), &namespacev1.GetNamespaceStatsRequest{StartAt: day.Unix(), EndAt: day.AddDate(0, 0, 3).Unix(), TopN: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &namespacev1.NamespaceStats{
+		Total: 4,
+		StatusCounts: []*namespacev1.NamespaceStatusCount{
+			{Status: enum.GlobalStatus_ENABLED, Count: 3},
+			{Status: enum.GlobalStatus_DISABLED, Count: 1},
+		},
+		DailyCreations: []*namespacev1.NamespaceDailyCount{
+			{Date: "2026-01-01", Count: 2},
+			{Date: "2026-01-03", Count: 1},
+		},
+		TopCreators: []*namespacev1.NamespaceCreatorCount{
+			{Creator: 7, Count: 2},
+			{Creator: 8, Count: 1},
+		},
+		LargestMetadata: []*namespacev1.NamespaceMetadataSize{
+			{Uid: 102, Name: "ns-2", Size: 3},
+			{Uid: 104, Name: "ns-4", Size: 2},
+		},
+	}
+	if !proto.Equal(stats, want) {
+		t.Fatalf("want stats %v, got %v", want, stats)
+	}
+}
+
+func TestRollbackNamespace(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	namespace, err := repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{Name: "ns", Metadata: map[string]string{"tier": "dev"}, Status: enum.GlobalStatus_ENABLED, DisplayName: "NS", Owners: []int64{10}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.UpdateNamespace(ctx, &namespacev1.UpdateNamespaceRequest{Uid: namespace.GetUid(), Name: "ns-renamed", Metadata: map[string]string{"tier": "prod"}, Owners: []int64{11}}); err != nil {
+		t.Fatal(err)
+	}
+
+	revision, err := repo.RollbackNamespace(ctx, &namespacev1.RollbackNamespaceRequest{NamespaceUid: namespace.GetUid(), Revision: 1, Operator: 42})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if revision.GetRevision() != 3 || revision.GetAction() != namespacev1.RevisionAction_REVISION_ACTION_ROLLBACK || revision.GetSourceRevision() != 1 || revision.GetCreator() != 42 {
+		t.Fatalf("want revision 3 rolling back to revision 1 by 42, got %v", revision)
+	}
+	restored, err := repo.GetNamespace(ctx, &namespacev1.GetNamespaceRequest{Uid: namespace.GetUid()})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if restored.GetName() != "ns" || restored.GetMetadata()["tier"] != "dev" || restored.GetDisplayName() != "NS" || !slices.Equal(restored.GetOwners(), []int64{10}) {
+		t.Fatalf("want the namespace of revision 1, got %v", restored)
+	}
+
+	tests := []struct {
+		name string
+		req  *namespacev1.RollbackNamespaceRequest
+	}{
+		{name: "missing revision", req: &namespacev1.RollbackNamespaceRequest{NamespaceUid: namespace.GetUid(), Revision: 9}},
+		{name: "missing namespace", req: &namespacev1.RollbackNamespaceRequest{NamespaceUid: namespace.GetUid() + 1, Revision: 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := repo.RollbackNamespace(ctx, tt.req); !merr.IsNotFound(err) {
+				t.Fatalf("want not found, got %v", err)
+			}
+		})
+	}
+}
+
+func TestTransferNamespaceOwnership(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	namespace, err := repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{Name: "ns", Status: enum.GlobalStatus_ENABLED, Owners: []int64{10, 11}})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name          string
+		previousOwner int64
+		newOwner      int64
+		owners        []int64
+		revision      int32
+	}{
+		{name: "replace an owner", previousOwner: 10, newOwner: 12, owners: []int64{12, 11}, revision: 2},
+		{name: "merge into an owner", previousOwner: 12, newOwner: 11, owners: []int64{11}, revision: 3},
+		{name: "not an owner", previousOwner: 10, newOwner: 13, owners: []int64{11}},
+		{name: "only owner", newOwner: 13, owners: []int64{13}, revision: 4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			revision, err := repo.TransferNamespaceOwnership(ctx, &namespacev1.TransferNamespaceOwnershipRequest{Uid: namespace.GetUid(), PreviousOwner: tt.previousOwner, NewOwner: tt.newOwner, Operator: 42})
+			if tt.revision == 0 {
+				if !merr.IsParams(err) {
+					t.Fatalf("want a params error, got %v", err)
+				}
+			} else {
+				if err != nil {
+					t.Fatal(err)
+				}
+				if revision.GetRevision() != tt.revision || revision.GetAction() != namespacev1.RevisionAction_REVISION_ACTION_TRANSFER_OWNERSHIP || revision.GetCreator() != 42 || !slices.Equal(revision.GetOwners(), tt.owners) {
+					t.Fatalf("want the transfer recorded as revision %d with owners %v, got %v", tt.revision, tt.owners, revision)
+				}
+			}
+			got, err := repo.GetNamespace(ctx, &namespacev1.GetNamespaceRequest{Uid: namespace.GetUid()})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !slices.Equal(got.GetOwners(), tt.owners) {
+				t.Fatalf("want owners %v, got %v", tt.owners, got.GetOwners())
+			}
+		})
+	}
+
+	if _, err := repo.TransferNamespaceOwnership(ctx, &namespacev1.TransferNamespaceOwnershipRequest{Uid: namespace.GetUid() + 1, NewOwner: 13}); !merr.IsNotFound(err) {
+		t.Fatalf("want a missing namespace not found, got %v", err)
+	}
+}
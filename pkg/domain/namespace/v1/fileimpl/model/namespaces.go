@@ -4,13 +4,35 @@ package model
 import "github.com/aide-family/sovereign/pkg/enum"
 
 type NamespaceModel struct {
-	ID        uint32            `json:"id" yaml:"id"`
-	UID       int64             `json:"uid" yaml:"uid"`
-	Name      string            `json:"name" yaml:"name"`
-	Metadata  map[string]string `json:"metadata" yaml:"metadata"`
-	Status    enum.GlobalStatus `json:"status" yaml:"status"`
-	CreatedAt int64             `json:"createdAt" yaml:"createdAt"`
-	UpdatedAt int64             `json:"updatedAt" yaml:"updatedAt"`
-	DeletedAt int64             `json:"deletedAt" yaml:"deletedAt"`
-	Creator   int64             `json:"creator" yaml:"creator"`
+	ID          uint32            `json:"id" yaml:"id"`
+	UID         int64             `json:"uid" yaml:"uid"`
+	Name        string            `json:"name" yaml:"name"`
+	Metadata    map[string]string `json:"metadata" yaml:"metadata"`
+	Status      enum.GlobalStatus `json:"status" yaml:"status"`
+	CreatedAt   int64             `json:"createdAt" yaml:"createdAt"`
+	UpdatedAt   int64             `json:"updatedAt" yaml:"updatedAt"`
+	DeletedAt   int64             `json:"deletedAt" yaml:"deletedAt"`
+	Creator     int64             `json:"creator" yaml:"creator"`
+	DisplayName string            `json:"displayName" yaml:"displayName"`
+	Description string            `json:"description" yaml:"description"`
+	Owners      []int64           `json:"owners" yaml:"owners"`
+	Icon        string            `json:"icon" yaml:"icon"`
+}
+
+type NamespaceRevisionModel struct {
+	ID             uint32            `json:"id" yaml:"id"`
+	UID            int64             `json:"uid" yaml:"uid"`
+	NamespaceUID   int64             `json:"namespaceUid" yaml:"namespaceUid"`
+	Revision       int32             `json:"revision" yaml:"revision"`
+	Name           string            `json:"name" yaml:"name"`
+	Metadata       map[string]string `json:"metadata" yaml:"metadata"`
+	Status         enum.GlobalStatus `json:"status" yaml:"status"`
+	Action         int32             `json:"action" yaml:"action"`
+	SourceRevision int32             `json:"sourceRevision" yaml:"sourceRevision"`
+	Creator        int64             `json:"creator" yaml:"creator"`
+	CreatedAt      int64             `json:"createdAt" yaml:"createdAt"`
+	DisplayName    string            `json:"displayName" yaml:"displayName"`
+	Description    string            `json:"description" yaml:"description"`
+	Owners         []int64           `json:"owners" yaml:"owners"`
+	Icon           string            `json:"icon" yaml:"icon"`
 }
@@ -10,15 +10,19 @@ import (
 
 func convertNamespaceModel(namespaceModel *model.NamespaceModel) *namespacev1.NamespaceModel {
 	return &namespacev1.NamespaceModel{
-		Id:        namespaceModel.ID,
-		Uid:       namespaceModel.UID,
-		Name:      namespaceModel.Name,
-		Metadata:  namespaceModel.Metadata,
-		Status:    namespaceModel.Status,
-		CreatedAt: namespaceModel.CreatedAt,
-		UpdatedAt: namespaceModel.UpdatedAt,
-		DeletedAt: namespaceModel.DeletedAt,
-		Creator:   namespaceModel.Creator,
+		Id:          namespaceModel.ID,
+		Uid:         namespaceModel.UID,
+		Name:        namespaceModel.Name,
+		Metadata:    namespaceModel.Metadata,
+		Status:      namespaceModel.Status,
+		CreatedAt:   namespaceModel.CreatedAt,
+		UpdatedAt:   namespaceModel.UpdatedAt,
+		DeletedAt:   namespaceModel.DeletedAt,
+		Creator:     namespaceModel.Creator,
+		DisplayName: namespaceModel.DisplayName,
+		Description: namespaceModel.Description,
+		Owners:      namespaceModel.Owners,
+		Icon:        namespaceModel.Icon,
 	}
 }
 
@@ -31,3 +35,23 @@ func convertNamespaceItemSelect(namespaceModel *model.NamespaceModel) *namespace
 		Tooltip:  string(metadata),
 	}
 }
+
+func convertNamespaceRevisionModel(revisionModel *model.NamespaceRevisionModel) *namespacev1.NamespaceRevisionModel {
+	return &namespacev1.NamespaceRevisionModel{
+		Id:             revisionModel.ID,
+		Uid:            revisionModel.UID,
+		NamespaceUid:   revisionModel.NamespaceUID,
+		Revision:       revisionModel.Revision,
+		Name:           revisionModel.Name,
+		Metadata:       revisionModel.Metadata,
+		Status:         revisionModel.Status,
+		Action:         namespacev1.RevisionAction(revisionModel.Action),
+		SourceRevision: revisionModel.SourceRevision,
+		Creator:        revisionModel.Creator,
+		CreatedAt:      revisionModel.CreatedAt,
+		DisplayName:    revisionModel.DisplayName,
+		Description:    revisionModel.Description,
+		Owners:         revisionModel.Owners,
+		Icon:           revisionModel.Icon,
+	}
+}
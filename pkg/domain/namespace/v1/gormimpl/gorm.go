@@ -4,6 +4,9 @@ package gormimpl
 import (
 	"context"
 	"errors"
+	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/aide-family/magicbox/hello"
 	"github.com/aide-family/magicbox/pointer"
@@ -11,8 +14,11 @@ import (
 	"github.com/bwmarrin/snowflake"
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/driver/mysql"
+	"gorm.io/gen"
 	"gorm.io/gen/field"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 
 	"github.com/aide-family/sovereign/pkg/config"
 	"github.com/aide-family/sovereign/pkg/connect"
@@ -29,6 +35,7 @@ func init() {
 }
 
 type Field interface {
+	field.IColumnName
 	Desc() field.Expr
 	Asc() field.Expr
 }
@@ -81,14 +88,25 @@ func (g *gormRepository) getField(name namespacev1.Field) Field {
 // CreateNamespace implements [namespacev1.Repository].
 func (g *gormRepository) CreateNamespace(ctx context.Context, req *namespacev1.CreateNamespaceRequest) (*namespacev1.NamespaceModel, error) {
 	namespaceDo := &model.Namespace{
-		Name:     req.Name,
-		Metadata: safety.NewMap(req.Metadata),
-		Status:   uint8(req.Status),
+		Name:        req.Name,
+		Metadata:    safety.NewMap(req.Metadata),
+		Status:      uint8(req.Status),
+		DisplayName: req.DisplayName,
+		Description: req.Description,
+		Owners:      req.Owners,
+		Icon:        req.Icon,
 	}
-	namespaceDo.WithCreator(1)
+	namespaceDo.WithCreator(operatorOf(req.Operator))
 	namespaceDo.WithUID(g.node.Generate())
-	mutation := query.Namespace
-	if err := mutation.WithContext(ctx).Create(namespaceDo); err != nil {
+	mutation := query.Use(g.db)
+	err := mutation.Transaction(func(tx *query.Query) error {
+		if err := tx.Namespace.WithContext(ctx).Create(namespaceDo); err != nil {
+			return err
+		}
+		_, err := g.appendRevision(ctx, tx, namespaceDo, namespacev1.RevisionAction_REVISION_ACTION_CREATE, 0, namespaceDo.Creator)
+		return err
+	})
+	if err != nil {
 		return nil, merr.ErrorInternalServer("create namespace failed: %v", err)
 	}
 	return g.GetNamespaceByName(ctx, &namespacev1.GetNamespaceByNameRequest{Name: req.Name})
@@ -140,31 +158,46 @@ func (g *gormRepository) ListNamespace(ctx context.Context, req *namespacev1.Lis
 	if req.Status > enum.GlobalStatus_GlobalStatus_UNKNOWN {
 		wrappers = wrappers.Where(mutation.Status.Eq(uint8(req.Status)))
 	}
-
-	fieldExpr := g.getField(req.OrderBy)
-	switch req.Order {
-	case namespacev1.Order_DESC:
-		wrappers = wrappers.Order(fieldExpr.Desc())
-	case namespacev1.Order_ASC:
-		wrappers = wrappers.Order(fieldExpr.Asc())
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("count namespace failed: %v", err)
 	}
 
-	if req.Page > 0 && req.PageSize > 0 {
-		wrappers = wrappers.Limit(int(req.PageSize)).Offset(int((req.Page - 1) * req.PageSize))
+	orderBy := namespacev1.CursorField(req.OrderBy)
+	desc := req.Order == namespacev1.Order_DESC
+	var offset int
+	if pointer.IsNotNil(req.Cursor) {
+		conditions, err := g.cursorConditions(orderBy, desc, req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		wrappers = wrappers.Where(conditions...)
+	} else if req.Page > 1 && req.PageSize > 0 {
+		offset = int((req.Page - 1) * req.PageSize)
+		wrappers = wrappers.Offset(offset)
+	}
+	wrappers = wrappers.Order(g.orderExprs(orderBy, desc != req.Cursor.GetBackward())...)
+	if req.PageSize > 0 {
+		wrappers = wrappers.Limit(int(req.PageSize) + 1)
 	}
 	queryNamespaces, err := wrappers.Find()
 	if err != nil {
 		return nil, merr.ErrorInternalServer("list namespace failed: %v", err)
 	}
+	queryNamespaces, prevCursor, nextCursor := namespacev1.PageWindow(queryNamespaces, int(req.PageSize), req.Cursor, pointer.IsNotNil(req.Cursor) || offset > 0, func(namespaceDo *model.Namespace) *namespacev1.PageCursor {
+		return namespaceCursor(namespaceDo, orderBy)
+	})
 	namespaces := make([]*namespacev1.NamespaceModel, 0, len(queryNamespaces))
 	for _, queryNamespace := range queryNamespaces {
 		namespaces = append(namespaces, ConvertNamespaceModel(queryNamespace))
 	}
 	return &namespacev1.ListNamespaceResponse{
 		Namespaces: namespaces,
-		Total:      int64(len(namespaces)),
+		Total:      total,
 		Page:       req.Page,
 		PageSize:   req.PageSize,
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
 	}, nil
 }
 
@@ -178,55 +211,447 @@ func (g *gormRepository) SelectNamespace(ctx context.Context, req *namespacev1.S
 	if req.Status > enum.GlobalStatus_GlobalStatus_UNKNOWN {
 		wrappers = wrappers.Where(mutation.Status.Eq(uint8(req.Status)))
 	}
-	wrappers = wrappers.Limit(int(req.Limit))
-	switch req.Order {
-	case namespacev1.Order_DESC:
-		wrappers = wrappers.Order(mutation.UID.Desc())
-	case namespacev1.Order_ASC:
-		wrappers = wrappers.Order(mutation.UID.Asc())
+	cursor := req.Cursor
+	if pointer.IsNil(cursor) && req.LastUID > 0 {
+		cursor = &namespacev1.PageCursor{Uid: req.LastUID}
 	}
-	if req.LastUID > 0 {
-		switch req.Order {
-		case namespacev1.Order_DESC:
-			wrappers = wrappers.Where(mutation.UID.Lt(req.LastUID))
-		case namespacev1.Order_ASC:
-			wrappers = wrappers.Where(mutation.UID.Gt(req.LastUID))
+	desc := req.Order == namespacev1.Order_DESC
+	if pointer.IsNotNil(cursor) {
+		conditions, err := g.cursorConditions(namespacev1.Field_UID, desc, cursor)
+		if err != nil {
+			return nil, err
 		}
+		wrappers = wrappers.Where(conditions...)
 	}
-	wrappers = wrappers.Select(mutation.UID, mutation.Name, mutation.Status, mutation.DeletedAt)
+	wrappers = wrappers.Order(g.orderExprs(namespacev1.Field_UID, desc != cursor.GetBackward())...)
+	wrappers = wrappers.Limit(int(req.Limit) + 1)
+	wrappers = wrappers.Select(mutation.UID, mutation.Name, mutation.Metadata, mutation.Status, mutation.DeletedAt)
 	queryNamespaces, err := wrappers.Find()
 	if err != nil {
 		return nil, merr.ErrorInternalServer("select namespace failed: %v", err)
 	}
+	queryNamespaces, prevCursor, nextCursor := namespacev1.PageWindow(queryNamespaces, int(req.Limit), cursor, pointer.IsNotNil(cursor), func(namespaceDo *model.Namespace) *namespacev1.PageCursor {
+		return namespaceCursor(namespaceDo, namespacev1.Field_UID)
+	})
 	namespaces := make([]*namespacev1.NamespaceItemSelect, 0, len(queryNamespaces))
 	for _, queryNamespace := range queryNamespaces {
 		namespaces = append(namespaces, ConvertNamespaceItemSelect(queryNamespace))
 	}
+	var lastUID int64
+	if len(queryNamespaces) > 0 {
+		lastUID = queryNamespaces[len(queryNamespaces)-1].UID.Int64()
+	}
 	return &namespacev1.SelectNamespaceResponse{
-		Items:   namespaces,
-		Total:   int64(len(namespaces)),
-		LastUID: queryNamespaces[len(queryNamespaces)-1].UID.Int64(),
-		HasMore: len(queryNamespaces) == int(req.Limit),
+		Items:      namespaces,
+		Total:      int64(len(namespaces)),
+		LastUID:    lastUID,
+		HasMore:    pointer.IsNotNil(nextCursor),
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
 	}, nil
 }
 
+// orderExprs orders by the field and then by uid so that rows sharing a sort key keep a stable order.
+func (g *gormRepository) orderExprs(orderBy namespacev1.Field, desc bool) []field.Expr {
+	fieldExpr, uidExpr := g.getField(orderBy), query.Namespace.UID
+	if desc {
+		return []field.Expr{fieldExpr.Desc(), uidExpr.Desc()}
+	}
+	return []field.Expr{fieldExpr.Asc(), uidExpr.Asc()}
+}
+
+// cursorConditions selects the rows after the cursor in the paging direction.
+func (g *gormRepository) cursorConditions(orderBy namespacev1.Field, desc bool, cursor *namespacev1.PageCursor) ([]gen.Condition, error) {
+	operator := ">"
+	if desc != cursor.GetBackward() {
+		operator = "<"
+	}
+	uidColumn := clause.Column{Name: query.Namespace.UID.ColumnName().String()}
+	if orderBy == namespacev1.Field_UID {
+		return []gen.Condition{field.NewUnsafeFieldRaw("? "+operator+" ?", uidColumn, cursor.GetUid())}, nil
+	}
+	value, err := parseCursorValue(orderBy, cursor.GetValue())
+	if err != nil {
+		return nil, err
+	}
+	column := clause.Column{Name: g.getField(orderBy).ColumnName().String()}
+	return []gen.Condition{field.NewUnsafeFieldRaw("(? "+operator+" ? OR (? = ? AND ? "+operator+" ?))",
+		column, value, column, value, uidColumn, cursor.GetUid())}, nil
+}
+
+// namespaceCursor encodes the sort key of the namespace, see parseCursorValue.
+func namespaceCursor(namespaceDo *model.Namespace, orderBy namespacev1.Field) *namespacev1.PageCursor {
+	cursor := &namespacev1.PageCursor{Uid: namespaceDo.UID.Int64()}
+	switch orderBy {
+	case namespacev1.Field_ID:
+		cursor.Value = strconv.FormatUint(uint64(namespaceDo.ID), 10)
+	case namespacev1.Field_NAME:
+		cursor.Value = namespaceDo.Name
+	case namespacev1.Field_STATUS:
+		cursor.Value = strconv.FormatUint(uint64(namespaceDo.Status), 10)
+	case namespacev1.Field_CREATED_AT:
+		cursor.Value = namespaceDo.CreatedAt.Format(time.RFC3339Nano)
+	case namespacev1.Field_UPDATED_AT:
+		cursor.Value = namespaceDo.UpdatedAt.Format(time.RFC3339Nano)
+	case namespacev1.Field_CREATOR:
+		cursor.Value = namespaceDo.Creator.String()
+	}
+	return cursor
+}
+
+func parseCursorValue(orderBy namespacev1.Field, value string) (any, error) {
+	switch orderBy {
+	case namespacev1.Field_NAME:
+		return value, nil
+	case namespacev1.Field_CREATED_AT, namespacev1.Field_UPDATED_AT:
+		t, err := time.Parse(time.RFC3339Nano, value)
+		if err != nil {
+			return nil, merr.ErrorParams("invalid page cursor")
+		}
+		return t, nil
+	default:
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, merr.ErrorParams("invalid page cursor")
+		}
+		return n, nil
+	}
+}
+
 // UpdateNamespace implements [namespacev1.Repository].
 func (g *gormRepository) UpdateNamespace(ctx context.Context, req *namespacev1.UpdateNamespaceRequest) (*namespacev1.ResultInfo, error) {
 	metadata := safety.NewMap(req.Metadata)
+	var result gen.ResultInfo
 	mutation := query.Use(g.db)
-	result, err := mutation.Namespace.WithContext(ctx).Where(mutation.Namespace.UID.Eq(req.Uid)).UpdateSimple(mutation.Namespace.Name.Value(req.Name), mutation.Namespace.Metadata.Value(metadata))
+	err := mutation.Transaction(func(tx *query.Query) error {
+		namespaceDo, err := g.lockNamespace(ctx, tx, req.Uid)
+		if err != nil {
+			return err
+		}
+		namespaceDo.Name, namespaceDo.Metadata = req.Name, metadata
+		namespaceDo.DisplayName, namespaceDo.Description, namespaceDo.Owners, namespaceDo.Icon = req.DisplayName, req.Description, req.Owners, req.Icon
+		result, err = g.saveDescriptiveFields(ctx, tx, namespaceDo)
+		if err != nil {
+			return merr.ErrorInternalServer("update namespace failed: %v", err)
+		}
+		_, err = g.appendRevision(ctx, tx, namespaceDo, namespacev1.RevisionAction_REVISION_ACTION_UPDATE, 0, operatorOf(req.Operator))
+		return err
+	})
 	if err != nil {
-		return nil, merr.ErrorInternalServer("update namespace failed: %v", err)
+		return nil, err
 	}
 	return convertResultInfo(&result), nil
 }
 
 // UpdateNamespaceStatus implements [namespacev1.Repository].
 func (g *gormRepository) UpdateNamespaceStatus(ctx context.Context, req *namespacev1.UpdateNamespaceStatusRequest) (*namespacev1.ResultInfo, error) {
+	var result gen.ResultInfo
 	mutation := query.Use(g.db)
-	result, err := mutation.Namespace.WithContext(ctx).Where(mutation.Namespace.UID.Eq(req.Uid)).UpdateSimple(mutation.Namespace.Status.Value(uint8(req.Status)))
+	err := mutation.Transaction(func(tx *query.Query) error {
+		namespaceDo, err := g.lockNamespace(ctx, tx, req.Uid)
+		if err != nil {
+			return err
+		}
+		result, err = tx.Namespace.WithContext(ctx).Where(tx.Namespace.UID.Eq(req.Uid)).UpdateSimple(tx.Namespace.Status.Value(uint8(req.Status)))
+		if err != nil {
+			return merr.ErrorInternalServer("update namespace status failed: %v", err)
+		}
+		namespaceDo.Status = uint8(req.Status)
+		_, err = g.appendRevision(ctx, tx, namespaceDo, namespacev1.RevisionAction_REVISION_ACTION_UPDATE_STATUS, 0, operatorOf(req.Operator))
+		return err
+	})
 	if err != nil {
-		return nil, merr.ErrorInternalServer("update namespace status failed: %v", err)
+		return nil, err
 	}
 	return convertResultInfo(&result), nil
 }
+
+// ListNamespaceRevisions implements [namespacev1.Repository].
+func (g *gormRepository) ListNamespaceRevisions(ctx context.Context, req *namespacev1.ListNamespaceRevisionsRequest) (*namespacev1.ListNamespaceRevisionsResponse, error) {
+	mutation := query.Use(g.db).NamespaceRevision
+	wrappers := mutation.WithContext(ctx).Where(mutation.NamespaceUID.Eq(req.NamespaceUid))
+	total, err := wrappers.Count()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("count namespace revisions failed: %v", err)
+	}
+	var offset int
+	if pointer.IsNotNil(req.Cursor) {
+		revision, err := namespacev1.ParseRevisionCursor(req.Cursor)
+		if err != nil {
+			return nil, err
+		}
+		if req.Cursor.GetBackward() {
+			wrappers = wrappers.Where(mutation.Revision.Gt(revision))
+		} else {
+			wrappers = wrappers.Where(mutation.Revision.Lt(revision))
+		}
+	} else if req.Page > 1 && req.PageSize > 0 {
+		offset = int((req.Page - 1) * req.PageSize)
+		wrappers = wrappers.Offset(offset)
+	}
+	if req.Cursor.GetBackward() {
+		wrappers = wrappers.Order(mutation.Revision.Asc())
+	} else {
+		wrappers = wrappers.Order(mutation.Revision.Desc())
+	}
+	if req.PageSize > 0 {
+		wrappers = wrappers.Limit(int(req.PageSize) + 1)
+	}
+	queryRevisions, err := wrappers.Find()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("list namespace revisions failed: %v", err)
+	}
+	queryRevisions, prevCursor, nextCursor := namespacev1.PageWindow(queryRevisions, int(req.PageSize), req.Cursor, pointer.IsNotNil(req.Cursor) || offset > 0, func(revisionDo *model.NamespaceRevision) *namespacev1.PageCursor {
+		return namespacev1.RevisionCursor(revisionDo.Revision)
+	})
+	revisions := make([]*namespacev1.NamespaceRevisionModel, 0, len(queryRevisions))
+	for _, queryRevision := range queryRevisions {
+		revisions = append(revisions, ConvertNamespaceRevisionModel(queryRevision))
+	}
+	return &namespacev1.ListNamespaceRevisionsResponse{
+		Revisions:  revisions,
+		Total:      total,
+		Page:       req.Page,
+		PageSize:   req.PageSize,
+		PrevCursor: prevCursor,
+		NextCursor: nextCursor,
+	}, nil
+}
+
+// GetNamespaceRevision implements [namespacev1.Repository].
+func (g *gormRepository) GetNamespaceRevision(ctx context.Context, req *namespacev1.GetNamespaceRevisionRequest) (*namespacev1.NamespaceRevisionModel, error) {
+	revisionDo, err := g.getRevision(ctx, query.Use(g.db), req.NamespaceUid, req.Revision)
+	if err != nil {
+		return nil, err
+	}
+	return ConvertNamespaceRevisionModel(revisionDo), nil
+}
+
+// RollbackNamespace implements [namespacev1.Repository].
+func (g *gormRepository) RollbackNamespace(ctx context.Context, req *namespacev1.RollbackNamespaceRequest) (*namespacev1.NamespaceRevisionModel, error) {
+	var revisionDo *model.NamespaceRevision
+	mutation := query.Use(g.db)
+	err := mutation.Transaction(func(tx *query.Query) error {
+		namespaceDo, err := g.lockNamespace(ctx, tx, req.NamespaceUid)
+		if err != nil {
+			return err
+		}
+		sourceDo, err := g.getRevision(ctx, tx, req.NamespaceUid, req.Revision)
+		if err != nil {
+			return err
+		}
+		namespaceDo.Name, namespaceDo.Metadata, namespaceDo.Status = sourceDo.Name, safety.NewMap(sourceDo.Metadata.Map()), sourceDo.Status
+		namespaceDo.DisplayName, namespaceDo.Description, namespaceDo.Owners, namespaceDo.Icon = sourceDo.DisplayName, sourceDo.Description, sourceDo.Owners, sourceDo.Icon
+		if _, err := g.saveDescriptiveFields(ctx, tx, namespaceDo, tx.Namespace.Status); err != nil {
+			return merr.ErrorInternalServer("rollback namespace failed: %v", err)
+		}
+		revisionDo, err = g.appendRevision(ctx, tx, namespaceDo, namespacev1.RevisionAction_REVISION_ACTION_ROLLBACK, sourceDo.Revision, operatorOf(req.Operator))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ConvertNamespaceRevisionModel(revisionDo), nil
+}
+
+// TransferNamespaceOwnership implements [namespacev1.Repository].
+func (g *gormRepository) TransferNamespaceOwnership(ctx context.Context, req *namespacev1.TransferNamespaceOwnershipRequest) (*namespacev1.NamespaceRevisionModel, error) {
+	var revisionDo *model.NamespaceRevision
+	mutation := query.Use(g.db)
+	err := mutation.Transaction(func(tx *query.Query) error {
+		namespaceDo, err := g.lockNamespace(ctx, tx, req.Uid)
+		if err != nil {
+			return err
+		}
+		owners, err := namespacev1.TransferOwners(namespaceDo.Owners, req.PreviousOwner, req.NewOwner)
+		if err != nil {
+			return err
+		}
+		namespaceDo.Owners = owners
+		if _, err := tx.Namespace.WithContext(ctx).Where(tx.Namespace.UID.Eq(req.Uid)).Select(tx.Namespace.Owners).Updates(namespaceDo); err != nil {
+			return merr.ErrorInternalServer("transfer namespace ownership failed: %v", err)
+		}
+		revisionDo, err = g.appendRevision(ctx, tx, namespaceDo, namespacev1.RevisionAction_REVISION_ACTION_TRANSFER_OWNERSHIP, 0, operatorOf(req.Operator))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ConvertNamespaceRevisionModel(revisionDo), nil
+}
+
+// saveDescriptiveFields writes name, metadata, display name, description, owners and icon of the namespace.
+// Updates is used instead of UpdateSimple so that the json serializer of owners is applied.
+func (g *gormRepository) saveDescriptiveFields(ctx context.Context, tx *query.Query, namespaceDo *model.Namespace, extra ...field.Expr) (gen.ResultInfo, error) {
+	mutation := tx.Namespace
+	columns := append([]field.Expr{
+		mutation.Name,
+		mutation.Metadata,
+		mutation.DisplayName,
+		mutation.Description,
+		mutation.Owners,
+		mutation.Icon,
+	}, extra...)
+	return mutation.WithContext(ctx).Where(mutation.UID.Eq(namespaceDo.UID.Int64())).Select(columns...).Updates(namespaceDo)
+}
+
+// lockNamespace loads the namespace with a row lock so revisions of the same namespace are numbered serially.
+func (g *gormRepository) lockNamespace(ctx context.Context, tx *query.Query, uid int64) (*model.Namespace, error) {
+	namespaceDo, err := tx.Namespace.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Where(tx.Namespace.UID.Eq(uid)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("namespace %d not found", uid)
+		}
+		return nil, merr.ErrorInternalServer("get namespace failed: %v", err)
+	}
+	return namespaceDo, nil
+}
+
+func (g *gormRepository) getRevision(ctx context.Context, q *query.Query, namespaceUID int64, revision int32) (*model.NamespaceRevision, error) {
+	revisionDo, err := q.NamespaceRevision.WithContext(ctx).Where(q.NamespaceRevision.NamespaceUID.Eq(namespaceUID), q.NamespaceRevision.Revision.Eq(revision)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("namespace %d revision %d not found", namespaceUID, revision)
+		}
+		return nil, merr.ErrorInternalServer("get namespace revision failed: %v", err)
+	}
+	return revisionDo, nil
+}
+
+// appendRevision snapshots the namespace as the next revision.
+func (g *gormRepository) appendRevision(ctx context.Context, tx *query.Query, namespaceDo *model.Namespace, action namespacev1.RevisionAction, sourceRevision int32, operator snowflake.ID) (*model.NamespaceRevision, error) {
+	mutation := tx.NamespaceRevision
+	var revision int32
+	lastRevision, err := mutation.WithContext(ctx).Where(mutation.NamespaceUID.Eq(namespaceDo.UID.Int64())).Order(mutation.Revision.Desc()).First()
+	if err == nil {
+		revision = lastRevision.Revision
+	} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, merr.ErrorInternalServer("get last namespace revision failed: %v", err)
+	}
+	revisionDo := &model.NamespaceRevision{
+		UID:            g.node.Generate(),
+		Creator:        operator,
+		NamespaceUID:   namespaceDo.UID,
+		Revision:       revision + 1,
+		Name:           namespaceDo.Name,
+		Metadata:       safety.NewMap(namespaceDo.Metadata.Map()),
+		Status:         namespaceDo.Status,
+		Action:         uint8(action),
+		SourceRevision: sourceRevision,
+		DisplayName:    namespaceDo.DisplayName,
+		Description:    namespaceDo.Description,
+		Owners:         namespaceDo.Owners,
+		Icon:           namespaceDo.Icon,
+	}
+	if err := mutation.WithContext(ctx).Create(revisionDo); err != nil {
+		return nil, merr.ErrorInternalServer("create namespace revision failed: %v", err)
+	}
+	return revisionDo, nil
+}
+
+// operatorOf falls back to the system operator when the change is not made by a signed-in user.
+func operatorOf(operator int64) snowflake.ID {
+	if operator <= 0 {
+		return 1
+	}
+	return snowflake.ParseInt64(operator)
+}
+
+// metadataSizeExpr counts the keys of the metadata json object with the dialect specific json function.
+func (g *gormRepository) metadataSizeExpr() string {
+	if g.db.Dialector.Name() == "mysql" {
+		return "CASE WHEN JSON_TYPE(metadata) = 'OBJECT' THEN JSON_LENGTH(metadata) ELSE 0 END"
+	}
+	return "CASE WHEN json_type(metadata) = 'object' THEN (SELECT COUNT(*) FROM json_each(namespaces.metadata)) ELSE 0 END"
+}
+
+// createdDateExpr formats the UTC day of created_at with the dialect specific date function.
+// sqlite keeps the time with its offset, which its date functions convert to UTC. mysql keeps the datetime
+// in the location of the connection, so it is shifted by the offset of that location at the given time.
+func (g *gormRepository) createdDateExpr(at time.Time) string {
+	dialector, ok := g.db.Dialector.(*mysql.Dialector)
+	if !ok {
+		return "strftime('%Y-%m-%d', created_at)"
+	}
+	loc := time.UTC
+	if dialector.DSNConfig != nil && dialector.DSNConfig.Loc != nil {
+		loc = dialector.DSNConfig.Loc
+	}
+	_, offset := at.In(loc).Zone()
+	if offset == 0 {
+		return "DATE_FORMAT(created_at, '%Y-%m-%d')"
+	}
+	sign := '+'
+	if offset < 0 {
+		sign, offset = '-', -offset
+	}
+	return fmt.Sprintf("DATE_FORMAT(CONVERT_TZ(created_at, '%c%02d:%02d', '+00:00'), '%%Y-%%m-%%d')", sign, offset/3600, offset%3600/60)
+}
+
+// GetNamespaceStats implements [namespacev1.Repository].
+func (g *gormRepository) GetNamespaceStats(ctx context.Context, req *namespacev1.GetNamespaceStatsRequest) (*namespacev1.NamespaceStats, error) {
+	mutation := query.Use(g.db).Namespace
+	total, err := mutation.WithContext(ctx).Count()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("count namespace failed: %v", err)
+	}
+
+	var statusRows []*struct {
+		Status uint8
+		Count  int64
+	}
+	if err := mutation.WithContext(ctx).Select(mutation.Status, mutation.ID.Count().As("count")).Group(mutation.Status).Order(mutation.Status).Scan(&statusRows); err != nil {
+		return nil, merr.ErrorInternalServer("count namespace by status failed: %v", err)
+	}
+
+	var dailyRows []*struct {
+		Date  string
+		Count int64
+	}
+	start, end := time.Unix(req.StartAt, 0), time.Unix(req.EndAt, 0)
+	date := field.NewUnsafeFieldRaw(g.createdDateExpr(start))
+	if err := mutation.WithContext(ctx).Select(date.As("date"), mutation.ID.Count().As("count")).Where(mutation.CreatedAt.Gte(start), mutation.CreatedAt.Lt(end)).
+		Group(field.NewField("", "date")).Order(field.NewField("", "date")).Scan(&dailyRows); err != nil {
+		return nil, merr.ErrorInternalServer("count namespace by day failed: %v", err)
+	}
+
+	var creatorRows []*struct {
+		Creator int64
+		Count   int64
+	}
+	if err := mutation.WithContext(ctx).Select(mutation.Creator, mutation.ID.Count().As("count")).Group(mutation.Creator).
+		Order(field.NewField("", "count").Desc(), mutation.Creator).Limit(int(req.TopN)).Scan(&creatorRows); err != nil {
+		return nil, merr.ErrorInternalServer("count namespace by creator failed: %v", err)
+	}
+
+	var metadataRows []*struct {
+		UID  int64
+		Name string
+		Size int64
+	}
+	if err := mutation.WithContext(ctx).Select(mutation.UID, mutation.Name, field.NewUnsafeFieldRaw(g.metadataSizeExpr()).As("size")).
+		Order(field.NewField("", "size").Desc(), mutation.ID).Limit(int(req.TopN)).Scan(&metadataRows); err != nil {
+		return nil, merr.ErrorInternalServer("rank namespace metadata failed: %v", err)
+	}
+
+	stats := &namespacev1.NamespaceStats{
+		Total:           total,
+		StatusCounts:    make([]*namespacev1.NamespaceStatusCount, 0, len(statusRows)),
+		DailyCreations:  make([]*namespacev1.NamespaceDailyCount, 0, len(dailyRows)),
+		TopCreators:     make([]*namespacev1.NamespaceCreatorCount, 0, len(creatorRows)),
+		LargestMetadata: make([]*namespacev1.NamespaceMetadataSize, 0, len(metadataRows)),
+	}
+	for _, row := range statusRows {
+		stats.StatusCounts = append(stats.StatusCounts, &namespacev1.NamespaceStatusCount{Status: enum.GlobalStatus(row.Status), Count: row.Count})
+	}
+	for _, row := range dailyRows {
+		stats.DailyCreations = append(stats.DailyCreations, &namespacev1.NamespaceDailyCount{Date: row.Date, Count: row.Count})
+	}
+	for _, row := range creatorRows {
+		stats.TopCreators = append(stats.TopCreators, &namespacev1.NamespaceCreatorCount{Creator: row.Creator, Count: row.Count})
+	}
+	for _, row := range metadataRows {
+		stats.LargestMetadata = append(stats.LargestMetadata, &namespacev1.NamespaceMetadataSize{Uid: row.UID, Name: row.Name, Size: row.Size})
+	}
+	return stats, nil
+}
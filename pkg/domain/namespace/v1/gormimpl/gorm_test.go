@@ -0,0 +1,180 @@
+package gormimpl_test
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/driver/sqlite"
+	"gorm.io/gorm"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	namespacev1 "github.com/aide-family/sovereign/pkg/domain/namespace/v1"
+	"github.com/aide-family/sovereign/pkg/domain/namespace/v1/gormimpl"
+	"github.com/aide-family/sovereign/pkg/domain/namespace/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/enum"
+)
+
+func newGormRepository(t *testing.T) namespacev1.Repository {
+	t.Helper()
+	repo, _ := newGormRepositoryDB(t)
+	return repo
+}
+
+// newGormRepositoryDB returns the repository with its database, so that tests can set columns the repository does not.
+func newGormRepositoryDB(t *testing.T) (namespacev1.Repository, *gorm.DB) {
+	t.Helper()
+	dsn := "file:" + filepath.Join(t.TempDir(), "sovereign.db")
+	db, err := gorm.Open(sqlite.Open(dsn), &gorm.Config{DisableForeignKeyConstraintWhenMigrating: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AutoMigrate(model.Models()...); err != nil {
+		t.Fatal(err)
+	}
+	sqliteOptions, err := anypb.New(&config.SQLiteOptions{Dsn: dsn})
+	if err != nil {
+		t.Fatal(err)
+	}
+	options, err := anypb.New(&config.ORMConfig{Dialector: config.ORMConfig_SQLITE, Options: sqliteOptions})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, closer, err := gormimpl.NewGormRepository(&config.DomainConfig{Driver: config.DomainConfig_GORM, Options: options})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+	})
+	return repo, db
+}
+
+func TestListNamespaceCursor(t *testing.T) {
+	repo := newGormRepository(t)
+	ctx := context.Background()
+	for i := 1; i <= 5; i++ {
+		if _, err := repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{Name: fmt.Sprintf("ns-%d", i), Status: enum.GlobalStatus_ENABLED}); err != nil {
+			t.Fatal(err)
+		}
+	}
+	tests := []struct {
+		name    string
+		orderBy namespacev1.Field
+		order   namespacev1.Order
+		want    [][]string
+	}{
+		{name: "name asc", orderBy: namespacev1.Field_NAME, order: namespacev1.Order_ASC, want: [][]string{{"ns-1", "ns-2"}, {"ns-3", "ns-4"}, {"ns-5"}}},
+		{name: "name desc", orderBy: namespacev1.Field_NAME, order: namespacev1.Order_DESC, want: [][]string{{"ns-5", "ns-4"}, {"ns-3", "ns-2"}, {"ns-1"}}},
+		{name: "created at ties broken by uid", orderBy: namespacev1.Field_CREATED_AT, order: namespacev1.Order_ASC, want: [][]string{{"ns-1", "ns-2"}, {"ns-3", "ns-4"}, {"ns-5"}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			list := func(cursor *namespacev1.PageCursor) *namespacev1.ListNamespaceResponse {
+				t.Helper()
+				resp, err := repo.ListNamespace(ctx, &namespacev1.ListNamespaceRequest{PageSize: 2, OrderBy: tt.orderBy, Order: tt.order, Cursor: cursor})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return resp
+			}
+			checkPage := func(resp *namespacev1.ListNamespaceResponse, want []string, hasPrev, hasNext bool) {
+				t.Helper()
+				names := make([]string, 0, len(resp.GetNamespaces()))
+				for _, namespace := range resp.GetNamespaces() {
+					names = append(names, namespace.GetName())
+				}
+				if !slices.Equal(names, want) {
+					t.Fatalf("want page %v, got %v", want, names)
+				}
+				if (resp.GetPrevCursor() != nil) != hasPrev || (resp.GetNextCursor() != nil) != hasNext {
+					t.Fatalf("page %v: want prev %v next %v, got prev %v next %v", want, hasPrev, hasNext, resp.GetPrevCursor(), resp.GetNextCursor())
+				}
+			}
+
+			first := list(nil)
+			checkPage(first, tt.want[0], false, true)
+			middle := list(first.GetNextCursor())
+			checkPage(middle, tt.want[1], true, true)
+			last := list(middle.GetNextCursor())
+			checkPage(last, tt.want[2], true, false)
+
+			back := list(last.GetPrevCursor())
+			checkPage(back, tt.want[1], true, true)
+			back = list(back.GetPrevCursor())
+			checkPage(back, tt.want[0], false, true)
+		})
+	}
+}
+
+func TestGetNamespaceStats(t *testing.T) {
+	repo, db := newGormRepositoryDB(t)
+	ctx := context.Background()
+	day := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	// ns-3 and ns-4 are kept with offsets whose local day is not the UTC day of the bucket
+	east, west := time.FixedZone("east", 8*3600), time.FixedZone("west", -5*3600)
+	namespaces := []struct {
+		name      string
+		status    enum.GlobalStatus
+		creator   int64
+		createdAt time.Time
+		metadata  map[string]string
+		deleted   bool
+	}{
+		{name: "ns-1", status: enum.GlobalStatus_ENABLED, creator: 7, createdAt: day.Add(-time.Hour), metadata: map[string]string{"a": "1"}},
+		{name: "ns-2", status: enum.GlobalStatus_ENABLED, creator: 7, createdAt: day.Add(12 * time.Hour), metadata: map[string]string{"a": "1", "b": "2", "c": "3"}},
+		{name: "ns-3", status: enum.GlobalStatus_DISABLED, creator: 8, createdAt: day.Add(23 * time.Hour).In(east)},
+		{name: "ns-4", status: enum.GlobalStatus_ENABLED, creator: 9, createdAt: day.Add(49 * time.Hour).In(west), metadata: map[string]string{"a": "1", "b": "2"}},
+		{name: "ns-5", status: enum.GlobalStatus_ENABLED, creator: 9, createdAt: day.Add(50 * time.Hour), deleted: true},
+	}
+	want := &namespacev1.NamespaceStats{
+		Total: 4,
+		StatusCounts: []*namespacev1.NamespaceStatusCount{
+			{Status: enum.GlobalStatus_ENABLED, Count: 3},
+			{Status: enum.GlobalStatus_DISABLED, Count: 1},
+		},
+		DailyCreations: []*namespacev1.NamespaceDailyCount{
+			{Date: "2026-01-01", Count: 2},
+			{Date: "2026-01-03", Count: 1},
+		},
+		TopCreators: []*namespacev1.NamespaceCreatorCount{
+			{Creator: 7, Count: 2},
+			{Creator: 8, Count: 1},
+		},
+	}
+	for _, item := range namespaces {
+		namespace, err := repo.CreateNamespace(ctx, &namespacev1.CreateNamespaceRequest{Name: item.name, Status: item.status, Operator: item.creator, Metadata: item.metadata})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := db.Model(&model.Namespace{}).Where("uid = ?", namespace.GetUid()).Update("created_at", item.createdAt).Error; err != nil {
+			t.Fatal(err)
+		}
+		if item.deleted {
+			if _, err := repo.DeleteNamespace(ctx, &namespacev1.DeleteNamespaceRequest{Uid: namespace.GetUid()}); err != nil {
+				t.Fatal(err)
+			}
+		}
+		if len(item.metadata) >= 2 {
+			want.LargestMetadata = append(want.LargestMetadata, &namespacev1.NamespaceMetadataSize{Uid: namespace.GetUid(), Name: item.name, Size: int64(len(item.metadata))})
+		}
+	}
+	slices.SortFunc(want.LargestMetadata, func(a, b *namespacev1.NamespaceMetadataSize) int {
+		return int(b.GetSize() - a.GetSize())
+	})
+
+	stats, err := repo.GetNamespaceStats(ctx, &namespacev1.GetNamespaceStatsRequest{StartAt: day.Unix(), EndAt: day.AddDate(0, 0, 3).Unix(), TopN: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !proto.Equal(stats, want) {
+		t.Fatalf("want stats %v, got %v", want, stats)
+	}
+}
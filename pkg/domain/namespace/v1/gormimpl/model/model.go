@@ -16,6 +16,7 @@ import (
 func Models() []any {
 	return []any{
 		&Namespace{},
+		&NamespaceRevision{},
 	}
 }
 
@@ -76,9 +77,13 @@ func (n *NamespaceModel) WithNamespace(namespace string) *NamespaceModel {
 type Namespace struct {
 	BaseModel
 
-	Name     string                      `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
-	Metadata *safety.Map[string, string] `gorm:"column:metadata;type:json;"`
-	Status   uint8                       `gorm:"column:status;type:tinyint;not null;default:0"`
+	Name        string                      `gorm:"column:name;type:varchar(100);not null;uniqueIndex"`
+	Metadata    *safety.Map[string, string] `gorm:"column:metadata;type:json;"`
+	Status      uint8                       `gorm:"column:status;type:tinyint;not null;default:0"`
+	DisplayName string                      `gorm:"column:display_name;type:varchar(100);not null;default:''"`
+	Description string                      `gorm:"column:description;type:varchar(1000);not null;default:''"`
+	Owners      []int64                     `gorm:"column:owners;type:json;serializer:json"`
+	Icon        string                      `gorm:"column:icon;type:varchar(255);not null;default:''"`
 }
 
 func (Namespace) TableName() string {
@@ -94,3 +99,27 @@ func (n *Namespace) BeforeCreate(tx *gorm.DB) (err error) {
 	}
 	return
 }
+
+// NamespaceRevision is an immutable snapshot of a namespace taken on every change.
+type NamespaceRevision struct {
+	ID           uint32                      `gorm:"column:id;primaryKey;autoIncrement"`
+	UID          snowflake.ID                `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt    time.Time                   `gorm:"column:created_at;type:datetime;not null;"`
+	Creator      snowflake.ID                `gorm:"column:creator;not null;index"`
+	NamespaceUID snowflake.ID                `gorm:"column:namespace_uid;not null;uniqueIndex:idx__namespace_revision__namespace_uid__revision"`
+	Revision     int32                       `gorm:"column:revision;type:int;not null;uniqueIndex:idx__namespace_revision__namespace_uid__revision"`
+	Name         string                      `gorm:"column:name;type:varchar(100);not null"`
+	Metadata     *safety.Map[string, string] `gorm:"column:metadata;type:json;"`
+	Status       uint8                       `gorm:"column:status;type:tinyint;not null;default:0"`
+	Action       uint8                       `gorm:"column:action;type:tinyint;not null;default:0"`
+	// SourceRevision is the revision restored by a rollback.
+	SourceRevision int32   `gorm:"column:source_revision;type:int;not null;default:0"`
+	DisplayName    string  `gorm:"column:display_name;type:varchar(100);not null;default:''"`
+	Description    string  `gorm:"column:description;type:varchar(1000);not null;default:''"`
+	Owners         []int64 `gorm:"column:owners;type:json;serializer:json"`
+	Icon           string  `gorm:"column:icon;type:varchar(255);not null;default:''"`
+}
+
+func (NamespaceRevision) TableName() string {
+	return "namespace_revisions"
+}
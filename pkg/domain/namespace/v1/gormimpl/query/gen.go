@@ -16,34 +16,39 @@ import (
 )
 
 var (
-	Q         = new(Query)
-	Namespace *namespace
+	Q                 = new(Query)
+	Namespace         *namespace
+	NamespaceRevision *namespaceRevision
 )
 
 func SetDefault(db *gorm.DB, opts ...gen.DOOption) {
 	*Q = *Use(db, opts...)
 	Namespace = &Q.Namespace
+	NamespaceRevision = &Q.NamespaceRevision
 }
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:        db,
-		Namespace: newNamespace(db, opts...),
+		db:                db,
+		Namespace:         newNamespace(db, opts...),
+		NamespaceRevision: newNamespaceRevision(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	Namespace namespace
+	Namespace         namespace
+	NamespaceRevision namespaceRevision
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:        db,
-		Namespace: q.Namespace.clone(db),
+		db:                db,
+		Namespace:         q.Namespace.clone(db),
+		NamespaceRevision: q.NamespaceRevision.clone(db),
 	}
 }
 
@@ -57,18 +62,21 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:        db,
-		Namespace: q.Namespace.replaceDB(db),
+		db:                db,
+		Namespace:         q.Namespace.replaceDB(db),
+		NamespaceRevision: q.NamespaceRevision.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	Namespace INamespaceDo
+	Namespace         INamespaceDo
+	NamespaceRevision INamespaceRevisionDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		Namespace: q.Namespace.WithContext(ctx),
+		Namespace:         q.Namespace.WithContext(ctx),
+		NamespaceRevision: q.NamespaceRevision.WithContext(ctx),
 	}
 }
 
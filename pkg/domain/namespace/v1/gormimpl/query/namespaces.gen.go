@@ -36,6 +36,10 @@ func newNamespace(db *gorm.DB, opts ...gen.DOOption) namespace {
 	_namespace.Name = field.NewString(tableName, "name")
 	_namespace.Metadata = field.NewField(tableName, "metadata")
 	_namespace.Status = field.NewUint8(tableName, "status")
+	_namespace.DisplayName = field.NewString(tableName, "display_name")
+	_namespace.Description = field.NewString(tableName, "description")
+	_namespace.Owners = field.NewField(tableName, "owners")
+	_namespace.Icon = field.NewString(tableName, "icon")
 
 	_namespace.fillFieldMap()
 
@@ -45,16 +49,20 @@ func newNamespace(db *gorm.DB, opts ...gen.DOOption) namespace {
 type namespace struct {
 	namespaceDo
 
-	ALL       field.Asterisk
-	ID        field.Uint32
-	UID       field.Int64
-	CreatedAt field.Time
-	UpdatedAt field.Time
-	DeletedAt field.Field
-	Creator   field.Int64
-	Name      field.String
-	Metadata  field.Field
-	Status    field.Uint8
+	ALL         field.Asterisk
+	ID          field.Uint32
+	UID         field.Int64
+	CreatedAt   field.Time
+	UpdatedAt   field.Time
+	DeletedAt   field.Field
+	Creator     field.Int64
+	Name        field.String
+	Metadata    field.Field
+	Status      field.Uint8
+	DisplayName field.String
+	Description field.String
+	Owners      field.Field
+	Icon        field.String
 
 	fieldMap map[string]field.Expr
 }
@@ -80,6 +88,10 @@ func (n *namespace) updateTableName(table string) *namespace {
 	n.Name = field.NewString(table, "name")
 	n.Metadata = field.NewField(table, "metadata")
 	n.Status = field.NewUint8(table, "status")
+	n.DisplayName = field.NewString(table, "display_name")
+	n.Description = field.NewString(table, "description")
+	n.Owners = field.NewField(table, "owners")
+	n.Icon = field.NewString(table, "icon")
 
 	n.fillFieldMap()
 
@@ -96,7 +108,7 @@ func (n *namespace) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (n *namespace) fillFieldMap() {
-	n.fieldMap = make(map[string]field.Expr, 9)
+	n.fieldMap = make(map[string]field.Expr, 13)
 	n.fieldMap["id"] = n.ID
 	n.fieldMap["uid"] = n.UID
 	n.fieldMap["created_at"] = n.CreatedAt
@@ -106,6 +118,10 @@ func (n *namespace) fillFieldMap() {
 	n.fieldMap["name"] = n.Name
 	n.fieldMap["metadata"] = n.Metadata
 	n.fieldMap["status"] = n.Status
+	n.fieldMap["display_name"] = n.DisplayName
+	n.fieldMap["description"] = n.Description
+	n.fieldMap["owners"] = n.Owners
+	n.fieldMap["icon"] = n.Icon
 }
 
 func (n namespace) clone(db *gorm.DB) namespace {
@@ -12,15 +12,19 @@ import (
 
 func ConvertNamespaceModel(namespaceDo *model.Namespace) *namespacev1.NamespaceModel {
 	return &namespacev1.NamespaceModel{
-		Id:        namespaceDo.ID,
-		Uid:       namespaceDo.UID.Int64(),
-		Name:      namespaceDo.Name,
-		Metadata:  namespaceDo.Metadata.Map(),
-		Status:    enum.GlobalStatus(namespaceDo.Status),
-		CreatedAt: namespaceDo.CreatedAt.Unix(),
-		UpdatedAt: namespaceDo.UpdatedAt.Unix(),
-		DeletedAt: namespaceDo.DeletedAt.Time.Unix(),
-		Creator:   namespaceDo.Creator.Int64(),
+		Id:          namespaceDo.ID,
+		Uid:         namespaceDo.UID.Int64(),
+		Name:        namespaceDo.Name,
+		Metadata:    namespaceDo.Metadata.Map(),
+		Status:      enum.GlobalStatus(namespaceDo.Status),
+		CreatedAt:   namespaceDo.CreatedAt.Unix(),
+		UpdatedAt:   namespaceDo.UpdatedAt.Unix(),
+		DeletedAt:   namespaceDo.DeletedAt.Time.Unix(),
+		Creator:     namespaceDo.Creator.Int64(),
+		DisplayName: namespaceDo.DisplayName,
+		Description: namespaceDo.Description,
+		Owners:      namespaceDo.Owners,
+		Icon:        namespaceDo.Icon,
 	}
 }
 
@@ -37,6 +41,26 @@ func ConvertNamespaceItemSelect(namespaceDo *model.Namespace) *namespacev1.Names
 	}
 }
 
+func ConvertNamespaceRevisionModel(revisionDo *model.NamespaceRevision) *namespacev1.NamespaceRevisionModel {
+	return &namespacev1.NamespaceRevisionModel{
+		Id:             revisionDo.ID,
+		Uid:            revisionDo.UID.Int64(),
+		NamespaceUid:   revisionDo.NamespaceUID.Int64(),
+		Revision:       revisionDo.Revision,
+		Name:           revisionDo.Name,
+		Metadata:       revisionDo.Metadata.Map(),
+		Status:         enum.GlobalStatus(revisionDo.Status),
+		Action:         namespacev1.RevisionAction(revisionDo.Action),
+		SourceRevision: revisionDo.SourceRevision,
+		Creator:        revisionDo.Creator.Int64(),
+		CreatedAt:      revisionDo.CreatedAt.Unix(),
+		DisplayName:    revisionDo.DisplayName,
+		Description:    revisionDo.Description,
+		Owners:         revisionDo.Owners,
+		Icon:           revisionDo.Icon,
+	}
+}
+
 func convertResultInfo(result *gen.ResultInfo) *namespacev1.ResultInfo {
 	var errStr string
 	if err := result.Error; err != nil {
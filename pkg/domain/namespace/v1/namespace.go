@@ -3,6 +3,9 @@ package namespacev1
 
 import (
 	context "context"
+	"slices"
+
+	"github.com/aide-family/sovereign/pkg/merr"
 )
 
 type Repository interface {
@@ -14,4 +17,31 @@ type Repository interface {
 	SelectNamespace(ctx context.Context, req *SelectNamespaceRequest) (*SelectNamespaceResponse, error)
 	UpdateNamespaceStatus(ctx context.Context, req *UpdateNamespaceStatusRequest) (*ResultInfo, error)
 	GetNamespaceByName(ctx context.Context, req *GetNamespaceByNameRequest) (*NamespaceModel, error)
+	GetNamespaceStats(ctx context.Context, req *GetNamespaceStatsRequest) (*NamespaceStats, error)
+	ListNamespaceRevisions(ctx context.Context, req *ListNamespaceRevisionsRequest) (*ListNamespaceRevisionsResponse, error)
+	GetNamespaceRevision(ctx context.Context, req *GetNamespaceRevisionRequest) (*NamespaceRevisionModel, error)
+	// RollbackNamespace restores the namespace to the given revision and records the result as a new revision.
+	RollbackNamespace(ctx context.Context, req *RollbackNamespaceRequest) (*NamespaceRevisionModel, error)
+	// TransferNamespaceOwnership replaces the previous owner with the new owner and records the change as a new revision.
+	TransferNamespaceOwnership(ctx context.Context, req *TransferNamespaceOwnershipRequest) (*NamespaceRevisionModel, error)
+}
+
+// TransferOwners replaces previousOwner with newOwner in owners, when previousOwner is 0 newOwner becomes the only owner.
+func TransferOwners(owners []int64, previousOwner, newOwner int64) ([]int64, error) {
+	if previousOwner == 0 {
+		return []int64{newOwner}, nil
+	}
+	if !slices.Contains(owners, previousOwner) {
+		return nil, merr.ErrorParams("user %d is not an owner of the namespace", previousOwner)
+	}
+	transferred := make([]int64, 0, len(owners))
+	for _, owner := range owners {
+		if owner == previousOwner {
+			owner = newOwner
+		}
+		if !slices.Contains(transferred, owner) {
+			transferred = append(transferred, owner)
+		}
+	}
+	return transferred, nil
 }
@@ -19,14 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	NamespaceService_CreateNamespace_FullMethodName       = "/domain.namespace.v1.NamespaceService/CreateNamespace"
-	NamespaceService_GetNamespace_FullMethodName          = "/domain.namespace.v1.NamespaceService/GetNamespace"
-	NamespaceService_UpdateNamespace_FullMethodName       = "/domain.namespace.v1.NamespaceService/UpdateNamespace"
-	NamespaceService_DeleteNamespace_FullMethodName       = "/domain.namespace.v1.NamespaceService/DeleteNamespace"
-	NamespaceService_ListNamespace_FullMethodName         = "/domain.namespace.v1.NamespaceService/ListNamespace"
-	NamespaceService_SelectNamespace_FullMethodName       = "/domain.namespace.v1.NamespaceService/SelectNamespace"
-	NamespaceService_UpdateNamespaceStatus_FullMethodName = "/domain.namespace.v1.NamespaceService/UpdateNamespaceStatus"
-	NamespaceService_GetNamespaceByName_FullMethodName    = "/domain.namespace.v1.NamespaceService/GetNamespaceByName"
+	NamespaceService_CreateNamespace_FullMethodName            = "/domain.namespace.v1.NamespaceService/CreateNamespace"
+	NamespaceService_GetNamespace_FullMethodName               = "/domain.namespace.v1.NamespaceService/GetNamespace"
+	NamespaceService_UpdateNamespace_FullMethodName            = "/domain.namespace.v1.NamespaceService/UpdateNamespace"
+	NamespaceService_DeleteNamespace_FullMethodName            = "/domain.namespace.v1.NamespaceService/DeleteNamespace"
+	NamespaceService_ListNamespace_FullMethodName              = "/domain.namespace.v1.NamespaceService/ListNamespace"
+	NamespaceService_SelectNamespace_FullMethodName            = "/domain.namespace.v1.NamespaceService/SelectNamespace"
+	NamespaceService_UpdateNamespaceStatus_FullMethodName      = "/domain.namespace.v1.NamespaceService/UpdateNamespaceStatus"
+	NamespaceService_GetNamespaceByName_FullMethodName         = "/domain.namespace.v1.NamespaceService/GetNamespaceByName"
+	NamespaceService_GetNamespaceStats_FullMethodName          = "/domain.namespace.v1.NamespaceService/GetNamespaceStats"
+	NamespaceService_ListNamespaceRevisions_FullMethodName     = "/domain.namespace.v1.NamespaceService/ListNamespaceRevisions"
+	NamespaceService_GetNamespaceRevision_FullMethodName       = "/domain.namespace.v1.NamespaceService/GetNamespaceRevision"
+	NamespaceService_RollbackNamespace_FullMethodName          = "/domain.namespace.v1.NamespaceService/RollbackNamespace"
+	NamespaceService_TransferNamespaceOwnership_FullMethodName = "/domain.namespace.v1.NamespaceService/TransferNamespaceOwnership"
 )
 
 // NamespaceServiceClient is the client API for NamespaceService service.
@@ -41,6 +46,11 @@ type NamespaceServiceClient interface {
 	SelectNamespace(ctx context.Context, in *ListNamespaceRequest, opts ...grpc.CallOption) (*SelectNamespaceResponse, error)
 	UpdateNamespaceStatus(ctx context.Context, in *UpdateNamespaceStatusRequest, opts ...grpc.CallOption) (*ResultInfo, error)
 	GetNamespaceByName(ctx context.Context, in *GetNamespaceByNameRequest, opts ...grpc.CallOption) (*NamespaceModel, error)
+	GetNamespaceStats(ctx context.Context, in *GetNamespaceStatsRequest, opts ...grpc.CallOption) (*NamespaceStats, error)
+	ListNamespaceRevisions(ctx context.Context, in *ListNamespaceRevisionsRequest, opts ...grpc.CallOption) (*ListNamespaceRevisionsResponse, error)
+	GetNamespaceRevision(ctx context.Context, in *GetNamespaceRevisionRequest, opts ...grpc.CallOption) (*NamespaceRevisionModel, error)
+	RollbackNamespace(ctx context.Context, in *RollbackNamespaceRequest, opts ...grpc.CallOption) (*NamespaceRevisionModel, error)
+	TransferNamespaceOwnership(ctx context.Context, in *TransferNamespaceOwnershipRequest, opts ...grpc.CallOption) (*NamespaceRevisionModel, error)
 }
 
 type namespaceServiceClient struct {
@@ -131,6 +141,56 @@ func (c *namespaceServiceClient) GetNamespaceByName(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *namespaceServiceClient) GetNamespaceStats(ctx context.Context, in *GetNamespaceStatsRequest, opts ...grpc.CallOption) (*NamespaceStats, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceStats)
+	err := c.cc.Invoke(ctx, NamespaceService_GetNamespaceStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) ListNamespaceRevisions(ctx context.Context, in *ListNamespaceRevisionsRequest, opts ...grpc.CallOption) (*ListNamespaceRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListNamespaceRevisionsResponse)
+	err := c.cc.Invoke(ctx, NamespaceService_ListNamespaceRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) GetNamespaceRevision(ctx context.Context, in *GetNamespaceRevisionRequest, opts ...grpc.CallOption) (*NamespaceRevisionModel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceRevisionModel)
+	err := c.cc.Invoke(ctx, NamespaceService_GetNamespaceRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) RollbackNamespace(ctx context.Context, in *RollbackNamespaceRequest, opts ...grpc.CallOption) (*NamespaceRevisionModel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceRevisionModel)
+	err := c.cc.Invoke(ctx, NamespaceService_RollbackNamespace_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) TransferNamespaceOwnership(ctx context.Context, in *TransferNamespaceOwnershipRequest, opts ...grpc.CallOption) (*NamespaceRevisionModel, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(NamespaceRevisionModel)
+	err := c.cc.Invoke(ctx, NamespaceService_TransferNamespaceOwnership_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NamespaceServiceServer is the server API for NamespaceService service.
 // All implementations must embed UnimplementedNamespaceServiceServer
 // for forward compatibility.
@@ -143,6 +203,11 @@ type NamespaceServiceServer interface {
 	SelectNamespace(context.Context, *ListNamespaceRequest) (*SelectNamespaceResponse, error)
 	UpdateNamespaceStatus(context.Context, *UpdateNamespaceStatusRequest) (*ResultInfo, error)
 	GetNamespaceByName(context.Context, *GetNamespaceByNameRequest) (*NamespaceModel, error)
+	GetNamespaceStats(context.Context, *GetNamespaceStatsRequest) (*NamespaceStats, error)
+	ListNamespaceRevisions(context.Context, *ListNamespaceRevisionsRequest) (*ListNamespaceRevisionsResponse, error)
+	GetNamespaceRevision(context.Context, *GetNamespaceRevisionRequest) (*NamespaceRevisionModel, error)
+	RollbackNamespace(context.Context, *RollbackNamespaceRequest) (*NamespaceRevisionModel, error)
+	TransferNamespaceOwnership(context.Context, *TransferNamespaceOwnershipRequest) (*NamespaceRevisionModel, error)
 	mustEmbedUnimplementedNamespaceServiceServer()
 }
 
@@ -177,6 +242,21 @@ func (UnimplementedNamespaceServiceServer) UpdateNamespaceStatus(context.Context
 func (UnimplementedNamespaceServiceServer) GetNamespaceByName(context.Context, *GetNamespaceByNameRequest) (*NamespaceModel, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceByName not implemented")
 }
+func (UnimplementedNamespaceServiceServer) GetNamespaceStats(context.Context, *GetNamespaceStatsRequest) (*NamespaceStats, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceStats not implemented")
+}
+func (UnimplementedNamespaceServiceServer) ListNamespaceRevisions(context.Context, *ListNamespaceRevisionsRequest) (*ListNamespaceRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListNamespaceRevisions not implemented")
+}
+func (UnimplementedNamespaceServiceServer) GetNamespaceRevision(context.Context, *GetNamespaceRevisionRequest) (*NamespaceRevisionModel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNamespaceRevision not implemented")
+}
+func (UnimplementedNamespaceServiceServer) RollbackNamespace(context.Context, *RollbackNamespaceRequest) (*NamespaceRevisionModel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackNamespace not implemented")
+}
+func (UnimplementedNamespaceServiceServer) TransferNamespaceOwnership(context.Context, *TransferNamespaceOwnershipRequest) (*NamespaceRevisionModel, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferNamespaceOwnership not implemented")
+}
 func (UnimplementedNamespaceServiceServer) mustEmbedUnimplementedNamespaceServiceServer() {}
 func (UnimplementedNamespaceServiceServer) testEmbeddedByValue()                          {}
 
@@ -342,6 +422,96 @@ func _NamespaceService_GetNamespaceByName_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _NamespaceService_GetNamespaceStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).GetNamespaceStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_GetNamespaceStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).GetNamespaceStats(ctx, req.(*GetNamespaceStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_ListNamespaceRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespaceRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).ListNamespaceRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_ListNamespaceRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).ListNamespaceRevisions(ctx, req.(*ListNamespaceRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_GetNamespaceRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).GetNamespaceRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_GetNamespaceRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).GetNamespaceRevision(ctx, req.(*GetNamespaceRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_RollbackNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).RollbackNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_RollbackNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).RollbackNamespace(ctx, req.(*RollbackNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_TransferNamespaceOwnership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferNamespaceOwnershipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).TransferNamespaceOwnership(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: NamespaceService_TransferNamespaceOwnership_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).TransferNamespaceOwnership(ctx, req.(*TransferNamespaceOwnershipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // NamespaceService_ServiceDesc is the grpc.ServiceDesc for NamespaceService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -381,6 +551,26 @@ var NamespaceService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetNamespaceByName",
 			Handler:    _NamespaceService_GetNamespaceByName_Handler,
 		},
+		{
+			MethodName: "GetNamespaceStats",
+			Handler:    _NamespaceService_GetNamespaceStats_Handler,
+		},
+		{
+			MethodName: "ListNamespaceRevisions",
+			Handler:    _NamespaceService_ListNamespaceRevisions_Handler,
+		},
+		{
+			MethodName: "GetNamespaceRevision",
+			Handler:    _NamespaceService_GetNamespaceRevision_Handler,
+		},
+		{
+			MethodName: "RollbackNamespace",
+			Handler:    _NamespaceService_RollbackNamespace_Handler,
+		},
+		{
+			MethodName: "TransferNamespaceOwnership",
+			Handler:    _NamespaceService_TransferNamespaceOwnership_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "domain/namespace/v1/namespace.proto",
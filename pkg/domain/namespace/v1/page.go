@@ -0,0 +1,64 @@
+package namespacev1
+
+import (
+	"slices"
+	"strconv"
+
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// CursorField returns the field a cursor page is ordered by, fields without a comparable value fall back to UID.
+func CursorField(orderBy Field) Field {
+	switch orderBy {
+	case Field_METADATA, Field_DELETED_AT:
+		return Field_UID
+	default:
+		return orderBy
+	}
+}
+
+// RevisionCursor returns the cursor of a revision, the revisions of a namespace are paged by revision number, newest first.
+func RevisionCursor(revision int32) *PageCursor {
+	return &PageCursor{Value: strconv.FormatInt(int64(revision), 10)}
+}
+
+// ParseRevisionCursor returns the revision number of a cursor returned by RevisionCursor.
+func ParseRevisionCursor(cursor *PageCursor) (int32, error) {
+	revision, err := strconv.ParseInt(cursor.GetValue(), 10, 32)
+	if err != nil {
+		return 0, merr.ErrorParams("invalid page cursor")
+	}
+	return int32(revision), nil
+}
+
+// PageWindow trims rows fetched with one extra look-ahead row and returns the cursors around the page.
+// Rows fetched backward are reversed into display order, hasPrevious reports whether rows exist before a forward page.
+func PageWindow[T any](rows []T, pageSize int, cursor *PageCursor, hasPrevious bool, cursorOf func(T) *PageCursor) ([]T, *PageCursor, *PageCursor) {
+	if pageSize <= 0 {
+		return rows, nil, nil
+	}
+	hasMore := len(rows) > pageSize
+	if hasMore {
+		rows = rows[:pageSize]
+	}
+	backward := cursor.GetBackward()
+	if backward {
+		slices.Reverse(rows)
+	}
+	if len(rows) == 0 {
+		return rows, nil, nil
+	}
+	hasPrev, hasNext := hasPrevious, hasMore
+	if backward {
+		hasPrev, hasNext = hasMore, true
+	}
+	var prev, next *PageCursor
+	if hasPrev {
+		prev = cursorOf(rows[0])
+		prev.Backward = true
+	}
+	if hasNext {
+		next = cursorOf(rows[len(rows)-1])
+	}
+	return rows, prev, next
+}
@@ -0,0 +1,111 @@
+package namespacev1_test
+
+import (
+	"slices"
+	"testing"
+
+	namespacev1 "github.com/aide-family/sovereign/pkg/domain/namespace/v1"
+)
+
+func TestPageWindow(t *testing.T) {
+	cursorOf := func(uid int64) *namespacev1.PageCursor {
+		return &namespacev1.PageCursor{Uid: uid}
+	}
+	tests := []struct {
+		name        string
+		rows        []int64
+		pageSize    int
+		cursor      *namespacev1.PageCursor
+		hasPrevious bool
+		wantRows    []int64
+		wantPrev    *namespacev1.PageCursor
+		wantNext    *namespacev1.PageCursor
+	}{
+		{
+			name:     "first page with more",
+			rows:     []int64{1, 2, 3},
+			pageSize: 2,
+			wantRows: []int64{1, 2},
+			wantNext: &namespacev1.PageCursor{Uid: 2},
+		},
+		{
+			name:     "only page",
+			rows:     []int64{1, 2},
+			pageSize: 2,
+			wantRows: []int64{1, 2},
+		},
+		{
+			name:        "forward to a middle page",
+			rows:        []int64{3, 4, 5},
+			pageSize:    2,
+			cursor:      &namespacev1.PageCursor{Uid: 2},
+			hasPrevious: true,
+			wantRows:    []int64{3, 4},
+			wantPrev:    &namespacev1.PageCursor{Uid: 3, Backward: true},
+			wantNext:    &namespacev1.PageCursor{Uid: 4},
+		},
+		{
+			name:        "forward to the last page",
+			rows:        []int64{5},
+			pageSize:    2,
+			cursor:      &namespacev1.PageCursor{Uid: 4},
+			hasPrevious: true,
+			wantRows:    []int64{5},
+			wantPrev:    &namespacev1.PageCursor{Uid: 5, Backward: true},
+		},
+		{
+			name:        "backward to a middle page",
+			rows:        []int64{4, 3, 2},
+			pageSize:    2,
+			cursor:      &namespacev1.PageCursor{Uid: 5, Backward: true},
+			hasPrevious: true,
+			wantRows:    []int64{3, 4},
+			wantPrev:    &namespacev1.PageCursor{Uid: 3, Backward: true},
+			wantNext:    &namespacev1.PageCursor{Uid: 4},
+		},
+		{
+			name:        "backward to the first page",
+			rows:        []int64{2, 1},
+			pageSize:    2,
+			cursor:      &namespacev1.PageCursor{Uid: 3, Backward: true},
+			hasPrevious: true,
+			wantRows:    []int64{1, 2},
+			wantNext:    &namespacev1.PageCursor{Uid: 2},
+		},
+		{
+			name:        "empty page past the end",
+			rows:        []int64{},
+			pageSize:    2,
+			cursor:      &namespacev1.PageCursor{Uid: 5},
+			hasPrevious: true,
+			wantRows:    []int64{},
+		},
+		{
+			name:     "unpaged",
+			rows:     []int64{1, 2, 3},
+			pageSize: 0,
+			wantRows: []int64{1, 2, 3},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rows, prev, next := namespacev1.PageWindow(slices.Clone(tt.rows), tt.pageSize, tt.cursor, tt.hasPrevious, cursorOf)
+			if !slices.Equal(rows, tt.wantRows) {
+				t.Fatalf("want rows %v, got %v", tt.wantRows, rows)
+			}
+			if !equalCursor(prev, tt.wantPrev) {
+				t.Fatalf("want prev %v, got %v", tt.wantPrev, prev)
+			}
+			if !equalCursor(next, tt.wantNext) {
+				t.Fatalf("want next %v, got %v", tt.wantNext, next)
+			}
+		})
+	}
+}
+
+func equalCursor(a, b *namespacev1.PageCursor) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.GetValue() == b.GetValue() && a.GetUid() == b.GetUid() && a.GetBackward() == b.GetBackward()
+}
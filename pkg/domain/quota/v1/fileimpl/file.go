@@ -0,0 +1,295 @@
+// Package fileimpl is the implementation of the file repository for the quota service.
+package fileimpl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"slices"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/aide-family/magicbox/hello"
+	"github.com/aide-family/magicbox/pointer"
+	"github.com/bwmarrin/snowflake"
+	klog "github.com/go-kratos/kratos/v2/log"
+	"go.yaml.in/yaml/v2"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	domain "github.com/aide-family/sovereign/pkg/domain"
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
+	"github.com/aide-family/sovereign/pkg/domain/quota/v1/fileimpl/model"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func init() {
+	domain.RegisterQuotaV1Factory(config.DomainConfig_FILE, NewFileRepository)
+}
+
+func NewFileRepository(c *config.DomainConfig) (quotav1.Repository, func() error, error) {
+	fileConfig := &config.FileConfig{}
+	if pointer.IsNotNil(c.GetOptions()) {
+		if err := anypb.UnmarshalTo(c.GetOptions(), fileConfig, proto.UnmarshalOptions{Merge: true}); err != nil {
+			return nil, nil, merr.ErrorInternalServer("unmarshal file config failed: %v", err)
+		}
+	}
+
+	// 确保目录存在
+	if err := os.MkdirAll(fileConfig.Path, 0755); err != nil {
+		return nil, nil, merr.ErrorInternalServer("create directory failed: %v", err)
+	}
+
+	tmpFilepath := filepath.Join(fileConfig.Path, fmt.Sprintf("%s.tmp", fileConfig.Filename))
+	filepath := filepath.Join(fileConfig.Path, fileConfig.Filename)
+	node, err := snowflake.NewNode(hello.NodeID())
+	if err != nil {
+		return nil, nil, err
+	}
+	f := &fileRepository{
+		repoConfig:      c,
+		fileConfig:      fileConfig,
+		tmpFilepath:     tmpFilepath,
+		filepath:        filepath,
+		stopChan:        make(chan struct{}),
+		storageInterval: fileConfig.StorageInterval.AsDuration(),
+		node:            node,
+		quotas:          make([]*model.QuotaModel, 0),
+	}
+	if err := f.load(); err != nil {
+		return nil, nil, err
+	}
+	f.watch()
+	return f, func() error {
+		close(f.stopChan)
+		return f.save()
+	}, nil
+}
+
+type fileRepository struct {
+	repoConfig      *config.DomainConfig
+	fileConfig      *config.FileConfig
+	tmpFilepath     string
+	filepath        string
+	mu              sync.RWMutex
+	quotas          []*model.QuotaModel
+	nextID          uint32
+	stopChan        chan struct{}
+	storageInterval time.Duration
+	changed         bool
+	node            *snowflake.Node
+}
+
+func (f *fileRepository) load() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	// 如果文件不存在，初始化为空列表
+	if _, err := os.Stat(f.filepath); os.IsNotExist(err) {
+		return nil
+	}
+
+	file, err := os.Open(f.filepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	var quotas []*model.QuotaModel
+	if err := yaml.NewDecoder(file).Decode(&quotas); err != nil {
+		// 如果文件为空，EOF 是正常情况，初始化为空列表
+		if err == io.EOF {
+			return nil
+		}
+		return err
+	}
+	for _, quota := range quotas {
+		f.nextID = max(f.nextID, quota.ID)
+	}
+	for _, quota := range quotas {
+		if quota.ID == 0 {
+			f.nextID++
+			quota.ID = f.nextID
+		}
+		if quota.UID == 0 {
+			quota.UID = f.node.Generate().Int64()
+		}
+	}
+	f.quotas = quotas
+	return nil
+}
+
+func (f *fileRepository) save() error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.changed = false
+	file, err := os.Create(f.tmpFilepath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	if err := yaml.NewEncoder(file).Encode(f.quotas); err != nil {
+		return err
+	}
+	if err := os.Rename(f.tmpFilepath, f.filepath); err != nil {
+		return err
+	}
+	klog.Debugw("msg", "save quotas to file", "filepath", f.filepath)
+	return nil
+}
+
+func (f *fileRepository) watch() {
+	if f.storageInterval <= 0 {
+		return
+	}
+	go func() {
+		ticker := time.NewTicker(f.storageInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ticker.C:
+				f.mu.RLock()
+				changed := f.changed
+				f.mu.RUnlock()
+				if changed {
+					if err := f.save(); err != nil {
+						klog.Warnw("msg", "save quotas failed", "error", err)
+					}
+				}
+			case <-f.stopChan:
+				klog.Debugw("msg", "stop watch quotas")
+				return
+			}
+		}
+	}()
+}
+
+// find returns the quota of the resource in the namespace, the caller must hold the lock.
+func (f *fileRepository) find(namespaceUID int64, resource string) *model.QuotaModel {
+	for _, quota := range f.quotas {
+		if quota.NamespaceUID == namespaceUID && quota.Resource == resource {
+			return quota
+		}
+	}
+	return nil
+}
+
+// SetQuota implements [quotav1.Repository].
+func (f *fileRepository) SetQuota(ctx context.Context, req *quotav1.SetQuotaRequest) (*quotav1.QuotaModel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.changed = true
+	now := time.Now().Unix()
+	if quota := f.find(req.NamespaceUid, req.Resource); quota != nil {
+		quota.Limit = req.Limit
+		quota.UpdatedAt = now
+		return convertQuotaModel(quota), nil
+	}
+	f.nextID++
+	quota := &model.QuotaModel{
+		ID:           f.nextID,
+		UID:          f.node.Generate().Int64(),
+		NamespaceUID: req.NamespaceUid,
+		Resource:     req.Resource,
+		Limit:        req.Limit,
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	f.quotas = append(f.quotas, quota)
+	return convertQuotaModel(quota), nil
+}
+
+// GetQuota implements [quotav1.Repository].
+func (f *fileRepository) GetQuota(ctx context.Context, req *quotav1.GetQuotaRequest) (*quotav1.QuotaModel, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	if quota := f.find(req.NamespaceUid, req.Resource); quota != nil {
+		return convertQuotaModel(quota), nil
+	}
+	return nil, merr.ErrorNotFound("quota %s not found in namespace %d", req.Resource, req.NamespaceUid)
+}
+
+// DeleteQuota implements [quotav1.Repository].
+func (f *fileRepository) DeleteQuota(ctx context.Context, req *quotav1.DeleteQuotaRequest) (*quotav1.ResultInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	for i, quota := range f.quotas {
+		if quota.NamespaceUID == req.NamespaceUid && quota.Resource == req.Resource {
+			f.changed = true
+			f.quotas = append(f.quotas[:i], f.quotas[i+1:]...)
+			return &quotav1.ResultInfo{RowsAffected: 1, Error: ""}, nil
+		}
+	}
+	return &quotav1.ResultInfo{RowsAffected: 0, Error: "quota not found"}, nil
+}
+
+// DeleteNamespaceQuotas implements [quotav1.Repository].
+func (f *fileRepository) DeleteNamespaceQuotas(ctx context.Context, req *quotav1.DeleteNamespaceQuotasRequest) (*quotav1.ResultInfo, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	count := len(f.quotas)
+	f.quotas = slices.DeleteFunc(f.quotas, func(quota *model.QuotaModel) bool {
+		return quota.NamespaceUID == req.NamespaceUid
+	})
+	rowsAffected := int64(count - len(f.quotas))
+	if rowsAffected > 0 {
+		f.changed = true
+	}
+	return &quotav1.ResultInfo{RowsAffected: rowsAffected}, nil
+}
+
+// ListQuota implements [quotav1.Repository].
+func (f *fileRepository) ListQuota(ctx context.Context, req *quotav1.ListQuotaRequest) (*quotav1.ListQuotaResponse, error) {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	quotas := make([]*quotav1.QuotaModel, 0, len(f.quotas))
+	for _, quota := range f.quotas {
+		if quota.NamespaceUID != req.NamespaceUid {
+			continue
+		}
+		quotas = append(quotas, convertQuotaModel(quota))
+	}
+	sort.Slice(quotas, func(i, j int) bool {
+		return quotas[i].Resource < quotas[j].Resource
+	})
+	return &quotav1.ListQuotaResponse{
+		Quotas: quotas,
+		Total:  int64(len(quotas)),
+	}, nil
+}
+
+// Reserve implements [quotav1.Repository].
+func (f *fileRepository) Reserve(ctx context.Context, req *quotav1.ReserveRequest) (*quotav1.QuotaModel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	quota := f.find(req.NamespaceUid, req.Resource)
+	if quota == nil {
+		return nil, merr.ErrorNotFound("quota %s not found in namespace %d", req.Resource, req.NamespaceUid)
+	}
+	if quota.Used+req.Amount > quota.Limit {
+		return nil, merr.ErrorTooManyRequests("quota %s exceeded in namespace %d: used %d, requested %d, limit %d", req.Resource, req.NamespaceUid, quota.Used, req.Amount, quota.Limit)
+	}
+	f.changed = true
+	quota.Used += req.Amount
+	quota.UpdatedAt = time.Now().Unix()
+	return convertQuotaModel(quota), nil
+}
+
+// Release implements [quotav1.Repository].
+func (f *fileRepository) Release(ctx context.Context, req *quotav1.ReleaseRequest) (*quotav1.QuotaModel, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	quota := f.find(req.NamespaceUid, req.Resource)
+	if quota == nil {
+		return nil, merr.ErrorNotFound("quota %s not found in namespace %d", req.Resource, req.NamespaceUid)
+	}
+	f.changed = true
+	quota.Used = max(quota.Used-req.Amount, 0)
+	quota.UpdatedAt = time.Now().Unix()
+	return convertQuotaModel(quota), nil
+}
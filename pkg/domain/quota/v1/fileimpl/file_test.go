@@ -0,0 +1,132 @@
+package fileimpl_test
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/protobuf/types/known/anypb"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
+	"github.com/aide-family/sovereign/pkg/domain/quota/v1/fileimpl"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+const (
+	defaultNamespaceUID = 1001
+	otherNamespaceUID   = 1002
+)
+
+func newFileRepository(t *testing.T) quotav1.Repository {
+	t.Helper()
+	options, err := anypb.New(&config.FileConfig{Path: t.TempDir(), Filename: "quotas.yaml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	repo, closer, err := fileimpl.NewFileRepository(&config.DomainConfig{Driver: config.DomainConfig_FILE, Options: options})
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := closer(); err != nil {
+			t.Error(err)
+		}
+	})
+	return repo
+}
+
+func TestReserveRelease(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	if _, err := repo.SetQuota(ctx, &quotav1.SetQuotaRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Limit: 3}); err != nil {
+		t.Fatal(err)
+	}
+
+	quota, err := repo.Reserve(ctx, &quotav1.ReserveRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Amount: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.GetUsed() != 2 {
+		t.Fatalf("want used 2, got %d", quota.GetUsed())
+	}
+	if _, err := repo.Reserve(ctx, &quotav1.ReserveRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Amount: 2}); !merr.IsTooManyRequests(err) {
+		t.Fatalf("want a reservation over the limit rejected, got %v", err)
+	}
+	quota, err = repo.Reserve(ctx, &quotav1.ReserveRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Amount: 1})
+	if err != nil {
+		t.Fatalf("want a reservation up to the limit allowed, got %v", err)
+	}
+	if quota.GetUsed() != 3 {
+		t.Fatalf("want used 3, got %d", quota.GetUsed())
+	}
+
+	quota, err = repo.Release(ctx, &quotav1.ReleaseRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Amount: 5})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.GetUsed() != 0 {
+		t.Fatalf("want a release below zero clamped to 0, got %d", quota.GetUsed())
+	}
+
+	if _, err := repo.Reserve(ctx, &quotav1.ReserveRequest{NamespaceUid: defaultNamespaceUID, Resource: "secrets", Amount: 1}); !merr.IsNotFound(err) {
+		t.Fatalf("want a reservation of an unknown quota not found, got %v", err)
+	}
+	if _, err := repo.Release(ctx, &quotav1.ReleaseRequest{NamespaceUid: otherNamespaceUID, Resource: "configs", Amount: 1}); !merr.IsNotFound(err) {
+		t.Fatalf("want a release of an unknown quota not found, got %v", err)
+	}
+}
+
+func TestSetQuotaKeepsUsage(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	if _, err := repo.SetQuota(ctx, &quotav1.SetQuotaRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Limit: 5}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := repo.Reserve(ctx, &quotav1.ReserveRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Amount: 4}); err != nil {
+		t.Fatal(err)
+	}
+	quota, err := repo.SetQuota(ctx, &quotav1.SetQuotaRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Limit: 2})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if quota.GetLimit() != 2 || quota.GetUsed() != 4 {
+		t.Fatalf("want limit 2 and used 4, got limit %d and used %d", quota.GetLimit(), quota.GetUsed())
+	}
+	if _, err := repo.Reserve(ctx, &quotav1.ReserveRequest{NamespaceUid: defaultNamespaceUID, Resource: "configs", Amount: 1}); !merr.IsTooManyRequests(err) {
+		t.Fatalf("want a reservation over a lowered limit rejected, got %v", err)
+	}
+	list, err := repo.ListQuota(ctx, &quotav1.ListQuotaRequest{NamespaceUid: defaultNamespaceUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if list.GetTotal() != 1 {
+		t.Fatalf("want updating a quota not to duplicate it, got %d quotas", list.GetTotal())
+	}
+}
+
+func TestDeleteNamespaceQuotas(t *testing.T) {
+	repo := newFileRepository(t)
+	ctx := context.Background()
+	for _, req := range []*quotav1.SetQuotaRequest{
+		{NamespaceUid: defaultNamespaceUID, Resource: "configs", Limit: 1},
+		{NamespaceUid: defaultNamespaceUID, Resource: "secrets", Limit: 1},
+		{NamespaceUid: otherNamespaceUID, Resource: "configs", Limit: 1},
+	} {
+		if _, err := repo.SetQuota(ctx, req); err != nil {
+			t.Fatal(err)
+		}
+	}
+	result, err := repo.DeleteNamespaceQuotas(ctx, &quotav1.DeleteNamespaceQuotasRequest{NamespaceUid: defaultNamespaceUID})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if result.GetRowsAffected() != 2 {
+		t.Fatalf("want both quotas of the namespace deleted, got %d", result.GetRowsAffected())
+	}
+	if list, err := repo.ListQuota(ctx, &quotav1.ListQuotaRequest{NamespaceUid: defaultNamespaceUID}); err != nil || list.GetTotal() != 0 {
+		t.Fatalf("want no quota left in the namespace, got %v %v", list, err)
+	}
+	if list, err := repo.ListQuota(ctx, &quotav1.ListQuotaRequest{NamespaceUid: otherNamespaceUID}); err != nil || list.GetTotal() != 1 {
+		t.Fatalf("want the quota of another namespace kept, got %v %v", list, err)
+	}
+}
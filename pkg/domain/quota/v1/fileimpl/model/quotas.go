@@ -0,0 +1,13 @@
+// Package model is the model package for the quota service.
+package model
+
+type QuotaModel struct {
+	ID           uint32 `json:"id" yaml:"id"`
+	UID          int64  `json:"uid" yaml:"uid"`
+	NamespaceUID int64  `json:"namespaceUid" yaml:"namespaceUid"`
+	Resource     string `json:"resource" yaml:"resource"`
+	Limit        int64  `json:"limit" yaml:"limit"`
+	Used         int64  `json:"used" yaml:"used"`
+	CreatedAt    int64  `json:"createdAt" yaml:"createdAt"`
+	UpdatedAt    int64  `json:"updatedAt" yaml:"updatedAt"`
+}
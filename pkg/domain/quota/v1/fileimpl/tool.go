@@ -0,0 +1,19 @@
+package fileimpl
+
+import (
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
+	"github.com/aide-family/sovereign/pkg/domain/quota/v1/fileimpl/model"
+)
+
+func convertQuotaModel(quotaModel *model.QuotaModel) *quotav1.QuotaModel {
+	return &quotav1.QuotaModel{
+		Id:           quotaModel.ID,
+		Uid:          quotaModel.UID,
+		NamespaceUid: quotaModel.NamespaceUID,
+		Resource:     quotaModel.Resource,
+		Limit:        quotaModel.Limit,
+		Used:         quotaModel.Used,
+		CreatedAt:    quotaModel.CreatedAt,
+		UpdatedAt:    quotaModel.UpdatedAt,
+	}
+}
@@ -0,0 +1,174 @@
+// Package gormimpl is the implementation of the gorm repository for the quota service.
+package gormimpl
+
+import (
+	"context"
+	"errors"
+
+	"github.com/aide-family/magicbox/hello"
+	"github.com/aide-family/magicbox/pointer"
+	"github.com/bwmarrin/snowflake"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	"github.com/aide-family/sovereign/pkg/connect"
+	domain "github.com/aide-family/sovereign/pkg/domain"
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
+	"github.com/aide-family/sovereign/pkg/domain/quota/v1/gormimpl/model"
+	"github.com/aide-family/sovereign/pkg/domain/quota/v1/gormimpl/query"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+func init() {
+	domain.RegisterQuotaV1Factory(config.DomainConfig_GORM, NewGormRepository)
+}
+
+func NewGormRepository(c *config.DomainConfig) (quotav1.Repository, func() error, error) {
+	ormConfig := &config.ORMConfig{}
+	if pointer.IsNotNil(c.GetOptions()) {
+		if err := anypb.UnmarshalTo(c.GetOptions(), ormConfig, proto.UnmarshalOptions{Merge: true}); err != nil {
+			return nil, nil, merr.ErrorInternalServer("unmarshal orm config failed: %v", err)
+		}
+	}
+	db, close, err := connect.NewDB(ormConfig)
+	if err != nil {
+		return nil, nil, err
+	}
+	query.SetDefault(db)
+	node, err := snowflake.NewNode(hello.NodeID())
+	if err != nil {
+		return nil, nil, err
+	}
+	return &gormRepository{repoConfig: c, db: db, node: node}, close, nil
+}
+
+type gormRepository struct {
+	repoConfig *config.DomainConfig
+	db         *gorm.DB
+	node       *snowflake.Node
+}
+
+// SetQuota implements [quotav1.Repository], an upsert keeps concurrent sets of a missing quota from racing on the unique index.
+func (g *gormRepository) SetQuota(ctx context.Context, req *quotav1.SetQuotaRequest) (*quotav1.QuotaModel, error) {
+	mutation := query.Use(g.db).Quota
+	err := mutation.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns: []clause.Column{
+			{Name: mutation.NamespaceUID.ColumnName().String()},
+			{Name: mutation.Resource.ColumnName().String()},
+		},
+		DoUpdates: clause.AssignmentColumns([]string{
+			mutation.Limit_.ColumnName().String(),
+			mutation.UpdatedAt.ColumnName().String(),
+		}),
+	}).Create(&model.Quota{
+		UID:          g.node.Generate(),
+		NamespaceUID: snowflake.ParseInt64(req.NamespaceUid),
+		Resource:     req.Resource,
+		Limit:        req.Limit,
+	})
+	if err != nil {
+		return nil, merr.ErrorInternalServer("set quota failed: %v", err)
+	}
+	return g.GetQuota(ctx, &quotav1.GetQuotaRequest{NamespaceUid: req.NamespaceUid, Resource: req.Resource})
+}
+
+// GetQuota implements [quotav1.Repository].
+func (g *gormRepository) GetQuota(ctx context.Context, req *quotav1.GetQuotaRequest) (*quotav1.QuotaModel, error) {
+	mutation := query.Use(g.db)
+	quotaDo, err := mutation.Quota.WithContext(ctx).Where(mutation.Quota.NamespaceUID.Eq(req.NamespaceUid), mutation.Quota.Resource.Eq(req.Resource)).First()
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, merr.ErrorNotFound("quota %s not found in namespace %d", req.Resource, req.NamespaceUid)
+		}
+		return nil, err
+	}
+	return ConvertQuotaModel(quotaDo), nil
+}
+
+// DeleteQuota implements [quotav1.Repository].
+func (g *gormRepository) DeleteQuota(ctx context.Context, req *quotav1.DeleteQuotaRequest) (*quotav1.ResultInfo, error) {
+	mutation := query.Use(g.db)
+	result, err := mutation.Quota.WithContext(ctx).Where(mutation.Quota.NamespaceUID.Eq(req.NamespaceUid), mutation.Quota.Resource.Eq(req.Resource)).Delete()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("delete quota failed: %v", err)
+	}
+	return convertResultInfo(&result), nil
+}
+
+// DeleteNamespaceQuotas implements [quotav1.Repository].
+func (g *gormRepository) DeleteNamespaceQuotas(ctx context.Context, req *quotav1.DeleteNamespaceQuotasRequest) (*quotav1.ResultInfo, error) {
+	mutation := query.Use(g.db)
+	result, err := mutation.Quota.WithContext(ctx).Where(mutation.Quota.NamespaceUID.Eq(req.NamespaceUid)).Delete()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("delete namespace quotas failed: %v", err)
+	}
+	return convertResultInfo(&result), nil
+}
+
+// ListQuota implements [quotav1.Repository].
+func (g *gormRepository) ListQuota(ctx context.Context, req *quotav1.ListQuotaRequest) (*quotav1.ListQuotaResponse, error) {
+	mutation := query.Use(g.db).Quota
+	queryQuotas, err := mutation.WithContext(ctx).Where(mutation.NamespaceUID.Eq(req.NamespaceUid)).Order(mutation.Resource).Find()
+	if err != nil {
+		return nil, merr.ErrorInternalServer("list quota failed: %v", err)
+	}
+	quotas := make([]*quotav1.QuotaModel, 0, len(queryQuotas))
+	for _, queryQuota := range queryQuotas {
+		quotas = append(quotas, ConvertQuotaModel(queryQuota))
+	}
+	return &quotav1.ListQuotaResponse{
+		Quotas: quotas,
+		Total:  int64(len(quotas)),
+	}, nil
+}
+
+// Reserve implements [quotav1.Repository].
+func (g *gormRepository) Reserve(ctx context.Context, req *quotav1.ReserveRequest) (*quotav1.QuotaModel, error) {
+	return g.adjust(ctx, req.NamespaceUid, req.Resource, func(quotaDo *model.Quota) (int64, error) {
+		used := quotaDo.Used + req.Amount
+		if used > quotaDo.Limit {
+			return 0, merr.ErrorTooManyRequests("quota %s exceeded in namespace %d: used %d, requested %d, limit %d", req.Resource, req.NamespaceUid, quotaDo.Used, req.Amount, quotaDo.Limit)
+		}
+		return used, nil
+	})
+}
+
+// Release implements [quotav1.Repository].
+func (g *gormRepository) Release(ctx context.Context, req *quotav1.ReleaseRequest) (*quotav1.QuotaModel, error) {
+	return g.adjust(ctx, req.NamespaceUid, req.Resource, func(quotaDo *model.Quota) (int64, error) {
+		return max(quotaDo.Used-req.Amount, 0), nil
+	})
+}
+
+// adjust locks the quota row, computes the new used value and writes it back in one transaction.
+func (g *gormRepository) adjust(ctx context.Context, namespaceUID int64, resource string, next func(quotaDo *model.Quota) (int64, error)) (*quotav1.QuotaModel, error) {
+	var quotaDo *model.Quota
+	mutation := query.Use(g.db)
+	err := mutation.Transaction(func(tx *query.Query) error {
+		var err error
+		quotaDo, err = tx.Quota.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(tx.Quota.NamespaceUID.Eq(namespaceUID), tx.Quota.Resource.Eq(resource)).First()
+		if err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return merr.ErrorNotFound("quota %s not found in namespace %d", resource, namespaceUID)
+			}
+			return merr.ErrorInternalServer("get quota failed: %v", err)
+		}
+		used, err := next(quotaDo)
+		if err != nil {
+			return err
+		}
+		if _, err := tx.Quota.WithContext(ctx).Where(tx.Quota.ID.Eq(quotaDo.ID)).UpdateSimple(tx.Quota.Used.Value(used)); err != nil {
+			return merr.ErrorInternalServer("update quota usage failed: %v", err)
+		}
+		quotaDo.Used = used
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ConvertQuotaModel(quotaDo), nil
+}
@@ -0,0 +1,32 @@
+// Package model is the model package for the quota service.
+package model
+
+import (
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+)
+
+func Models() []any {
+	return []any{
+		&Quota{},
+	}
+}
+
+type Quota struct {
+	ID        uint32       `gorm:"column:id;primaryKey;autoIncrement"`
+	UID       snowflake.ID `gorm:"column:uid;not null;uniqueIndex"`
+	CreatedAt time.Time    `gorm:"column:created_at;type:datetime;not null;"`
+	UpdatedAt time.Time    `gorm:"column:updated_at;type:datetime;not null;"`
+
+	// NamespaceUID keys the quota by the namespace uid, which neither a rename nor a rollback of the namespace changes
+	// and a later namespace of the same name does not reuse.
+	NamespaceUID snowflake.ID `gorm:"column:namespace_uid;not null;uniqueIndex:idx__quota__namespace_uid__resource"`
+	Resource     string       `gorm:"column:resource;type:varchar(100);not null;uniqueIndex:idx__quota__namespace_uid__resource"`
+	Limit        int64        `gorm:"column:limit;type:bigint;not null;default:0"`
+	Used         int64        `gorm:"column:used;type:bigint;not null;default:0"`
+}
+
+func (Quota) TableName() string {
+	return "namespace_quotas"
+}
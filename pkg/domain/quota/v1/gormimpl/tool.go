@@ -0,0 +1,32 @@
+package gormimpl
+
+import (
+	"gorm.io/gen"
+
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
+	"github.com/aide-family/sovereign/pkg/domain/quota/v1/gormimpl/model"
+)
+
+func ConvertQuotaModel(quotaDo *model.Quota) *quotav1.QuotaModel {
+	return &quotav1.QuotaModel{
+		Id:           quotaDo.ID,
+		Uid:          quotaDo.UID.Int64(),
+		NamespaceUid: quotaDo.NamespaceUID.Int64(),
+		Resource:     quotaDo.Resource,
+		Limit:        quotaDo.Limit,
+		Used:         quotaDo.Used,
+		CreatedAt:    quotaDo.CreatedAt.Unix(),
+		UpdatedAt:    quotaDo.UpdatedAt.Unix(),
+	}
+}
+
+func convertResultInfo(result *gen.ResultInfo) *quotav1.ResultInfo {
+	var errStr string
+	if err := result.Error; err != nil {
+		errStr = err.Error()
+	}
+	return &quotav1.ResultInfo{
+		RowsAffected: result.RowsAffected,
+		Error:        errStr,
+	}
+}
@@ -0,0 +1,20 @@
+// Package quotav1 is the quota service implementation.
+package quotav1
+
+import (
+	context "context"
+)
+
+type Repository interface {
+	SetQuota(ctx context.Context, req *SetQuotaRequest) (*QuotaModel, error)
+	GetQuota(ctx context.Context, req *GetQuotaRequest) (*QuotaModel, error)
+	DeleteQuota(ctx context.Context, req *DeleteQuotaRequest) (*ResultInfo, error)
+	// DeleteNamespaceQuotas removes every quota of the namespace at once.
+	DeleteNamespaceQuotas(ctx context.Context, req *DeleteNamespaceQuotasRequest) (*ResultInfo, error)
+	ListQuota(ctx context.Context, req *ListQuotaRequest) (*ListQuotaResponse, error)
+	// Reserve atomically adds amount to the used value of the quota,
+	// it returns merr.ErrorTooManyRequests if the limit would be exceeded.
+	Reserve(ctx context.Context, req *ReserveRequest) (*QuotaModel, error)
+	// Release atomically subtracts amount from the used value of the quota, never below zero.
+	Release(ctx context.Context, req *ReleaseRequest) (*QuotaModel, error)
+}
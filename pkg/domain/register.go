@@ -7,6 +7,7 @@ import (
 	"github.com/aide-family/sovereign/pkg/config"
 	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
 	namespacev1 "github.com/aide-family/sovereign/pkg/domain/namespace/v1"
+	quotav1 "github.com/aide-family/sovereign/pkg/domain/quota/v1"
 )
 
 var globalRegistry = NewRegistry()
@@ -15,6 +16,7 @@ func NewRegistry() Registry {
 	return &registry{
 		namespaceV1: safety.NewSyncMap(make(map[config.DomainConfig_Driver]NamespaceFactoryV1)),
 		authV1:      safety.NewSyncMap(make(map[config.DomainConfig_Driver]AuthFactoryV1)),
+		quotaV1:     safety.NewSyncMap(make(map[config.DomainConfig_Driver]QuotaFactoryV1)),
 	}
 }
 
@@ -22,16 +24,21 @@ type NamespaceFactoryV1 func(c *config.DomainConfig) (namespacev1.Repository, fu
 
 type AuthFactoryV1 func(c *config.DomainConfig, jwtConfig *config.JWT) (authv1.Repository, func() error, error)
 
+type QuotaFactoryV1 func(c *config.DomainConfig) (quotav1.Repository, func() error, error)
+
 type Registry interface {
 	RegisterNamespaceV1Factory(name config.DomainConfig_Driver, factory NamespaceFactoryV1)
 	GetNamespaceV1Factory(name config.DomainConfig_Driver) (NamespaceFactoryV1, bool)
 	RegisterAuthV1Factory(name config.DomainConfig_Driver, factory AuthFactoryV1)
 	GetAuthV1Factory(name config.DomainConfig_Driver) (AuthFactoryV1, bool)
+	RegisterQuotaV1Factory(name config.DomainConfig_Driver, factory QuotaFactoryV1)
+	GetQuotaV1Factory(name config.DomainConfig_Driver) (QuotaFactoryV1, bool)
 }
 
 type registry struct {
 	namespaceV1 *safety.SyncMap[config.DomainConfig_Driver, NamespaceFactoryV1]
 	authV1      *safety.SyncMap[config.DomainConfig_Driver, AuthFactoryV1]
+	quotaV1     *safety.SyncMap[config.DomainConfig_Driver, QuotaFactoryV1]
 }
 
 func (r *registry) RegisterNamespaceV1Factory(name config.DomainConfig_Driver, factory NamespaceFactoryV1) {
@@ -50,6 +57,14 @@ func (r *registry) GetAuthV1Factory(name config.DomainConfig_Driver) (AuthFactor
 	return r.authV1.Get(name)
 }
 
+func (r *registry) RegisterQuotaV1Factory(name config.DomainConfig_Driver, factory QuotaFactoryV1) {
+	r.quotaV1.Set(name, factory)
+}
+
+func (r *registry) GetQuotaV1Factory(name config.DomainConfig_Driver) (QuotaFactoryV1, bool) {
+	return r.quotaV1.Get(name)
+}
+
 func RegisterNamespaceV1Factory(name config.DomainConfig_Driver, factory NamespaceFactoryV1) {
 	globalRegistry.RegisterNamespaceV1Factory(name, factory)
 }
@@ -65,3 +80,11 @@ func RegisterAuthV1Factory(name config.DomainConfig_Driver, factory AuthFactoryV
 func GetAuthV1Factory(name config.DomainConfig_Driver) (AuthFactoryV1, bool) {
 	return globalRegistry.GetAuthV1Factory(name)
 }
+
+func RegisterQuotaV1Factory(name config.DomainConfig_Driver, factory QuotaFactoryV1) {
+	globalRegistry.RegisterQuotaV1Factory(name, factory)
+}
+
+func GetQuotaV1Factory(name config.DomainConfig_Driver) (QuotaFactoryV1, bool) {
+	return globalRegistry.GetQuotaV1Factory(name)
+}
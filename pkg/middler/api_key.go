@@ -0,0 +1,72 @@
+package middler
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	"github.com/aide-family/magicbox/strutil"
+	"github.com/aide-family/magicbox/strutil/cnst"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// APIKeyVerifyFunc returns the claims of the owner of an API key and the restrictions of the key.
+type APIKeyVerifyFunc func(ctx context.Context, key string) (*authv1.JwtClaims, *authv1.APIKeyInfo, error)
+
+// APIKeyServe signs a request sent with an API key in as the owner of the key when the scopes of the key allow the operation,
+// requests sent with a JWT go through jwtServe.
+func APIKeyServe(jwtServe middleware.Middleware, verify APIKeyVerifyFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		jwtHandler := jwtServe(handler)
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return jwtHandler(ctx, req)
+			}
+			auths := strings.SplitN(tr.RequestHeader().Get(cnst.HTTPHeaderAuthorization), " ", 2)
+			if len(auths) != 2 || !strings.EqualFold(auths[0], cnst.HTTPHeaderBearerPrefix) || !authv1.IsAPIKey(auths[1]) {
+				return jwtHandler(ctx, req)
+			}
+			claims, info, err := verify(ctx, auths[1])
+			if err != nil {
+				return nil, err
+			}
+			if !info.AllowOperation(tr.Operation()) {
+				return nil, merr.ErrorForbidden("api key is not allowed to call %s", tr.Operation())
+			}
+			ctx = jwt.NewContext(ctx, claims)
+			ctx = authv1.WithAPIKeyInfo(ctx, info)
+			return handler(ctx, req)
+		}
+	}
+}
+
+// MustAPIKeyNamespace rejects a namespace outside the namespaces of the API key of the request, it runs after MustNamespace
+// or BindRequestNamespace. A key restricted to namespaces can not call the unscoped operations, which act across namespaces,
+// nor an operation without a namespace.
+func MustAPIKeyNamespace(unscoped ...string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			info, ok := authv1.GetAPIKeyInfo(ctx)
+			if !ok || len(info.Namespaces) == 0 {
+				return handler(ctx, req)
+			}
+			if tr, ok := transport.FromServerContext(ctx); ok && slices.Contains(unscoped, tr.Operation()) {
+				return nil, merr.ErrorForbidden("api key restricted to namespaces is not allowed to call %s", tr.Operation())
+			}
+			namespace := GetNamespace(ctx)
+			if strutil.IsEmpty(namespace) {
+				return nil, merr.ErrorForbidden("api key restricted to namespaces requires a namespace")
+			}
+			if !info.AllowNamespace(namespace) {
+				return nil, merr.ErrorForbidden("api key is not allowed to use namespace %s", namespace)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
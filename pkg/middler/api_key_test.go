@@ -0,0 +1,75 @@
+package middler_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+	"github.com/aide-family/sovereign/pkg/middler"
+)
+
+func TestMustAPIKeyNamespaceByRequestUID(t *testing.T) {
+	names := map[snowflake.ID]string{1: "team-a", 2: "team-b"}
+	nameOf := func(_ context.Context, uid snowflake.ID) (string, error) {
+		name, ok := names[uid]
+		if !ok {
+			return "", merr.ErrorNotFound("namespace %s not found", uid)
+		}
+		return name, nil
+	}
+	unscoped := []string{apiv1.OperationNamespaceCreateNamespace, apiv1.OperationNamespaceListNamespace}
+	bind := middler.BindRequestNamespace(nameOf)
+	handler := bind(middler.MustAPIKeyNamespace(unscoped...)(func(ctx context.Context, _ any) (any, error) {
+		return middler.GetNamespace(ctx), nil
+	}))
+	restricted := &authv1.APIKeyInfo{UID: 7, Namespaces: []string{"team-a"}}
+	call := func(info *authv1.APIKeyInfo, operation string, req any) (any, error) {
+		ctx := transport.NewServerContext(context.Background(), &serverTransport{header: headerCarrier(nethttp.Header{}), operation: operation})
+		if info != nil {
+			ctx = authv1.WithAPIKeyInfo(ctx, info)
+		}
+		return handler(ctx, req)
+	}
+
+	tests := []struct {
+		name      string
+		info      *authv1.APIKeyInfo
+		operation string
+		req       any
+		want      string
+		forbidden bool
+	}{
+		{name: "namespace of the key", info: restricted, operation: apiv1.OperationNamespaceDeleteNamespace, req: &apiv1.DeleteNamespaceRequest{Uid: 1}, want: "team-a"},
+		{name: "namespace outside the key", info: restricted, operation: apiv1.OperationNamespaceDeleteNamespace, req: &apiv1.DeleteNamespaceRequest{Uid: 2}, forbidden: true},
+		{name: "update outside the key", info: restricted, operation: apiv1.OperationNamespaceUpdateNamespace, req: &apiv1.UpdateNamespaceRequest{Uid: 2}, forbidden: true},
+		{name: "missing namespace", info: restricted, operation: apiv1.OperationNamespaceGetNamespace, req: &apiv1.GetNamespaceRequest{Uid: 3}, forbidden: true},
+		{name: "create with a restricted key", info: restricted, operation: apiv1.OperationNamespaceCreateNamespace, req: &apiv1.CreateNamespaceRequest{}, forbidden: true},
+		{name: "list with a restricted key", info: restricted, operation: apiv1.OperationNamespaceListNamespace, req: &apiv1.ListNamespaceRequest{}, forbidden: true},
+		{name: "unrestricted key", info: &authv1.APIKeyInfo{UID: 8}, operation: apiv1.OperationNamespaceDeleteNamespace, req: &apiv1.DeleteNamespaceRequest{Uid: 2}, want: "team-b"},
+		{name: "unrestricted key lists", info: &authv1.APIKeyInfo{UID: 8}, operation: apiv1.OperationNamespaceListNamespace, req: &apiv1.ListNamespaceRequest{}, want: ""},
+		{name: "token", operation: apiv1.OperationNamespaceGetNamespace, req: &apiv1.GetNamespaceRequest{Uid: 2}, want: "team-b"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := call(tt.info, tt.operation, tt.req)
+			if tt.forbidden {
+				if !merr.IsForbidden(err) {
+					t.Fatalf("want forbidden, got %v, %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("want namespace %q bound, got %q", tt.want, got)
+			}
+		})
+	}
+}
@@ -3,10 +3,13 @@ package middler
 
 import (
 	"context"
+	"errors"
+	"reflect"
 	"strings"
 
 	"github.com/aide-family/magicbox/strutil"
 	"github.com/aide-family/magicbox/strutil/cnst"
+	"github.com/bwmarrin/snowflake"
 	"github.com/go-kratos/kratos/v2/metadata"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
@@ -56,25 +59,94 @@ func JwtClient(headers ...string) middleware.Middleware {
 	}
 }
 
-func JwtServe(signKey string, claims jwtv5.Claims) middleware.Middleware {
-	return jwt.Server(
-		func(token *jwtv5.Token) (interface{}, error) {
-			return []byte(signKey), nil
-		},
-		jwt.WithSigningMethod(jwtv5.SigningMethodHS256),
-		jwt.WithClaims(func() jwtv5.Claims {
-			return claims
-		}),
-	)
+// TokenRevokedFunc reports whether the token with the jti tokenID has been revoked before it expires.
+type TokenRevokedFunc func(ctx context.Context, tokenID string) (bool, error)
+
+// UserDisabledFunc reports whether the user has been disabled.
+type UserDisabledFunc func(ctx context.Context, uid snowflake.ID) (bool, error)
+
+// IdentityVerifyFunc returns the user the subject of a trusted issuer token is linked to as an identity of the provider.
+type IdentityVerifyFunc func(ctx context.Context, provider, subject string) (authv1.BaseInfo, error)
+
+// JwtServe parses the bearer token into a new value of the claims type for every request,
+// the token is verified by the key set with the key of its issuer and kid.
+// The uid of a trusted issuer token is not trusted, the token signs in the user its subject is linked to by verifyIdentity.
+func JwtServe(keySet *authv1.KeySet, claims jwtv5.Claims, verifyIdentity IdentityVerifyFunc) middleware.Middleware {
+	claimsType := reflect.TypeOf(claims)
+	newClaims := func() jwtv5.Claims {
+		if claimsType.Kind() == reflect.Pointer {
+			return reflect.New(claimsType.Elem()).Interface().(jwtv5.Claims)
+		}
+		return claims
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, jwt.ErrWrongContext
+			}
+			auths := strings.SplitN(tr.RequestHeader().Get(cnst.HTTPHeaderAuthorization), " ", 2)
+			if len(auths) != 2 || !strings.EqualFold(auths[0], cnst.HTTPHeaderBearerPrefix) {
+				return nil, jwt.ErrMissingJwtToken
+			}
+			token, err := jwtv5.ParseWithClaims(auths[1], newClaims(), keySet.Keyfunc, jwtv5.WithValidMethods(keySet.Methods()))
+			if err != nil {
+				if errors.Is(err, jwtv5.ErrTokenMalformed) || errors.Is(err, jwtv5.ErrTokenUnverifiable) {
+					return nil, jwt.ErrTokenInvalid
+				}
+				if errors.Is(err, jwtv5.ErrTokenNotValidYet) || errors.Is(err, jwtv5.ErrTokenExpired) {
+					return nil, jwt.ErrTokenExpired
+				}
+				return nil, jwt.ErrTokenParseFail
+			}
+			if !token.Valid {
+				return nil, jwt.ErrTokenInvalid
+			}
+			issuer, _ := token.Claims.GetIssuer()
+			if provider, ok := keySet.TrustedProvider(issuer); ok {
+				jwtClaims, ok := token.Claims.(*authv1.JwtClaims)
+				if !ok || verifyIdentity == nil || strutil.IsEmpty(jwtClaims.Subject) {
+					return nil, jwt.ErrTokenInvalid
+				}
+				baseInfo, err := verifyIdentity(ctx, provider, jwtClaims.Subject)
+				if err != nil {
+					return nil, err
+				}
+				jwtClaims.BaseInfo = baseInfo
+			}
+			ctx = jwt.NewContext(ctx, token.Claims)
+			return handler(ctx, req)
+		}
+	}
 }
 
-func MustLogin() middleware.Middleware {
+// MustLogin requires a valid token which is not revoked, tokens without a jti can not be revoked and pass until they expire.
+// The user of the token must not be disabled, which covers the API keys too.
+func MustLogin(isRevoked TokenRevokedFunc, isDisabled UserDisabledFunc) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req any) (any, error) {
 			claims, err := authv1.GetClaimsFromContext(ctx)
 			if err != nil {
 				return nil, err
 			}
+			if isRevoked != nil && strutil.IsNotEmpty(claims.ID) {
+				revoked, err := isRevoked(ctx, claims.ID)
+				if err != nil {
+					return nil, err
+				}
+				if revoked {
+					return nil, merr.ErrorUnauthorized("token is revoked, please login again")
+				}
+			}
+			if isDisabled != nil {
+				disabled, err := isDisabled(ctx, claims.UID)
+				if err != nil {
+					return nil, err
+				}
+				if disabled {
+					return nil, merr.ErrorForbidden("user %s is disabled", claims.Username)
+				}
+			}
 			ctx = authv1.WithBaseInfo(ctx, claims.BaseInfo)
 			return handler(ctx, req)
 		}
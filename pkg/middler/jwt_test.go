@@ -0,0 +1,170 @@
+package middler_test
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	nethttp "net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"github.com/go-kratos/kratos/v2/transport"
+	jwtv5 "github.com/golang-jwt/jwt/v5"
+
+	"github.com/aide-family/sovereign/pkg/config"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+	"github.com/aide-family/sovereign/pkg/middler"
+)
+
+func TestMustLoginRevokedToken(t *testing.T) {
+	revokedIDs := map[string]bool{"revoked": true}
+	isRevoked := func(_ context.Context, tokenID string) (bool, error) {
+		return revokedIDs[tokenID], nil
+	}
+	handler := middler.MustLogin(isRevoked, nil)(func(ctx context.Context, _ any) (any, error) {
+		baseInfo, _ := authv1.GetBaseInfo(ctx)
+		return baseInfo.UID, nil
+	})
+	call := func(tokenID string) (any, error) {
+		claims := &authv1.JwtClaims{
+			BaseInfo:         authv1.BaseInfo{UID: snowflake.ID(42), Username: "alice"},
+			RegisteredClaims: jwtv5.RegisteredClaims{ID: tokenID},
+		}
+		return handler(jwt.NewContext(context.Background(), claims), nil)
+	}
+
+	if _, err := call("revoked"); !merr.IsUnauthorized(err) {
+		t.Fatalf("want a revoked jti rejected, got %v", err)
+	}
+	uid, err := call("active")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if uid != snowflake.ID(42) {
+		t.Fatalf("want the user of the token in the context, got %v", uid)
+	}
+	if _, err := handler(context.Background(), nil); !merr.IsUnauthorized(err) {
+		t.Fatalf("want a request without a token rejected, got %v", err)
+	}
+}
+
+// serverTransport carries the request header of a server call.
+type serverTransport struct {
+	header    headerCarrier
+	operation string
+}
+
+type headerCarrier nethttp.Header
+
+func (h headerCarrier) Get(key string) string              { return nethttp.Header(h).Get(key) }
+func (h headerCarrier) Set(key, value string)              { nethttp.Header(h).Set(key, value) }
+func (h headerCarrier) Add(key, value string)              { nethttp.Header(h).Add(key, value) }
+func (h headerCarrier) Values(key string) []string         { return nethttp.Header(h).Values(key) }
+func (h headerCarrier) Keys() []string                     { return nil }
+func (t *serverTransport) Kind() transport.Kind            { return transport.KindHTTP }
+func (t *serverTransport) Endpoint() string                { return "" }
+func (t *serverTransport) Operation() string               { return t.operation }
+func (t *serverTransport) RequestHeader() transport.Header { return t.header }
+func (t *serverTransport) ReplyHeader() transport.Header   { return headerCarrier{} }
+
+// newRemoteKeySet returns the key set of a remote issuer, its JWKS is served by a test server.
+func newRemoteKeySet(t *testing.T) (*authv1.KeySet, string) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privateKeyFile := filepath.Join(t.TempDir(), "remote.pem")
+	if err := os.WriteFile(privateKeyFile, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	keySet, err := authv1.NewKeySet(&config.JWT{Issuer: "sovereign-remote", Keys: []*config.JWTKey{{PrivateKeyFile: privateKeyFile}}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := httptest.NewServer(nethttp.HandlerFunc(func(w nethttp.ResponseWriter, _ *nethttp.Request) {
+		_ = json.NewEncoder(w).Encode(keySet.JWKS())
+	}))
+	t.Cleanup(srv.Close)
+	return keySet, srv.URL
+}
+
+func TestJwtServeTrustedIssuer(t *testing.T) {
+	remote, jwksURL := newRemoteKeySet(t)
+	keySet, err := authv1.NewKeySet(&config.JWT{
+		Secret:         "secret",
+		Issuer:         "sovereign-test",
+		TrustedIssuers: []*config.JWTTrustedIssuer{{Issuer: "sovereign-remote", JwksUrl: jwksURL, Audience: "sovereign-test", Provider: "remote-sso"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	verifyIdentity := func(_ context.Context, provider, subject string) (authv1.BaseInfo, error) {
+		if provider == "remote-sso" && subject == "alice@remote" {
+			return authv1.BaseInfo{UID: snowflake.ID(7), Username: "alice@example.com"}, nil
+		}
+		return authv1.BaseInfo{}, merr.ErrorUnauthorized("token subject is not linked to a user")
+	}
+	handler := middler.JwtServe(keySet, &authv1.JwtClaims{}, verifyIdentity)(func(ctx context.Context, _ any) (any, error) {
+		return authv1.GetClaimsFromContext(ctx)
+	})
+	call := func(token string) (*authv1.JwtClaims, error) {
+		tr := &serverTransport{header: headerCarrier{}}
+		tr.header.Set("Authorization", "Bearer "+token)
+		reply, err := handler(transport.NewServerContext(context.Background(), tr), nil)
+		if err != nil {
+			return nil, err
+		}
+		return reply.(*authv1.JwtClaims), nil
+	}
+	sign := func(subject string) string {
+		token, err := remote.Sign(&authv1.JwtClaims{
+			BaseInfo: authv1.BaseInfo{UID: snowflake.ID(1), Username: "admin", AMR: []string{"mfa"}},
+			RegisteredClaims: jwtv5.RegisteredClaims{
+				Issuer:    "sovereign-remote",
+				Subject:   subject,
+				Audience:  jwtv5.ClaimStrings{"sovereign-test"},
+				ExpiresAt: jwtv5.NewNumericDate(time.Now().Add(time.Minute)),
+			},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return token
+	}
+
+	claims, err := call(sign("alice@remote"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims.UID != snowflake.ID(7) || claims.Username != "alice@example.com" || len(claims.AMR) > 0 {
+		t.Fatalf("want the linked user instead of the uid of the token, got %+v", claims.BaseInfo)
+	}
+	if _, err := call(sign("mallory@remote")); !merr.IsUnauthorized(err) {
+		t.Fatalf("want a subject without a linked user refused, got %v", err)
+	}
+	if _, err := call(sign("")); err == nil {
+		t.Fatal("want a token without a subject refused")
+	}
+
+	local, err := authv1.NewJwtClaims(&config.JWT{Secret: "secret", Issuer: "sovereign-test"}, authv1.BaseInfo{UID: snowflake.ID(42), Username: "bob"}).GenerateToken()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if claims, err := call(local); err != nil || claims.UID != snowflake.ID(42) {
+		t.Fatalf("want the uid of a local token kept, got %v %v", claims, err)
+	}
+}
@@ -0,0 +1,38 @@
+package middler
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// MustMFA requires the token of an MFA login for the operations, an item ending with * matches the operations starting with it,
+// it runs after MustLogin, the requests with an API key never pass.
+func MustMFA(operations []string) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		if len(operations) == 0 {
+			return handler
+		}
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, merr.ErrorUnauthorized("wrong context for middleware")
+			}
+			if !authv1.MatchPermission(operations, tr.Operation()) {
+				return handler(ctx, req)
+			}
+			baseInfo, ok := authv1.GetBaseInfo(ctx)
+			if !ok {
+				return nil, merr.ErrorUnauthorized("token is required")
+			}
+			if !baseInfo.MFA() {
+				return nil, merr.ErrorForbidden("operation %s requires a login with MFA", tr.Operation())
+			}
+			return handler(ctx, req)
+		}
+	}
+}
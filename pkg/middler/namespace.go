@@ -5,6 +5,7 @@ import (
 
 	"github.com/aide-family/magicbox/strutil"
 	"github.com/aide-family/magicbox/strutil/cnst"
+	"github.com/bwmarrin/snowflake"
 	"github.com/go-kratos/kratos/v2/metadata"
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/transport"
@@ -18,11 +19,18 @@ func WithNamespace(ctx context.Context, namespace string) context.Context {
 	return context.WithValue(ctx, namespaceKey{}, namespace)
 }
 
+// GetNamespace returns the namespace of the request, empty for the operations which do not require one.
 func GetNamespace(ctx context.Context) string {
-	return ctx.Value(namespaceKey{}).(string)
+	namespace, _ := ctx.Value(namespaceKey{}).(string)
+	return namespace
 }
 
-func MustNamespace() middleware.Middleware {
+// DefaultNamespaceFunc returns the default namespace of the signed-in user, empty when the user has none.
+type DefaultNamespaceFunc func(ctx context.Context) (string, error)
+
+// MustNamespace takes the namespace from the request header or metadata, and falls back to the default namespace
+// of the signed-in user when both are missing.
+func MustNamespace(defaultNamespace DefaultNamespaceFunc) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req any) (any, error) {
 			var namespace string
@@ -49,7 +57,44 @@ func MustNamespace() middleware.Middleware {
 				return handler(ctx, req)
 			}
 
-			return nil, merr.ErrorForbidden("namespace is required, please set the namespace in the request header or metadata, Example: %s: default", cnst.HTTPHeaderXNamespace)
+			if defaultNamespace != nil {
+				namespace, err := defaultNamespace(ctx)
+				if err != nil {
+					return nil, err
+				}
+				if strutil.IsNotEmpty(namespace) {
+					ctx = WithNamespace(ctx, namespace)
+					tr.RequestHeader().Set(cnst.MetadataGlobalKeyNamespace, namespace)
+					return handler(ctx, req)
+				}
+			}
+
+			return nil, merr.ErrorForbidden("namespace is required, please set the namespace in the request header or metadata, or a default namespace in your preferences, Example: %s: default", cnst.HTTPHeaderXNamespace)
+		}
+	}
+}
+
+// NamespaceNameFunc returns the name of the namespace with the uid.
+type NamespaceNameFunc func(ctx context.Context, uid snowflake.ID) (string, error)
+
+// BindRequestNamespace binds the namespace addressed by the uid of the request, so that the roles bound in it and the
+// namespaces of an API key apply to the operations on a namespace, which carry no namespace header. A request for a
+// missing namespace binds none and the operation reports it.
+func BindRequestNamespace(nameOf NamespaceNameFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			r, ok := req.(interface{ GetUid() int64 })
+			if !ok || r.GetUid() <= 0 {
+				return handler(ctx, req)
+			}
+			namespace, err := nameOf(ctx, snowflake.ParseInt64(r.GetUid()))
+			if err != nil {
+				if !merr.IsNotFound(err) {
+					return nil, err
+				}
+				namespace = ""
+			}
+			return handler(WithNamespace(ctx, namespace), req)
 		}
 	}
 }
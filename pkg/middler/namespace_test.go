@@ -0,0 +1,70 @@
+package middler_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/aide-family/magicbox/strutil/cnst"
+	"github.com/go-kratos/kratos/v2/metadata"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/aide-family/sovereign/pkg/merr"
+	"github.com/aide-family/sovereign/pkg/middler"
+)
+
+func TestMustNamespace(t *testing.T) {
+	preferences := func(preferred string, err error) middler.DefaultNamespaceFunc {
+		return func(context.Context) (string, error) {
+			return preferred, err
+		}
+	}
+	handler := func(ctx context.Context, _ any) (any, error) {
+		return middler.GetNamespace(ctx), nil
+	}
+
+	tests := []struct {
+		name             string
+		header           string
+		metadata         string
+		defaultNamespace middler.DefaultNamespaceFunc
+		want             string
+		check            func(error) bool
+	}{
+		{name: "header", header: "team-a", metadata: "team-b", defaultNamespace: preferences("team-c", nil), want: "team-a"},
+		{name: "metadata", metadata: "team-b", defaultNamespace: preferences("team-c", nil), want: "team-b"},
+		{name: "preference", defaultNamespace: preferences("team-c", nil), want: "team-c"},
+		{name: "no preference", defaultNamespace: preferences("", nil), check: merr.IsForbidden},
+		{name: "no preference lookup", check: merr.IsForbidden},
+		{name: "preference lookup fails", defaultNamespace: preferences("", merr.ErrorInternal("get preferences failed")), check: merr.IsInternal},
+		{name: "header skips the preference lookup", header: "team-a", defaultNamespace: preferences("", merr.ErrorInternal("get preferences failed")), want: "team-a"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := headerCarrier(nethttp.Header{})
+			if tt.header != "" {
+				header.Set(cnst.HTTPHeaderXNamespace, tt.header)
+			}
+			ctx := transport.NewServerContext(context.Background(), &serverTransport{header: header})
+			if tt.metadata != "" {
+				ctx = metadata.NewServerContext(ctx, metadata.New(map[string][]string{cnst.MetadataGlobalKeyNamespace: {tt.metadata}}))
+			}
+			got, err := middler.MustNamespace(tt.defaultNamespace)(handler)(ctx, nil)
+			if tt.check != nil {
+				if !tt.check(err) {
+					t.Fatalf("unexpected error %v, %v", got, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got != tt.want {
+				t.Fatalf("want namespace %q, got %q", tt.want, got)
+			}
+			if bound := header.Get(cnst.MetadataGlobalKeyNamespace); bound != tt.want {
+				t.Fatalf("want namespace %q in the request header, got %q", tt.want, bound)
+			}
+		})
+	}
+}
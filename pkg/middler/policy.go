@@ -0,0 +1,30 @@
+package middler
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// PolicyAuthorizeFunc evaluates the policies of the operation, the returned context caches the decision for the request.
+type PolicyAuthorizeFunc func(ctx context.Context, operation, namespace string, req any) (context.Context, error)
+
+// MustPolicy requires the policies matching the operation to allow the request, it runs after MustPermission.
+func MustPolicy(authorize PolicyAuthorizeFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, merr.ErrorUnauthorized("wrong context for middleware")
+			}
+			ctx, err := authorize(ctx, tr.Operation(), GetNamespace(ctx), req)
+			if err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
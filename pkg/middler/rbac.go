@@ -0,0 +1,40 @@
+package middler
+
+import (
+	"context"
+
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+)
+
+// UserPermissionsFunc returns the permissions of the roles bound to the user in every namespace and in the namespace.
+type UserPermissionsFunc func(ctx context.Context, userUID snowflake.ID, namespace string) ([]string, error)
+
+// MustPermission requires a role of the signed-in user to grant the operation, it runs after MustLogin,
+// and after MustNamespace or BindRequestNamespace so that the roles bound in the namespace of the request count.
+func MustPermission(permissions UserPermissionsFunc) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req any) (any, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return nil, merr.ErrorUnauthorized("wrong context for middleware")
+			}
+			baseInfo, ok := authv1.GetBaseInfo(ctx)
+			if !ok {
+				return nil, merr.ErrorUnauthorized("token is required")
+			}
+			granted, err := permissions(ctx, baseInfo.UID, GetNamespace(ctx))
+			if err != nil {
+				return nil, err
+			}
+			if !authv1.MatchPermission(granted, tr.Operation()) {
+				return nil, merr.ErrorForbidden("permission %s is required", tr.Operation())
+			}
+			return handler(ctx, req)
+		}
+	}
+}
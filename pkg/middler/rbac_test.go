@@ -0,0 +1,88 @@
+package middler_test
+
+import (
+	"context"
+	nethttp "net/http"
+	"testing"
+
+	"github.com/aide-family/magicbox/strutil/cnst"
+	"github.com/bwmarrin/snowflake"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	apiv1 "github.com/aide-family/sovereign/pkg/api/v1"
+	authv1 "github.com/aide-family/sovereign/pkg/domain/auth/v1"
+	"github.com/aide-family/sovereign/pkg/merr"
+	"github.com/aide-family/sovereign/pkg/middler"
+)
+
+func TestMustPermission(t *testing.T) {
+	// alice is bound to admin in team-a and to viewer in every namespace.
+	grants := map[string][]string{
+		"":       {apiv1.OperationNamespaceGetNamespace},
+		"team-a": {"*"},
+	}
+	permissions := func(_ context.Context, userUID snowflake.ID, namespace string) ([]string, error) {
+		if userUID != 42 {
+			return nil, nil
+		}
+		granted := append([]string{}, grants[""]...)
+		if namespace != "" {
+			granted = append(granted, grants[namespace]...)
+		}
+		return granted, nil
+	}
+	names := map[snowflake.ID]string{1: "team-a", 2: "team-b"}
+	nameOf := func(_ context.Context, uid snowflake.ID) (string, error) {
+		name, ok := names[uid]
+		if !ok {
+			return "", merr.ErrorNotFound("namespace %s not found", uid)
+		}
+		return name, nil
+	}
+	ok := func(context.Context, any) (any, error) { return "ok", nil }
+	byUID := middler.BindRequestNamespace(nameOf)(middler.MustPermission(permissions)(ok))
+	byHeader := middler.MustNamespace(nil)(middler.MustPermission(permissions)(ok))
+
+	tests := []struct {
+		name      string
+		handler   func(context.Context, any) (any, error)
+		userUID   snowflake.ID
+		operation string
+		namespace string
+		req       any
+		forbidden bool
+	}{
+		{name: "delete the bound namespace", handler: byUID, userUID: 42, operation: apiv1.OperationNamespaceDeleteNamespace, req: &apiv1.DeleteNamespaceRequest{Uid: 1}},
+		{name: "delete another namespace", handler: byUID, userUID: 42, operation: apiv1.OperationNamespaceDeleteNamespace, req: &apiv1.DeleteNamespaceRequest{Uid: 2}, forbidden: true},
+		{name: "delete a missing namespace", handler: byUID, userUID: 42, operation: apiv1.OperationNamespaceDeleteNamespace, req: &apiv1.DeleteNamespaceRequest{Uid: 3}, forbidden: true},
+		{name: "get another namespace with a global role", handler: byUID, userUID: 42, operation: apiv1.OperationNamespaceGetNamespace, req: &apiv1.GetNamespaceRequest{Uid: 2}},
+		{name: "list without a namespace", handler: byUID, userUID: 42, operation: apiv1.OperationNamespaceListNamespace, req: &apiv1.ListNamespaceRequest{}, forbidden: true},
+		{name: "quota in the bound namespace", handler: byHeader, userUID: 42, operation: apiv1.OperationQuotaGetQuota, namespace: "team-a", req: &apiv1.GetQuotaRequest{}},
+		{name: "quota in another namespace", handler: byHeader, userUID: 42, operation: apiv1.OperationQuotaGetQuota, namespace: "team-b", req: &apiv1.GetQuotaRequest{}, forbidden: true},
+		{name: "user without roles", handler: byUID, userUID: 7, operation: apiv1.OperationNamespaceGetNamespace, req: &apiv1.GetNamespaceRequest{Uid: 1}, forbidden: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := headerCarrier(nethttp.Header{})
+			if tt.namespace != "" {
+				header.Set(cnst.HTTPHeaderXNamespace, tt.namespace)
+			}
+			ctx := transport.NewServerContext(context.Background(), &serverTransport{header: header, operation: tt.operation})
+			ctx = authv1.WithBaseInfo(ctx, authv1.BaseInfo{UID: tt.userUID})
+			_, err := tt.handler(ctx, tt.req)
+			if tt.forbidden {
+				if !merr.IsForbidden(err) {
+					t.Fatalf("want forbidden, got %v", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+		})
+	}
+
+	if _, err := byUID(context.Background(), &apiv1.GetNamespaceRequest{Uid: 1}); !merr.IsUnauthorized(err) {
+		t.Fatalf("want a request without a transport rejected, got %v", err)
+	}
+}